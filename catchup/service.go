@@ -64,7 +64,8 @@ type Ledger interface {
 
 // Service represents the catchup service. Once started and until it is stopped, it ensures that the ledger is up to date with network.
 type Service struct {
-	syncStartNS         int64 // at top of struct to keep 64 bit aligned for atomic.* ops
+	syncStartNS         int64  // at top of struct to keep 64 bit aligned for atomic.* ops
+	disableSyncRound    uint64 // at top of struct to keep 64 bit aligned for atomic.* ops
 	cfg                 config.Local
 	ledger              Ledger
 	ctx                 context.Context
@@ -89,6 +90,9 @@ type Service struct {
 	protocolErrorLogged          bool
 	lastSupportedRound           basics.Round
 	unmatchedPendingCertificates <-chan PendingUnmatchedCertificate
+
+	// syncNow is used to wake up periodicSync when the disable sync round is changed.
+	syncNow chan struct{}
 }
 
 // A BlockAuthenticator authenticates blocks given a certificate.
@@ -116,6 +120,7 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.parallelBlocks = config.CatchupParallelBlocks
 	s.deadlineTimeout = agreement.DeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
+	s.syncNow = make(chan struct{}, 1)
 
 	return s
 }
@@ -156,6 +161,44 @@ func (s *Service) SynchronizingTime() time.Duration {
 	return time.Duration(timeInNS - startNS)
 }
 
+// ErrSyncRoundInvalid is returned when the sync round requested is behind the current ledger round
+var ErrSyncRoundInvalid = errors.New("requested sync round cannot be less than the latest round")
+
+// SetDisableSyncRound attempts to set the first round we _do_not_ want to fetch from the network.
+// Blocks from every round prior to this round will be fetched as usual. The catchup resumes right
+// away if the given round is ahead of where it was previously paused.
+func (s *Service) SetDisableSyncRound(rnd uint64) error {
+	if basics.Round(rnd) < s.ledger.LastRound() {
+		return ErrSyncRoundInvalid
+	}
+	atomic.StoreUint64(&s.disableSyncRound, rnd)
+	select {
+	case s.syncNow <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// UnsetDisableSyncRound removes any previously set disabled sync round
+func (s *Service) UnsetDisableSyncRound() {
+	atomic.StoreUint64(&s.disableSyncRound, 0)
+	select {
+	case s.syncNow <- struct{}{}:
+	default:
+	}
+}
+
+// GetDisableSyncRound returns the disabled sync round, or 0 if none is set
+func (s *Service) GetDisableSyncRound() uint64 {
+	return atomic.LoadUint64(&s.disableSyncRound)
+}
+
+// roundIsDisabled returns true if the given round is at or beyond the disabled sync round
+func (s *Service) roundIsDisabled(r basics.Round) bool {
+	disableSyncRound := atomic.LoadUint64(&s.disableSyncRound)
+	return disableSyncRound != 0 && r >= basics.Round(disableSyncRound)
+}
+
 // errLedgerAlreadyHasBlock is returned by innerFetch in case the local ledger already has the requested block.
 var errLedgerAlreadyHasBlock = errors.New("ledger already has block")
 
@@ -212,6 +255,12 @@ func (s *Service) fetchAndWrite(r basics.Round, prevFetchCompleteChan chan bool,
 		default:
 		}
 
+		// Stop if the round was disabled while we were retrying.
+		if s.roundIsDisabled(r) {
+			s.log.Debugf("fetchAndWrite(%v): round is beyond the disabled sync round", r)
+			return false
+		}
+
 		// Stop retrying after a while.
 		if i > catchupRetryLimit {
 			loggedMessage := fmt.Sprintf("fetchAndWrite(%d): block retrieval exceeded retry limit", r)
@@ -476,12 +525,23 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 			break
 		}
 
+		// Stop scheduling rounds which are at or beyond the disabled sync round
+		if s.roundIsDisabled(nextRound) {
+			break
+		}
+
 		currentRoundComplete := make(chan bool, 2)
 		// len(taskCh) + (# pending writes to completed) increases by 1
 		taskCh <- s.pipelineCallback(nextRound, currentRoundComplete, recentReqs[len(recentReqs)-1], recentReqs[len(recentReqs)-int(seedLookback)], peerSelector)
 		recentReqs = append(recentReqs[1:], currentRoundComplete)
 	}
 
+	// If the pipeline already reached the disabled sync round, there is nothing more to schedule;
+	// the deferred cleanup waits for the rounds already scheduled.
+	if s.roundIsDisabled(nextRound) {
+		return
+	}
+
 	completedRounds := make(map[basics.Round]bool)
 	// the rest
 	for {
@@ -506,6 +566,10 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 					s.handleUnsupportedRound(nextRound)
 					return
 				}
+				// Stop the pipeline once it reaches the disabled sync round
+				if s.roundIsDisabled(nextRound) {
+					return
+				}
 				delete(completedRounds, nextRound)
 
 				currentRoundComplete := make(chan bool, 2)
@@ -561,6 +625,14 @@ func (s *Service) periodicSync() {
 			s.suspendForCatchpointWriting = false
 			s.log.Info("It's been too long since our ledger advanced; resyncing")
 			s.sync()
+		case <-s.syncNow:
+			// the disabled sync round has changed; resume fetching right away.
+			if s.parallelBlocks == 0 || s.cfg.DisableNetworking || s.ledger.IsWritingCatchpointFile() {
+				continue
+			}
+			s.suspendForCatchpointWriting = false
+			s.log.Info("Disabled sync round was updated; resyncing")
+			s.sync()
 		case cert := <-s.unmatchedPendingCertificates:
 			// the agreement service has a valid certificate for a block, but not the block itself.
 			if s.cfg.DisableNetworking {
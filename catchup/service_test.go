@@ -420,6 +420,60 @@ func TestServiceFetchBlocksMultiBlocks(t *testing.T) {
 	}
 }
 
+func TestServiceFetchBlocksDisabledSyncRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// Make Ledger
+	numberOfBlocks := basics.Round(20)
+	local := new(mockedLedger)
+	local.blocks = append(local.blocks, bookkeeping.Block{})
+
+	remote, _, blk, err := buildTestLedger(t, bookkeeping.Block{})
+	if err != nil {
+		t.Fatal(err)
+		return
+	}
+	addBlocks(t, remote, blk, int(numberOfBlocks)-1)
+
+	// Create a network and block service
+	blockServiceConfig := config.GetDefaultLocal()
+	net := &httpTestPeerSource{}
+	ls := rpcs.MakeBlockService(logging.Base(), blockServiceConfig, remote, net, "test genesisID")
+
+	nodeA := basicRPCNode{}
+	nodeA.RegisterHTTPHandler(rpcs.BlockServiceBlockPath, ls)
+	nodeA.start()
+	defer nodeA.stop()
+	rootURL := nodeA.rootURL()
+	net.addPeer(rootURL)
+
+	// Make Service
+	syncer := MakeService(logging.Base(), defaultConfig, net, local, &mockedAuthenticator{errorRound: -1}, nil, nil)
+	syncer.testStart()
+
+	require.Equal(t, uint64(0), syncer.GetDisableSyncRound())
+	require.NoError(t, syncer.SetDisableSyncRound(5))
+	require.Equal(t, uint64(5), syncer.GetDisableSyncRound())
+
+	// Fetch blocks; the sync stops right before the disabled round
+	syncer.sync()
+	require.Equal(t, basics.Round(4), local.LastRound())
+
+	// The disabled round cannot be moved behind the ledger
+	require.ErrorIs(t, syncer.SetDisableSyncRound(3), ErrSyncRoundInvalid)
+	require.Equal(t, uint64(5), syncer.GetDisableSyncRound())
+
+	require.NoError(t, syncer.SetDisableSyncRound(12))
+	syncer.sync()
+	require.Equal(t, basics.Round(11), local.LastRound())
+
+	// Once unset, the sync fetches everything available
+	syncer.UnsetDisableSyncRound()
+	require.Equal(t, uint64(0), syncer.GetDisableSyncRound())
+	syncer.sync()
+	require.Equal(t, numberOfBlocks, local.LastRound())
+}
+
 func TestServiceFetchBlocksMalformed(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -49,9 +49,11 @@ var (
 	approvalProgFile string
 	clearProgFile    string
 
-	method           string
-	methodArgs       []string
-	methodCreatesApp bool
+	method             string
+	methodArgs         []string
+	methodCreatesApp   bool
+	methodContractFile string
+	methodSubmit       bool
 
 	approvalProgRawFile string
 	clearProgRawFile    string
@@ -123,7 +125,9 @@ func init() {
 	updateAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to send update transaction from")
 	methodAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to call method from")
 
-	methodAppCmd.Flags().StringVar(&method, "method", "", "Method to be called")
+	methodAppCmd.Flags().StringVar(&method, "method", "", "Method to be called. The method signature, or, with --contract, the name or signature of a method of the contract")
+	methodAppCmd.Flags().StringVar(&methodContractFile, "contract", "", "ARC-4 contract description (JSON) of the app. The app ID is looked up in its networks when --app-id is not provided. Read-only methods of the contract are simulated instead of submitted")
+	methodAppCmd.Flags().BoolVar(&methodSubmit, "submit", false, "Submit the call of a read-only method of the --contract instead of simulating it")
 	methodAppCmd.Flags().StringArrayVar(&methodArgs, "arg", nil, "Args to pass in for calling a method")
 	methodAppCmd.Flags().StringVar(&onCompletion, "on-completion", "NoOp", "OnCompletion action for application transaction")
 	methodAppCmd.Flags().BoolVar(&methodCreatesApp, "create", false, "Create an application in this method call")
@@ -1197,10 +1201,39 @@ var methodAppCmd = &cobra.Command{
 
 		onCompletionEnum := mustParseOnCompletion(onCompletion)
 
+		// read-only methods are simulated, which needs the developer API of the node
+		var methodReadOnly bool
+		if methodContractFile != "" {
+			contract, err := abi.ContractFromJSON(mustReadFile(methodContractFile))
+			if err != nil {
+				reportErrorf("cannot load contract description %s: %v", methodContractFile, err)
+			}
+			contractMethod, err := contract.GetMethod(method)
+			if err != nil {
+				reportErrorf("cannot find method in contract %s: %v", contract.Name, err)
+			}
+			method = contractMethod.Signature()
+			methodReadOnly = contractMethod.ReadOnly && !methodSubmit
+
+			if !methodCreatesApp && appIdx == 0 {
+				params, err := client.SuggestedParams()
+				if err != nil {
+					reportErrorf(errorRequestFail, err)
+				}
+				appIdx, err = contract.AppID(base64.StdEncoding.EncodeToString(params.GenesisHash))
+				if err != nil {
+					reportErrorf("cannot determine the app ID, provide --app-id: %v", err)
+				}
+			}
+		}
+
 		if methodCreatesApp {
 			if appIdx != 0 {
 				reportErrorf("--app-id and --create are mutually exclusive, only provide one")
 			}
+			if methodReadOnly {
+				reportErrorf("method %s is read-only and cannot create an app, provide --submit to create it anyway", method)
+			}
 
 			switch onCompletionEnum {
 			case transactions.CloseOutOC, transactions.ClearStateOC:
@@ -1378,6 +1411,22 @@ var methodAppCmd = &cobra.Command{
 			return
 		}
 
+		if methodReadOnly {
+			resp, err := client.SimulateTransactionGroup(signedTxnGroup, false)
+			if err != nil {
+				reportErrorf("cannot simulate read-only method %s, provide --submit to submit it: %v", method, err)
+			}
+			if !resp.WouldSucceed {
+				failure := ""
+				if resp.FailureMessage != nil {
+					failure = *resp.FailureMessage
+				}
+				reportErrorf("read-only method %s would fail: %s", method, failure)
+			}
+			reportMethodResult(method, retType, resp.TxnResults[len(resp.TxnResults)-1].Logs)
+			return
+		}
+
 		// Broadcast
 		err = client.BroadcastTransactionGroup(signedTxnGroup)
 		if err != nil {
@@ -1415,35 +1464,41 @@ var methodAppCmd = &cobra.Command{
 				reportInfof("Created app with app index %d", *resp.ApplicationIndex)
 			}
 
-			if retType == nil {
-				reportInfof("method %s succeeded", method)
-				return
-			}
+			reportMethodResult(method, retType, resp.Logs)
+		}
+	},
+}
 
-			// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
-			var abiReturnHash = []byte{0x15, 0x1f, 0x7c, 0x75}
+// reportMethodResult reports the return value of a successful method call, which the method
+// logged last.
+func reportMethodResult(method string, retType *abi.Type, logs *[][]byte) {
+	if retType == nil {
+		reportInfof("method %s succeeded", method)
+		return
+	}
 
-			if resp.Logs == nil || len(*resp.Logs) == 0 {
-				reportErrorf("method %s succeed but did not log a return value", method)
-			}
+	// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
+	var abiReturnHash = []byte{0x15, 0x1f, 0x7c, 0x75}
 
-			lastLog := (*resp.Logs)[len(*resp.Logs)-1]
-			if !bytes.HasPrefix(lastLog, abiReturnHash) {
-				reportErrorf("method %s succeed but did not log a return value", method)
-			}
+	if logs == nil || len(*logs) == 0 {
+		reportErrorf("method %s succeed but did not log a return value", method)
+	}
 
-			rawReturnValue := lastLog[len(abiReturnHash):]
-			decoded, err := retType.Decode(rawReturnValue)
-			if err != nil {
-				reportErrorf("method %s succeed but its return value could not be decoded.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
-			}
+	lastLog := (*logs)[len(*logs)-1]
+	if !bytes.HasPrefix(lastLog, abiReturnHash) {
+		reportErrorf("method %s succeed but did not log a return value", method)
+	}
 
-			decodedJSON, err := retType.MarshalToJSON(decoded)
-			if err != nil {
-				reportErrorf("method %s succeed but its return value could not be converted to JSON.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
-			}
+	rawReturnValue := lastLog[len(abiReturnHash):]
+	decoded, err := retType.Decode(rawReturnValue)
+	if err != nil {
+		reportErrorf("method %s succeed but its return value could not be decoded.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
+	}
 
-			reportInfof("method %s succeeded with output: %s", method, string(decodedJSON))
-		}
-	},
+	decodedJSON, err := retType.MarshalToJSON(decoded)
+	if err != nil {
+		reportErrorf("method %s succeed but its return value could not be converted to JSON.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
+	}
+
+	reportInfof("method %s succeeded with output: %s", method, string(decodedJSON))
 }
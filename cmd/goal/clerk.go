@@ -17,6 +17,7 @@
 package main
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -27,6 +28,7 @@ import (
 	"strings"
 	"time"
 
+	cmdutil "github.com/algorand/go-algorand/cmd/util"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
@@ -64,8 +66,15 @@ var (
 	rekeyToAddress  string
 	signerAddress   string
 	rawOutput       bool
+	execTrace       bool
+	lintKind        string
+	coverageFile    string
+	coverageSources []string
+	profileFile     string
 )
 
+var coverageFormat cmdutil.CobraStringValue = *cmdutil.MakeCobraStringValue("lcov", []string{"html"})
+
 func init() {
 	clerkCmd.AddCommand(sendCmd)
 	clerkCmd.AddCommand(rawsendCmd)
@@ -74,8 +83,10 @@ func init() {
 	clerkCmd.AddCommand(groupCmd)
 	clerkCmd.AddCommand(splitCmd)
 	clerkCmd.AddCommand(compileCmd)
+	clerkCmd.AddCommand(lintCmd)
 	clerkCmd.AddCommand(dryrunCmd)
 	clerkCmd.AddCommand(dryrunRemoteCmd)
+	clerkCmd.AddCommand(simulateCmd)
 
 	// Wallet to be used for the clerk operation
 	clerkCmd.PersistentFlags().StringVarP(&walletName, "wallet", "w", "", "Set the wallet to be used for the selected operation")
@@ -130,19 +141,34 @@ func init() {
 	compileCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename to write program bytes or signed LogicSig to")
 	compileCmd.Flags().StringVarP(&account, "account", "a", "", "Account address to sign the program (If not specified, uses default account)")
 
+	lintCmd.Flags().StringVar(&lintKind, "kind", "", "how the program is used: sig, approval or clear (default approval for programs using application opcodes, sig otherwise)")
+	lintCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "consensus protocol version id string, which sets the cost budget")
+
 	dryrunCmd.Flags().StringVarP(&txFilename, "txfile", "t", "", "transaction or transaction-group to test")
 	dryrunCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "consensus protocol version id string")
 	dryrunCmd.Flags().BoolVar(&dumpForDryrun, "dryrun-dump", false, "Dump in dryrun format acceptable by dryrun REST api instead of running")
 	dryrunCmd.Flags().Var(&dumpForDryrunFormat, "dryrun-dump-format", "Dryrun dump format: "+dumpForDryrunFormat.AllowedString())
 	dryrunCmd.Flags().StringSliceVar(&dumpForDryrunAccts, "dryrun-accounts", nil, "additional accounts to include into dryrun request obj")
 	dryrunCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename for writing dryrun state object")
+	dryrunCmd.Flags().BoolVar(&execTrace, "trace", false, "Print a per-opcode execution trace of each logic signature as JSON instead of the text trace (application calls are traced by dryrun-remote and simulate)")
+	dryrunCmd.Flags().StringVar(&coverageFile, "coverage", "", "Filename for writing the source lines of the programs executed, and how often")
+	dryrunCmd.Flags().Var(&coverageFormat, "coverage-format", "Coverage report format: "+coverageFormat.AllowedString())
+	dryrunCmd.Flags().StringSliceVar(&coverageSources, "coverage-source", nil, "TEAL source of a program in the transactions, for mapping coverage to its lines")
+	dryrunCmd.Flags().StringVar(&profileFile, "profile", "", "Filename for writing a pprof profile of the cost of each opcode executed, for use with go tool pprof")
 	dryrunCmd.MarkFlagRequired("txfile")
 
 	dryrunRemoteCmd.Flags().StringVarP(&txFilename, "dryrun-state", "D", "", "dryrun request object to run")
 	dryrunRemoteCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "print more info")
 	dryrunRemoteCmd.Flags().BoolVarP(&rawOutput, "raw", "r", false, "output raw response from algod")
+	dryrunRemoteCmd.Flags().BoolVar(&execTrace, "trace", false, "Print a per-opcode execution trace of each program as JSON")
+	dryrunRemoteCmd.Flags().StringVar(&profileFile, "profile", "", "Filename for writing a pprof profile of the cost of each opcode executed, for use with go tool pprof")
 	dryrunRemoteCmd.MarkFlagRequired("dryrun-state")
 
+	simulateCmd.Flags().StringVarP(&txFilename, "txfile", "t", "", "transaction or transaction-group to simulate")
+	simulateCmd.Flags().BoolVarP(&rawOutput, "raw", "r", false, "output raw response from algod")
+	simulateCmd.Flags().BoolVar(&execTrace, "trace", false, "Print a per-opcode execution trace of each application call as JSON, including inner application calls")
+	simulateCmd.MarkFlagRequired("txfile")
+
 }
 
 var clerkCmd = &cobra.Command{
@@ -943,7 +969,7 @@ func assembleFileImpl(fname string, printWarnings bool) *logic.OpStream {
 	if err != nil {
 		reportErrorf("%s: %s", fname, err)
 	}
-	ops, err := logic.AssembleString(string(text))
+	ops, err := logic.AssembleStringWithIncludes(string(text), fname, logic.FileIncludeResolver(""))
 	if err != nil {
 		ops.ReportProblems(fname, os.Stderr)
 		reportErrorf("%s: %s", fname, err)
@@ -980,7 +1006,7 @@ func assembleFile(fname string, printWarnings bool) (program []byte) {
 
 func assembleFileWithMap(fname string, printWarnings bool) ([]byte, logic.SourceMap) {
 	ops := assembleFileImpl(fname, printWarnings)
-	return ops.Program, logic.GetSourceMap([]string{fname}, ops.OffsetToLine)
+	return ops.Program, logic.GetSourceMapWithSources(ops.Sources, ops.OffsetToSource)
 }
 
 func disassembleFile(fname, outname string) {
@@ -1022,7 +1048,7 @@ func disassembleFile(fname, outname string) {
 var compileCmd = &cobra.Command{
 	Use:   "compile [input file 1] [input file 2]...",
 	Short: "Compile a contract program",
-	Long:  "Reads a TEAL contract program and compiles it to binary output and contract address.",
+	Long:  "Reads a TEAL contract program and compiles it to binary output and contract address. #include directives are resolved relative to the including file.",
 	Run: func(cmd *cobra.Command, args []string) {
 		for _, fname := range args {
 			if disassemble {
@@ -1092,6 +1118,64 @@ var compileCmd = &cobra.Command{
 	},
 }
 
+var lintCmd = &cobra.Command{
+	Use:   "lint [input file 1] [input file 2]...",
+	Short: "Check a contract program for common mistakes",
+	Long:  "Reads TEAL contract programs and reports unreachable code, logic sigs that don't check RekeyTo, CloseRemainderTo and AssetCloseTo, approval programs that don't check OnCompletion, loops, and paths whose worst-case cost exceeds the budget.",
+	Run: func(cmd *cobra.Command, args []string) {
+		_, params := getProto(protoVersion)
+		failed := false
+		for _, fname := range args {
+			ops := assembleFileImpl(fname, true)
+
+			kind := logic.LogicSigKind
+			switch lintKind {
+			case "":
+				if ops.HasStatefulOps {
+					kind = logic.ApprovalKind
+				}
+			case "sig":
+			case "approval":
+				kind = logic.ApprovalKind
+			case "clear":
+				kind = logic.ClearStateKind
+			default:
+				reportErrorf(tealLintKind, lintKind)
+			}
+			budget := params.MaxAppProgramCost
+			if kind == logic.LogicSigKind {
+				budget = int(params.LogicSigMaxCost)
+			}
+
+			report, err := logic.Lint(ops.Program, kind, budget)
+			if err != nil {
+				reportErrorf("%s: %s", fname, err)
+			}
+			for _, finding := range report.Findings {
+				location := fname
+				if loc, ok := ops.OffsetToSource[finding.PC]; ok && finding.PC != 0 {
+					location = fmt.Sprintf("%s:%d", ops.Sources[loc.Source], loc.Line+1)
+				}
+				reportWarnRawf("%s: [%s] %s", location, finding.Check, finding.Message)
+			}
+
+			unbounded := ""
+			for _, path := range report.Paths {
+				if path.Unbounded {
+					unbounded = " excluding loops"
+				}
+			}
+			fmt.Printf(tealLintCost, fname, report.MaxCost, unbounded, report.Budget)
+			if len(report.Findings) > 0 {
+				failed = true
+			}
+		}
+		if failed {
+			reportErrorln(tealLintFailed)
+		}
+	},
+}
+
 var dryrunCmd = &cobra.Command{
 	Use:   "dryrun",
 	Short: "Test a program offline",
@@ -1135,6 +1219,17 @@ var dryrunCmd = &cobra.Command{
 		if timeStamp <= 0 {
 			timeStamp = time.Now().Unix()
 		}
+		var coverage *logic.Coverage
+		if coverageFile != "" {
+			if execTrace {
+				reportErrorln(dryrunCoverageTrace)
+			}
+			coverage = logic.NewCoverage()
+		}
+		var profiler *logic.Profiler
+		if profileFile != "" {
+			profiler = logic.NewProfiler()
+		}
 		for i, txn := range txgroup {
 			if txn.Lsig.Blank() {
 				continue
@@ -1147,10 +1242,23 @@ var dryrunCmd = &cobra.Command{
 			if err != nil {
 				reportErrorf("program failed Check: %s", err)
 			}
-			ep.Trace = &strings.Builder{}
+			var recorder logic.ExecTraceRecorder
+			if execTrace {
+				ep.Debugger = &recorder
+			} else {
+				ep.Trace = &strings.Builder{}
+			}
+			if coverage != nil {
+				ep.Debugger = coverage
+			}
+			ep.Profiler = profiler
 			pass, err := logic.EvalSignature(i, ep)
 			// TODO: optionally include `inspect` output here?
-			fmt.Fprintf(os.Stdout, "tx[%d] trace:\n%s\n", i, ep.Trace.String())
+			if execTrace {
+				fmt.Fprintf(os.Stdout, "tx[%d] trace:\n%s\n", i, protocol.EncodeJSON(&recorder.Trace))
+			} else {
+				fmt.Fprintf(os.Stdout, "tx[%d] trace:\n%s\n", i, ep.Trace.String())
+			}
 			if pass {
 				fmt.Fprintf(os.Stdout, " - pass -\n")
 			} else {
@@ -1160,10 +1268,68 @@ var dryrunCmd = &cobra.Command{
 				fmt.Fprintf(os.Stdout, "ERROR: %s\n", err.Error())
 			}
 		}
-
+		if coverage != nil {
+			writeDryrunCoverage(coverage, txgroup)
+		}
+		if profiler != nil {
+			var profile bytes.Buffer
+			err = profiler.WriteProfile(&profile)
+			if err != nil {
+				reportErrorf("%s: %s", profileFile, err)
+			}
+			writeDryrunProfile(profile.Bytes())
+		}
 	},
 }
 
+// writeDryrunProfile writes a profile to the --profile file
+func writeDryrunProfile(profile []byte) {
+	err := writeFile(profileFile, profile, 0666)
+	if err != nil {
+		reportErrorf("%s: %s", profileFile, err)
+	}
+	reportInfof(dryrunProfileWritten, profileFile, profileFile)
+}
+
+// writeDryrunCoverage maps the coverage of the logic sigs in txgroup to the
+// lines of the --coverage-source files they were assembled from
+func writeDryrunCoverage(coverage *logic.Coverage, txgroup []transactions.SignedTxnWithAD) {
+	lc := make(logic.LineCoverage)
+	mapped := make(map[string]bool)
+	for _, fname := range coverageSources {
+		ops := assembleFileImpl(fname, false)
+		if !coverage.Executed(ops.Program) {
+			reportWarnf(dryrunCoverageNotRun, fname)
+		}
+		sourceMap := logic.GetSourceMapWithSources(ops.Sources, ops.OffsetToSource)
+		err := coverage.MapLines(ops.Program, sourceMap, lc)
+		if err != nil {
+			reportErrorf("%s: %s", fname, err)
+		}
+		mapped[logic.GetProgramID(ops.Program)] = true
+	}
+	for i, txn := range txgroup {
+		if !txn.Lsig.Blank() && !mapped[logic.GetProgramID(txn.Lsig.Logic)] {
+			reportWarnf(dryrunCoverageNoSource, i)
+		}
+	}
+
+	var report bytes.Buffer
+	var err error
+	if coverageFormat.String() == "html" {
+		err = lc.WriteHTML(&report, readFile)
+	} else {
+		err = lc.WriteLcov(&report)
+	}
+	if err != nil {
+		reportErrorf("%s: %s", coverageFile, err)
+	}
+	err = writeFile(coverageFile, report.Bytes(), 0666)
+	if err != nil {
+		reportErrorf("%s: %s", coverageFile, err)
+	}
+}
+
 var dryrunRemoteCmd = &cobra.Command{
 	Use:   "dryrun-remote",
 	Short: "Test a program with algod's dryrun REST endpoint",
@@ -1180,8 +1346,14 @@ var dryrunRemoteCmd = &cobra.Command{
 		if err != nil {
 			reportErrorf("dryrun-remote: %s", err.Error())
 		}
+		if profileFile != "" {
+			if resp.Profile == nil {
+				reportErrorln(dryrunNoProfile)
+			}
+			writeDryrunProfile(*resp.Profile)
+		}
 		if rawOutput {
-			fmt.Fprintf(os.Stdout, string(protocol.EncodeJSON(&resp)))
+			os.Stdout.Write(protocol.EncodeJSON(&resp))
 			return
 		}
 
@@ -1225,6 +1397,14 @@ var dryrunRemoteCmd = &cobra.Command{
 				for _, msg := range msgs {
 					fmt.Fprintf(os.Stdout, "%s\n", msg)
 				}
+				if execTrace {
+					if txnResult.LogicSigExecTrace != nil {
+						fmt.Fprintf(os.Stdout, "tx[%d] logic sig trace:\n%s\n", i, protocol.EncodeJSON(txnResult.LogicSigExecTrace))
+					}
+					if txnResult.AppCallExecTrace != nil {
+						fmt.Fprintf(os.Stdout, "tx[%d] app call trace:\n%s\n", i, protocol.EncodeJSON(txnResult.AppCallExecTrace))
+					}
+				}
 				if verbose && len(trace) > 0 {
 					fmt.Fprintf(os.Stdout, "tx[%d] trace:\n", i)
 					for _, item := range trace {
@@ -1237,6 +1417,80 @@ var dryrunRemoteCmd = &cobra.Command{
 	},
 }
 
+var simulateCmd = &cobra.Command{
+	Use:   "simulate",
+	Short: "Simulate a transaction or transaction group with algod's simulate REST endpoint",
+	Long:  "Simulate a transaction or transaction group against the latest ledger state with algod's simulate REST endpoint, without broadcasting it. Signatures are not checked, so the transactions may be unsigned.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		data, err := readFile(txFilename)
+		if err != nil {
+			reportErrorf(fileReadError, txFilename, err)
+		}
+		dec := protocol.NewDecoderBytes(data)
+		stxns := make([]transactions.SignedTxn, 0, 10)
+		for {
+			var txn transactions.SignedTxn
+			err = dec.Decode(&txn)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				reportErrorf(txDecodeError, txFilename, err)
+			}
+			stxns = append(stxns, txn)
+		}
+
+		dataDir := ensureSingleDataDir()
+		client := ensureFullClient(dataDir)
+		resp, err := client.SimulateTransactionGroup(stxns, execTrace)
+		if err != nil {
+			reportErrorf("simulate: %s", err.Error())
+		}
+		if rawOutput {
+			os.Stdout.Write(protocol.EncodeJSON(&resp))
+			return
+		}
+
+		fmt.Fprintf(os.Stdout, "simulated after round %d\n", resp.LastRound)
+		for i, txnResult := range resp.TxnResults {
+			if resp.FailedAt != nil && uint64(i) == *resp.FailedAt {
+				fmt.Fprintf(os.Stdout, "tx[%d] would fail: %s\n", i, *resp.FailureMessage)
+			} else {
+				fmt.Fprintf(os.Stdout, "tx[%d] would succeed\n", i)
+			}
+			if txnResult.ApplicationIndex != nil {
+				fmt.Fprintf(os.Stdout, "tx[%d] application index: %d\n", i, *txnResult.ApplicationIndex)
+			}
+			if txnResult.AssetIndex != nil {
+				fmt.Fprintf(os.Stdout, "tx[%d] asset index: %d\n", i, *txnResult.AssetIndex)
+			}
+			if txnResult.InnerTxns != nil && len(*txnResult.InnerTxns) > 0 {
+				fmt.Fprintf(os.Stdout, "tx[%d] inner transactions: %d\n", i, len(*txnResult.InnerTxns))
+			}
+			if txnResult.Logs != nil {
+				for _, log := range *txnResult.Logs {
+					fmt.Fprintf(os.Stdout, "tx[%d] log: %s\n", i, heuristicFormatStr(string(log)))
+				}
+			}
+		}
+		if resp.ExecTraces != nil {
+			for _, trace := range *resp.ExecTraces {
+				fmt.Fprintf(os.Stdout, "tx[%d] trace:\n%s\n", trace.GroupIndex, protocol.EncodeJSON(&trace))
+			}
+		}
+		if resp.AppBudgetAdded != nil {
+			fmt.Fprintf(os.Stdout, "app budget consumed: %d of %d\n", *resp.AppBudgetConsumed, *resp.AppBudgetAdded)
+		}
+		if !resp.WouldSucceed {
+			if resp.FailedAt == nil {
+				fmt.Fprintf(os.Stdout, "group would fail: %s\n", *resp.FailureMessage)
+			}
+			os.Exit(1)
+		}
+	},
+}
+
 // unmarshalSlice converts string addresses to basics.Address
 func unmarshalSlice(accts []string) ([]basics.Address, error) {
 	result := make([]basics.Address, 0, len(accts))
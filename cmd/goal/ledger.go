@@ -19,6 +19,8 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -31,16 +33,23 @@ var (
 	rawBlock       bool
 	base32Encoding bool
 	strictJSON     bool
+
+	snapshotFilename string
+	snapshotFormat   string
 )
 
 func init() {
 	ledgerCmd.AddCommand(supplyCmd)
 	ledgerCmd.AddCommand(blockCmd)
+	ledgerCmd.AddCommand(snapshotCmd)
 
 	blockCmd.Flags().StringVarP(&blockFilename, "out", "o", stdoutFilenameValue, "The filename to dump the block to (if not set, use stdout)")
 	blockCmd.Flags().BoolVarP(&rawBlock, "raw", "r", false, "Format block as msgpack")
 	blockCmd.Flags().BoolVar(&base32Encoding, "b32", false, "Encode binary blobs using base32 instead of base64")
 	blockCmd.Flags().BoolVar(&strictJSON, "strict", false, "Strict JSON decode: turn all keys into strings")
+
+	snapshotCmd.Flags().StringVarP(&snapshotFilename, "out", "o", stdoutFilenameValue, "The filename to write the snapshot to (if not set, use stdout)")
+	snapshotCmd.Flags().StringVarP(&snapshotFormat, "format", "f", "msgpack", "The snapshot format: msgpack (a catchpoint formatted tar.gz archive) or json (one JSON line per account)")
 }
 
 var ledgerCmd = &cobra.Command{
@@ -110,3 +119,33 @@ var blockCmd = &cobra.Command{
 		}
 	},
 }
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Export a snapshot of the account state",
+	Long:  "Export a snapshot of every account and its assets and applications, as of the round the node's accounts database is at. The msgpack format is laid out like a catchpoint file, and can be inspected with catchpointdump. The json format has a header line followed by one line per account.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		if snapshotFormat != "msgpack" && snapshotFormat != "json" {
+			reportErrorf(errBadSnapshotFormat, snapshotFormat)
+		}
+
+		dataDir := ensureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+
+		var out io.Writer = os.Stdout
+		if snapshotFilename != stdoutFilenameValue {
+			file, err := os.OpenFile(snapshotFilename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+			if err != nil {
+				reportErrorf(fileWriteError, snapshotFilename, err)
+			}
+			defer file.Close()
+			out = file
+		}
+
+		err := client.LedgerSnapshot(snapshotFormat, out)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+	},
+}
@@ -82,6 +82,16 @@ const (
 	infoNodeWroteToken                 = "Successfully wrote new API token: %s"
 	infoNodePendingTxnsDescription     = "Pending Transactions (Truncated max=%d, Total in pool=%d): "
 	infoNodeNoPendingTxnsDescription   = "None"
+	infoNodeNoPeers                    = "Not connected to any peer"
+	infoNodeNoPersistentPeers          = "No persistent peers"
+	infoNodeNoPeerBans                 = "No peer bans"
+	infoNodePersistentPeerAdded        = "Added persistent peer %s"
+	infoNodePersistentPeerRemoved      = "Removed persistent peer %s"
+	infoNodePeerDisconnected           = "Disconnected from %s"
+	infoNodePeerBanned                 = "Banned %s until %s"
+	infoNodePeerUnbanned               = "Lifted the ban of %s"
+	errorNodePeerControl               = "Cannot update the peers of the node: %v"
+	errorNodeBanDuration               = "The ban duration must be at least one second"
 	infoDataDir                        = "[Data Directory: %s]"
 	errLoadingConfig                   = "Error loading Config file from '%s': %v"
 	errorNodeFailedToShutdown          = "Unable to shut down node: %v"
@@ -161,6 +171,15 @@ const (
 
 	tealLogicSigSize = "%s: logicsig program size too large: %d > %d"
 	tealAppSize      = "%s: app program size too large: %d > %d"
+	tealLintKind     = "unknown program kind %s, expected sig, approval or clear"
+	tealLintCost     = "%s: worst-case cost %d%s, budget %d\n"
+	tealLintFailed   = "lint found problems"
+
+	dryrunCoverageTrace    = "--coverage can't be combined with --trace"
+	dryrunCoverageNotRun   = "%s: program was not executed by any transaction"
+	dryrunCoverageNoSource = "tx[%d]: no --coverage-source assembles to its program, so it is left out of the coverage report"
+	dryrunProfileWritten   = "Wrote profile to %s, view it with: go tool pprof -http=: %s"
+	dryrunNoProfile        = "algod did not return a profile, it may predate profiling"
 
 	// Wallet
 	infoRecoveryPrompt           = "Please type your recovery mnemonic below, and hit return when you are done: "
@@ -176,6 +195,11 @@ const (
 	errorCouldntInitializeWallet = "Couldn't initialize wallet: %s"
 	errorCouldntExportMDK        = "Couldn't export master derivation key: %s"
 	errorCouldntMakeMnemonic     = "Couldn't make mnemonic: %s"
+	errorCouldntBackupWallet     = "Couldn't back up wallet: %s"
+	errorCouldntRestoreWallet    = "Couldn't restore wallet: %s"
+	infoWroteWalletBackup        = "Wrote encrypted wallet backup to %s"
+	infoBackupPasswordPrompt     = "Please enter the password the backup was made with: "
+	infoRestoredWallet           = "Restored wallet '%s'"
 	errorCouldntListWallets      = "Couldn't list wallets: %s"
 	errorPasswordConfirmation    = "Password confirmation did not match"
 	errorBadMnemonic             = "Problem with mnemonic: %s"
@@ -201,4 +225,5 @@ const (
 	errParsingRoundNumber  = "Error parsing round number: %s"
 	errBadBlockArgs        = "Cannot combine --b32=true or --strict=true with --raw"
 	errEncodingBlockAsJSON = "Error encoding block as json: %s"
+	errBadSnapshotFormat   = "Unknown snapshot format '%s', it should be either json or msgpack"
 )
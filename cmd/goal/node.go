@@ -23,17 +23,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
 
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
@@ -60,6 +63,7 @@ var newNodeRelay string
 var newNodeFullConfig bool
 var watchMillisecond uint64
 var abortCatchup bool
+var peersShowTags bool
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -75,6 +79,7 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(peersCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -108,6 +113,7 @@ func init() {
 	statusCmd.Flags().Uint64VarP(&watchMillisecond, "watch", "w", 0, "Time (in milliseconds) between two successive status updates")
 
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
+	peersCmd.Flags().BoolVarP(&peersShowTags, "tags", "t", false, "Also show the traffic exchanged with each peer by message tag")
 
 }
 
@@ -551,6 +557,83 @@ var pendingTxnsCmd = &cobra.Command{
 	},
 }
 
+var peersCmd = &cobra.Command{
+	Use:   "peers",
+	Short: "List the peers the node is connected to",
+	Long:  "List the peers the node is connected to, along with the traffic exchanged with each of them: messages and bytes received and sent, messages dropped as duplicates, filtered out or dropped because the outgoing queues were full, the depth of the outgoing queues, and the round trip time of the last ping. Pings are only sent when PeerPingPeriodSeconds is set in the node configuration.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			response, err := client.GetPeers()
+			if err != nil {
+				reportErrorf(errorNodeStatus, err)
+			}
+			if len(response.Peers) == 0 {
+				reportInfoln(infoNodeNoPeers)
+				return
+			}
+			writePeers(os.Stdout, response.Peers, time.Now(), peersShowTags)
+		})
+	},
+}
+
+// writePeers renders peers as a table, followed, if showTags is set, by the
+// traffic exchanged with each of them by message tag
+func writePeers(out io.Writer, peers []privateV2.PeerStatus, now time.Time, showTags bool) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Direction\tAddress\tRemote\tTelemetry GUID\tConnected\tPing RTT\tQueued\tMsgs In\tBytes In\tMsgs Out\tBytes Out\tDuplicates\tFiltered\tDropped")
+	for _, peer := range peers {
+		var msgsIn, bytesIn, msgsOut, bytesOut uint64
+		for _, tag := range peer.Tags {
+			msgsIn += tag.MessagesIn
+			bytesIn += tag.BytesIn
+			msgsOut += tag.MessagesOut
+			bytesOut += tag.BytesOut
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d/%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\n",
+			peerDirection(peer), valueOrDash(peer.Address), valueOrDash(peer.RemoteAddress), valueOrDash(peer.TelemetryGuid),
+			now.Sub(time.Unix(int64(peer.ConnectedSince), 0)).Truncate(time.Second), peerPingRTT(peer),
+			peer.QueuedHighPriority, peer.QueuedBulk, msgsIn, bytesIn, msgsOut, bytesOut,
+			peer.DuplicatesDropped, peer.FilteredOut, peer.DroppedOut)
+	}
+	w.Flush()
+
+	if !showTags {
+		return
+	}
+	for _, peer := range peers {
+		fmt.Fprintf(out, "\n%s %s:\n", peerDirection(peer), valueOrDash(peer.Address))
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Tag\tMsgs In\tBytes In\tMsgs Out\tBytes Out")
+		for _, tag := range peer.Tags {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", tag.Tag, tag.MessagesIn, tag.BytesIn, tag.MessagesOut, tag.BytesOut)
+		}
+		w.Flush()
+	}
+}
+
+func peerDirection(peer privateV2.PeerStatus) string {
+	if peer.Outgoing {
+		return "outgoing"
+	}
+	return "incoming"
+}
+
+func peerPingRTT(peer privateV2.PeerStatus) string {
+	if peer.LastPingRoundTripUs == nil {
+		return "-"
+	}
+	return (time.Duration(*peer.LastPingRoundTripUs) * time.Microsecond).String()
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 var waitCmd = &cobra.Command{
 	Use:   "wait",
 	Short: "Waits for the node to make progress",
@@ -16,12 +16,15 @@
 package main
 
 import (
+	"bytes"
 	"net/http"
 	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/assert"
@@ -110,3 +113,43 @@ func TestGetMissingCatchpointLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestWritePeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rtt := uint64(1500)
+	peers := []privateV2.PeerStatus{
+		{
+			Address:             "r1.algorand.network:4160",
+			RemoteAddress:       "10.0.0.1:4160",
+			Outgoing:            true,
+			TelemetryGuid:       "guid-1",
+			ConnectedSince:      1600000000,
+			LastPingRoundTripUs: &rtt,
+			QueuedBulk:          2,
+			Tags: []privateV2.PeerTagStatistics{
+				{Tag: "AV", MessagesIn: 20, BytesIn: 4000},
+				{Tag: "TX", MessagesIn: 10, BytesIn: 2000, MessagesOut: 5, BytesOut: 1000},
+			},
+		},
+		{
+			RemoteAddress:     "10.0.0.2:52000",
+			ConnectedSince:    1600003600,
+			DuplicatesDropped: 7,
+		},
+	}
+	now := time.Unix(1600007200, 0)
+
+	var out bytes.Buffer
+	writePeers(&out, peers, now, false)
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, []string{"outgoing", "r1.algorand.network:4160", "10.0.0.1:4160", "guid-1", "2h0m0s", "1.5ms", "0/2", "30", "6000", "5", "1000", "0", "0", "0"}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"incoming", "-", "10.0.0.2:52000", "-", "1h0m0s", "-", "0/0", "0", "0", "0", "0", "7", "0", "0"}, strings.Fields(lines[2]))
+
+	out.Reset()
+	writePeers(&out, peers, now, true)
+	require.Contains(t, out.String(), "outgoing r1.algorand.network:4160:\n")
+	require.Regexp(t, `\nTX +10 +2000 +5 +1000\n`, out.String())
+	require.Contains(t, out.String(), "incoming -:\n")
+}
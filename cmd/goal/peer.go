@@ -0,0 +1,197 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
+)
+
+var banDuration time.Duration
+
+func init() {
+	nodeCmd.AddCommand(peerCmd)
+	peerCmd.AddCommand(peerAddCmd)
+	peerCmd.AddCommand(peerRemoveCmd)
+	peerCmd.AddCommand(peerListCmd)
+	peerCmd.AddCommand(peerDisconnectCmd)
+	peerCmd.AddCommand(peerBanCmd)
+	peerCmd.AddCommand(peerUnbanCmd)
+	peerCmd.AddCommand(peerBansCmd)
+
+	peerBanCmd.Flags().DurationVar(&banDuration, "duration", 24*time.Hour, "How long the ban lasts, e.g. 30m or 72h")
+}
+
+var peerCmd = &cobra.Command{
+	Use:   "peer",
+	Short: "Manage the peers of the node at runtime",
+	Long:  "Add and remove persistent peers, disconnect peers, and ban IP addresses or telemetry GUIDs from connecting to the node, without restarting it.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+var peerAddCmd = &cobra.Command{
+	Use:   "add [host:port]",
+	Short: "Add a persistent peer",
+	Long:  "Add a persistent peer to the phonebook of the node, and connect to it. The node reconnects to persistent peers whenever their connection drops, until they are removed. Persistent peers are persisted to the data directory, and are kept across restarts.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			err := client.AddPersistentPeer(args[0])
+			if err != nil {
+				reportErrorf(errorNodePeerControl, err)
+			}
+			reportInfof(infoNodePersistentPeerAdded, args[0])
+		})
+	},
+}
+
+var peerRemoveCmd = &cobra.Command{
+	Use:   "remove [host:port]",
+	Short: "Remove a persistent peer",
+	Long:  "Remove a persistent peer from the phonebook of the node. The node stays connected to it until the connection drops; use disconnect to drop it right away.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			err := client.RemovePersistentPeer(args[0])
+			if err != nil {
+				reportErrorf(errorNodePeerControl, err)
+			}
+			reportInfof(infoNodePersistentPeerRemoved, args[0])
+		})
+	},
+}
+
+var peerListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the persistent peers",
+	Long:  "List the persistent peers of the node. Use goal node peers to list the peers it is connected to.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			response, err := client.GetPersistentPeers()
+			if err != nil {
+				reportErrorf(errorNodeStatus, err)
+			}
+			if len(response.Peers) == 0 {
+				reportInfoln(infoNodeNoPersistentPeers)
+				return
+			}
+			for _, peer := range response.Peers {
+				fmt.Println(peer)
+			}
+		})
+	},
+}
+
+var peerDisconnectCmd = &cobra.Command{
+	Use:   "disconnect [address]",
+	Short: "Disconnect a peer",
+	Long:  "Disconnect the peers with the given address or remote address, as listed by goal node peers. The node reconnects to persistent peers; ban or remove them to keep them away.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			err := client.DisconnectPeer(args[0])
+			if err != nil {
+				reportErrorf(errorNodePeerControl, err)
+			}
+			reportInfof(infoNodePeerDisconnected, args[0])
+		})
+	},
+}
+
+var peerBanCmd = &cobra.Command{
+	Use:   "ban [IP address or telemetry GUID]",
+	Short: "Ban an IP address or a telemetry GUID",
+	Long:  "Ban an IP address or a telemetry GUID for a duration, and disconnect the matching peers. The node refuses connections from banned peers, and doesn't connect to them. Telemetry GUIDs are reported by the peers themselves, so banning one only keeps away peers that don't change it. Bans are kept in the data directory across restarts.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if banDuration < time.Second {
+			reportErrorln(errorNodeBanDuration)
+		}
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			err := client.BanPeer(args[0], uint64(banDuration/time.Second))
+			if err != nil {
+				reportErrorf(errorNodePeerControl, err)
+			}
+			reportInfof(infoNodePeerBanned, args[0], time.Now().Add(banDuration).UTC().Truncate(time.Second).Format(time.RFC3339))
+		})
+	},
+}
+
+var peerUnbanCmd = &cobra.Command{
+	Use:   "unban [IP address or telemetry GUID]",
+	Short: "Lift the ban of an IP address or a telemetry GUID",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			err := client.UnbanPeer(args[0])
+			if err != nil {
+				reportErrorf(errorNodePeerControl, err)
+			}
+			reportInfof(infoNodePeerUnbanned, args[0])
+		})
+	},
+}
+
+var peerBansCmd = &cobra.Command{
+	Use:   "bans",
+	Short: "List the peer bans",
+	Long:  "List the IP addresses and telemetry GUIDs banned from connecting to the node, along with the time each ban is lifted at.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			response, err := client.GetPeerBans()
+			if err != nil {
+				reportErrorf(errorNodeStatus, err)
+			}
+			if len(response.Bans) == 0 {
+				reportInfoln(infoNodeNoPeerBans)
+				return
+			}
+			writePeerBans(os.Stdout, response.Bans, time.Now())
+		})
+	},
+}
+
+// writePeerBans renders bans as a table
+func writePeerBans(out io.Writer, bans []privateV2.PeerBan, now time.Time) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Target\tExpires\tRemaining")
+	for _, ban := range bans {
+		expires := time.Unix(int64(ban.Expires), 0)
+		fmt.Fprintf(w, "%s\t%s\t%s\n", ban.Target, expires.UTC().Format(time.RFC3339), expires.Sub(now).Truncate(time.Second))
+	}
+	w.Flush()
+}
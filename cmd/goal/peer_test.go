@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestWritePeerBans(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	bans := []privateV2.PeerBan{
+		{Target: "10.0.0.1", Expires: 1600003600},
+		{Target: "e06b4ee0-2ff9-4a4e-a1a1-2d4a0c0d1f5c", Expires: 1600086400},
+	}
+	var out bytes.Buffer
+	writePeerBans(&out, bans, time.Unix(1600000000, 0))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 3)
+	require.Equal(t, []string{"10.0.0.1", "2020-09-13T13:26:40Z", "1h0m0s"}, strings.Fields(lines[1]))
+	require.Equal(t, []string{"e06b4ee0-2ff9-4a4e-a1a1-2d4a0c0d1f5c", "2020-09-14T12:26:40Z", "24h0m0s"}, strings.Fields(lines[2]))
+}
@@ -33,17 +33,28 @@ import (
 var (
 	recoverWallet     bool
 	defaultWalletName string
+	backupWalletName  string
+	backupOutFile     string
+	restoreWalletName string
 )
 
 func init() {
 	walletCmd.AddCommand(newWalletCmd)
 	walletCmd.AddCommand(listWalletsCmd)
+	walletCmd.AddCommand(backupWalletCmd)
+	walletCmd.AddCommand(restoreWalletCmd)
 
 	// Default wallet to use when -w not specified
 	walletCmd.Flags().StringVarP(&defaultWalletName, "default", "f", "", "Set the wallet with this name to be the default wallet")
 
 	// Should we recover the wallet?
 	newWalletCmd.Flags().BoolVarP(&recoverWallet, "recover", "r", false, "Recover the wallet from the backup mnemonic provided at wallet creation (NOT the mnemonic provided by goal account export or by algokey). Regenerate accounts in the wallet with `goal account new`")
+
+	backupWalletCmd.Flags().StringVarP(&backupWalletName, "wallet", "w", "", "Set the wallet to back up (defaults to the default wallet)")
+	backupWalletCmd.Flags().StringVarP(&backupOutFile, "out", "o", "", "File to write the encrypted wallet backup to")
+	backupWalletCmd.MarkFlagRequired("out")
+
+	restoreWalletCmd.Flags().StringVarP(&restoreWalletName, "name", "n", "", "Name for the restored wallet (defaults to the name it was backed up with)")
 }
 
 var walletCmd = &cobra.Command{
@@ -199,6 +210,69 @@ var listWalletsCmd = &cobra.Command{
 	},
 }
 
+var backupWalletCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "Back up an entire wallet to an encrypted file",
+	Long:  "Write every key, multisig preimage and the master derivation key of a wallet to a single file, encrypted with the wallet password. Restore it with `goal wallet restore`.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		dataDir := ensureSingleDataDir()
+		client := ensureKmdClient(dataDir)
+		wh, pw := ensureWalletHandleMaybePassword(dataDir, backupWalletName, true)
+
+		backup, err := client.BackupWallet(wh, pw)
+		if err != nil {
+			reportErrorf(errorCouldntBackupWallet, err)
+		}
+
+		err = writeFile(backupOutFile, backup, 0600)
+		if err != nil {
+			reportErrorf(fileWriteError, backupOutFile, err)
+		}
+		reportInfof(infoWroteWalletBackup, backupOutFile)
+	},
+}
+
+var restoreWalletCmd = &cobra.Command{
+	Use:   "restore [backup file]",
+	Short: "Restore a wallet from a file written by `goal wallet backup`",
+	Long:  "Restore a wallet from a file written by `goal wallet backup`. The password the backup was made with becomes the password of the restored wallet.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dataDir := ensureSingleDataDir()
+		accountList := makeAccountsList(dataDir)
+		client := ensureKmdClient(dataDir)
+
+		backup, err := readFile(args[0])
+		if err != nil {
+			reportErrorf(fileReadError, args[0], err)
+		}
+
+		fmt.Printf(infoBackupPasswordPrompt)
+		walletPassword := ensurePassword()
+
+		walletID, err := client.RestoreWallet([]byte(restoreWalletName), walletPassword, backup)
+		if err != nil {
+			reportErrorf(errorCouldntRestoreWallet, err)
+		}
+
+		walletName, _, err := client.FindWalletNameByID(walletID)
+		if err != nil {
+			walletName = walletID
+		}
+		reportInfof(infoRestoredWallet, walletName)
+
+		// We are the only wallet -- make us the default
+		wallets, err := client.ListWallets()
+		if err != nil {
+			reportErrorf(errorCouldntListWallets, err)
+		}
+		if len(wallets) == 1 {
+			accountList.setDefaultWalletID(walletID)
+		}
+	},
+}
+
 func printWallets(dataDir string, wallets []kmdapi.APIV1Wallet) {
 	accountList := makeAccountsList(dataDir)
 	defaultWalletID := string(accountList.getDefaultWalletID())
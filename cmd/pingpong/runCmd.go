@@ -65,6 +65,7 @@ var appProgLocalKeys uint32
 var duration uint32
 var rekey bool
 var nftAsaPerSecond uint32
+var scenarioFile string
 
 func init() {
 	rootCmd.AddCommand(runCmd)
@@ -103,6 +104,7 @@ func init() {
 	runCmd.Flags().BoolVar(&rekey, "rekey", false, "Create RekeyTo transactions. Requires groupsize=2 and any of random flags exc random dst")
 	runCmd.Flags().Uint32Var(&duration, "duration", 0, "The number of seconds to run the pingpong test, forever if 0")
 	runCmd.Flags().Uint32Var(&nftAsaPerSecond, "nftasapersecond", 0, "The number of NFT-style ASAs to create per second")
+	runCmd.Flags().StringVar(&scenarioFile, "scenario", "", "JSON file describing weighted workloads and the phases of their rate, which replaces the single workload and rate set by the other flags")
 
 }
 
@@ -288,6 +290,14 @@ var runCmd = &cobra.Command{
 
 		cfg.NftAsaPerSecond = nftAsaPerSecond
 
+		if scenarioFile != "" {
+			if numAsset != 0 || numApp != 0 || groupSize != 1 || rekey || nftAsaPerSecond != 0 {
+				reportErrorf("numasset, numapp, groupsize, rekey and nftasapersecond are set by the workloads of a scenario\n")
+			}
+			runScenario(ac, cfg)
+			return
+		}
+
 		reportInfof("Preparing to initialize PingPong with config:\n")
 		cfg.Dump(os.Stdout)
 
@@ -311,6 +321,23 @@ var runCmd = &cobra.Command{
 	},
 }
 
+func runScenario(ac libgoal.Client, cfg pingpong.PpConfig) {
+	sc, err := pingpong.LoadScenarioFromFile(scenarioFile)
+	if err != nil {
+		reportErrorf("Error loading scenario from '%s': %v\n", scenarioFile, err)
+	}
+
+	pps := pingpong.NewPingpong(cfg)
+	err = pps.PrepareScenario(ac, sc)
+	if err != nil {
+		reportErrorf("Error preparing accounts for scenario: %v\n", err)
+	}
+
+	reportInfof("Running scenario %s\n", scenarioFile)
+	report := pps.RunScenario(context.Background(), ac, sc)
+	report.Write(os.Stdout)
+}
+
 func reportErrorf(format string, args ...interface{}) {
 	fmt.Printf(format, args...)
 	os.Exit(1)
@@ -41,14 +41,68 @@ type cdtSession struct {
 	endpoint      cdt.TabDescription
 	done          chan struct{}
 
-	contextID    int
+	contextID int
+	cdtScript
+	states AppState
+
+	// inners are the inner application calls in progress, innermost last
+	inners []*cdtInner
+
+	verbose bool
+}
+
+// cdtScript identifies a program shown in a CDT session
+type cdtScript struct {
 	scriptID     string
 	scriptHash   string
 	scriptURL    string
 	sourceMapURL string
-	states       AppState
+}
 
-	verbose bool
+// cdtInner is an inner application call shown in the CDT session of the
+// top-level program, as a script of its own whose frames are on top of those
+// of its caller
+type cdtInner struct {
+	cdtScript
+	state  cdtState
+	name   string
+	parsed bool
+
+	// where the caller is stopped
+	callerLine  int
+	callerStack []logic.CallFrame
+}
+
+func makeCdtInner(n *Notification) *cdtInner {
+	ds := &n.DebugState
+	appIdx := ds.TxnGroup[ds.GroupIndex].Txn.ApplicationID
+	inner := &cdtInner{
+		name:        fmt.Sprintf("app %d", appIdx),
+		callerLine:  n.CallerLine,
+		callerStack: n.CallerStack,
+	}
+	inner.scriptID = strconv.Itoa(int(atomic.AddInt32(&scriptCounter, 1)))
+	hash := sha256.Sum256([]byte(ds.Disassembly))
+	inner.scriptHash = hex.EncodeToString(hash[:])
+	inner.scriptURL = fmt.Sprintf("file://app-%d.teal", appIdx)
+
+	inner.state.Init(ds.Disassembly, ds.Proto, ds.TxnGroup, ds.GroupIndex, ds.Globals)
+	inner.state.Update(cdtStateUpdate{
+		ds.Stack, ds.Scratch,
+		0, 0, "", ds.OpcodeBudget, ds.CallStack,
+		AppState{},
+	})
+	return inner
+}
+
+// active returns the script and state of the innermost application call in
+// progress, given the state of the top-level program
+func (s *cdtSession) active(state *cdtState) (*cdtScript, *cdtState) {
+	if len(s.inners) == 0 {
+		return &s.cdtScript, state
+	}
+	inner := s.inners[len(s.inners)-1]
+	return &inner.cdtScript, &inner.state
 }
 
 var contextCounter int32 = 0
@@ -133,6 +187,14 @@ func (s *cdtSession) websocketHandler(w http.ResponseWriter, r *http.Request) {
 					// no mutex, the access already synchronized by "registered" chan
 					dbgState = notification.DebugState
 					registered <- struct{}{}
+				case "entered":
+					dbgStateMu.Lock()
+					s.inners = append(s.inners, makeCdtInner(&notification))
+					dbgStateMu.Unlock()
+				case "exited":
+					dbgStateMu.Lock()
+					s.inners = s.inners[:len(s.inners)-1]
+					dbgStateMu.Unlock()
 				case "completed":
 					// if completed we still want to see updated state
 					state.completed.SetTo(true)
@@ -244,14 +306,24 @@ func (s *cdtSession) websocketHandler(w http.ResponseWriter, r *http.Request) {
 				dbgStateMu.Lock()
 
 				appState := s.debugger.GetStates(&dbgState)
-				state.Update(cdtStateUpdate{
+				_, active := s.active(&state)
+				active.Update(cdtStateUpdate{
 					dbgState.Stack, dbgState.Scratch,
 					dbgState.PC, dbgState.Line, dbgState.Error,
 					dbgState.OpcodeBudget, dbgState.CallStack, appState,
 				})
-				dbgStateMu.Unlock()
 
+				// announce inner application calls before stopping in them
+				for _, inner := range s.inners {
+					if !inner.parsed {
+						evParsed := s.makeScriptParsedEvent(&inner.cdtScript, &inner.state)
+						cdtEventCh <- &evParsed
+						inner.parsed = true
+					}
+				}
 				event := s.computeEvent(&state)
+				dbgStateMu.Unlock()
+
 				cdtEventCh <- event
 			case <-closed:
 				return
@@ -286,7 +358,7 @@ func (s *cdtSession) handleCdtRequest(req *cdt.ChromeRequest, state *cdtState) (
 	switch req.Method {
 	case "Debugger.enable":
 		evCtxCreated := s.makeContextCreatedEvent()
-		evParsed := s.makeScriptParsedEvent(state)
+		evParsed := s.makeScriptParsedEvent(&s.cdtScript, state)
 		events = append(events, &evCtxCreated, &evParsed)
 
 		debuggerID := make(map[string]string)
@@ -302,13 +374,18 @@ func (s *cdtSession) handleCdtRequest(req *cdt.ChromeRequest, state *cdtState) (
 		response = cdt.ChromeResponse{ID: req.ID, Result: isolateID}
 	case "Debugger.getScriptSource":
 		p := req.Params.(map[string]interface{})
-		_, ok := p["scriptId"]
+		scriptID, ok := p["scriptId"]
 		source := make(map[string]string)
 		if !ok {
 			err = fmt.Errorf("getScriptSource failed: no scriptId")
 			return
 		}
 		source["scriptSource"] = state.disassembly
+		for _, inner := range s.inners {
+			if inner.scriptID == scriptID {
+				source["scriptSource"] = inner.state.disassembly
+			}
+		}
 		response = cdt.ChromeResponse{ID: req.ID, Result: source}
 	case "Debugger.setPauseOnExceptions":
 		p := req.Params.(map[string]interface{})
@@ -356,6 +433,7 @@ func (s *cdtSession) handleCdtRequest(req *cdt.ChromeRequest, state *cdtState) (
 			return
 		}
 		args := argsRaw.([]interface{})
+		_, state := s.active(state)
 		if strings.HasPrefix(funcDecl, "function packRanges") {
 			ranges := state.packRanges(objID, args)
 			response = cdt.ChromeResponse{ID: req.ID, Result: cmdResult{ranges}}
@@ -381,7 +459,8 @@ func (s *cdtSession) handleCdtRequest(req *cdt.ChromeRequest, state *cdtState) (
 		}
 
 		var desc []cdt.RuntimePropertyDescriptor
-		desc, err = state.getObjectDescriptor(objID, preview)
+		_, active := s.active(state)
+		desc, err = active.getObjectDescriptor(objID, preview)
 		if err != nil {
 			err = fmt.Errorf("getObjectDescriptor error: " + err.Error())
 			return
@@ -459,8 +538,9 @@ func (s *cdtSession) handleCdtRequest(req *cdt.ChromeRequest, state *cdtState) (
 
 		result := make(map[string]interface{})
 		result["breakpointId"] = strconv.Itoa(bpLine)
+		script, _ := s.active(state)
 		result["locations"] = []cdt.DebuggerLocation{
-			{ScriptID: s.scriptID, LineNumber: bpLine},
+			{ScriptID: script.scriptID, LineNumber: bpLine},
 		}
 		response = cdt.ChromeResponse{ID: req.ID, Result: result}
 	case "Debugger.resume":
@@ -473,7 +553,7 @@ func (s *cdtSession) handleCdtRequest(req *cdt.ChromeRequest, state *cdtState) (
 		response = cdt.ChromeResponse{ID: req.ID, Result: empty}
 	case "Debugger.stepOut":
 		state.lastAction.Store("step")
-		if len(state.callStack) == 0 {
+		if _, active := s.active(state); len(active.callStack) == 0 && len(s.inners) == 0 {
 			// If we are not in a subroutine, pause at the end so user can
 			// inspect the final state of the program.
 			state.pauseOnCompleted.SetTo(true)
@@ -527,7 +607,7 @@ func (s *cdtSession) computeEvent(state *cdtState) (event interface{}) {
 	return
 }
 
-func (s *cdtSession) makeScriptParsedEvent(state *cdtState) cdt.DebuggerScriptParsedEvent {
+func (s *cdtSession) makeScriptParsedEvent(script *cdtScript, state *cdtState) cdt.DebuggerScriptParsedEvent {
 	// {"method":"Debugger.scriptParsed","params":{"scriptId":"69","url":"internal/dtrace.js","startLine":0,"startColumn":0,"endLine":21,"endColumn":0,"executionContextId":1,"hash":"2e8fbf2f9f6aaa183be557d25f5fbc5b09fae00a","executionContextAuxData":{"isDefault":true},"isLiveEdit":false,"sourceMapURL":"","hasSourceURL":false,"isModule":false,"length":568,"stackTrace":{"callFrames":[{"functionName":"NativeModule.compile","scriptId":"7","url":"internal/bootstrap/loaders.js","lineNumber":298,"columnNumber":15}]}}}
 	progLines := strings.Count(state.disassembly, "\n")
 	length := len(state.disassembly)
@@ -535,15 +615,15 @@ func (s *cdtSession) makeScriptParsedEvent(state *cdtState) cdt.DebuggerScriptPa
 	evParsed := cdt.DebuggerScriptParsedEvent{
 		Method: "Debugger.scriptParsed",
 		Params: cdt.DebuggerScriptParsedParams{
-			ScriptID:           s.scriptID,
-			URL:                s.scriptURL,
-			SourceMapURL:       s.sourceMapURL,
+			ScriptID:           script.scriptID,
+			URL:                script.scriptURL,
+			SourceMapURL:       script.sourceMapURL,
 			StartLine:          0,
 			StartColumn:        0,
 			EndLine:            progLines,
 			EndColumn:          0,
 			ExecutionContextID: s.contextID,
-			Hash:               s.scriptHash,
+			Hash:               script.scriptHash,
 			IsLiveEdit:         false,
 			Length:             length,
 		},
@@ -552,7 +632,8 @@ func (s *cdtSession) makeScriptParsedEvent(state *cdtState) cdt.DebuggerScriptPa
 }
 
 func (s *cdtSession) makeDebuggerPausedEvent(state *cdtState) cdt.DebuggerPausedEvent {
-	progLines := strings.Count(state.disassembly, "\n")
+	script, active := s.active(state)
+	progLines := strings.Count(active.disassembly, "\n")
 
 	scopeLocal := cdt.DebuggerScope{
 		Type: "local",
@@ -563,12 +644,12 @@ func (s *cdtSession) makeDebuggerPausedEvent(state *cdtState) cdt.DebuggerPaused
 			ObjectID:    localScopeObjID,
 		},
 		StartLocation: &cdt.DebuggerLocation{
-			ScriptID:     s.scriptID,
+			ScriptID:     script.scriptID,
 			LineNumber:   0,
 			ColumnNumber: 0,
 		},
 		EndLocation: &cdt.DebuggerLocation{
-			ScriptID:     s.scriptID,
+			ScriptID:     script.scriptID,
 			LineNumber:   progLines,
 			ColumnNumber: 0,
 		},
@@ -584,36 +665,19 @@ func (s *cdtSession) makeDebuggerPausedEvent(state *cdtState) cdt.DebuggerPaused
 	}
 	sc := []cdt.DebuggerScope{scopeLocal, scopeGlobal}
 
-	cfs := []cdt.DebuggerCallFrame{
-		{
-			CallFrameID:  "mainframe",
-			FunctionName: "main",
-			Location: &cdt.DebuggerLocation{
-				ScriptID:     s.scriptID,
-				LineNumber:   state.line.Load(),
-				ColumnNumber: 0,
-			},
-			URL:        s.scriptURL,
-			ScopeChain: sc,
-		},
+	name := "main"
+	if len(s.inners) > 0 {
+		name = s.inners[len(s.inners)-1].name
 	}
-	for i := range state.callStack {
-		cf := cdt.DebuggerCallFrame{
-			CallFrameID:  "mainframe",
-			FunctionName: state.callStack[i].LabelName,
-			Location: &cdt.DebuggerLocation{
-				ScriptID:     s.scriptID,
-				LineNumber:   state.line.Load(),
-				ColumnNumber: 0,
-			},
-			URL:        s.scriptURL,
-			ScopeChain: sc,
+	cfs := makeCallFrames(script, name, active.line.Load(), active.callStack, sc)
+
+	// frames of the callers of inner application calls, innermost first
+	for i := len(s.inners) - 1; i >= 0; i-- {
+		caller, name := &s.cdtScript, "main"
+		if i > 0 {
+			caller, name = &s.inners[i-1].cdtScript, s.inners[i-1].name
 		}
-		// Set the previous call frame line number
-		cfs[0].Location.LineNumber = state.callStack[i].FrameLine
-		// We have to prepend the newest frame for it to appear first
-		// in the debugger...
-		cfs = append([]cdt.DebuggerCallFrame{cf}, cfs...)
+		cfs = append(cfs, makeCallFrames(caller, name, s.inners[i].callerLine, s.inners[i].callerStack, sc)...)
 	}
 
 	evPaused := cdt.DebuggerPausedEvent{
@@ -625,7 +689,7 @@ func (s *cdtSession) makeDebuggerPausedEvent(state *cdtState) cdt.DebuggerPaused
 		},
 	}
 
-	if lastError := state.err.Load(); len(lastError) != 0 {
+	if lastError := active.err.Load(); len(lastError) != 0 {
 		evPaused.Params.Reason = "exception"
 		evPaused.Params.Data = map[string]interface{}{
 			"type":        "object",
@@ -638,6 +702,43 @@ func (s *cdtSession) makeDebuggerPausedEvent(state *cdtState) cdt.DebuggerPaused
 	return evPaused
 }
 
+// makeCallFrames returns the call frames of a program stopped at line with
+// callStack, the innermost first
+func makeCallFrames(script *cdtScript, name string, line int, callStack []logic.CallFrame, sc []cdt.DebuggerScope) []cdt.DebuggerCallFrame {
+	cfs := []cdt.DebuggerCallFrame{
+		{
+			CallFrameID:  "mainframe",
+			FunctionName: name,
+			Location: &cdt.DebuggerLocation{
+				ScriptID:     script.scriptID,
+				LineNumber:   line,
+				ColumnNumber: 0,
+			},
+			URL:        script.scriptURL,
+			ScopeChain: sc,
+		},
+	}
+	for i := range callStack {
+		cf := cdt.DebuggerCallFrame{
+			CallFrameID:  "mainframe",
+			FunctionName: callStack[i].LabelName,
+			Location: &cdt.DebuggerLocation{
+				ScriptID:     script.scriptID,
+				LineNumber:   line,
+				ColumnNumber: 0,
+			},
+			URL:        script.scriptURL,
+			ScopeChain: sc,
+		}
+		// Set the previous call frame line number
+		cfs[0].Location.LineNumber = callStack[i].FrameLine
+		// We have to prepend the newest frame for it to appear first
+		// in the debugger...
+		cfs = append([]cdt.DebuggerCallFrame{cf}, cfs...)
+	}
+	return cfs
+}
+
 func (s *cdtSession) makeContextCreatedEvent() cdt.RuntimeExecutionContextCreatedEvent {
 	// {"method":"Runtime.executionContextCreated","params":{"context":{"id":1,"origin":"","name":"node[47576]","auxData":{"isDefault":true}}}}
 
@@ -459,6 +459,63 @@ func TestCdtSessionStateToEvent(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestCdtSessionInnerAppCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	sid := "test"
+	dbg := MockDebugControl{}
+	ch := make(chan Notification)
+	s := makeCdtSession(sid, &dbg, ch)
+
+	state := cdtState{}
+	state.disassembly = "#pragma version 6\nitxn_submit\nint 1\n"
+
+	// an inner call of app 200 made from line 3 of subroutine "sub"
+	var txn transactions.SignedTxnWithAD
+	txn.Txn.ApplicationID = 200
+	s.inners = append(s.inners, makeCdtInner(&Notification{
+		Event: "entered",
+		DebugState: logic.DebugState{
+			Disassembly: "#pragma version 6\nint 1\n",
+			TxnGroup:    []transactions.SignedTxnWithAD{txn},
+			Globals:     make([]basics.TealValue, len(logic.GlobalFieldNames)),
+		},
+		CallerLine:  3,
+		CallerStack: []logic.CallFrame{{FrameLine: 1, LabelName: "sub"}},
+	}))
+	s.inners[0].state.line.Store(1)
+
+	e := s.makeDebuggerPausedEvent(&state)
+	var frames []string
+	for _, cf := range e.Params.CallFrames {
+		frames = append(frames, fmt.Sprintf("%s %s:%d", cf.FunctionName, cf.Location.ScriptID, cf.Location.LineNumber))
+	}
+	inner := s.inners[0].scriptID
+	require.NotEqual(t, s.scriptID, inner)
+	require.Equal(t, []string{
+		"app 200 " + inner + ":1",
+		"sub " + s.scriptID + ":3",
+		"main " + s.scriptID + ":1",
+	}, frames)
+	require.Equal(t, inner, e.Params.CallFrames[0].ScopeChain[0].StartLocation.ScriptID)
+
+	// sources are those of the requested script
+	req := cdt.ChromeRequest{ID: 1, Method: "Debugger.getScriptSource"}
+	req.Params = map[string]interface{}{"scriptId": inner}
+	resp, _, err := s.handleCdtRequest(&req, &state)
+	require.NoError(t, err)
+	require.Equal(t, "#pragma version 6\nint 1\n", resp.Result.(map[string]string)["scriptSource"])
+	req.Params = map[string]interface{}{"scriptId": s.scriptID}
+	resp, _, err = s.handleCdtRequest(&req, &state)
+	require.NoError(t, err)
+	require.Equal(t, state.disassembly, resp.Result.(map[string]string)["scriptSource"])
+
+	// stepping out of the inner call does not wait for the end of the group
+	req = cdt.ChromeRequest{ID: 2, Method: "Debugger.stepOut"}
+	_, _, err = s.handleCdtRequest(&req, &state)
+	require.NoError(t, err)
+	require.False(t, state.pauseOnCompleted.IsSet())
+}
+
 func TestCdtSessionGetObjects(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	sid := "test"
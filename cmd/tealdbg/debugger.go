@@ -24,15 +24,19 @@ import (
 
 	"github.com/algorand/go-deadlock"
 
-	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 )
 
 // Notification is sent to the client over their websocket connection
-// on each new TEAL execution/update/completion
+// on each new TEAL execution/update/completion.
+// Inner application calls are reported in the session of their caller with
+// "entered" and "exited" events, and "entered" also tells where the caller is.
 type Notification struct {
 	Event      string           `codec:"event"`
 	DebugState logic.DebugState `codec:"state"`
+
+	CallerLine  int               `codec:"callerline,omitempty"`
+	CallerStack []logic.CallFrame `codec:"callerstack,omitempty"`
 }
 
 // DebugAdapter represents debugger frontend (i.e. CDT, webpage, VSCode, etc)
@@ -171,6 +175,11 @@ type session struct {
 	callStack []logic.CallFrame
 
 	states AppState
+
+	// caller is the session of the program that made this inner application
+	// call, and inner the session of the inner application call in progress
+	caller *session
+	inner  *session
 }
 
 type breakpoint struct {
@@ -182,6 +191,29 @@ func (bs *breakpoint) NonEmpty() bool {
 	return bs.set
 }
 
+// active returns the session of the innermost application call in progress,
+// that is s unless s is waiting on an inner application call. Execution
+// control goes to the active session.
+func (s *session) active() *session {
+	s.mu.Lock()
+	inner := s.inner
+	s.mu.Unlock()
+	if inner == nil {
+		return s
+	}
+	return inner.active()
+}
+
+// stepCallers makes the callers of s break as soon as execution returns to them
+func (s *session) stepCallers() {
+	for c := s.caller; c != nil; c = c.caller {
+		c.mu.Lock()
+		c.debugConfig = makeDebugConfig()
+		c.debugConfig.setStepBreak()
+		c.mu.Unlock()
+	}
+}
+
 func makeSession(disassembly string, line int) (s *session) {
 	s = new(session)
 
@@ -218,17 +250,20 @@ func (s *session) resume() {
 }
 
 func (s *session) Step() {
+	s = s.active()
 	func() {
 		s.mu.Lock()
 		defer s.mu.Unlock()
 		s.debugConfig = makeDebugConfig()
 		s.debugConfig.setStepBreak()
 	}()
+	s.stepCallers()
 
 	s.resume()
 }
 
 func (s *session) StepOver() {
+	s = s.active()
 	func() {
 		s.mu.Lock()
 		defer s.mu.Unlock()
@@ -236,8 +271,9 @@ func (s *session) StepOver() {
 		currentOp := strings.Fields(s.lines[s.line.Load()])[0]
 		s.debugConfig = makeDebugConfig()
 
-		// Step over a function call (callsub op).
-		if currentOp == "callsub" && s.line.Load() < len(s.breakpoints) {
+		// Step over a function call (callsub op) or inner application calls
+		// (itxn_submit op).
+		if (currentOp == "callsub" || currentOp == "itxn_submit") && s.line.Load() < len(s.breakpoints) {
 			// Set a flag to check if we are in StepOver mode and to
 			// save our initial call depth so we can pass over breakpoints that
 			// are not on the correct call depth.
@@ -250,10 +286,12 @@ func (s *session) StepOver() {
 			s.debugConfig.setStepBreak()
 		}
 	}()
+	s.stepCallers()
 	s.resume()
 }
 
 func (s *session) StepOut() {
+	s = s.active()
 	func() {
 		s.mu.Lock()
 		defer s.mu.Unlock()
@@ -269,29 +307,38 @@ func (s *session) StepOut() {
 			}
 		}
 	}()
+	// stepping out of an inner application call stops in its caller
+	s.stepCallers()
 
 	s.resume()
 }
 
 func (s *session) Resume() {
-	func() {
-		s.mu.Lock()
-		defer s.mu.Unlock()
-		s.debugConfig = makeDebugConfig()
-		// find any active breakpoints and set break
-		for line, state := range s.breakpoints {
-			if state.set && state.active {
-				err := s.setBreakpoint(line)
-				if err != nil {
-					s.debugConfig.setStepBreak()
-				}
-			}
-		}
-	}()
+	s = s.active()
+	// the callers also run until one of their breakpoints is hit
+	for c := s; c != nil; c = c.caller {
+		c.setResumeBreak()
+	}
 
 	s.resume()
 }
 
+// setResumeBreak configures s to break at its active breakpoints only
+func (s *session) setResumeBreak() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.debugConfig = makeDebugConfig()
+	// find any active breakpoints and set break
+	for line, state := range s.breakpoints {
+		if state.set && state.active {
+			err := s.setBreakpoint(line)
+			if err != nil {
+				s.debugConfig.setStepBreak()
+			}
+		}
+	}
+}
+
 // setBreakpoint must be called with lock taken
 // Used for setting a breakpoint in step execution and adding bp to the session.
 func (s *session) setBreakpoint(line int) error {
@@ -304,6 +351,7 @@ func (s *session) setBreakpoint(line int) error {
 }
 
 func (s *session) SetBreakpoint(line int) error {
+	s = s.active()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	// Reset all existing flags and breakpoints and set a new bp.
@@ -318,6 +366,7 @@ func (s *session) setCallStack(callStack []logic.CallFrame) {
 }
 
 func (s *session) RemoveBreakpoint(line int) error {
+	s = s.active()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -333,6 +382,12 @@ func (s *session) RemoveBreakpoint(line int) error {
 }
 
 func (s *session) SetBreakpointsActive(active bool) {
+	for c := s.active(); c != nil; c = c.caller {
+		c.setBreakpointsActive(active)
+	}
+}
+
+func (s *session) setBreakpointsActive(active bool) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -407,54 +462,14 @@ func (s *session) GetSource() (string, []byte) {
 }
 
 func (s *session) GetStates(st *logic.DebugState) AppState {
+	s = s.active()
 	if st == nil {
 		return s.states
 	}
 
 	newStates := s.states.clone()
-	appIdx := newStates.appIdx
-
-	applyDelta := func(sd basics.StateDelta, tkv basics.TealKeyValue) {
-		for key, delta := range sd {
-			switch delta.Action {
-			case basics.SetUintAction:
-				tkv[key] = basics.TealValue{Type: basics.TealUintType, Uint: delta.Uint}
-			case basics.SetBytesAction:
-				tkv[key] = basics.TealValue{
-					Type: basics.TealBytesType, Bytes: delta.Bytes,
-				}
-			case basics.DeleteAction:
-				delete(tkv, key)
-			}
-		}
-	}
-
 	changes := st.EvalDelta
-	if len(changes.GlobalDelta) > 0 {
-		tkv := newStates.global[appIdx]
-		if tkv == nil {
-			tkv = make(basics.TealKeyValue)
-		}
-		applyDelta(changes.GlobalDelta, tkv)
-		newStates.global[appIdx] = tkv
-	}
-
-	txn := st.TxnGroup[st.GroupIndex].Txn
-	accounts := append([]basics.Address{txn.Sender}, txn.Accounts...)
-	for idx, delta := range changes.LocalDeltas {
-		addr := accounts[idx]
-		local := newStates.locals[addr]
-		if local == nil {
-			local = make(map[basics.AppIndex]basics.TealKeyValue)
-		}
-		tkv := local[appIdx]
-		if tkv == nil {
-			tkv = make(basics.TealKeyValue)
-		}
-		applyDelta(delta, tkv)
-		local[appIdx] = tkv
-		newStates.locals[addr] = local
-	}
+	newStates.applyStateDelta(newStates.appIdx, changes, &st.TxnGroup[st.GroupIndex].Txn)
 
 	if len(changes.Logs) > 0 {
 		newStates.logs = changes.Logs
@@ -495,6 +510,38 @@ func (d *Debugger) createSession(sid string, disassembly string, line int, pcOff
 	return
 }
 
+// enterInner makes s, the session of an inner application call, the active
+// session of its caller so that it is debugged within the caller's frontend
+// sessions. It breaks on its first line if the caller was stepping into it.
+func (d *Debugger) enterInner(caller *session, s *session, state *logic.DebugState) {
+	if s.states.empty() {
+		s.states = makeAppState()
+		s.states.appIdx = state.TxnGroup[state.GroupIndex].Txn.ApplicationID
+	}
+
+	caller.mu.Lock()
+	stepping := caller.debugConfig.StepBreak
+	callStack := caller.callStack
+	caller.inner = s
+	caller.mu.Unlock()
+
+	s.mu.Lock()
+	s.caller = caller
+	s.notifications = caller.notifications
+	if stepping {
+		s.debugConfig = makeDebugConfig()
+		s.debugConfig.setStepBreak()
+	}
+	s.mu.Unlock()
+
+	s.notifications <- Notification{
+		Event:       "entered",
+		DebugState:  *state,
+		CallerLine:  caller.line.Load(),
+		CallerStack: callStack,
+	}
+}
+
 func (d *Debugger) removeSession(sid string) (s *session) {
 	d.mus.Lock()
 	defer d.mus.Unlock()
@@ -534,7 +581,16 @@ func (d *Debugger) Register(state *logic.DebugState) error {
 	for _, pco := range state.PCOffset {
 		pcOffset[state.PCToLine(pco.PC)] = pco.PC
 	}
+	var caller *session
+	if state.Caller != "" {
+		caller, _ = d.getSession(state.Caller)
+	}
 	s := d.createSession(sid, state.Disassembly, state.Line, pcOffset)
+	if caller != nil {
+		// no acknowledgement needed, the first Update breaks if required
+		d.enterInner(caller, s, state)
+		return nil
+	}
 
 	// Store the state for this execution
 	d.mud.Lock()
@@ -552,7 +608,7 @@ func (d *Debugger) Register(state *logic.DebugState) error {
 	// make Resume() synchronous but special handling needed for already completed programs
 
 	// Inform the user to configure execution
-	s.notifications <- Notification{"registered", *state}
+	s.notifications <- Notification{Event: "registered", DebugState: *state}
 
 	// Wait for acknowledgement
 	<-s.acknowledged
@@ -568,6 +624,8 @@ func (d *Debugger) Update(state *logic.DebugState) error {
 		return err
 	}
 	s.line.Store(state.Line)
+	// Copy callstack information
+	s.setCallStack(state.CallStack)
 	cfg := s.debugConfig
 
 	// copy state to prevent a data race in this the go-routine and upcoming updates to the state
@@ -575,10 +633,8 @@ func (d *Debugger) Update(state *logic.DebugState) error {
 		// Check if we are triggered and acknowledge asynchronously
 		if !cfg.NoBreak {
 			if cfg.isBreak(localState.Line, len(localState.CallStack)) {
-				// Copy callstack information
-				s.setCallStack(state.CallStack)
 				// Breakpoint hit! Inform the user
-				s.notifications <- Notification{"updated", localState}
+				s.notifications <- Notification{Event: "updated", DebugState: localState}
 			} else {
 				// Continue if we haven't hit the next breakpoint
 				s.acknowledged <- true
@@ -603,8 +659,18 @@ func (d *Debugger) Complete(state *logic.DebugState) error {
 		return err
 	}
 
+	if s.caller != nil {
+		// return to the caller, which completes the session
+		s.notifications <- Notification{Event: "exited", DebugState: *state}
+		d.removeSession(sid)
+		s.caller.mu.Lock()
+		s.caller.inner = nil
+		s.caller.mu.Unlock()
+		return nil
+	}
+
 	// Inform the user
-	s.notifications <- Notification{"completed", *state}
+	s.notifications <- Notification{Event: "completed", DebugState: *state}
 
 	// Clean up exec-specific state
 	d.removeSession(sid)
@@ -361,3 +361,50 @@ func TestSourceMaps(t *testing.T) {
 	require.NotEmpty(t, name)
 	require.Greater(t, len(data), 0)
 }
+
+// Tests that execution control goes to inner application calls, and back to
+// their callers.
+func TestInnerSessionControl(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	caller := createSessionFromSource(t, "#pragma version %d\nitxn_begin\nitxn_submit\nint 1\n")
+	inner := createSessionFromSource(t, "#pragma version %d\nint 1\n")
+	err := caller.SetBreakpoint(3)
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	ackFunc := func(s *session) {
+		<-s.acknowledged
+		done <- struct{}{}
+	}
+
+	// step over an inner application call
+	caller.line.Store(2)
+	go ackFunc(caller)
+	caller.StepOver()
+	<-done
+	require.Equal(t, true, caller.debugConfig.StepOutOver)
+	require.Equal(t, map[int]struct{}{3: {}}, caller.debugConfig.ActiveBreak)
+
+	caller.inner = inner
+	inner.caller = caller
+	require.Equal(t, inner, caller.active())
+
+	// stepping in the inner call stops in the caller once it returns
+	go ackFunc(inner)
+	caller.Step()
+	<-done
+	require.Equal(t, true, inner.debugConfig.StepBreak)
+	require.Equal(t, true, caller.debugConfig.StepBreak)
+
+	// resuming runs the caller to its breakpoints
+	go ackFunc(inner)
+	caller.Resume()
+	<-done
+	require.Equal(t, false, inner.debugConfig.StepBreak)
+	require.Equal(t, false, caller.debugConfig.StepBreak)
+	require.Equal(t, map[int]struct{}{3: {}}, caller.debugConfig.ActiveBreak)
+
+	caller.inner = nil
+	require.Equal(t, caller, caller.active())
+}
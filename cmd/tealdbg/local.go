@@ -17,8 +17,10 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"time"
 
@@ -204,6 +206,51 @@ func (a *AppState) empty() bool {
 		len(a.innerTxns) == 0
 }
 
+// applyStateDelta applies the global and local state changes in delta, made by
+// app appIdx when called by txn. Changed key-value stores are copied first as
+// they may be shared with balance records.
+func (a *AppState) applyStateDelta(appIdx basics.AppIndex, delta transactions.EvalDelta, txn *transactions.Transaction) {
+	applyDelta := func(sd basics.StateDelta, tkv basics.TealKeyValue) {
+		for key, vd := range sd {
+			switch vd.Action {
+			case basics.SetUintAction:
+				tkv[key] = basics.TealValue{Type: basics.TealUintType, Uint: vd.Uint}
+			case basics.SetBytesAction:
+				tkv[key] = basics.TealValue{
+					Type: basics.TealBytesType, Bytes: vd.Bytes,
+				}
+			case basics.DeleteAction:
+				delete(tkv, key)
+			}
+		}
+	}
+
+	if len(delta.GlobalDelta) > 0 {
+		tkv := a.global[appIdx].Clone()
+		if tkv == nil {
+			tkv = make(basics.TealKeyValue)
+		}
+		applyDelta(delta.GlobalDelta, tkv)
+		a.global[appIdx] = tkv
+	}
+
+	accounts := append([]basics.Address{txn.Sender}, txn.Accounts...)
+	for idx, ld := range delta.LocalDeltas {
+		addr := accounts[idx]
+		local := a.locals[addr]
+		if local == nil {
+			local = make(map[basics.AppIndex]basics.TealKeyValue)
+		}
+		tkv := local[appIdx].Clone()
+		if tkv == nil {
+			tkv = make(basics.TealKeyValue)
+		}
+		applyDelta(ld, tkv)
+		local[appIdx] = tkv
+		a.locals[addr] = local
+	}
+}
+
 type modeType int
 
 func (m modeType) String() string {
@@ -237,13 +284,13 @@ type evaluation struct {
 	states       AppState
 }
 
-func (e *evaluation) eval(gi int, ep *logic.EvalParams) (pass bool, err error) {
+func (e *evaluation) eval(gi int, ep *logic.EvalParams) (pass bool, delta transactions.EvalDelta, err error) {
 	if e.mode == modeStateful {
-		pass, _, err = e.ba.StatefulEval(gi, ep, e.aidx, e.program)
-		return
+		return e.ba.StatefulEval(gi, ep, e.aidx, e.program)
 	}
 	ep.TxnGroup[gi].Lsig.Logic = e.program
-	return logic.EvalSignature(gi, ep)
+	pass, err = logic.EvalSignature(gi, ep)
+	return
 }
 
 // LocalRunner runs local eval
@@ -253,6 +300,14 @@ type LocalRunner struct {
 	protoName string
 	txnGroup  []transactions.SignedTxn
 	runs      []evaluation
+
+	// groupRuns is set when runs are the programs of the transaction group
+	// in order, sharing a ledger so that each sees the changes made by the
+	// ones before it
+	groupRuns bool
+
+	// profileFile receives a profile of the runs' opcode costs, if set
+	profileFile string
 }
 
 func makeAppState() (states AppState) {
@@ -323,6 +378,8 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 
 	log.Printf("Using proto: %s", r.protoName)
 
+	r.profileFile = dp.ProfileFile
+
 	r.txnGroup = ddr.Txns
 	if len(dp.TxnBlob) != 0 || len(r.txnGroup) == 0 {
 		r.txnGroup, err = txnGroupFromParams(dp)
@@ -435,6 +492,20 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 	}
 
 	r.runs = nil
+	r.groupRuns = true
+	// app calls share the ledger of the first one
+	var groupBalances apply.Balances
+	makeGroupBalances := func(gi int, appIdx basics.AppIndex) (apply.Balances, AppState, error) {
+		b, states, err := makeBalancesAdapter(
+			balances, r.txnGroup, gi,
+			r.protoName, dp.Round, dp.LatestTimestamp,
+			appIdx, dp.Painless, dp.IndexerURL, dp.IndexerToken,
+		)
+		if groupBalances == nil {
+			groupBalances = b
+		}
+		return groupBalances, states, err
+	}
 	// otherwise, if no program(s) set, check transactions for TEAL programs
 	for gi, stxn := range r.txnGroup {
 		// make a new ledger per possible execution since it requires a current group index
@@ -452,11 +523,7 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 			if appIdx == 0 { // app create, use ApprovalProgram from the transaction
 				if len(stxn.Txn.ApprovalProgram) > 0 {
 					appIdx = basics.AppIndex(dp.AppID)
-					b, states, err = makeBalancesAdapter(
-						balances, r.txnGroup, gi,
-						r.protoName, dp.Round, dp.LatestTimestamp,
-						appIdx, dp.Painless, dp.IndexerURL, dp.IndexerToken,
-					)
+					b, states, err = makeGroupBalances(gi, appIdx)
 					if err != nil {
 						return
 					}
@@ -487,11 +554,7 @@ func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
 								err = fmt.Errorf("empty program found for app idx %d", appIdx)
 								return
 							}
-							b, states, err = makeBalancesAdapter(
-								balances, r.txnGroup, gi,
-								r.protoName, dp.Round, dp.LatestTimestamp,
-								appIdx, dp.Painless, dp.IndexerURL, dp.IndexerToken,
-							)
+							b, states, err = makeGroupBalances(gi, appIdx)
 							if err != nil {
 								return
 							}
@@ -537,6 +600,7 @@ func (r *LocalRunner) RunAll() error {
 		// if ep.Debugger != nil // FALSE
 		if r.debugger != nil {
 			ep.Debugger = r.debugger
+			ep.DebugInnerCalls = true
 		}
 	}
 
@@ -546,6 +610,9 @@ func (r *LocalRunner) RunAll() error {
 
 	ep := logic.NewEvalParams(txngroup, &r.proto, &transactions.SpecialAddresses{})
 	configureDebugger(ep)
+	if r.profileFile != "" {
+		ep.Profiler = logic.NewProfiler()
+	}
 
 	var last error
 	for i := range r.runs {
@@ -553,14 +620,40 @@ func (r *LocalRunner) RunAll() error {
 		if r.debugger != nil {
 			r.debugger.SaveProgram(run.name, run.program, run.source, run.offsetToLine, run.states)
 		}
+		if ep.Profiler != nil && run.offsetToLine != nil {
+			err := ep.Profiler.AddSource(run.program, logic.GetSourceMap([]string{run.name}, run.offsetToLine))
+			if err != nil {
+				return err
+			}
+		}
 
-		run.result.pass, run.result.err = run.eval(int(run.groupIndex), ep)
+		var delta transactions.EvalDelta
+		run.result.pass, delta, run.result.err = run.eval(int(run.groupIndex), ep)
 		if run.result.err != nil {
 			failed++
 			last = run.result.err
 		}
+		if r.groupRuns && run.result.pass && run.mode == modeStateful {
+			txn := &r.txnGroup[run.groupIndex].Txn
+			for j := i + 1; j < len(r.runs); j++ {
+				if r.runs[j].mode == modeStateful {
+					r.runs[j].states.applyStateDelta(run.aidx, delta, txn)
+				}
+			}
+		}
 	}
 	elapsed := time.Since(start)
+	if ep.Profiler != nil {
+		var profile bytes.Buffer
+		err := ep.Profiler.WriteProfile(&profile)
+		if err == nil {
+			err = ioutil.WriteFile(r.profileFile, profile.Bytes(), 0666)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write profile: %w", err)
+		}
+		log.Printf("Wrote profile to %s", r.profileFile)
+	}
 	if failed == len(r.runs) && elapsed < time.Second {
 		return fmt.Errorf("all %d program(s) failed in less than a second, invocation error? %w", failed, last)
 	}
@@ -17,11 +17,15 @@
 package main
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -1813,3 +1817,214 @@ func TestRunAllGloads(t *testing.T) {
 	err = local.RunAll()
 	a.NoError(err)
 }
+
+func TestLocalRunnerProfile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	profileFile := filepath.Join(t.TempDir(), "test.prof")
+	dp := DebugParams{
+		ProgramNames: []string{"test.teal"},
+		ProgramBlobs: [][]byte{[]byte("#pragma version 5\nbyte 0x01\nsha256\nlen")},
+		TxnBlob:      []byte(txnSample),
+		Proto:        string(protocol.ConsensusCurrentVersion),
+		RunMode:      "signature",
+		ProfileFile:  profileFile,
+	}
+
+	local := MakeLocalRunner(nil) // no debugger
+	err := local.Setup(&dp)
+	a.NoError(err)
+	r := runAllResultFromInvocation(*local)
+	a.Equal(allPassing(len(local.runs)), r)
+
+	profile, err := ioutil.ReadFile(profileFile)
+	a.NoError(err)
+	gz, err := gzip.NewReader(bytes.NewReader(profile))
+	a.NoError(err)
+	raw, err := ioutil.ReadAll(gz)
+	a.NoError(err)
+	// Frames refer to the source file rather than the disassembly
+	a.Contains(string(raw), "sha256")
+	a.Contains(string(raw), "test.teal")
+}
+
+func TestDebugGroupSharesState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	a.NoError(err)
+
+	// the first call of the group sets a global that the second one checks
+	ops, err := logic.AssembleString(`#pragma version 6
+txn GroupIndex
+bz first
+byte "gkeyint"
+app_global_get
+int 7
+==
+return
+first:
+byte "gkeyint"
+int 7
+app_global_put
+int 1`)
+	a.NoError(err)
+
+	appIdx := basics.AppIndex(100)
+	br := makeSampleBalanceRecord(sender, 50, appIdx)
+	params := br.AppParams[appIdx]
+	params.ApprovalProgram = ops.Program
+	br.AppParams[appIdx] = params
+
+	txn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type: protocol.ApplicationCallTx,
+			Header: transactions.Header{
+				Sender: sender,
+				Fee:    basics.MicroAlgos{Raw: 1000},
+			},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: appIdx,
+			},
+		},
+	}
+	txnBlob := protocol.EncodeMsgp(&txn)
+	txnBlob = append(txnBlob, protocol.EncodeMsgp(&txn)...)
+
+	dp := DebugParams{
+		BalanceBlob: protocol.EncodeMsgp(&br),
+		TxnBlob:     txnBlob,
+		Proto:       string(protocol.ConsensusCurrentVersion),
+		Round:       222,
+	}
+
+	local := MakeLocalRunner(nil) // no debugger
+	err = local.Setup(&dp)
+	a.NoError(err)
+	a.Len(local.runs, 2)
+
+	r := runAllResultFromInvocation(*local)
+	a.Equal(allPassing(len(local.runs)), r)
+	// the state shown for the second call includes the changes of the first
+	a.Equal(uint64(2), br.AppParams[appIdx].GlobalState["gkeyint"].Uint)
+	a.Equal(uint64(7), local.runs[1].states.global[appIdx]["gkeyint"].Uint)
+}
+
+// stepAdapter steps through every program it is notified of and records the
+// events it receives
+type stepAdapter struct {
+	debugger Control
+	events   []Notification
+	done     chan struct{}
+}
+
+func (d *stepAdapter) SessionStarted(_ string, debugger Control, ch chan Notification) {
+	d.debugger = debugger
+	go func() {
+		for n := range ch {
+			d.events = append(d.events, n)
+			switch n.Event {
+			case "registered", "updated":
+				d.debugger.Step()
+			case "completed":
+				d.done <- struct{}{}
+				return
+			}
+		}
+	}()
+}
+
+func (d *stepAdapter) SessionEnded(_ string) {}
+
+func (d *stepAdapter) WaitForCompletion() {
+	<-d.done
+}
+
+func (d *stepAdapter) URL() string {
+	return ""
+}
+
+func TestDebugInnerAppCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	a.NoError(err)
+	creator, err := getRandomAddress()
+	a.NoError(err)
+
+	outer, err := logic.AssembleString(`#pragma version 6
+itxn_begin
+int appl
+itxn_field TypeEnum
+int 200
+itxn_field ApplicationID
+itxn_submit
+int 1`)
+	a.NoError(err)
+	inner, err := logic.AssembleString("#pragma version 6\nint 1")
+	a.NoError(err)
+
+	brs := makeSampleBalanceRecord(sender, 50, 100)
+	brs.AppParams[100] = basics.AppParams{ApprovalProgram: outer.Program}
+	brc := makeSampleBalanceRecord(creator, 51, 200)
+	brc.AppParams[200] = basics.AppParams{ApprovalProgram: inner.Program}
+	bra := makeSampleBalanceRecord(basics.AppIndex(100).Address(), 52, 300)
+	balanceBlob := protocol.EncodeMsgp(&brs)
+	balanceBlob = append(balanceBlob, protocol.EncodeMsgp(&brc)...)
+	balanceBlob = append(balanceBlob, protocol.EncodeMsgp(&bra)...)
+
+	txn := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type: protocol.ApplicationCallTx,
+			Header: transactions.Header{
+				Sender: sender,
+				Fee:    basics.MicroAlgos{Raw: 2000},
+			},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: 100,
+				ForeignApps:   []basics.AppIndex{200},
+			},
+		},
+	}
+
+	dp := DebugParams{
+		BalanceBlob: balanceBlob,
+		TxnBlob:     protocol.EncodeMsgp(&txn),
+		Proto:       string(protocol.ConsensusCurrentVersion),
+		Round:       222,
+	}
+
+	debugger := MakeDebugger()
+	da := &stepAdapter{done: make(chan struct{})}
+	debugger.AddAdapter(da)
+	local := MakeLocalRunner(debugger)
+	err = local.Setup(&dp)
+	a.NoError(err)
+
+	r := runAllResultFromInvocation(*local)
+	a.Equal(allPassing(len(local.runs)), r)
+	da.WaitForCompletion()
+
+	// the inner call is stepped through in the session of its caller
+	var events []string
+	for _, n := range da.events {
+		event := n.Event
+		if n.DebugState.ExecID == logic.GetProgramID(inner.Program) {
+			event = "inner " + event
+		}
+		events = append(events, event)
+	}
+	a.Equal([]string{
+		"registered",
+		"updated", "updated", "updated", "updated", "updated", "updated", // to itxn_submit
+		"inner entered", "inner updated", "inner exited",
+		"updated", "completed",
+	}, events)
+
+	entered := da.events[7]
+	a.Equal(logic.GetProgramID(outer.Program), entered.DebugState.Caller)
+	a.Equal(da.events[6].DebugState.Line, entered.CallerLine)
+}
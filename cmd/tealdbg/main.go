@@ -26,6 +26,10 @@ import (
 	"github.com/spf13/cobra/doc"
 
 	cmdutil "github.com/algorand/go-algorand/cmd/util"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
 )
 
 func main() {
@@ -77,6 +81,22 @@ var remoteCmd = &cobra.Command{
 	},
 }
 
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Debug a confirmed transaction at the state it was evaluated in",
+	Long: `Fetch the transaction group holding a confirmed transaction and the accounts and applications it uses,
+as of the round before it was confirmed, from algod or a stopped node's data directory, apply the transactions
+preceding the group in its round, and debug the group.
+
+Only recent transactions can be replayed: algod and the ledger of a node only keep the account state of
+the rounds within their lookback window, about the last 320 rounds, and archival nodes do not extend it.
+The ledger of a node is read without being modified; the accounts of the rounds of the window are
+rebuilt by evaluating their blocks, and transactions of earlier rounds are rejected.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		debugReplay()
+	},
+}
+
 type frontendValue struct {
 	*cmdutil.CobraStringValue
 }
@@ -122,6 +142,12 @@ var verbose bool
 var painless bool
 var appID uint64
 var listenForDrReq bool
+var profileFile string
+var replayTxID string
+var replayRound uint64
+var algodURL string
+var algodToken string
+var ledgerDir string
 
 func init() {
 	rootCmd.PersistentFlags().VarP(&frontend, "frontend", "f", "Frontend to use: "+frontend.AllowedString())
@@ -147,9 +173,18 @@ func init() {
 	debugCmd.Flags().StringVarP(&indexerURL, "indexer-url", "i", "", "URL for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().StringVarP(&indexerToken, "indexer-token", "", "", "API token for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().BoolVarP(&listenForDrReq, "listen-dr-req", "q", false, "Listen for upcoming debugging dryrun request objects instead of taking program(s) from command line")
+	debugCmd.Flags().StringVar(&profileFile, "profile", "", "Write a pprof profile of the cost of each opcode executed to this file")
+
+	replayCmd.Flags().StringVar(&replayTxID, "txid", "", "ID of the transaction to replay")
+	replayCmd.Flags().Uint64VarP(&replayRound, "round", "r", 0, "Round the transaction was confirmed in. Looked up from algod if not set")
+	replayCmd.Flags().StringVar(&algodURL, "algod", "", "URL of algod to fetch the block and balance records from")
+	replayCmd.Flags().StringVar(&algodToken, "algod-token", "", "API token for algod")
+	replayCmd.Flags().StringVar(&ledgerDir, "ledger-dir", "", "Data directory of a stopped node to read the block and balance records from. Its ledger is opened read-only")
+	replayCmd.Flags().StringVar(&profileFile, "profile", "", "Write a pprof profile of the cost of each opcode executed to this file")
 
 	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(remoteCmd)
+	rootCmd.AddCommand(replayCmd)
 }
 
 func debugRemote() {
@@ -256,6 +291,60 @@ func debugLocal(args []string) {
 		AppID:            appID,
 		Painless:         painless,
 		ListenForDrReq:   listenForDrReq,
+		ProfileFile:      profileFile,
+	}
+
+	ds := makeDebugServer(iface, port, &frontend, &dp)
+
+	err = ds.startDebug()
+	if err != nil {
+		log.Fatalf("Debug error: %s", err.Error())
+	}
+}
+
+func debugReplay() {
+	if len(replayTxID) == 0 {
+		log.Fatalln("Error: txid is required")
+	}
+	if (len(algodURL) == 0) == (len(ledgerDir) == 0) {
+		log.Fatalln("Error: exactly one of algod or ledger-dir must be specified")
+	}
+
+	var txid transactions.Txid
+	err := txid.UnmarshalText([]byte(replayTxID))
+	if err != nil {
+		log.Fatalf("Invalid txid %s: %s", replayTxID, err.Error())
+	}
+
+	var src replaySource
+	if len(algodURL) != 0 {
+		src, err = makeAlgodReplaySource(algodURL, algodToken)
+	} else {
+		src, err = openLedgerReplaySource(ledgerDir)
+	}
+	if err != nil {
+		log.Fatalf("Replay error: %s", err.Error())
+	}
+
+	rnd := basics.Round(replayRound)
+	if rnd == 0 {
+		rnd, err = src.ConfirmedRound(txid)
+	}
+	var ddr v2.DryrunRequest
+	var gi int
+	if err == nil {
+		ddr, gi, err = replayRequest(src, txid, rnd)
+	}
+	src.Close()
+	if err != nil {
+		log.Fatalf("Replay error: %s", err.Error())
+	}
+	log.Printf("Replaying round %d: transaction %d of a group of %d", rnd, gi, len(ddr.Txns))
+
+	dp := DebugParams{
+		DdrBlob:          protocol.EncodeReflect(&ddr),
+		DisableSourceMap: noSourceMap,
+		ProfileFile:      profileFile,
 	}
 
 	ds := makeDebugServer(iface, port, &frontend, &dp)
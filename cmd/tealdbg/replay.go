@@ -0,0 +1,634 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+// replaySource provides the blocks and the historical account states
+// a confirmed transaction is replayed from
+type replaySource interface {
+	// ConfirmedRound returns the round the transaction was confirmed in
+	ConfirmedRound(txid transactions.Txid) (basics.Round, error)
+	// CheckRound returns an error when the transactions confirmed in round rnd can not be replayed
+	CheckRound(rnd basics.Round) error
+	Block(rnd basics.Round) (bookkeeping.Block, error)
+	// Account returns the account data with all its assets and applications as of round rnd
+	Account(rnd basics.Round, addr basics.Address) (basics.AccountData, error)
+	Creator(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
+	Close()
+}
+
+// algodReplaySource fetches blocks and accounts from algod's REST API.
+// Accounts can only be looked up at rounds within algod's lookback window
+// of recent rounds, which archival nodes do not extend.
+type algodReplaySource struct {
+	client client.RestClient
+}
+
+func makeAlgodReplaySource(algodURL string, algodToken string) (*algodReplaySource, error) {
+	u, err := url.Parse(algodURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid algod URL %s: %w", algodURL, err)
+	}
+	c := client.MakeRestClient(*u, algodToken)
+	c.SetAPIVersionAffinity(client.APIVersionV2)
+	return &algodReplaySource{client: c}, nil
+}
+
+func (s *algodReplaySource) ConfirmedRound(txid transactions.Txid) (basics.Round, error) {
+	resp, err := s.client.PendingTransactionInformationV2(txid.String())
+	if err != nil {
+		return 0, fmt.Errorf("transaction %s lookup error: %w", txid, err)
+	}
+	if resp.ConfirmedRound == nil || *resp.ConfirmedRound == 0 {
+		return 0, fmt.Errorf("transaction %s is not confirmed", txid)
+	}
+	return basics.Round(*resp.ConfirmedRound), nil
+}
+
+// CheckRound lets algod report the rounds outside its lookback window when they are requested.
+func (s *algodReplaySource) CheckRound(rnd basics.Round) error {
+	return nil
+}
+
+func (s *algodReplaySource) Block(rnd basics.Round) (bookkeeping.Block, error) {
+	raw, err := s.client.RawBlock(uint64(rnd))
+	if err != nil {
+		return bookkeeping.Block{}, fmt.Errorf("block %d request error: %w", rnd, err)
+	}
+	var bc rpcs.EncodedBlockCert
+	err = protocol.Decode(raw, &bc)
+	if err != nil {
+		return bookkeeping.Block{}, fmt.Errorf("block %d decode error: %w", rnd, err)
+	}
+	return bc.Block, nil
+}
+
+func (s *algodReplaySource) Account(rnd basics.Round, addr basics.Address) (basics.AccountData, error) {
+	raw, err := s.client.RawAccountInformationAtRound(addr.String(), uint64(rnd))
+	if err != nil {
+		return basics.AccountData{}, fmt.Errorf("account %s request error: %w", addr, err)
+	}
+	var ad basics.AccountData
+	err = protocol.Decode(raw, &ad)
+	if err != nil {
+		return basics.AccountData{}, fmt.Errorf("account %s decode error: %w", addr, err)
+	}
+	return ad, nil
+}
+
+// Creator looks up the current creator of an asset or application, since algod does not
+// serve them at past rounds. A creatable deleted since is reported as not found.
+func (s *algodReplaySource) Creator(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	var creator string
+	var err error
+	switch ctype {
+	case basics.AssetCreatable:
+		var asset generated.Asset
+		asset, err = s.client.AssetInformationV2(uint64(cidx))
+		creator = asset.Params.Creator
+	case basics.AppCreatable:
+		var app generated.Application
+		app, err = s.client.ApplicationInformation(uint64(cidx))
+		creator = app.Params.Creator
+	default:
+		return basics.Address{}, false, fmt.Errorf("unknown creatable type %d", ctype)
+	}
+	var httpErr client.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+		return basics.Address{}, false, nil
+	}
+	if err != nil {
+		return basics.Address{}, false, fmt.Errorf("creatable %d request error: %w", cidx, err)
+	}
+	addr, err := basics.UnmarshalChecksumAddress(creator)
+	if err != nil {
+		return basics.Address{}, false, fmt.Errorf("UnmarshalChecksumAddress error: %w", err)
+	}
+	return addr, true, nil
+}
+
+func (s *algodReplaySource) Close() {}
+
+// ledgerReplaySource reads blocks and accounts from the ledger in the data directory of a
+// stopped node, without modifying it. The accounts database of the ledger only holds the
+// accounts as of a single round, so the accounts of the rounds that follow it are rebuilt by
+// evaluating their blocks on top of it. Earlier rounds can not be replayed.
+type ledgerReplaySource struct {
+	reader  *ledger.Reader
+	dataDir string
+
+	// hdr is the header of the round the accounts and creators below were rebuilt up to
+	hdr      bookkeeping.BlockHeader
+	accounts map[basics.Address]basics.AccountData
+	creators map[basics.CreatableIndex]ledgercore.ModifiedCreatable
+}
+
+func openLedgerReplaySource(dataDir string) (*ledgerReplaySource, error) {
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(dataDir, config.GenesisJSONFile))
+	if err != nil {
+		return nil, fmt.Errorf("genesis reading error: %w", err)
+	}
+	cfg, err := config.LoadConfigFromDisk(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("config reading error: %w", err)
+	}
+
+	ledgerPathnamePrefix := filepath.Join(dataDir, genesis.ID(), config.LedgerFilenamePrefix)
+	files, err := filepath.Glob(ledgerPathnamePrefix + ".*")
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no ledger in %s", dataDir)
+	}
+
+	reader, err := ledger.OpenReader(ledgerPathnamePrefix, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("ledger opening error: %w", err)
+	}
+	blk, err := reader.Block(reader.AccountsRound())
+	if err != nil {
+		reader.Close()
+		return nil, fmt.Errorf("ledger opening error: %w", err)
+	}
+	return &ledgerReplaySource{
+		reader:   reader,
+		dataDir:  dataDir,
+		hdr:      blk.BlockHeader,
+		accounts: make(map[basics.Address]basics.AccountData),
+		creators: make(map[basics.CreatableIndex]ledgercore.ModifiedCreatable),
+	}, nil
+}
+
+func (s *ledgerReplaySource) ConfirmedRound(txid transactions.Txid) (basics.Round, error) {
+	return 0, fmt.Errorf("round of transaction %s must be specified when replaying from a ledger directory", txid)
+}
+
+func (s *ledgerReplaySource) CheckRound(rnd basics.Round) error {
+	if rnd <= s.reader.AccountsRound() {
+		return fmt.Errorf("round %d can not be replayed from the ledger in %s, which holds the accounts of round %d on: only transactions confirmed after round %d can be replayed", rnd, s.dataDir, s.reader.AccountsRound(), s.reader.AccountsRound())
+	}
+	latest, err := s.reader.Latest()
+	if err != nil {
+		return err
+	}
+	if rnd > latest {
+		return fmt.Errorf("round %d is past the latest round %d of the ledger in %s", rnd, latest, s.dataDir)
+	}
+	return nil
+}
+
+func (s *ledgerReplaySource) Block(rnd basics.Round) (bookkeeping.Block, error) {
+	return s.reader.Block(rnd)
+}
+
+// advance rebuilds the accounts and creators up to round rnd, evaluating the blocks that
+// follow the round they were rebuilt up to so far
+func (s *ledgerReplaySource) advance(rnd basics.Round) error {
+	if rnd < s.hdr.Round {
+		return fmt.Errorf("the accounts of round %d can not be read from the ledger in %s, which holds the accounts of round %d on", rnd, s.dataDir, s.reader.AccountsRound())
+	}
+	for s.hdr.Round < rnd {
+		blk, err := s.reader.Block(s.hdr.Round + 1)
+		if err != nil {
+			return err
+		}
+		proto, ok := config.Consensus[blk.CurrentProtocol]
+		if !ok {
+			return fmt.Errorf("unknown consensus version %s of round %d", blk.CurrentProtocol, blk.Round())
+		}
+		l := makeReplayLedger(s, s.hdr, blk.RewardsLevel)
+		delta, _, err := ledger.EvalForIndexer(l, &blk, proto, ledger.EvalForIndexerResources{})
+		if err != nil {
+			return fmt.Errorf("evaluating round %d: %w", blk.Round(), err)
+		}
+		pool, err := l.account(s.hdr.RewardsPool)
+		if err != nil {
+			return err
+		}
+		rewards := roundRewards(s.hdr, blk.BlockHeader, proto, pool.MicroAlgos)
+
+		updated := make(map[basics.Address]basics.AccountData)
+		for _, addr := range delta.Accts.ModifiedAccounts() {
+			ad, err := l.account(addr)
+			if err != nil {
+				return err
+			}
+			updated[addr] = delta.Accts.ApplyToBasicsAccountData(addr, ad)
+		}
+		if ad, ok := updated[s.hdr.RewardsPool]; ok {
+			pool = ad
+		}
+		pool.MicroAlgos.Raw -= rewards
+		updated[s.hdr.RewardsPool] = pool
+
+		for addr, ad := range updated {
+			s.accounts[addr] = ad
+		}
+		for cidx, mc := range delta.Creatables {
+			s.creators[cidx] = mc
+		}
+		s.hdr = blk.BlockHeader
+	}
+	return nil
+}
+
+func (s *ledgerReplaySource) Account(rnd basics.Round, addr basics.Address) (basics.AccountData, error) {
+	err := s.advance(rnd)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	ad, ok := s.accounts[addr]
+	if !ok {
+		ad, err = s.reader.LookupAccount(addr)
+		if err != nil {
+			return basics.AccountData{}, err
+		}
+	}
+	return ad.WithUpdatedRewards(config.Consensus[s.hdr.CurrentProtocol], s.hdr.RewardsLevel), nil
+}
+
+func (s *ledgerReplaySource) Creator(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	err := s.advance(rnd)
+	if err != nil {
+		return basics.Address{}, false, err
+	}
+	if mc, ok := s.creators[cidx]; ok && mc.Ctype == ctype {
+		return mc.Creator, mc.Created, nil
+	}
+	return s.reader.GetCreator(cidx, ctype)
+}
+
+func (s *ledgerReplaySource) Close() {
+	s.reader.Close()
+}
+
+// replayLedger serves the state after the round of hdr from a replaySource to the
+// evaluator replaying the transactions that precede a group in the next block
+type replayLedger struct {
+	src      replaySource
+	hdr      bookkeeping.BlockHeader
+	accounts map[basics.Address]basics.AccountData
+
+	// rewards level of the replayed round
+	rewardsLevel uint64
+}
+
+func makeReplayLedger(src replaySource, hdr bookkeeping.BlockHeader, rewardsLevel uint64) *replayLedger {
+	return &replayLedger{
+		src:          src,
+		hdr:          hdr,
+		accounts:     make(map[basics.Address]basics.AccountData),
+		rewardsLevel: rewardsLevel,
+	}
+}
+
+// account returns the account data of addr with all its assets and applications,
+// fetching it from the source at most once
+func (l *replayLedger) account(addr basics.Address) (basics.AccountData, error) {
+	if ad, ok := l.accounts[addr]; ok {
+		return ad, nil
+	}
+	ad, err := l.src.Account(l.hdr.Round, addr)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	l.accounts[addr] = ad
+	return ad, nil
+}
+
+// LatestBlockHdr returns the previous block header with the rewards level of the replayed
+// round, so that the evaluator does not withdraw the round's rewards from the rewards pool.
+// That needs the totals of reward units, which neither source serves for past rounds, so
+// replayRequest withdraws them instead.
+func (l *replayLedger) LatestBlockHdr() (bookkeeping.BlockHeader, error) {
+	hdr := l.hdr
+	hdr.RewardsLevel = l.rewardsLevel
+	return hdr, nil
+}
+
+func (l *replayLedger) LookupWithoutRewards(addrs map[basics.Address]struct{}) (map[basics.Address]*ledgercore.AccountData, error) {
+	res := make(map[basics.Address]*ledgercore.AccountData, len(addrs))
+	for addr := range addrs {
+		ad, err := l.account(addr)
+		if err != nil {
+			return nil, err
+		}
+		data := ledgercore.ToAccountData(ad)
+		res[addr] = &data
+	}
+	return res, nil
+}
+
+func (l *replayLedger) LookupResources(addrs map[basics.Address]map[ledger.Creatable]struct{}) (map[basics.Address]map[ledger.Creatable]ledgercore.AccountResource, error) {
+	res := make(map[basics.Address]map[ledger.Creatable]ledgercore.AccountResource, len(addrs))
+	for addr, creatables := range addrs {
+		ad, err := l.account(addr)
+		if err != nil {
+			return nil, err
+		}
+		res[addr] = make(map[ledger.Creatable]ledgercore.AccountResource, len(creatables))
+		for creatable := range creatables {
+			var r ledgercore.AccountResource
+			switch creatable.Type {
+			case basics.AssetCreatable:
+				aidx := basics.AssetIndex(creatable.Index)
+				if params, ok := ad.AssetParams[aidx]; ok {
+					r.AssetParams = &params
+				}
+				if holding, ok := ad.Assets[aidx]; ok {
+					r.AssetHolding = &holding
+				}
+			case basics.AppCreatable:
+				aidx := basics.AppIndex(creatable.Index)
+				if params, ok := ad.AppParams[aidx]; ok {
+					r.AppParams = &params
+				}
+				if state, ok := ad.AppLocalStates[aidx]; ok {
+					r.AppLocalState = &state
+				}
+			}
+			res[addr][creatable] = r
+		}
+	}
+	return res, nil
+}
+
+func (l *replayLedger) GetAssetCreator(aidxs map[basics.AssetIndex]struct{}) (map[basics.AssetIndex]ledger.FoundAddress, error) {
+	res := make(map[basics.AssetIndex]ledger.FoundAddress, len(aidxs))
+	for aidx := range aidxs {
+		addr, ok, err := l.src.Creator(l.hdr.Round, basics.CreatableIndex(aidx), basics.AssetCreatable)
+		if err != nil {
+			return nil, err
+		}
+		res[aidx] = ledger.FoundAddress{Address: addr, Exists: ok}
+	}
+	return res, nil
+}
+
+func (l *replayLedger) GetAppCreator(aidxs map[basics.AppIndex]struct{}) (map[basics.AppIndex]ledger.FoundAddress, error) {
+	res := make(map[basics.AppIndex]ledger.FoundAddress, len(aidxs))
+	for aidx := range aidxs {
+		addr, ok, err := l.src.Creator(l.hdr.Round, basics.CreatableIndex(aidx), basics.AppCreatable)
+		if err != nil {
+			return nil, err
+		}
+		res[aidx] = ledger.FoundAddress{Address: addr, Exists: ok}
+	}
+	return res, nil
+}
+
+// LatestTotals returns made up totals, as neither source serves the totals of past rounds.
+// With the rewards withdrawal left out, the evaluator only updates them, so they are
+// just large enough for the accounts moved between them not to overflow them.
+func (l *replayLedger) LatestTotals() (ledgercore.AccountTotals, error) {
+	count := ledgercore.AlgoCount{
+		Money:       basics.MicroAlgos{Raw: math.MaxUint64 / 4},
+		RewardUnits: math.MaxUint64 / 4,
+	}
+	return ledgercore.AccountTotals{
+		Online:           count,
+		Offline:          count,
+		NotParticipating: count,
+		RewardsLevel:     l.rewardsLevel,
+	}, nil
+}
+
+// roundRewards returns the rewards round blk withdraws from the rewards pool when it starts,
+// which had pool in it after the round of prev
+func roundRewards(prev bookkeeping.BlockHeader, blk bookkeeping.BlockHeader, proto config.ConsensusParams, pool basics.MicroAlgos) uint64 {
+	if blk.RewardsLevel == prev.RewardsLevel {
+		return 0
+	}
+	// with no reward units, the next rewards state only refreshes the rewards rate
+	next := prev.NextRewardsState(blk.Round, proto, pool, 0, logging.Base())
+	rate := prev.RewardsRate
+	if proto.RewardsCalculationFix {
+		rate = next.RewardsRate
+	}
+	// the rate and the previous residue are spread over all reward units, leaving the new residue
+	return rate + prev.RewardsResidue - blk.RewardsResidue
+}
+
+// findTxnGroup returns the transaction group of blk holding txid, the index of txid in it,
+// and the number of transactions of blk that precede the group
+func findTxnGroup(blk bookkeeping.Block, txid transactions.Txid) ([]transactions.SignedTxn, int, int, error) {
+	groups, err := blk.DecodePaysetGroups()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	preceding := 0
+	for _, group := range groups {
+		for i, stxnad := range group {
+			if stxnad.ID() != txid {
+				continue
+			}
+			txgroup := make([]transactions.SignedTxn, len(group))
+			for j := range group {
+				txgroup[j] = group[j].SignedTxn
+			}
+			return txgroup, i, preceding, nil
+		}
+		preceding += len(group)
+	}
+	return nil, 0, 0, fmt.Errorf("transaction %s not found in round %d", txid, blk.Round())
+}
+
+// evalPrecedingTxns evaluates the first preceding transactions of blk on top of the
+// state of the ledger after the previous round, and returns the changes they make
+func evalPrecedingTxns(l *replayLedger, blk bookkeeping.Block, preceding int, proto config.ConsensusParams) (ledgercore.StateDelta, error) {
+	if preceding == 0 {
+		return ledgercore.StateDelta{}, nil
+	}
+	partial := blk
+	partial.Payset = blk.Payset[:preceding]
+	// accounts expire at the end of the round, after all of its transactions
+	partial.ParticipationUpdates = bookkeeping.ParticipationUpdates{}
+	delta, _, err := ledger.EvalForIndexer(l, &partial, proto, ledger.EvalForIndexerResources{})
+	if err != nil {
+		return ledgercore.StateDelta{}, fmt.Errorf("evaluating the transactions preceding the group in round %d: %w", blk.Round(), err)
+	}
+	return delta, nil
+}
+
+// replayCreator looks up the creator of an asset or application before the group is
+// evaluated, taking into account the creatables created or deleted earlier in the round
+func replayCreator(l *replayLedger, delta ledgercore.StateDelta, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	if mc, ok := delta.Creatables[cidx]; ok && mc.Ctype == ctype {
+		return mc.Creator, mc.Created, nil
+	}
+	return l.src.Creator(l.hdr.Round, cidx, ctype)
+}
+
+// replayReferences returns the accounts, applications and assets that may be accessed
+// while evaluating txgroup
+func replayReferences(txgroup []transactions.SignedTxn) (accounts map[basics.Address]bool, apps map[basics.AppIndex]bool, assets map[basics.AssetIndex]bool) {
+	accounts = make(map[basics.Address]bool)
+	apps = make(map[basics.AppIndex]bool)
+	assets = make(map[basics.AssetIndex]bool)
+
+	for _, stxn := range txgroup {
+		txn := &stxn.Txn
+		addrs := []basics.Address{
+			txn.Sender, txn.Receiver, txn.CloseRemainderTo,
+			txn.AssetSender, txn.AssetReceiver, txn.AssetCloseTo, txn.FreezeAccount,
+		}
+		addrs = append(addrs, txn.Accounts...)
+		for _, addr := range addrs {
+			if !addr.IsZero() {
+				accounts[addr] = true
+			}
+		}
+
+		if txn.ApplicationID != 0 {
+			apps[txn.ApplicationID] = true
+		}
+		for _, aidx := range txn.ForeignApps {
+			apps[aidx] = true
+		}
+
+		for _, aidx := range []basics.AssetIndex{txn.XferAsset, txn.ConfigAsset, txn.FreezeAsset} {
+			if aidx != 0 {
+				assets[aidx] = true
+			}
+		}
+		for _, aidx := range txn.ForeignAssets {
+			assets[aidx] = true
+		}
+	}
+
+	// applications hold their own accounts
+	for aidx := range apps {
+		accounts[aidx.Address()] = true
+	}
+	return
+}
+
+// replayRequest builds a DryrunRequest evaluating the transaction group that holds txid,
+// confirmed in round rnd, at the state of the ledger after round rnd-1 and the groups
+// preceding it in round rnd. It returns the request and the index of txid in the group.
+func replayRequest(src replaySource, txid transactions.Txid, rnd basics.Round) (v2.DryrunRequest, int, error) {
+	if rnd == 0 {
+		return v2.DryrunRequest{}, 0, fmt.Errorf("transaction %s can not be replayed from round 0", txid)
+	}
+	if err := src.CheckRound(rnd); err != nil {
+		return v2.DryrunRequest{}, 0, err
+	}
+	blk, err := src.Block(rnd)
+	if err != nil {
+		return v2.DryrunRequest{}, 0, err
+	}
+	txgroup, groupIndex, preceding, err := findTxnGroup(blk, txid)
+	if err != nil {
+		return v2.DryrunRequest{}, 0, err
+	}
+	proto, ok := config.Consensus[blk.CurrentProtocol]
+	if !ok {
+		return v2.DryrunRequest{}, 0, fmt.Errorf("unknown consensus version %s of round %d", blk.CurrentProtocol, rnd)
+	}
+
+	prev := rnd - 1
+	prevBlk, err := src.Block(prev)
+	if err != nil {
+		return v2.DryrunRequest{}, 0, err
+	}
+	l := makeReplayLedger(src, prevBlk.BlockHeader, blk.RewardsLevel)
+	delta, err := evalPrecedingTxns(l, blk, preceding, proto)
+	if err != nil {
+		return v2.DryrunRequest{}, 0, err
+	}
+	pool, err := l.account(prevBlk.RewardsPool)
+	if err != nil {
+		return v2.DryrunRequest{}, 0, err
+	}
+	rewards := roundRewards(prevBlk.BlockHeader, blk.BlockHeader, proto, pool.MicroAlgos)
+
+	accounts, apps, assets := replayReferences(txgroup)
+	for aidx := range apps {
+		creator, ok, err := replayCreator(l, delta, basics.CreatableIndex(aidx), basics.AppCreatable)
+		if err != nil {
+			return v2.DryrunRequest{}, 0, err
+		}
+		// the app may be created by the group itself
+		if ok {
+			accounts[creator] = true
+		}
+	}
+	for aidx := range assets {
+		creator, ok, err := replayCreator(l, delta, basics.CreatableIndex(aidx), basics.AssetCreatable)
+		if err != nil {
+			return v2.DryrunRequest{}, 0, err
+		}
+		if ok {
+			accounts[creator] = true
+		}
+	}
+
+	addrs := make([]basics.Address, 0, len(accounts))
+	for addr := range accounts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].String() < addrs[j].String()
+	})
+
+	ddr := v2.DryrunRequest{
+		Txns:            txgroup,
+		ProtocolVersion: string(blk.CurrentProtocol),
+		Round:           uint64(rnd),
+		LatestTimestamp: prevBlk.TimeStamp,
+	}
+	for _, addr := range addrs {
+		ad, err := l.account(addr)
+		if err != nil {
+			return v2.DryrunRequest{}, 0, err
+		}
+		ad = delta.Accts.ApplyToBasicsAccountData(addr, ad)
+		if addr == prevBlk.RewardsPool {
+			ad.MicroAlgos.Raw -= rewards
+		}
+		account, err := v2.AccountDataToAccount(addr.String(), &ad, prev, &proto, ad.MicroAlgos)
+		if err != nil {
+			return v2.DryrunRequest{}, 0, err
+		}
+		ddr.Accounts = append(ddr.Accounts, account)
+	}
+	return ddr, groupIndex, nil
+}
@@ -0,0 +1,468 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// mapReplaySource serves blocks and per-round account states from memory
+type mapReplaySource struct {
+	blocks   map[basics.Round]bookkeeping.Block
+	accounts map[basics.Round]map[basics.Address]basics.AccountData
+}
+
+func (s *mapReplaySource) ConfirmedRound(txid transactions.Txid) (basics.Round, error) {
+	for rnd, blk := range s.blocks {
+		if _, _, _, err := findTxnGroup(blk, txid); err == nil {
+			return rnd, nil
+		}
+	}
+	return 0, fmt.Errorf("transaction %s not found", txid)
+}
+
+func (s *mapReplaySource) CheckRound(rnd basics.Round) error {
+	return nil
+}
+
+func (s *mapReplaySource) Block(rnd basics.Round) (bookkeeping.Block, error) {
+	blk, ok := s.blocks[rnd]
+	if !ok {
+		return bookkeeping.Block{}, fmt.Errorf("no block %d", rnd)
+	}
+	return blk, nil
+}
+
+func (s *mapReplaySource) Account(rnd basics.Round, addr basics.Address) (basics.AccountData, error) {
+	return s.accounts[rnd][addr], nil
+}
+
+func (s *mapReplaySource) Creator(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	for addr, ad := range s.accounts[rnd] {
+		if _, ok := ad.AppParams[basics.AppIndex(cidx)]; ok && ctype == basics.AppCreatable {
+			return addr, true, nil
+		}
+		if _, ok := ad.AssetParams[basics.AssetIndex(cidx)]; ok && ctype == basics.AssetCreatable {
+			return addr, true, nil
+		}
+	}
+	return basics.Address{}, false, nil
+}
+
+func (s *mapReplaySource) Close() {}
+
+var replayFeeSink = basics.Address{0x0f}
+var replayRewardsPool = basics.Address{0x0e}
+
+func makeReplayBlock(t *testing.T, rnd basics.Round, ts int64, txns ...transactions.SignedTxn) bookkeeping.Block {
+	blk := bookkeeping.Block{
+		BlockHeader: bookkeeping.BlockHeader{
+			Round:       rnd,
+			TimeStamp:   ts,
+			GenesisID:   "replay-test",
+			GenesisHash: crypto.Digest{0x01},
+			RewardsState: bookkeeping.RewardsState{
+				FeeSink:     replayFeeSink,
+				RewardsPool: replayRewardsPool,
+			},
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusCurrentVersion,
+			},
+		},
+	}
+	for _, stxn := range txns {
+		stxn.Txn.GenesisHash = blk.BlockHeader.GenesisHash
+		stxnib, err := blk.BlockHeader.EncodeSignedTxn(stxn, transactions.ApplyData{})
+		require.NoError(t, err)
+		blk.Payset = append(blk.Payset, stxnib)
+	}
+	return blk
+}
+
+func makeReplaySource(t *testing.T) (*mapReplaySource, transactions.Txid) {
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	require.NoError(t, err)
+	creator, err := basics.UnmarshalChecksumAddress("PNWOET7LLOWMBMLE4KOCELCX6X3D3Q4H2Q4QJASYIEOF7YIPPQBG3YQ5YI")
+	require.NoError(t, err)
+	other, err := basics.UnmarshalChecksumAddress("OC6IROKUJ7YCU5NV76AZJEDKYQG33V2CJ7HAPVQ4ENTAGMLIOINSQ6EKGE")
+	require.NoError(t, err)
+
+	// the call passes only at the state and time of round 10
+	ops, err := logic.AssembleString(`#pragma version 6
+global LatestTimestamp
+int 1650000000
+==
+assert
+txna Assets 0
+asset_params_get AssetTotal
+assert
+int 1000
+==
+assert
+byte "gkeyint"
+app_global_get
+int 3
+==`)
+	require.NoError(t, err)
+
+	appIdx := basics.AppIndex(100)
+	appState := func(value uint64) basics.AccountData {
+		return basics.AccountData{
+			MicroAlgos: basics.MicroAlgos{Raw: 1000000},
+			AppParams: map[basics.AppIndex]basics.AppParams{
+				appIdx: {
+					ApprovalProgram:   ops.Program,
+					ClearStateProgram: ops.Program,
+					GlobalState: basics.TealKeyValue{
+						"gkeyint": basics.TealValue{Type: basics.TealUintType, Uint: value},
+					},
+					StateSchemas: makeSchemas(),
+				},
+			},
+		}
+	}
+	assetCreator := basics.AccountData{
+		MicroAlgos:  basics.MicroAlgos{Raw: 1000000},
+		AssetParams: map[basics.AssetIndex]basics.AssetParams{50: {Total: 1000}},
+	}
+
+	pay := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.PaymentTx,
+			Header: transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: 1000}},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: appIdx.Address(),
+				Amount:   basics.MicroAlgos{Raw: 100000},
+			},
+		},
+	}
+	call := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.ApplicationCallTx,
+			Header: transactions.Header{Sender: sender, Fee: basics.MicroAlgos{Raw: 1000}},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: appIdx,
+				ForeignApps:   []basics.AppIndex{200},
+				ForeignAssets: []basics.AssetIndex{50},
+			},
+		},
+	}
+	var group transactions.TxGroup
+	for _, stxn := range []*transactions.SignedTxn{&pay, &call} {
+		stxn.Txn.GenesisHash = crypto.Digest{0x01}
+		group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(stxn.Txn.ID()))
+	}
+	pay.Txn.Group = crypto.HashObj(group)
+	call.Txn.Group = pay.Txn.Group
+
+	// an unrelated transaction comes first in the block
+	unrelated := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type:   protocol.PaymentTx,
+			Header: transactions.Header{Sender: other, Fee: basics.MicroAlgos{Raw: 1000}},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: sender,
+				Amount:   basics.MicroAlgos{Raw: 500000},
+			},
+		},
+	}
+
+	src := &mapReplaySource{
+		blocks: map[basics.Round]bookkeeping.Block{
+			9:  makeReplayBlock(t, 9, 1650000000),
+			10: makeReplayBlock(t, 10, 1650000004, unrelated, pay, call),
+		},
+		accounts: map[basics.Round]map[basics.Address]basics.AccountData{
+			9: {
+				sender:            {MicroAlgos: basics.MicroAlgos{Raw: 5000000}},
+				creator:           appState(3),
+				other:             assetCreator,
+				replayRewardsPool: {MicroAlgos: basics.MicroAlgos{Raw: 1000000}},
+			},
+			10: {
+				sender:  {MicroAlgos: basics.MicroAlgos{Raw: 4898000}},
+				creator: appState(4),
+				other:   assetCreator,
+			},
+		},
+	}
+	return src, call.ID()
+}
+
+func TestReplayRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	src, txid := makeReplaySource(t)
+	rnd, err := src.ConfirmedRound(txid)
+	a.NoError(err)
+	a.Equal(basics.Round(10), rnd)
+
+	ddr, gi, err := replayRequest(src, txid, rnd)
+	a.NoError(err)
+	a.Equal(1, gi)
+	a.Len(ddr.Txns, 2)
+	a.Equal(txid, ddr.Txns[1].ID())
+	a.Equal(protocol.PaymentTx, ddr.Txns[0].Txn.Type)
+	a.Equal(uint64(10), ddr.Round)
+	a.Equal(int64(1650000000), ddr.LatestTimestamp)
+	a.Equal(string(protocol.ConsensusCurrentVersion), ddr.ProtocolVersion)
+
+	// the sender, the accounts of both apps and the creators of the app and the asset
+	a.Len(ddr.Accounts, 5)
+	accounts := make(map[string]generated.Account)
+	for _, account := range ddr.Accounts {
+		accounts[account.Address] = account
+	}
+	// the unrelated payment preceding the group in round 10 has been applied
+	a.Equal(uint64(5500000), accounts["47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU"].Amount)
+	a.Contains(accounts, basics.AppIndex(100).Address().String())
+	a.Contains(accounts, "OC6IROKUJ7YCU5NV76AZJEDKYQG33V2CJ7HAPVQ4ENTAGMLIOINSQ6EKGE")
+	a.Equal(uint64(499000), accounts["OC6IROKUJ7YCU5NV76AZJEDKYQG33V2CJ7HAPVQ4ENTAGMLIOINSQ6EKGE"].Amount)
+	a.NotNil(accounts["OC6IROKUJ7YCU5NV76AZJEDKYQG33V2CJ7HAPVQ4ENTAGMLIOINSQ6EKGE"].CreatedAssets)
+	creator := accounts["PNWOET7LLOWMBMLE4KOCELCX6X3D3Q4H2Q4QJASYIEOF7YIPPQBG3YQ5YI"]
+	a.NotNil(creator.CreatedApps)
+	a.Len(*creator.CreatedApps, 1)
+
+	dp := DebugParams{DdrBlob: protocol.EncodeReflect(&ddr)}
+	local := MakeLocalRunner(nil) // no debugger
+	err = local.Setup(&dp)
+	a.NoError(err)
+	a.Len(local.runs, 1)
+	a.Equal(uint64(1), local.runs[0].groupIndex)
+
+	r := runAllResultFromInvocation(*local)
+	a.Equal(allPassing(len(local.runs)), r)
+
+	_, _, err = replayRequest(src, txid, 9)
+	a.Error(err)
+	a.Contains(err.Error(), "not found in round 9")
+}
+
+func TestAlgodReplaySource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	src, txid := makeReplaySource(t)
+	creator, err := basics.UnmarshalChecksumAddress("PNWOET7LLOWMBMLE4KOCELCX6X3D3Q4H2Q4QJASYIEOF7YIPPQBG3YQ5YI")
+	a.NoError(err)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/blocks/10", func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("msgpack", r.URL.Query().Get("format"))
+		w.Write(protocol.EncodeReflect(rpcs.EncodedBlockCert{Block: src.blocks[10]}))
+	})
+	mux.HandleFunc("/v2/accounts/"+creator.String(), func(w http.ResponseWriter, r *http.Request) {
+		a.Equal("msgpack", r.URL.Query().Get("format"))
+		a.Equal("9", r.URL.Query().Get("round"))
+		ad := src.accounts[9][creator]
+		w.Write(protocol.Encode(&ad))
+	})
+	mux.HandleFunc("/v2/applications/100", func(w http.ResponseWriter, r *http.Request) {
+		w.Write(protocol.EncodeJSON(generated.Application{Id: 100, Params: generated.ApplicationParams{Creator: creator.String()}}))
+	})
+	mux.HandleFunc("/v2/applications/200", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "application does not exist", http.StatusNotFound)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	algod, err := makeAlgodReplaySource(server.URL, "")
+	a.NoError(err)
+
+	blk, err := algod.Block(10)
+	a.NoError(err)
+	_, gi, preceding, err := findTxnGroup(blk, txid)
+	a.NoError(err)
+	a.Equal(1, gi)
+	a.Equal(1, preceding)
+
+	ad, err := algod.Account(9, creator)
+	a.NoError(err)
+	a.Equal(uint64(3), ad.AppParams[100].GlobalState["gkeyint"].Uint)
+
+	addr, ok, err := algod.Creator(9, 100, basics.AppCreatable)
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(creator, addr)
+
+	_, ok, err = algod.Creator(9, 200, basics.AppCreatable)
+	a.NoError(err)
+	a.False(ok)
+}
+
+func TestReplayRoundRewards(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	prev := bookkeeping.BlockHeader{
+		Round: 9,
+		RewardsState: bookkeeping.RewardsState{
+			RewardsLevel:              7,
+			RewardsRate:               1000003,
+			RewardsResidue:            11,
+			RewardsRecalculationRound: 500000,
+		},
+	}
+	pool := basics.MicroAlgos{Raw: 1000000000000}
+
+	for _, units := range []uint64{1, 1000, 999999} {
+		blk := prev
+		blk.Round = 10
+		blk.RewardsState = prev.NextRewardsState(10, proto, pool, units, logging.Base())
+		a.Equal(units*(blk.RewardsLevel-prev.RewardsLevel), roundRewards(prev, blk, proto, pool), units)
+	}
+
+	// so many reward units that the level does not change
+	blk := prev
+	blk.Round = 10
+	blk.RewardsState = prev.NextRewardsState(10, proto, pool, 1<<40, logging.Base())
+	a.Equal(prev.RewardsLevel, blk.RewardsLevel)
+	a.Zero(roundRewards(prev, blk, proto, pool))
+}
+
+func TestLedgerReplaySource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	addr, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	a.NoError(err)
+	receiver := basics.Address{0x01}
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "replay-test",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     basics.Address(replayFeeSink).String(),
+		RewardsPool: basics.Address(replayRewardsPool).String(),
+		Allocation: []bookkeeping.GenesisAllocation{
+			{Address: addr.String(), State: basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 5000000}}},
+			{Address: basics.Address(replayFeeSink).String(), State: basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000000}}},
+			{Address: basics.Address(replayRewardsPool).String(), State: basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000000}}},
+		},
+	}
+	dataDir := t.TempDir()
+	a.NoError(ioutil.WriteFile(filepath.Join(dataDir, config.GenesisJSONFile), protocol.EncodeJSON(genesis), 0600))
+	genesisDir := filepath.Join(dataDir, genesis.ID())
+	a.NoError(os.Mkdir(genesisDir, 0700))
+
+	_, err = openLedgerReplaySource(dataDir)
+	a.Error(err)
+	a.Contains(err.Error(), "no ledger in")
+
+	// a ledger whose accounts database is still at the genesis, and a payment in each of
+	// the two following rounds
+	genalloc, err := genesis.Balances()
+	a.NoError(err)
+	l, err := data.LoadLedger(logging.TestingLog(t), filepath.Join(genesisDir, config.LedgerFilenamePrefix), false, genesis.Proto, genalloc, genesis.ID(), genesis.Hash(), nil, config.GetDefaultLocal())
+	a.NoError(err)
+	proto := config.Consensus[genesis.Proto]
+	for rnd := basics.Round(1); rnd <= 2; rnd++ {
+		prev, err := l.BlockHdr(rnd - 1)
+		a.NoError(err)
+		blk := bookkeeping.MakeBlock(prev)
+		eval, err := l.StartEvaluator(blk.BlockHeader, 0, 0)
+		a.NoError(err)
+		txn := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addr,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  rnd,
+				LastValid:   rnd + 10,
+				GenesisID:   genesis.ID(),
+				GenesisHash: genesis.Hash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: receiver,
+				Amount:   basics.MicroAlgos{Raw: 1000000},
+			},
+		}
+		a.NoError(eval.Transaction(transactions.SignedTxn{Txn: txn}, transactions.ApplyData{}))
+		vb, err := eval.GenerateBlock()
+		a.NoError(err)
+		a.NoError(l.AddValidatedBlock(*vb, agreement.Certificate{}))
+	}
+	l.WaitForCommit(2)
+	l.Close()
+
+	readLedgerFiles := func() map[string][]byte {
+		files := make(map[string][]byte)
+		entries, err := ioutil.ReadDir(genesisDir)
+		a.NoError(err)
+		for _, entry := range entries {
+			content, err := ioutil.ReadFile(filepath.Join(genesisDir, entry.Name()))
+			a.NoError(err)
+			files[entry.Name()] = content
+		}
+		return files
+	}
+	before := readLedgerFiles()
+	a.NotEmpty(before)
+
+	src, err := openLedgerReplaySource(dataDir)
+	a.NoError(err)
+	blk, err := src.Block(0)
+	a.NoError(err)
+	a.Equal(genesis.Hash(), blk.GenesisHash())
+	a.NoError(src.CheckRound(1))
+	a.NoError(src.CheckRound(2))
+	err = src.CheckRound(3)
+	a.Error(err)
+	a.Contains(err.Error(), "past the latest round 2")
+
+	// the accounts of the rounds after the accounts database are rebuilt from the blocks
+	ad, err := src.Account(0, addr)
+	a.NoError(err)
+	a.Equal(uint64(5000000), ad.MicroAlgos.Raw)
+	ad, err = src.Account(1, receiver)
+	a.NoError(err)
+	a.Equal(uint64(1000000), ad.MicroAlgos.Raw)
+	ad, err = src.Account(2, addr)
+	a.NoError(err)
+	a.Equal(5000000-2*(1000000+proto.MinTxnFee), ad.MicroAlgos.Raw)
+	ad, err = src.Account(2, receiver)
+	a.NoError(err)
+	a.Equal(uint64(2000000), ad.MicroAlgos.Raw)
+	_, err = src.Account(1, receiver)
+	a.Error(err)
+	src.Close()
+
+	a.Equal(before, readLedgerFiles())
+}
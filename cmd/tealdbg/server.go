@@ -96,6 +96,7 @@ type DebugParams struct {
 	AppID            uint64
 	Painless         bool
 	ListenForDrReq   bool
+	ProfileFile      string
 }
 
 // FrontendFactory interface for attaching debug frontends
@@ -69,6 +69,14 @@ const CompactCertFilename = "compactcert.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// PeerBansFilename is the name of the file the peer bans are persisted to.
+// It is kept in the data directory so that bans survive restarts.
+const PeerBansFilename = "peerbans.json"
+
+// PersistentPeersFilename is the name of the file the persistent peers added at runtime are persisted to.
+// It is kept in the data directory so that persistent peers survive restarts.
+const PersistentPeersFilename = "persistentpeers.json"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus prototocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -41,7 +41,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23"`
 
 	// environmental (may be overridden)
 	// When enabled, stores blocks indefinitally, otherwise, only the most recents blocks
@@ -98,6 +98,31 @@ type Local struct {
 	// outgoing broadcast messages from this node.
 	PriorityPeers map[string]bool `version[4]:""`
 
+	// PeerMessageRateLimits specifies, by message tag, the number of messages per second a single
+	// peer may send. Messages beyond the limit are dropped, and lower the reputation of the peer.
+	// Tags that aren't listed are not limited. For example, {"TX": 200, "AV": 1000}.
+	PeerMessageRateLimits map[string]int `version[23]:""`
+
+	// PeerMessageRateLimitBurstSeconds is the number of seconds worth of messages a peer may send
+	// in a burst before PeerMessageRateLimits applies.
+	PeerMessageRateLimitBurstSeconds int `version[23]:"2"`
+
+	// PeerReputationInvalidMessagePenalty is the reputation a peer loses for each invalid message,
+	// such as an invalid transaction or vote. Peers start with a reputation of 100, which recovers
+	// by one point per second; they are throttled to half of PeerMessageRateLimits below 50, and
+	// disconnected and kept away for PeerReputationPenaltyDurationSeconds at 0. When set to 0, the
+	// default, peers sending an invalid message are disconnected right away, without any penalty.
+	PeerReputationInvalidMessagePenalty int `version[23]:"0"`
+
+	// PeerReputationThrottledMessagePenalty is the reputation a peer loses for each message dropped
+	// because the peer exceeded PeerMessageRateLimits.
+	PeerReputationThrottledMessagePenalty int `version[23]:"1"`
+
+	// PeerReputationPenaltyDurationSeconds is the number of seconds we refuse to connect to, or to be
+	// connected from, a peer that was disconnected because its reputation dropped to zero.
+	// Setting it to 0 only disconnects such peers.
+	PeerReputationPenaltyDurationSeconds int `version[23]:"600"`
+
 	// To make sure the algod process does not run out of FDs, algod ensures
 	// that RLIMIT_NOFILE >= IncomingConnectionsLimit + RestConnectionsHardLimit +
 	// ReservedFDs. ReservedFDs are meant to leave room for short-lived FDs like
@@ -164,6 +189,14 @@ type Local struct {
 	// TxPoolSize is the number of transactions that fit in the transaction pool
 	TxPoolSize int `version[0]:"50000" version[5]:"15000"`
 
+	// TxPoolSenderQuota is the maximum number of transactions a single sender may have pending in the
+	// transaction pool. Groups that would take a sender past the quota are rejected. Zero disables the quota.
+	TxPoolSenderQuota int `version[23]:"0"`
+
+	// TxPoolDropLogSize is the number of recently rejected or evicted transaction groups the transaction pool
+	// remembers, along with the reason they were dropped, for the pool introspection API.
+	TxPoolDropLogSize int `version[23]:"1000"`
+
 	// number of seconds allowed for syncing transactions
 	TxSyncTimeoutSeconds int64 `version[0]:"30"`
 
@@ -312,6 +345,10 @@ type Local struct {
 	// This functionality is disabled by default.
 	EnableDeveloperAPI bool `version[9]:"false"`
 
+	// TealIncludeDir is the directory from which teal/compile resolves #include directives. Included files must
+	// lie within it. When empty, the default, #include is not available through the API.
+	TealIncludeDir string `version[23]:""`
+
 	// OptimizeAccountsDatabaseOnStartup controls whether the accounts database would be optimized
 	// on algod startup.
 	OptimizeAccountsDatabaseOnStartup bool `version[10]:"false"`
@@ -447,6 +484,46 @@ type Local struct {
 
 	// AgreementIncomingBundlesQueueLength sets the size of the buffer holding incoming bundles.
 	AgreementIncomingBundlesQueueLength uint64 `version[21]:"7"`
+
+	// EnableFollowMode launches the node in "follower" mode. This turns off the agreement service,
+	// and APIs related to broadcasting transactions, and enables APIs which can retrieve detailed information
+	// from ledger caches and can control the ledger round.
+	EnableFollowMode bool `version[23]:"false"`
+
+	// MaxAcctLookback sets the minimum number of rounds before the latest one whose state deltas are kept in memory by the ledger.
+	// The ledger always keeps at least the consensus balance lookback; larger values widen the window
+	// of rounds whose state can be served by a follower node.
+	MaxAcctLookback uint64 `version[23]:"0"`
+
+	// BlockStorageBackend selects how the ledger persists blocks. The supported options are:
+	// "sqlite" - blocks are stored in the ledger's block SQLite database.
+	// "file" - every block is stored in its own file, which avoids contending on the SQLite write lock.
+	// Switching backends does not migrate the existing blocks; the node would fetch them again from the network.
+	// The tracker databases, which hold the accounts, always use SQLite.
+	BlockStorageBackend string `version[23]:"sqlite"`
+
+	// EnableP2P runs the node on the p2p transport instead of the websocket network. The messages are published
+	// on one pubsub topic per message tag, and the peers are the ones of P2PSeedList instead of the DNS bootstrap.
+	EnableP2P bool `version[23]:"false"`
+
+	// EnableP2PHybridMode runs the p2p transport alongside the websocket network, and sends the messages on both.
+	EnableP2PHybridMode bool `version[23]:"false"`
+
+	// P2PNetAddress is the address the p2p transport listens on. When it's empty, the node only connects to its seeds.
+	P2PNetAddress string `version[23]:""`
+
+	// P2PSeedList is a semicolon-separated list of the host:port addresses of the p2p peers to connect to.
+	P2PSeedList string `version[23]:""`
+}
+
+// P2PSeedArray returns the addresses of P2PSeedList
+func (cfg Local) P2PSeedArray() (seeds []string) {
+	for _, seed := range strings.Split(cfg.P2PSeedList, ";") {
+		if len(seed) > 0 {
+			seeds = append(seeds, seed)
+		}
+	}
+	return
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
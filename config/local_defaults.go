@@ -1,4 +1,4 @@
-// Copyright (C) 2019-2022 Algorand, Inc.
+// Copyright (C) 2019-2026 Algorand, Inc.
 // This file is part of go-algorand
 //
 // go-algorand is free software: you can redistribute it and/or modify
@@ -20,7 +20,7 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    22,
+	Version:                                    23,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AgreementIncomingBundlesQueueLength:        7,
@@ -30,6 +30,7 @@ var defaultLocal = Local{
 	Archival:                                   false,
 	BaseLoggerDebugLevel:                       4,
 	BlockServiceCustomFallbackEndpoints:        "",
+	BlockStorageBackend:                        "sqlite",
 	BroadcastConnectionsLimit:                  -1,
 	CadaverSizeTarget:                          1073741824,
 	CatchpointFileHistoryLength:                365,
@@ -59,11 +60,14 @@ var defaultLocal = Local{
 	EnableBlockServiceFallbackToArchiver:       true,
 	EnableCatchupFromArchiveServers:            false,
 	EnableDeveloperAPI:                         false,
+	EnableFollowMode:                           false,
 	EnableGossipBlockService:                   true,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
 	EnableMetricReporting:                      false,
 	EnableOutgoingNetworkMessageFiltering:      true,
+	EnableP2P:                                  false,
+	EnableP2PHybridMode:                        false,
 	EnablePingHandler:                          true,
 	EnableProcessBlockStats:                    false,
 	EnableProfiler:                             false,
@@ -85,6 +89,7 @@ var defaultLocal = Local{
 	LogArchiveName:                             "node.archive.log",
 	LogSizeLimit:                               1073741824,
 	MaxAPIResourcesPerAccount:                  100000,
+	MaxAcctLookback:                            0,
 	MaxCatchpointDownloadDuration:              7200000000000,
 	MaxConnectionsPerIP:                        30,
 	MinCatchpointFileDownloadBytesPerSecond:    20480,
@@ -96,9 +101,16 @@ var defaultLocal = Local{
 	OptimizeAccountsDatabaseOnStartup:          false,
 	OutgoingMessageFilterBucketCount:           3,
 	OutgoingMessageFilterBucketSize:            128,
+	P2PNetAddress:                              "",
+	P2PSeedList:                                "",
 	ParticipationKeysRefreshInterval:           60000000000,
 	PeerConnectionsUpdateInterval:              3600,
+	PeerMessageRateLimitBurstSeconds:           2,
+	PeerMessageRateLimits:                      map[string]int{},
 	PeerPingPeriodSeconds:                      0,
+	PeerReputationInvalidMessagePenalty:        0,
+	PeerReputationPenaltyDurationSeconds:       600,
+	PeerReputationThrottledMessagePenalty:      1,
 	PriorityPeers:                              map[string]bool{},
 	ProposalAssemblyTime:                       250000000,
 	PublicAddress:                              "",
@@ -113,10 +125,13 @@ var defaultLocal = Local{
 	SuggestedFeeSlidingWindowSize:              50,
 	TLSCertFile:                                "",
 	TLSKeyFile:                                 "",
+	TealIncludeDir:                             "",
 	TelemetryToLog:                             true,
 	TransactionSyncDataExchangeRate:            0,
 	TransactionSyncSignificantMessageThreshold: 0,
+	TxPoolDropLogSize:                          1000,
 	TxPoolExponentialIncreaseFactor:            2,
+	TxPoolSenderQuota:                          0,
 	TxPoolSize:                                 15000,
 	TxSyncIntervalSeconds:                      60,
 	TxSyncServeResponseSize:                    1000000,
@@ -59,10 +59,18 @@ const (
 
 // rawRequestPaths is a set of paths where the body should not be urlencoded
 var rawRequestPaths = map[string]bool{
-	"/v1/transactions":  true,
-	"/v2/teal/dryrun":   true,
-	"/v2/teal/compile":  true,
-	"/v2/participation": true,
+	"/v1/transactions":          true,
+	"/v2/teal/dryrun":           true,
+	"/v2/teal/compile":          true,
+	"/v2/participation":         true,
+	"/v2/transactions/simulate": true,
+}
+
+// rawRequestWithParams is a raw request body to be sent along with query
+// parameters, for the paths of rawRequestPaths
+type rawRequestWithParams struct {
+	body   []byte
+	params interface{}
 }
 
 // unauthorizedRequestError is generated when we receive 401 error from the server. This error includes the inner error
@@ -165,6 +173,14 @@ func (client RestClient) submitForm(response interface{}, path string, request i
 
 	if request != nil {
 		if rawRequestPaths[path] {
+			if withParams, ok := request.(rawRequestWithParams); ok {
+				v, err := query.Values(withParams.params)
+				if err != nil {
+					return err
+				}
+				queryURL.RawQuery = v.Encode()
+				request = withParams.body
+			}
 			reqBytes, ok := request.([]byte)
 			if !ok {
 				return fmt.Errorf("couldn't decode raw request as bytes")
@@ -378,6 +394,11 @@ type accountInformationParams struct {
 	Exclude string `url:"exclude"`
 }
 
+type accountAtRoundParams struct {
+	Format string `url:"format"`
+	Round  uint64 `url:"round"`
+}
+
 // TransactionsByAddr returns all transactions for a PK [addr] in the [first,
 // last] rounds range.
 func (client RestClient) TransactionsByAddr(addr string, first, last, max uint64) (response v1.TransactionList, err error) {
@@ -450,6 +471,14 @@ func (client RestClient) RawAccountInformationV2(address string) (response []byt
 	return
 }
 
+// RawAccountInformationAtRound gets the raw AccountData associated with the passed address, as of the passed round
+func (client RestClient) RawAccountInformationAtRound(address string, round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/accounts/%s", address), accountAtRoundParams{Format: "msgpack", Round: round})
+	response = blob
+	return
+}
+
 // TransactionInformation gets information about a specific transaction involving a specific account
 func (client RestClient) TransactionInformation(accountAddress, transactionID string) (response v1.Transaction, err error) {
 	transactionID = stripTransaction(transactionID)
@@ -520,6 +549,19 @@ func (client RestClient) SuggestedParams() (response v1.TransactionParams, err e
 	return
 }
 
+type estimateFeeParams struct {
+	Size   uint64 `url:"size"`
+	Txns   uint64 `url:"txns"`
+	Rounds uint64 `url:"rounds"`
+}
+
+// EstimateFee asks algod for the fee a transaction group of size bytes, holding txns
+// transactions, should pay to be proposed within the given number of rounds.
+func (client RestClient) EstimateFee(size, txns, rounds uint64) (response generatedV2.FeeEstimateResponse, err error) {
+	err = client.get(&response, "/v2/transactions/fee", estimateFeeParams{Size: size, Txns: txns, Rounds: rounds})
+	return
+}
+
 // SendRawTransaction gets a SignedTxn and broadcasts it to the network
 func (client RestClient) SendRawTransaction(txn transactions.SignedTxn) (response v1.TransactionID, err error) {
 	err = client.post(&response, "/v1/transactions", protocol.Encode(&txn))
@@ -579,6 +621,70 @@ func (client RestClient) Catchup(catchpointLabel string) (response privateV2.Cat
 	return
 }
 
+// SetSyncRound sets the round a node in follow mode keeps available, pausing its catchup past it
+func (client RestClient) SetSyncRound(round uint64) (err error) {
+	var blob Blob
+	err = client.submitForm(&blob, fmt.Sprintf("/v2/ledger/sync/%d", round), nil, "POST", false, false)
+	return
+}
+
+// GetSyncRound gets the sync round of a node in follow mode
+func (client RestClient) GetSyncRound() (response privateV2.GetSyncRoundResponse, err error) {
+	err = client.get(&response, "/v2/ledger/sync", nil)
+	return
+}
+
+// UnsetSyncRound removes the sync round of a node in follow mode
+func (client RestClient) UnsetSyncRound() (err error) {
+	var blob Blob
+	err = client.submitForm(&blob, "/v2/ledger/sync", nil, "DELETE", false, false)
+	return
+}
+
+// RawLedgerStateDelta gets the msgpack encoded ledger state delta of the given round
+func (client RestClient) RawLedgerStateDelta(round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/deltas/%d", round), rawFormat{Format: "msgpack"})
+	response = blob
+	return
+}
+
+type ledgerSnapshotParams struct {
+	Format string `url:"format"`
+}
+
+// LedgerSnapshot writes a snapshot of the node's account state in the given format to w.
+// Unlike the other requests, the response is streamed rather than buffered, as it holds every account.
+func (client RestClient) LedgerSnapshot(format string, w io.Writer) error {
+	queryURL := client.serverURL
+	queryURL.Path = "/v2/ledger/snapshot"
+	v, err := query.Values(ledgerSnapshotParams{Format: format})
+	if err != nil {
+		return err
+	}
+	queryURL.RawQuery = v.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(authHeader, client.apiToken)
+
+	httpClient := &http.Client{}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = extractError(resp)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -654,6 +760,25 @@ func (client RestClient) RawDryrun(data []byte) (response []byte, err error) {
 	return
 }
 
+type simulateParams struct {
+	ExecTrace bool `url:"exec-trace,omitempty"`
+}
+
+// RawSimulateRawTransaction gets the raw SimulateResponse for the given transaction group,
+// including the execution traces of its application calls if execTrace is set
+func (client RestClient) RawSimulateRawTransaction(txgroup []transactions.SignedTxn, execTrace bool) (response []byte, err error) {
+	var enc []byte
+	for _, tx := range txgroup {
+		enc = append(enc, protocol.Encode(&tx)...)
+	}
+
+	var blob Blob
+	request := rawRequestWithParams{body: enc, params: simulateParams{ExecTrace: execTrace}}
+	err = client.submitForm(&blob, "/v2/transactions/simulate", request, "POST", false /* encodeJSON */, false /* decodeJSON */)
+	response = blob
+	return
+}
+
 // Proof gets a Merkle proof for a transaction in a block.
 func (client RestClient) Proof(txid string, round uint64, hashType crypto.HashType) (response generatedV2.ProofResponse, err error) {
 	txid = stripTransaction(txid)
@@ -667,6 +792,63 @@ func (client RestClient) PostParticipationKey(file []byte) (response generatedV2
 	return
 }
 
+// GetPeers gets the peers the node is connected to, along with the traffic exchanged with each of them
+func (client RestClient) GetPeers() (response privateV2.PeersResponse, err error) {
+	err = client.get(&response, "/v2/peers", nil)
+	return
+}
+
+// GetPersistentPeers gets the addresses the node keeps connected to
+func (client RestClient) GetPersistentPeers() (response privateV2.PersistentPeersResponse, err error) {
+	err = client.get(&response, "/v2/peers/persistent", nil)
+	return
+}
+
+// AddPersistentPeer adds an address to the phonebook of the node until it's removed, and connects to it
+func (client RestClient) AddPersistentPeer(address string) (err error) {
+	var blob Blob
+	err = client.submitForm(&blob, fmt.Sprintf("/v2/peers/persistent/%s", address), nil, "POST", false, false)
+	return
+}
+
+// RemovePersistentPeer removes an address added with AddPersistentPeer
+func (client RestClient) RemovePersistentPeer(address string) (err error) {
+	var blob Blob
+	err = client.submitForm(&blob, fmt.Sprintf("/v2/peers/persistent/%s", address), nil, "DELETE", false, false)
+	return
+}
+
+// DisconnectPeer disconnects the peers with the given address or remote address
+func (client RestClient) DisconnectPeer(address string) (err error) {
+	var blob Blob
+	err = client.submitForm(&blob, fmt.Sprintf("/v2/peers/disconnect/%s", address), nil, "POST", false, false)
+	return
+}
+
+// GetPeerBans gets the IP addresses and telemetry GUIDs banned from connecting to the node
+func (client RestClient) GetPeerBans() (response privateV2.PeerBansResponse, err error) {
+	err = client.get(&response, "/v2/peers/bans", nil)
+	return
+}
+
+type banPeerParams struct {
+	Duration uint64 `url:"duration"`
+}
+
+// BanPeer bans an IP address or a telemetry GUID for the given number of seconds
+func (client RestClient) BanPeer(target string, durationSeconds uint64) (err error) {
+	var blob Blob
+	err = client.submitForm(&blob, fmt.Sprintf("/v2/peers/bans/%s", target), banPeerParams{durationSeconds}, "POST", false, false)
+	return
+}
+
+// UnbanPeer lifts the ban of an IP address or a telemetry GUID
+func (client RestClient) UnbanPeer(target string) (err error) {
+	var blob Blob
+	err = client.submitForm(&blob, fmt.Sprintf("/v2/peers/bans/%s", target), nil, "DELETE", false, false)
+	return
+}
+
 // GetParticipationKeys gets all of the participation keys
 func (client RestClient) GetParticipationKeys() (response generatedV2.ParticipationKeysResponse, err error) {
 	err = client.get(&response, "/v2/participation", nil)
@@ -0,0 +1,142 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// encodedAccount is an account whose base data changed in a round. Its
+// resource maps are always empty; resource changes are listed separately.
+type encodedAccount struct {
+	Address basics.Address     `codec:"address"`
+	Data    basics.AccountData `codec:"data"`
+}
+
+// encodedAppResource is a change to an application's params or to an
+// account's local state for it.
+type encodedAppResource struct {
+	Address           basics.Address        `codec:"address"`
+	App               basics.AppIndex       `codec:"app"`
+	Params            *basics.AppParams     `codec:"params,omitempty"`
+	ParamsDeleted     bool                  `codec:"params-deleted,omitempty"`
+	LocalState        *basics.AppLocalState `codec:"local-state,omitempty"`
+	LocalStateDeleted bool                  `codec:"local-state-deleted,omitempty"`
+}
+
+// encodedAssetResource is a change to an asset's params or to an account's
+// holding of it.
+type encodedAssetResource struct {
+	Address        basics.Address       `codec:"address"`
+	Asset          basics.AssetIndex    `codec:"asset"`
+	Params         *basics.AssetParams  `codec:"params,omitempty"`
+	ParamsDeleted  bool                 `codec:"params-deleted,omitempty"`
+	Holding        *basics.AssetHolding `codec:"holding,omitempty"`
+	HoldingDeleted bool                 `codec:"holding-deleted,omitempty"`
+}
+
+// encodedCreatable is an application or asset created or deleted in a round
+type encodedCreatable struct {
+	Index   basics.CreatableIndex `codec:"index"`
+	Type    basics.CreatableType  `codec:"type"`
+	Created bool                  `codec:"created"`
+	Creator basics.Address        `codec:"creator"`
+}
+
+// encodedStateDelta is the encodable form of a ledgercore.StateDelta
+type encodedStateDelta struct {
+	Accounts   []encodedAccount         `codec:"accounts"`
+	Apps       []encodedAppResource     `codec:"apps,omitempty"`
+	Assets     []encodedAssetResource   `codec:"assets,omitempty"`
+	Creatables []encodedCreatable       `codec:"creatables,omitempty"`
+	Totals     ledgercore.AccountTotals `codec:"totals"`
+}
+
+func makeEncodedStateDelta(delta ledgercore.StateDelta) encodedStateDelta {
+	sd := encodedStateDelta{
+		Accounts: make([]encodedAccount, delta.Accts.Len()),
+		Totals:   delta.Totals,
+	}
+	for i := range sd.Accounts {
+		addr, data := delta.Accts.GetByIdx(i)
+		sd.Accounts[i].Address = addr
+		ledgercore.AssignAccountData(&sd.Accounts[i].Data, data)
+	}
+	for _, rec := range delta.Accts.GetAllAppResources() {
+		sd.Apps = append(sd.Apps, encodedAppResource{
+			Address:           rec.Addr,
+			App:               rec.Aidx,
+			Params:            rec.Params.Params,
+			ParamsDeleted:     rec.Params.Deleted,
+			LocalState:        rec.State.LocalState,
+			LocalStateDeleted: rec.State.Deleted,
+		})
+	}
+	for _, rec := range delta.Accts.GetAllAssetResources() {
+		sd.Assets = append(sd.Assets, encodedAssetResource{
+			Address:        rec.Addr,
+			Asset:          rec.Aidx,
+			Params:         rec.Params.Params,
+			ParamsDeleted:  rec.Params.Deleted,
+			Holding:        rec.Holding.Holding,
+			HoldingDeleted: rec.Holding.Deleted,
+		})
+	}
+	for cidx, mc := range delta.Creatables {
+		sd.Creatables = append(sd.Creatables, encodedCreatable{
+			Index:   cidx,
+			Type:    mc.Ctype,
+			Created: mc.Created,
+			Creator: mc.Creator,
+		})
+	}
+	sort.Slice(sd.Creatables, func(i, j int) bool { return sd.Creatables[i].Index < sd.Creatables[j].Index })
+	return sd
+}
+
+// GetLedgerStateDelta returns the changes to the ledger state made by the given round, as long as
+// the round is still in the ledger's in-memory deltas window.
+// (GET /v2/deltas/{round})
+func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64, params generated.GetLedgerStateDeltaParams) error {
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	if round == 0 || basics.Round(round) > ledger.Latest() {
+		return notFound(ctx, errors.New(errRoundNotInDeltaWindow), errRoundNotInDeltaWindow, v2.Log)
+	}
+	delta, err := ledger.LookupStateDelta(basics.Round(round))
+	if err != nil {
+		return notFound(ctx, err, errRoundNotInDeltaWindow, v2.Log)
+	}
+
+	data, err := encode(handle, makeEncodedStateDelta(delta))
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
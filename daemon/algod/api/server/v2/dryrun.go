@@ -17,6 +17,7 @@
 package v2
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
 	"strings"
@@ -116,6 +117,7 @@ type dryrunDebugReceiver struct {
 	lines         []string
 	history       []generated.DryrunState
 	scratchActive []bool
+	trace         logic.ExecTraceRecorder
 }
 
 func (ddr *dryrunDebugReceiver) updateScratch() {
@@ -185,7 +187,7 @@ func (ddr *dryrunDebugReceiver) stateToState(state *logic.DebugState) generated.
 func (ddr *dryrunDebugReceiver) Register(state *logic.DebugState) error {
 	ddr.disassembly = state.Disassembly
 	ddr.lines = strings.Split(state.Disassembly, "\n")
-	return nil
+	return ddr.trace.Register(state)
 }
 
 // Update is fired on every step (DebuggerHook interface)
@@ -193,12 +195,126 @@ func (ddr *dryrunDebugReceiver) Update(state *logic.DebugState) error {
 	st := ddr.stateToState(state)
 	ddr.history = append(ddr.history, st)
 	ddr.updateScratch()
-	return nil
+	return ddr.trace.Update(state)
 }
 
 // Complete is called when the program exits (DebuggerHook interface)
 func (ddr *dryrunDebugReceiver) Complete(state *logic.DebugState) error {
-	return ddr.Update(state)
+	st := ddr.stateToState(state)
+	ddr.history = append(ddr.history, st)
+	ddr.updateScratch()
+	return ddr.trace.Complete(state)
+}
+
+// execTrace converts the recorded execution trace to its API representation
+func (ddr *dryrunDebugReceiver) execTrace() *[]generated.DryrunExecTraceStep {
+	steps := convertExecTraceSteps(ddr.trace.Trace.Steps)
+	return &steps
+}
+
+// convertExecTrace converts an execution trace, and those of the inner
+// application calls it holds, to their API representation
+func convertExecTrace(trace *logic.ExecTrace) generated.ExecTrace {
+	result := generated.ExecTrace{
+		GroupIndex: uint64(trace.GroupIndex),
+		Steps:      convertExecTraceSteps(trace.Steps),
+	}
+	if trace.Error != "" {
+		result.Error = new(string)
+		*result.Error = trace.Error
+	}
+	if len(trace.InnerTraces) > 0 {
+		inners := make([]generated.ExecTrace, len(trace.InnerTraces))
+		for i := range trace.InnerTraces {
+			inners[i] = convertExecTrace(&trace.InnerTraces[i])
+		}
+		result.InnerTraces = &inners
+	}
+	return result
+}
+
+func convertExecTraceSteps(traceSteps []logic.ExecTraceStep) []generated.DryrunExecTraceStep {
+	steps := make([]generated.DryrunExecTraceStep, len(traceSteps))
+	for i, step := range traceSteps {
+		st := generated.DryrunExecTraceStep{
+			Line: uint64(step.Line),
+			Pc:   uint64(step.PC),
+		}
+		if step.StackPopCount > 0 {
+			popCount := uint64(step.StackPopCount)
+			st.StackPopCount = &popCount
+		}
+		if len(step.StackAdditions) > 0 {
+			additions := make([]generated.TealValue, len(step.StackAdditions))
+			for j, v := range step.StackAdditions {
+				additions[j] = generated.TealValue{
+					Uint:  v.Uint,
+					Bytes: v.Bytes,
+					Type:  uint64(v.Type),
+				}
+			}
+			st.StackAdditions = &additions
+		}
+		if len(step.ScratchChanges) > 0 {
+			changes := make([]generated.DryrunScratchChange, len(step.ScratchChanges))
+			for j, sc := range step.ScratchChanges {
+				changes[j] = generated.DryrunScratchChange{
+					Slot: sc.Slot,
+					NewValue: generated.TealValue{
+						Uint:  sc.NewValue.Uint,
+						Bytes: sc.NewValue.Bytes,
+						Type:  uint64(sc.NewValue.Type),
+					},
+				}
+			}
+			st.ScratchChanges = &changes
+		}
+		if len(step.StateChanges) > 0 {
+			changes := make([]generated.DryrunStateChange, len(step.StateChanges))
+			for j, sc := range step.StateChanges {
+				change := generated.DryrunStateChange{
+					AppStateType: sc.AppStateType,
+					Key:          sc.Key,
+					NewValue:     generated.EvalDelta{Action: uint64(sc.NewValue.Action)},
+				}
+				if sc.AppStateType == "l" {
+					account := sc.Account.String()
+					change.Account = &account
+				}
+				// the recorder already base64 encoded the value bytes
+				if sc.NewValue.Action == basics.SetBytesAction {
+					bytesVal := sc.NewValue.Bytes
+					change.NewValue.Bytes = &bytesVal
+				} else if sc.NewValue.Action == basics.SetUintAction {
+					uintVal := sc.NewValue.Uint
+					change.NewValue.Uint = &uintVal
+				}
+				changes[j] = change
+			}
+			st.StateChanges = &changes
+		}
+		if len(step.SpawnedInners) > 0 {
+			inners := make([]uint64, len(step.SpawnedInners))
+			for j, idx := range step.SpawnedInners {
+				inners[j] = uint64(idx)
+			}
+			st.SpawnedInners = &inners
+		}
+		if len(step.Logs) > 0 {
+			logs := make([][]byte, len(step.Logs))
+			for j, l := range step.Logs {
+				// the recorder produced these, so they always decode
+				logs[j], _ = base64.StdEncoding.DecodeString(l)
+			}
+			st.Logs = &logs
+		}
+		if len(step.Error) > 0 {
+			st.Error = new(string)
+			*st.Error = step.Error
+		}
+		steps[i] = st
+	}
+	return steps
 }
 
 type dryrunLedger struct {
@@ -390,6 +506,8 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 	txgroup := transactions.WrapSignedTxnsWithAD(dr.Txns)
 	specials := transactions.SpecialAddresses{}
 	ep := logic.NewEvalParams(txgroup, &proto, &specials)
+	profiler := logic.NewProfiler()
+	ep.Profiler = profiler
 
 	origEnableAppCostPooling := proto.EnableAppCostPooling
 	// Enable EnableAppCostPooling so that dryrun
@@ -419,6 +537,7 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 			result.Disassembly = debug.lines          // Keep backwards compat
 			result.LogicSigDisassembly = &debug.lines // Also add to Lsig specific
 			result.LogicSigTrace = &debug.history
+			result.LogicSigExecTrace = debug.execTrace()
 			if pass {
 				messages = append(messages, "PASS")
 			} else {
@@ -512,6 +631,7 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 				}
 				result.Disassembly = debug.lines
 				result.AppCallTrace = &debug.history
+				result.AppCallExecTrace = debug.execTrace()
 				result.GlobalDelta = StateDeltaToStateDelta(delta.GlobalDelta)
 				if len(delta.LocalDeltas) > 0 {
 					localDeltas := make([]generated.AccountStateDelta, 0, len(delta.LocalDeltas))
@@ -574,6 +694,15 @@ func doDryrunRequest(dr *DryrunRequest, response *generated.DryrunResponse) {
 		}
 		response.Txns[ti] = result
 	}
+
+	var profile bytes.Buffer
+	err = profiler.WriteProfile(&profile)
+	if err != nil {
+		response.Error = err.Error()
+		return
+	}
+	profileBytes := profile.Bytes()
+	response.Profile = &profileBytes
 }
 
 // StateDeltaToStateDelta converts basics.StateDelta to generated.StateDelta
@@ -18,9 +18,11 @@ package v2
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"strconv"
 	"strings"
 	"testing"
@@ -593,6 +595,20 @@ func TestDryrunLocal1(t *testing.T) {
 		t.Error("no local delta for value foo")
 	}
 
+	// the exec trace attributes the same write to a single opcode
+	require.NotNil(t, response.Txns[0].AppCallExecTrace)
+	var stateChanges []generated.DryrunStateChange
+	for _, step := range *response.Txns[0].AppCallExecTrace {
+		if step.StateChanges != nil {
+			stateChanges = append(stateChanges, *step.StateChanges...)
+		}
+	}
+	require.Len(t, stateChanges, 1)
+	require.Equal(t, "l", stateChanges[0].AppStateType)
+	require.Equal(t, lds.Address, *stateChanges[0].Account)
+	require.Equal(t, b64("foo"), stateChanges[0].Key)
+	require.Equal(t, b64("bar"), *stateChanges[0].NewValue.Bytes)
+
 	if t.Failed() {
 		logResponse(t, &response)
 	}
@@ -1594,6 +1610,25 @@ int 1
 	if t.Failed() {
 		logResponse(t, &response)
 	}
+
+	trace := *response.Txns[0].AppCallExecTrace
+	a.Len(trace, 10)
+	a.Equal(response.Txns[0].Disassembly[trace[8].Line], "itxn_submit")
+	a.Equal([]uint64{0}, *trace[8].SpawnedInners)
+	for i, step := range trace {
+		if i != 8 {
+			a.Nil(step.SpawnedInners)
+		}
+	}
+
+	// The profile covers the whole group
+	a.NotNil(response.Profile)
+	gz, err := gzip.NewReader(bytes.NewReader(*response.Profile))
+	a.NoError(err)
+	profile, err := ioutil.ReadAll(gz)
+	a.NoError(err)
+	a.Contains(string(profile), "itxn_submit")
+	a.Contains(string(profile), "app 7")
 }
 
 func TestDryrunScratchSpace(t *testing.T) {
@@ -1805,3 +1840,34 @@ int %d`, expectedUint, i))
 	}
 
 }
+
+func TestConvertExecTrace(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	trace := logic.ExecTrace{
+		GroupIndex: 1,
+		Steps: []logic.ExecTraceStep{
+			{PC: 1, Line: 1, SpawnedInners: []int{0}},
+			{PC: 2, Line: 2, StackPopCount: 1},
+		},
+		InnerTraces: []logic.ExecTrace{{
+			Steps: []logic.ExecTraceStep{{PC: 1, Line: 1, Error: "err opcode executed"}},
+			Error: "err opcode executed",
+		}},
+	}
+	converted := convertExecTrace(&trace)
+	a.Equal(uint64(1), converted.GroupIndex)
+	a.Nil(converted.Error)
+	a.Len(converted.Steps, 2)
+	a.Equal([]uint64{0}, *converted.Steps[0].SpawnedInners)
+	a.Equal(uint64(1), *converted.Steps[1].StackPopCount)
+
+	a.NotNil(converted.InnerTraces)
+	a.Len(*converted.InnerTraces, 1)
+	inner := (*converted.InnerTraces)[0]
+	a.Zero(inner.GroupIndex)
+	a.Equal("err opcode executed", *inner.Error)
+	a.Equal("err opcode executed", *inner.Steps[0].Error)
+	a.Nil(inner.InnerTraces)
+}
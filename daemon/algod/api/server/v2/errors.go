@@ -28,12 +28,20 @@ var (
 	errFailedParsingFormatOption               = "failed to parse the format option"
 	errFailedToParseAddress                    = "failed to parse the address"
 	errFailedToParseExclude                    = "failed to parse exclude"
+	errFailedToParseSnapshotFormat             = "unknown snapshot format"
 	errFailedToParseTransaction                = "failed to parse transaction"
 	errFailedToParseBlock                      = "failed to parse block"
 	errFailedToParseCert                       = "failed to parse cert"
+	errFailedToParseLastEventID                = "failed to parse the Last-Event-ID header"
+	errRoundNotStreamable                      = "round %d is no longer available, the oldest round that can be streamed is %d"
 	errFailedToParseSourcemap                  = "failed to parse sourcemap"
 	errFailedToEncodeResponse                  = "failed to encode response"
+	errFailedToSimulate                        = "failed to simulate transaction group"
+	errFailedToWriteSnapshot                   = "failed to write the accounts snapshot"
 	errInternalFailure                         = "internal failure"
+	errInvalidFeeEstimateSize                  = "the size of the transaction group must be positive"
+	errInvalidFeeEstimateTxns                  = "the number of transactions must be between 1 and %d"
+	errInvalidFeeEstimateRounds                = "the number of rounds must be between 1 and %d"
 	errNoTxnSpecified                          = "no transaction ID was specified"
 	errInvalidHashType                         = "invalid hash type"
 	errTransactionNotFound                     = "could not find the transaction in the transaction pool or in the last 1000 confirmed rounds"
@@ -42,6 +50,18 @@ var (
 	errFailedToParseCatchpoint                 = "failed to parse catchpoint"
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
+	errFailedSettingSyncRound                  = "failed to set sync round on the ledger : %v"
+	errSyncRoundNotSet                         = "sync round is not set"
+	errRoundNotInDeltaWindow                   = "requested round is not in the ledger's state delta window"
+	errRoundAheadOfLedger                      = "requested round %d is ahead of the latest round %d"
+	errRoundOutsideLookbackWindow              = "requested round is older than the ledger's in-memory lookback window"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
+	errFailedToParsePeerAddress                = "failed to parse the peer address, it must be host:port"
+	errInvalidBanDuration                      = "the ban duration must be between 1 and %d seconds"
+	errNotPersistentPeer                       = "the address is not a persistent peer"
+	errNoPeerWithAddress                       = "no connected peer has this address"
+	errNotBanned                               = "the target is not banned"
+	errFailedToPersistPeerBans                 = "failed to persist the peer bans : %v"
+	errFailedToPersistPersistentPeers          = "failed to persist the persistent peers : %v"
 )
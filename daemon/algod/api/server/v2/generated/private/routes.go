@@ -23,6 +23,18 @@ type ServerInterface interface {
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
 	StartCatchup(ctx echo.Context, catchpoint string) error
+	// Export a snapshot of the account state.
+	// (GET /v2/ledger/snapshot)
+	GetLedgerSnapshot(ctx echo.Context, params GetLedgerSnapshotParams) error
+	// Removes minimum sync round restriction from the ledger.
+	// (DELETE /v2/ledger/sync)
+	UnsetSyncRound(ctx echo.Context) error
+	// Returns the minimum sync round the ledger is keeping in cache.
+	// (GET /v2/ledger/sync)
+	GetSyncRound(ctx echo.Context) error
+	// Given a round, tells the ledger to keep that round in its cache.
+	// (POST /v2/ledger/sync/{round})
+	SetSyncRound(ctx echo.Context, round uint64) error
 	// Return a list of participation keys
 	// (GET /v2/participation)
 	GetParticipationKeys(ctx echo.Context) error
@@ -38,9 +50,36 @@ type ServerInterface interface {
 	// Append state proof keys to a participation key
 	// (POST /v2/participation/{participation-id})
 	AppendKeys(ctx echo.Context, participationId string) error
+	// Get the connected peers and their traffic statistics.
+	// (GET /v2/peers)
+	GetPeers(ctx echo.Context) error
+	// Get the peer bans.
+	// (GET /v2/peers/bans)
+	GetPeerBans(ctx echo.Context) error
+	// Lift a peer ban.
+	// (DELETE /v2/peers/bans/{target})
+	UnbanPeer(ctx echo.Context, target string) error
+	// Ban an IP address or a telemetry GUID.
+	// (POST /v2/peers/bans/{target})
+	BanPeer(ctx echo.Context, target string, params BanPeerParams) error
+	// Disconnect a peer.
+	// (POST /v2/peers/disconnect/{address})
+	DisconnectPeer(ctx echo.Context, address string) error
+	// Get the persistent peers.
+	// (GET /v2/peers/persistent)
+	GetPersistentPeers(ctx echo.Context) error
+	// Remove a persistent peer.
+	// (DELETE /v2/peers/persistent/{address})
+	RemovePersistentPeer(ctx echo.Context, address string) error
+	// Add a persistent peer.
+	// (POST /v2/peers/persistent/{address})
+	AddPersistentPeer(ctx echo.Context, address string) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
+	// Get the composition of the transaction pool.
+	// (GET /v2/transactions/pool)
+	GetTransactionPoolComposition(ctx echo.Context, params GetTransactionPoolCompositionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -108,6 +147,118 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 	return err
 }
 
+// GetLedgerSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLedgerSnapshot(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLedgerSnapshotParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLedgerSnapshot(ctx, params)
+	return err
+}
+
+// UnsetSyncRound converts echo context to params.
+func (w *ServerInterfaceWrapper) UnsetSyncRound(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnsetSyncRound(ctx)
+	return err
+}
+
+// GetSyncRound converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSyncRound(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSyncRound(ctx)
+	return err
+}
+
+// SetSyncRound converts echo context to params.
+func (w *ServerInterfaceWrapper) SetSyncRound(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetSyncRound(ctx, round)
+	return err
+}
+
 // GetParticipationKeys converts echo context to params.
 func (w *ServerInterfaceWrapper) GetParticipationKeys(ctx echo.Context) error {
 
@@ -244,6 +395,240 @@ func (w *ServerInterfaceWrapper) AppendKeys(ctx echo.Context) error {
 	return err
 }
 
+// GetPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeers(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeers(ctx)
+	return err
+}
+
+// GetPeerBans converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeerBans(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeerBans(ctx)
+	return err
+}
+
+// UnbanPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) UnbanPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "target" -------------
+	var target string
+
+	err = runtime.BindStyledParameter("simple", false, "target", ctx.Param("target"), &target)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter target: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnbanPeer(ctx, target)
+	return err
+}
+
+// BanPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) BanPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":   true,
+		"duration": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "target" -------------
+	var target string
+
+	err = runtime.BindStyledParameter("simple", false, "target", ctx.Param("target"), &target)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter target: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BanPeerParams
+	// ------------- Required query parameter "duration" -------------
+	if paramValue := ctx.QueryParam("duration"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument duration is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.BanPeer(ctx, target, params)
+	return err
+}
+
+// DisconnectPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) DisconnectPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DisconnectPeer(ctx, address)
+	return err
+}
+
+// GetPersistentPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPersistentPeers(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPersistentPeers(ctx)
+	return err
+}
+
+// RemovePersistentPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) RemovePersistentPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RemovePersistentPeer(ctx, address)
+	return err
+}
+
+// AddPersistentPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPersistentPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameter("simple", false, "address", ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddPersistentPeer(ctx, address)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 
@@ -280,6 +665,42 @@ func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 	return err
 }
 
+// GetTransactionPoolComposition converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionPoolComposition(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"max":    true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionPoolCompositionParams
+	// ------------- Optional query parameter "max" -------------
+	if paramValue := ctx.QueryParam("max"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTransactionPoolComposition(ctx, params)
+	return err
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router interface {
 	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -299,167 +720,268 @@ func RegisterHandlers(router interface {
 
 	router.DELETE("/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST("/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.GET("/v2/ledger/snapshot", wrapper.GetLedgerSnapshot, m...)
+	router.DELETE("/v2/ledger/sync", wrapper.UnsetSyncRound, m...)
+	router.GET("/v2/ledger/sync", wrapper.GetSyncRound, m...)
+	router.POST("/v2/ledger/sync/:round", wrapper.SetSyncRound, m...)
 	router.GET("/v2/participation", wrapper.GetParticipationKeys, m...)
 	router.POST("/v2/participation", wrapper.AddParticipationKey, m...)
 	router.DELETE("/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
 	router.GET("/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST("/v2/participation/:participation-id", wrapper.AppendKeys, m...)
+	router.GET("/v2/peers", wrapper.GetPeers, m...)
+	router.GET("/v2/peers/bans", wrapper.GetPeerBans, m...)
+	router.DELETE("/v2/peers/bans/:target", wrapper.UnbanPeer, m...)
+	router.POST("/v2/peers/bans/:target", wrapper.BanPeer, m...)
+	router.POST("/v2/peers/disconnect/:address", wrapper.DisconnectPeer, m...)
+	router.GET("/v2/peers/persistent", wrapper.GetPersistentPeers, m...)
+	router.DELETE("/v2/peers/persistent/:address", wrapper.RemovePersistentPeer, m...)
+	router.POST("/v2/peers/persistent/:address", wrapper.AddPersistentPeer, m...)
 	router.POST("/v2/shutdown", wrapper.ShutdownNode, m...)
+	router.GET("/v2/transactions/pool", wrapper.GetTransactionPoolComposition, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPcNrLgv4KafVX+uOGM/JVdqyr1TrGcrC6O47KUfXfP9iUYsmcGKxJgAFCaiU//",
-	"+xUaAAmS4Az1scpzPf9kawg0Go1Go7vR3fg8SUVRCg5cq8nh50lJJS1Ag8S/aJqKiuuEZeavDFQqWamZ",
-	"4JND/40oLRlfTaYTZn4tqV5PphNOC2jamP7TiYTfKyYhmxxqWcF0otI1FNQA1tvStK4hbZKVSByIIwvi",
-	"5HhyteMDzTIJSvWx/JnnW8J4mlcZEC0pVzQ1nxS5ZHpN9Jop4joTxongQMSS6HWrMVkyyDM185P8vQK5",
-	"DWbpBh+e0lWDYiJFDn08X4liwTh4rKBGql4QogXJYImN1lQTM4LB1TfUgiigMl2TpZB7ULVIhPgCr4rJ",
-	"4YeJAp6BxNVKgV3gf5cS4A9INJUr0JNP09jklhpkolkRmdqJo74EVeVaEWyLc1yxC+DE9JqRnyqlyQII",
-	"5eT996/Is2fPXpqJFFRryByTDc6qGT2ck+0+OZxkVIP/3Oc1mq+EpDxL6vbvv3+F45+6CY5tRZWC+GY5",
-	"Ml/IyfHQBHzHCAsxrmGF69DiftMjsimanxewFBJGroltfKeLEo7/p65KSnW6LgXjOrIuBL8S+zkqw4Lu",
-	"u2RYjUCrfWkoJQ3QDwfJy0+fn0yfHFz95cNR8p/uzxfPrkZO/1UNdw8Fog3TSkrg6TZZSaC4W9aU9+nx",
-	"3vGDWosqz8iaXuDi0wJFvetLTF8rOi9oXhk+YakUR/lKKEIdG2WwpFWuiR+YVDw3YspAc9xOmCKlFBcs",
-	"g2xqpO/lmqVrklJlQWA7csny3PBgpSAb4rX47HZspquQJAavG9EDJ/RflxjNvPZQAjYoDZI0FwoSLfYc",
-	"T/7EoTwj4YHSnFXqeocVOVsDwcHNB3vYIu244ek83xKN65oRqggl/miaErYkW1GRS1ycnJ1jfzcbQ7WC",
-	"GKLh4rTOUbN5h8jXI0aEeAshcqAcief3XZ9kfMlWlQRFLteg1+7Mk6BKwRUQsfgnpNos+/86/fktEZL8",
-	"BErRFbyj6TkBnopseI3doLET/J9KmAUv1Kqk6Xn8uM5ZwSIo/0Q3rKgKwqtiAdKslz8ftCASdCX5EEIW",
-	"4h4+K+imP+iZrHiKi9sM21LUDCsxVeZ0OyMnS1LQzbcHU4eOIjTPSQk8Y3xF9IYPKmlm7P3oJVJUPBuh",
-	"w2izYMGpqUpI2ZJBRmooOzBxw+zDh/Hr4dNoVgE6HsggOvUoe9DhsInwjNm65gsp6QoClpmRX5zkwq9a",
-	"nAOvBRxZbPFTKeGCiUrVnQZwxKF3q9dcaEhKCUsW4bFTRw4jPWwbJ14Lp+CkgmvKOGRG8iLSQoOVRIM4",
-	"BQPuNmb6R/SCKvjm+dAB3nwdufpL0V31nSs+arWxUWK3ZORcNF/dho2rTa3+I4y/cGzFVon9ubeQbHVm",
-	"jpIly/GY+adZP0+GSqEQaBHCHzyKrTjVlYTDj/yx+Ysk5FRTnlGZmV8K+9NPVa7ZKVuZn3L70xuxYukp",
-	"Ww0Qs8Y1ak1ht8L+Y+DFxbHeRI2GN0KcV2U4obRllS625OR4aJEtzOsy5lFtyoZWxdnGWxrX7aE39UIO",
-	"IDlIu5KahuewlWCwpekS/9kskZ/oUv5h/inLPEZTw8DuoEWngHMWHJVlzlJqqPfefTZfze4Hax7QpsUc",
-	"T9LDzwFupRQlSM0sUFqWSS5SmidKU42Q/k3CcnI4+cu88arMbXc1DwZ/Y3qdYiejiFrlJqFleQ0Y74xC",
-	"o3ZICSOZ8RPKByvvUBVi3K6e4SFmZG8OF5TrWWOItARBvXM/uJEaelsdxtK7Y1gNEpzYhgtQVq+1DR8o",
-	"EpCeIFkJkhXVzFUuFvUPD4/KsqEgfj8qS0sP1AmBoboFG6a0eoTTp80WCsc5OZ6RH0LYqGALnm/NqWB1",
-	"DHMoLN1x5Y6v2mPk5tBAfKAILqeQM7M0ngxGeb8LjkNjYS1yo+7s5RXT+O+ubchm5vdRnb8MFgtpO8xc",
-	"aD45ylnLBX8JTJaHHc7pM45z4szIUbfvzdjGQIkzzI14Zed6Wrg76FiT8FLS0iLovthDlHE0vWwji+st",
-	"pelIQRfFOdjDAa8hVjfea3v3QxQTZIUODt/lIj2/g/2+MHD62w7BkzXQDCTJqKbBvnL7JX5YY8e/Yz+U",
-	"CCAjGv3P+B+aE/PZML6RixassdQZ8q8I/OqZMXCt2mxHMg3Q8BaksDYtMbbotbB81QzekxGWLGNkxGtr",
-	"RhPs4Sdhpt44yY4WQt6MXzqMwEnj+iPUQA22y7Szsti0KhNHn4j7wDboAGpuW/paZEihLvgYrVpUONX0",
-	"X0AFZaDeBRXagO6aCqIoWQ53sF/XVK37kzD23LOn5PTvRy+ePP316YtvjEFSSrGStCCLrQZFHjo1mii9",
-	"zeFRf2aoz1a5jkP/5rl3GLXhxuAoUckUClr2QVlHlD20bDNi2vWp1iYzzrpGcMy2PAMjXizZifWxGtSO",
-	"mTJnYrG4k8UYIljWjJIRh0kGe5nputNrhtmGU5RbWd2F8QFSChlxheAW0yIVeXIBUjER8Wq/cy2Ia+EV",
-	"krL7u8WWXFJFzNjopat4BnIW4yy94Yga01CofQeqBX224Q1tHEAqJd32yG/nG5mdG3fMurSJ750+ipQg",
-	"E73hJINFtWrprkspCkJJhh3x4HgrMjB2R6XuQFo2wBpkzEKEKNCFqDShhIsM0EipVFyODlxxoW8drwR0",
-	"KJr12p7TCzAKcUqr1VqTqiTo8O4tbdMxoaldlATPVDXgEaxdubaVHc5en+QSaGYUZeBELJzbzTkEcZIU",
-	"vfXaSyInxSOmQwuvUooUlDIGjlVb96Lm29lV1jvohIgjwvUoRAmypPKGyGqhab4HUWwTQ7dWu5yvso/1",
-	"uOF3LWB38HAZqTQ2juUCo+OZ3Z2DhiESjqTJBUj02f1L188PctPlq8qBG3WnqZyxAk0lTrlQkAqeqSiw",
-	"nCqd7Nu2plFLnTIzCHZKbKci4AFz/Q1V2npuGc9QtbbiBsexdrwZYhjhwRPFQP6HP0z6sFMjJ7mqVH2y",
-	"qKoshdSQxebAYbNjrLewqccSywB2fXxpQSoF+yAPUSmA74hlZ2IJRHXt53BXG/3JoTfAnAPbKClbSDSE",
-	"2IXIqW8VUDe8VRxAxNhhdU9kHKY6nFNfZU4nSouyNPtPJxWv+w2R6dS2PtK/NG37zEV1I9czAWZ07XFy",
-	"mF9aytr75DU1OjBCJgU9N2cTarTWxdzH2WzGRDGeQrKL8822PDWtwi2wZ5MOGBMuYiUYrbM5OvwbZbpB",
-	"JtizCkMTHrBs3lGpWcpK1CR+hO2du0W6A0Q9JCQDTZnRtoMPKMBR9tb9ib0z6MK8maI1Sgnto9/TQiPT",
-	"yZnCA6ON/Dls0VX6zl5GnwVX2HegKUagmt1NOUFE/RWXOZDDJrChqc635pjTa9iSS5BAVLUomNY2uqCt",
-	"SGpRJiGAqIG/Y0TnYrEXuX4Fxvh8ThFUML3+UkwnVm3Zjd9ZR3FpkcMpTKUQ+QhXdI8YUQxGuapJKcyq",
-	"MxfM4iMePCe1kHRKDPrXauH5QLXIjDMg/0dUJKUcFbBKQ30iCIliFo9fM4I5wOoxnVO6oRDkUIDVK/HL",
-	"48fdiT9+7NacKbKESx8BZhp2yfH4MVpJ74TSrc11Bxav2W4nEdmOng9zUDgdritTZntNewd5zEq+6wCv",
-	"3SVmTynlGNdM/9YCoLMzN2PmHvLImqr1/rkj3FFOjQB0bN523aUQyztypMUjANA4cZf6phVZVtwiVSln",
-	"juA9l3doiOW0jvKw0d2HBEMA1tR749yfT198M5k2V/f1d3Mm26+fIholyzaxAI0MNrE1cVsMrakHxvTY",
-	"KojeiqFgFstIjBbI89zNrCM6SAFmT6s1Kw3IJp5kq6EVi/p/H/774Yej5D9p8sdB8vJ/zD99fn716HHv",
-	"x6dX3377/9o/Pbv69tG//1vUrajZIu7+/LtZJbEkTsRv+Am3FxhLIa09tnVqnljeP95aAmRQ6nUs+LOU",
-	"oFA02iDOUq+bRQXo+FBKKS6ATwmbwawrYrMVKO9MyoEuMQgRbQox5lK03g6W3zxzBFQPJzJKjsX4B6/4",
-	"kDdxMxujI9/egfJiARHZpqc31pX9KpZh5KzbKGqrNBR9f5ft+uuAtv/e68q9TSV4zjgkheCwjSaLMA4/",
-	"4cdYb3vcDXRGxWOob9eWaOHfQas9zpjFvC19cbUD+f6uvti+g8Xvwu24OsOYYXTVQF4SStKcoSNHcKVl",
-	"leqPnKKpGLBr5DrJG8DDzoNXvkncWxFxJjhQHzlVhoa1ARl1gS8hcmR9D+B9CKparUDpjtK8BPjIXSvG",
-	"ScWZxrEKs16JXbASJN7pzGzLgm7Jkubo6/gDpCCLSrfVSDz0lGZ57vyuZhgilh851UYGKU1+Yvxsg+B8",
-	"BKHnGQ76UsjzmgrxI2oFHBRTSVzu/2C/ovh301+7owDzTOxnL2/uW+573GOBdw7zk2NnYp0cox7deFx7",
-	"uN+bG65gPIkymdGLCsYxfrvDW+ShsQY8Az1qfLdu1T9yveGGkS5ozjKjO92EHboirrcX7e7ocE1rITpe",
-	"FT/XT7GwgZVISpqe463xZMX0ulrMUlHMvWk5X4nazJxnFArB8Vs2pyWbqxLS+cWTPXruLeQViYirq+nE",
-	"SR11544YBzg2oe6YtT/T/60FefDD6zMydyulHtgoXAs6CJ+MeANchFDrwspM3maR2TDkj/wjP4Yl48x8",
-	"P/zIM6rpfEEVS9W8UiC/oznlKcxWghz6oKNjqulH3hPxg4meQbgXKatFzlJyHh7Fzda0yTt9CB8/fjAM",
-	"8vHjp97tR//gdENF96gdILlkei0qnbjshETCJZVZBHVVR6cjZJtbtGvUKXGwLUe67AcHPy6qaVmqbrBq",
-	"f/plmZvpB2yoXCimWTKitJBeCBrJaLHB9X0rnMkl6aVPbakUKPJbQcsPjOtPJPlYHRw8A9KK3vzNyRrD",
-	"k9sSWn6jGwXTdn1GOHGrUMFGS5qUdAUqOn0NtMTVx4O6QA9lnhPs1ooa9TEWCKqZgKfH8AJYPK4dAYeT",
-	"O7W9fJppfAr4CZcQ2xjp1Dj+b7peQRzpjZerE4vaW6VKrxOzt6OzUobF/crU2WcrI5P9bYxiK242gUvU",
-	"WwBJ15CeQ4Y5Q1CUejttdfcXfu6E86KDKZtbZwPdMAEEXWwLIFWZUacDUL7tRuIr0NqnH7yHc9ieiSZ/",
-	"5Dqh9+2AcDW0UZFTg8PIMGu4bR2M7uK7y2MMgi1LH1eNMYSeLQ5rvvB9hjeyPSHvYBPHmKIVsDxECCoj",
-	"hLDMP0CCG0zUwLsV68emZ9SbhT35Im4eL/uJa9Jobe4COJwNxmHb7wVgoq64VGRBFWREuBxTG/QcSLFK",
-	"0RUM+J5CL+fI0OKWZxSB7Dv3oiedWHYPtN55E0XZNk7MnKOcAuaLYRV0E3au/f1I1pGOM5gRLB3hCLbI",
-	"UU2qIw6s0KGy5W22ufBDqMUZGCRvFA6PRpsioWazpsqnv2KWsN/Lo3SAf2EQ/66crZPgxjpIBa4zsrzM",
-	"7e7Tnt/WZW75dC2foxU6bUfkW00nLogqthyCowKUQQ4rO3Hb2DNKk1DQLJDB4+flMmccSBK7/KZKiZTZ",
-	"/OXmmHFjgNGPHxNifU9kNIQYGwdo4wURAiZvRbg3+eo6SHKXEEE9bLxaCv6GeCSgDW8yKo8ojQhnfCAw",
-	"zUsA6iIm6vOrE7eDYAjjU2LE3AXNjZhzTtQGSC+DCNXWTr6Qu6J8NKTO7nD92YPlWnOyR9FNZhPqTB7p",
-	"uEK3A+PdqkRsCRTSy5m+Na2GztIxQw8c30O0ehjkHt0IgY4noinP4yy/vRZa+2zun2SNSJ82ybQ+MjPG",
-	"+0P8E12lAfr1HcF1ttC77nEdNdLbV5ftRKlAf4qJYrNH+q7RvgNWQQ6oESctDSI5jznMjWIPKG5PfbfA",
-	"csd0LMq3j4L7cAkrpjQ0ritzKnlf7H1fd1FM/xZiOTw7Xcqlmd97IWoZbdMM7fVdOM17n8GF0JAsmVQ6",
-	"Qb9fdAqm0fcKLcrvTdO4otC+cbeVUFgWlw047Dlsk4zlVZxf3bg/Hpth39ZOGFUtzmGL6iDQdE0WWLkn",
-	"GoezY2gbqrVzwm/shN/QO5vvuN1gmpqBpWGX9hhfyL7oSN5d4iDCgDHm6K/aIEl3CEg8+I8h17GMpUBp",
-	"sJszMw1nu1yPvc2Uedi7DKUAi+EzykKKziWwlnfOgmH0gTH3mA4K3/TTBgb2AC1Llm06jkALddBcpNey",
-	"9n1icYcKuLoO2B4KBE6/WGSqBNXOIW+0W1vCiIdzm42izFk70zsUCOFQTPkCfH1CGdbGKlH7aHUGNP8R",
-	"tv8wbXE6k6vp5HZ+wxitHcQ9tH5XL2+UznghZv1IrWuAa5KclqUUFzRPnHd1iDWluHCsic29M/aeRV3c",
-	"h3f2+ujNO4f+1XSS5kBlUqsKg7PCduUXMyubrj6wQXyBL2PweJ3dqpLB4tdpxKFH9nINrphSoI32ij80",
-	"3vZgKzoP7TJ+L7/X3+ouBuwUd1wQQFnfDzS+K3s90L4SoBeU5d5p5LEduEPHyY2rIBKVCiGAW18tBDdE",
-	"yZ2Km97uju+Ohrv2yKRwrB3lngpb0UwRwbshWUaFRF8UsmpBsXSDdQn0hROvisRsv0TlLI07GPlCGebg",
-	"9uLINCbYeEAZNRArNnAPySsWwDLN1AhDt4NkMEaUmL4MyBDtFsKVoq04+70CwjLg2nySuCs7GxVrZThX",
-	"c/84NbpDfywH2LqnG/C30THCsiXdEw+R2K1ghNdUPXSPa5PZT7R2x5gfAn/8NW67wxF7R+KOm2rHH46b",
-	"bcjQun3dFFaO7cs/wxi2ytj+srXeeHX1UwbGiJahZSpZSvEHxO08NI8jYeu+UAvDqMk/gM8i2T9dEVN7",
-	"d5pqus3og8s9pN2EXqj2Df0A1+PKB3dSWBTDu2cpt0ttq0K24kLiDBPGcs0t/IZhHM69+LecXi5orGKI",
-	"UTIMTkfN7WfLkawF8Z097Z3Pm7naOTMSXKTWbZlN6CpBNhkl/eThGyoMdtjRqkKjGSDXhjrB1F5+5UpE",
-	"wFT8knJbXNT0s1vJ9VZgnV+m16WQmI6p4j7vDFJW0DyuOWRI/Xb6asZWzJbWrBQEtRsdIFuT2HKRq39p",
-	"75cb0pwsycE0qA7rViNjF0yxRQ7Y4oltsaAKJXntiKq7mOkB12uFzZ+OaL6ueCYh02tlCasEqZU6NG/q",
-	"m5sF6EsATg6w3ZOX5CHeWSl2AY8MFd35PDl88hKdrvaPg9gB4Gro7pImGYqT/3DiJM7HeGlnYRjB7aDO",
-	"osmFtvD5sODasZts1zF7CVs6Wbd/LxWU0xXEwySKPTjZvria6Ejr0IVntmqv0lJsCdPx8UFTI58GYj6N",
-	"+LNokFQUBdOFu9lQojD81BRmtIN6cLYEsKse5PHyH/GCsPT3Ix0j8n6dpvZ8i80ar3Hf0gLaZJ0SanNw",
-	"c9Zc3fuCX+TEZ/JjOaW6ipKljRnLTB3VHLzJX5JSMq7RsKj0MvkbSddU0tSIv9kQusnim+eRElLtqjH8",
-	"eojfO90lKJAXcdLLAbb3OoTrSx5ywZPCSJTsURNjHezKwZvMeLSYl+jdYMHdoMcqZQZKMshuVYvdaCCp",
-	"b8V4fAfAW7JiPZ9r8eO1Z3bvnFnJOHvQyqzQL+/fOC2jEDJW16XZ7k7jkKAlgwsMXIsvkoF5y7WQ+ahV",
-	"uA32f+7Ng1c5A7XM7+WYIfBdxfLsH03OSKcKn6Q8XUf9/gvT8demSnI9ZbuPo2VE1pRzyKPg7Jn5qz9b",
-	"I6f/P8XYcQrGR7btVtez0+1MrkG8jaZHyg9oyMt0bgYIqdoOoq+jLvOVyAiO09SsaLisXzAwqKD1ewVK",
-	"x5L28ION/ED/jrELbAEnAjxDrXpGfrCvnKyBtFLqUZtlRZXb9GzIViCd47Eqc0GzKTFwzl4fvSF2VNvH",
-	"lvy0BaRWqMy1Z9Gx64MCN+NiCH31znh883g4uwMuzayVxgoXStOijKWumBZnvgHmx4S+TlTzQurMyLHV",
-	"sJXX3+wghh+WTBZGM62hWRmPPGH+ozVN16i6tqTJMMuPr3zmuVIFheHrOq91jRrcdwZvV/zM1j6bEmHs",
-	"i0um7OMWcAHtbJk6dcyZTj57pj09WXFuOSUqo3elNt6E7B45e6Ht3aFRzDqEv6biYgsHXrcQ3Cn2ihZ9",
-	"6FaV61WEt1nFdYlS/2hRSrngLMWSC8FzGjXK7qGMMXcFI6pTdJ1Rfou7HRrZXNFadnU4kaPiYHU7Lwgd",
-	"4frOyuCrWVTLHfZPjS8yrKkmK9DKSTbIpr4ko/OXMK7A1RzCN1MCOSlk6/4FJWT0Si+pXb/XZCOMnR9Q",
-	"gL8339468wiDSs8ZR0XIkc3Fr1qPBtbx10Z7YpqsBCg3n3Zqvvpg+swwPT2DzaeZr/uPMOz1hZm2vavr",
-	"gzryN3fupsy0fWXaEht1WP/cClO0gx6VpRt0uGBnVB/QGz5I4MgNTOJd4AFxa/ghtB3stvPKHc9Tw2hw",
-	"gRd2UOI53GOMunhlp1rvBc0ry1HYgthQl2h+JeMRNN4wDs2rFJEDIo0eCbgwuF8H+qlUUm1VwFEy7Qxo",
-	"jrd0MYGmtHPR3hZUZ4GRJDhHP8bwMjZ1NwcER92gUdwo39aPYRjuDpSJV/gKjyNkv4omalVOicow7LhT",
-	"VzMmOIzg9pV72wdAfxv0dSLbXUtqd851TqKhTLJFla1AJzTLYsXavsOvBL+SrELNATaQVnWxq7IkKWZs",
-	"t1PY+9zmBkoFV1WxYyzf4JbDpSKmR7/FAZSPq26AzwiKXyN6j1+/e//61dHZ62N7Xhiz3KaSGZ1bQmEE",
-	"orFjlQajOlcKyG8hGX/Dfr91JhxHM6inG2HasKavZ0QMqF9s8d9YQaphBnJ36teO6vIX6Njx2up9G1JP",
-	"OTdbL1FslYynBB59tydHM/TN9mPT/043ZC5WbUTuuXLMLmEcrlFMDL8251uYBd6rsmZPwDpJG2OohC/N",
-	"j9ZtnV7YFp544vbKrqHvvq6yvtt7MlwvfYpn9EAkZVAvh1o1wF4GDcVTpoPhv1S7LBxNyU5JiUXOYxBs",
-	"MIYtrm7fZYw6woYCMGz8hfnc6z1Oge2ZAwh7J0F9ZE8foR992CApKXM3nY2w6FPWBRj3Q77HhB42C9yd",
-	"hAvbRSCxmfSqKe7mkF7YdpB6YIvezcan/x/V18h4uYUly1fAXc3ydkDm6LCw5RJSzS72hMn/hzEtmhDs",
-	"qTc+7IMYQdQ8q8OM/POd17SJGoR2RbHvxCeoMXJrdIaCZM9h+0CRFjdEq/BNPaPeJLsUKYD1VxLDIkLF",
-	"rmmst8R5zpmqOQOp4K9FbXdoSl8Nlj8Okj5uOJZnSULDRJAdQ16ImLk1aizT9VrpURgxMxRJ3y9AOnx6",
-	"HWO9V1WXrq/f5wxUUWNVd6vjXbrsVkxqqB2EPs8VlP/NZzDZUey7r02BZnTHXlKZ+RZR+8KbLslAbFo3",
-	"2tsG1bM40st6ZNYEsfQDniNVITBUKc2FYnyVDMV7teNGwqej8HYMPTlY2RXxWoJ0hdm1f1Y30cIHvezC",
-	"Yxcp3DNHNyGCGqxxaJEbzI9+3ySAYyksah9Vdjd/4QSNsUENdjJI0x4ecxexX9nvPsLXl0IaYUY5fk32",
-	"5ln78CWmekQMuX5J3Gm5P3L4JqYK49y+e6FiOdvckDJ0+ZVSZFVqD+hwYzSG4diKCDtESVTLT/uz7Cls",
-	"OdYHeRPkYZzDdm6VpnRNeVOopb2tbelGO4cg77Gz2ndqxcUV1nxlJ7C6Ezz/TEtoOimFyJMBH99JP/W8",
-	"uwfOWXoOGTFnh7/4HyiBTB6ia6m+xLlcb32qdVkCh+zRjBBjSxWl3vr7nHbRtc7g/IHeNf4GR80qWw3C",
-	"GWmzjzwes2KfKb+lfPNgdks1BUb43XIoC2RPbvdmIO1d0stIQfCxb75Fbli6RZobprJYxLSUGyb6jdrf",
-	"fUMtwvphisYe++e8ZdXZskKdWxUh4Y6tu8CdfE3rrp98MnZ6OA+UapWC/jxHL0CLtgO0H0P4xjXRJ+6w",
-	"R0EvxngU4iVQTHd0aViCYP0ggqiS3578RiQssZ6gII8f4wCPH09d09+etj8b6+vx4+jOvDdnRutpOTdu",
-	"jGP+MXQLb2+aBwI+OutRsTzbxxit8J2mticGqPzqAp3+lOqiv1oTub9VXaHF67hRu4uAhInMtTV4MFQQ",
-	"mDMiJsd1m0Uf/1OQVpLpLeZfeYuK/RrNa/+hdsK490rriH0XMK7FOdQZfI3LpnnM/QdhHwsszFmPTmyN",
-	"rx+83tCizMFtlG8fLP4Kz/72PDt49uSvi78dvDhI4fmLlwcH9OVz+uTlsyfw9G8vnh/Ak+U3LxdPs6fP",
-	"ny6eP33+zYuX6bPnTxbPv3n51wf+JXWLaPNK+f/GErzJ0buT5Mwg29CElqx+9MSwsS/nSVPcicYmySeH",
-	"/qf/6XfYLBVFA97/OnHBhJO11qU6nM8vLy9nYZf5Cm20RIsqXc/9OP3HJt6d1IFONkEFV9TGsBhWwEV1",
-	"rHCE396/Pj0jR+9OZg3DTA4nB7OD2ROsml0CpyWbHE6e4U+4e9a47nPHbJPDz1fTyXwNNMdS6uaPArRk",
-	"qf+kLulqBXLm6pqany6ezn2cxPyzs0+vdn2bhyWC5p9bZny2pydWUZl/9slBu1u3sm+c+yLoMBKL4SHt",
-	"c2rzz2gPDv7eRuOz3rDsau7dT66He5Zo/rl5J+zK7sIcYq4jG/hGg2fFpsZex2dmlf3VbDwfb89U+1m5",
-	"motOMsM9pter+s20oNTA4Yee+mUBEQ8Jt5rho2YntEZqhJ2WFYTZ77Uob7VvBPqHg+Tlp89Ppk8Orv5i",
-	"BLb788Wzq5E+4OZZXHJaS+ORDT9hsDpas7hBnh4c/Dd7VPj5NWe8U+duXZNFiht/RzPiY0Fx7Cf3N/YJ",
-	"Rw+8EZzEHgxX08mL+5z9CTcsT3OCLYMsqf7S/8LPubjkvqU5xauioHLrt7FqCQX/EiKeFXSl0AKT7IJq",
-	"mHxCEz8WNDAgXPD15msLF3yS+qtwuS/h8mW81f30mhv8y5/xV3H6pYnTUyvuxotTp8rZdIO5fa+l0fB6",
-	"xXhXEM17wAwEuut1wq6E/QF077HFyS1FzJ/27uJ/733y/OD5/WHQriT5I2zJW6HJ93jt9YXu2XHbZ5cm",
-	"1LGMsqzH5Fb8g9LfiWy7g0KFWpUuRDiilywYNyj3T5f+Sya9xxDPYUvsVbB3+bvHgNv60NUtZcAX+27j",
-	"VxnyVYZIO/yz+xv+FOQFS4GcQVEKSSXLt+QXXid43dysy7JomF176/dkmrFGUpHBCnjiBFayENnWF/dp",
-	"ATwH65ruKSrzz+0Kndb9NeiWOsbf64eD+kgvtuTkuKfB2G5dSfvdFpt2LMaITdhFcadl2JVFA8bYLjY3",
-	"E1kJTSwVMjepr4Lnq+C5lfIyevPE9JeoNeEdOd0zeeoznWO1AKjuDz3G5vhTt+t/2Wfwv4qEryLh5iLh",
-	"B4hsRty1TkhEmO4mnt6+gMDIq6xb5x7DF3zzKqeSKBjrpjhCiM45cR9S4r6NtCitrI1GOYENU/huS2TB",
-	"7tZu+yrivoq4L+jWar+gaSsi17Z0zmFb0LK2b9S60pm4tBWColIRi+fS3FXaw9p3dSSGFsQDaBKcyM8u",
-	"oy/f4vvxLDNqnGYFGJWqlnWmsw9bbeJmDYTmwcMV4zgAigocxZaUpEHqgIJUcPs8WOeuzWH21tqEMSH7",
-	"ewUo0RxtHI6TaeuyxS1jpIDjrfWv/t3I1Q5fev3GV+vv+SVlOlkK6TKHkEL9KAwNNJ+7WhidX5u8zt4X",
-	"TFYNfgxiN+K/zuuaxtGP3aiT2FcXFOIbNWFlYZgWrmEdoPXhk1kKLInnlreJOjqczzHcfi2Unk+upp87",
-	"EUnhx0819T/XJ69bhatPV/8/AAD//75BkK9YsgAA",
+	"H4sIAAAAAAACA+19aXPbSLLgX0HovQi3vQQlH90zdkTvW/loj3fcPQ5LPbM7trcbJIokRiCAwSGK7fV/",
+	"3zzqAlAFghKtHs/6ky2ijqysrKy8KvPj0TxfF3kmsro6evLxqIjKaC1qUdJf0XyeN1kdJjH+FYtqXiZF",
+	"neTZ0RP1LajqMsmWR5OjBH8tonoF/89gENMG+0+OSvHPJikFDFWXjZgcVfOVWEc4cL0tsLUe6Spc5qEc",
+	"4pSHePX86NPAhyiOS1FVfSj/kqXbIMnmaROLoC6jrIrm+KkKNkm9CupVUgWyMzQLABFBvoCfW42DRSLS",
+	"uJqqRf6zEeXWWqWc3L+kTwbEsMxT0YfzWb6eJTC5hEpooPSGBHUexGJBjVZRHeAMCKtqCJ8rEZXzVbDI",
+	"yx2gMhA2vCJr1kdP3h1VIotFSbs1F8kl/XdRCvGbCOuoXIr66MPEtbgFQBjWydqxtFcS+zBxk9aA7gWt",
+	"Bta4hAmyAHtNgx+bqg5msO4sePvDs+Dhw4ePcSHrqK5FLInMuyozu70m7g7f46gW6nOf1qJ0mcNex6Fu",
+	"DwDQ/GdygWNbRVUl3IflFL8EQKueBaiODhJKslosaR9a1I89HIfC/DwTAKkYuSfc+KCbYs//u+7KPKrn",
+	"qyIHPDr2JaCvAX928jCr+xAP0wC02heIqRIHfXcSPv7w8f7k/smn/3h3Gv5d/vntw08jl/9Mj7sDA86G",
+	"86YsRTbfhstSRHRaVlHWx8dbSQ/VKm/SOFhFl7T50ZpYvewbYF9mnZdR2iCdJPMyPwVI4HRLMgJWFcFQ",
+	"gZo4aLIU2RSOJqk9gAGKMr9MYhFPkPtuVgnsxTyqeAhqBxwxTZEGm0rEPlpzr27gMH2yUYJwXQsftKB/",
+	"XWSYde3AhLgibhDO07yCI5nvuJ7UjQNUF9gXirmrqv0uq+AcFkiT4we+bAl3GdJ0Cjd4TfsK08Hvgbqa",
+	"AE2LYJs3wYY2J00uqL9cDWJtHSDSaHNa9ygeXh/6eshwIG+Ww3IBr4g8de76KMsWybKB5QIKBADDdx78",
+	"DeIWrDSf/UPMa9z2/3n2l5+CvAx+BMxES/Emml8EsIF57N9jOanrBv9HleOGr6tlAQO5r+s0WScOkH+M",
+	"rpJ1sw5gpBmAC/ul7gfAWSnqpsx8APGIO+hsHV31Jz0vm2xOm2umbQlqSEpJVaTRdhq8WgQwyPcnEwkO",
+	"kAMciAKEFlhaUF9lXiEN594NHtBxk8UjZJgaN8y6NatCzBOg3DjQowxAIqfZBU+S7QePkawscNQgXnD0",
+	"LDvAycSVg2bw6OIXOGBLYZHMNPhZci76WucXIFUoBhfMtvSpKMVlkjeV7uSBkaYeFq+zHKQJGG+ROGjs",
+	"TKIDuQe3kex1LQWceZ7VEXCrGDkvAQ3DMSfywmRNOKzM9K/oGXD17x75LnDzdeTuQ8/Org/u+KjdpkYh",
+	"H0nHvYhf5YF1i02t/iOUP3vuKlmG/HNvI5PlOV4liySla+YfuH8KDU1FTKCFCHXxwJBZBBxDPHmf3cO/",
+	"ghCkI0B7VMb4y5p/+hEGSmAS/Cnln17ny2QOP3mQqWF1alPUbc3/4HhudlxfOZWG13l+0RT2guYtrRQO",
+	"0avnvk3mMfclzFOtytpaxfmV0jT27QFQqI30AOnFXRFhwwuxLQVCG80X9M/VgugpWpS/4T9FkbpwigQs",
+	"L1oyCkhjwSk0T+CyAey9lZ/xK55+wepBZFoc000KvxnYgH8VoqwTHhTahmk+j9KwquECw5/+E/gBwPEf",
+	"x8aqcszdq2Nr8tfY64w6oSDKwk0I4+0xxhsUaKoBLoGcmT4Rf2B+R6JQkvHuIQ0lyHtTcRll9dQoIi1G",
+	"oE/uOzmTwTfLMIzvjmLlRXjADWeiYrmWG94B1mzaBoTWgNBKYuYyzWf6h29gVINB+g6/MD5IJhQJiVvi",
+	"Kqnq6i4tPzJHyJ4Hzk/w0h6bBOwcjUYzIWUMvBQW8rqS15e2GMk1mBFhHbSdaIIBpCg0oPB+CIojZWGV",
+	"pyju7KQVbPwn2dYmM/x9VOcvg8Rs3PqJi9QniTnWXOgXS2X5pkM5fcKRRpxpcNrtez2ywVHcBHMtWhnc",
+	"Tx53AI8ahZsyKhhA+YUvURCMIq29MKw35KYjGZ0TZusMW7RGUF37rO08D05IiBQ6MDwF/nVxgPM+w3H6",
+	"x46GD1YiioFW46iOrHMlz4v7sqaOf6J+xBFgJofJnP4DHBE/I+EjX+RhUVNPiH5zy64eo4LLYjPPhA1I",
+	"8c6DNeu0Aeqie0H5zEze4xGMljE84gWr0QH1UIvApRsj2eksL69HLx1CyAJj+gsiHNU6LpPOzlLTpggl",
+	"fhzmA27QGch4W/pSpI2h7vAuXLWwAFfpZ8BChaMeAgvtgQ6NBTjuSSoOcF5XUbXqLwL1uYcPgrM/nX57",
+	"/8EvD779DhUS6LiE6wOE+Bouq2+kGA0r26bibn9lJM+CcuIe/btHymDUHtc1TpU35RygL/pDsSGKLy1u",
+	"FmC7PtbaaKZVawDHHMtzgeyF0R6wjRVBe55UeCeuZwfZDB/CYjNLHEhIYrGTmPZdnplmay+x3JbNIZQP",
+	"UZZ56TCF0BED9dhxmN7wB7XDeYHrhsWDkOxyei5BFCsmbHJd/pYUBVIXDh7IGabBM+qLltpZSQaeON+Q",
+	"XspjTxQVpUmGfzTQqqnp/0mWwd1li+JztOShYGbBgBzDmEWAolsulf/zzX89QVdKFP52Ej7+b8cfPj76",
+	"dPde78cHn77//v+2f3r46fu7//WfrtMBS6vzeZ6GlyDWJXnmxCK1CGQLJc0V3d95q4MNIBA3jkycDXpX",
+	"p66J0XZJanIt1tUuaYSHPr/KDGHJAaOyBL25S7tMLI7VyXnHEHWbcpXFrAoKdLdcwdaLWbNsCf6LMl8D",
+	"7cTUkW7dH4R4UdUJfD/EAYe1JpegfZdS+e7rJhGsE6WPKvnN5deXG5fDpkXxOiGnJowvVZr8Upvs5wAd",
+	"yxCVPBDQDH+UNk9sxSIGzjQNXtXohRAiroL7JydSPLLnJdBBXIoq7U/j4eEYZKSmkPvCpS9NWPwJ4QSm",
+	"w6segpE8SMoM21rg0Jwg/IGisvVYgS2vgTWeNTGCzEccoeTdC0pSu0Wp4WbMu8GIxQJoGZTYhfBseZpv",
+	"RFUH8F2bRTcrdE5JdgZqzBoddCh2wmaSQ4sV16QGokZHElAoe9mQ3HLEE7aShmE8VjBBy11B9FJNg7/h",
+	"Pick/s4EAIJQTHp8leitFHjOGDkEGbAGmBZ6429w1xANLIAnVshFrXZw26JgDeABP5VEGy3hKMoRoGW2",
+	"G3khHlviqE4sIvrwJGCLHs5gxfZQOyZj5OwkGWplLRNwKK5A88blwXI3UVIrf8tMoA5vNkdivYi21QjA",
+	"1Ki3BxhqQ3qrymS5AvVgQ96sGuSrrWQVu6jLuRjvMRhL/07iJGZYSVCzfEOQJuRlSgWwLOq1TjJyFuJM",
+	"AKWAM7ttDSV5Ds3rhf4mdOg5WHTt2dA7505hHeGAWQu/y4ugEiJTrDIDscY9IPrRvNuhkNUKKoO1fZPl",
+	"tV7gXQV8LC1Pdms+8sAwE+2jYkd/rSUPN1zSORoyN95F7osGWR8zbuVWldN1UT3CmNe6oVsXV/dG6bB2",
+	"H7Pq85X+geZT0aGu1oabzeqhZ4wk9FaASLbGQLlYUX/Ul5xJ4Hkp6rNtNid32SFUGj+9KgqrYDrLJosb",
+	"B3rO0n2hXtv22hUDleONp7pTOcBBdLymz2S2fy7SOjqEEMg2SgdhS+slhl9gZAnqyWQsC+Ygbi2Nq9f4",
+	"SpXoPcZIpQ2qXdGbnFIOcJ7RrHR322oPGZE5lsZyelT7w1MUgE1St5wwoZlyB1TGqM3wSGv5NWCRJlgv",
+	"NGTxjkABd22bQU5lDPXSSo7CYQmXfCrqm27gMwWDC8A6r6PUxSzpdzrxKMoqCov4ShSoNSzaMO1BSzx4",
+	"n4kqCtdgjTmhz5R+Zo6ldHHFePKYaUkoofNPcK/huWyqAxgBzWBGTUQwbOUwmuUNyEF0oRJgTeU2D3oi",
+	"N8+tC9CyONarSMrfyJLmUYNCADrRc5fSbTqG0ZwxPvKe1LoNTMdRgSnQU4z+HxAW8pmMJrGkBtQbC7Ri",
+	"SgqRxknnnW3BBRiZCzgBcejndG3QNFWS/l0P4IkAJ4D1LKhrLKLymsASfe4AlNq4wNXehI540wqoHTH9",
+	"0AZ2J7e3Ec1YWgADfoh2F+QzPhSOxAnIxRSK8ln3T01y3e1rCk+guDTAn8NH3JcsyuAuhUMdV36petex",
+	"JdHa9hJ05GvXSR0S11/DNw5ISkCWQ4+R5HptEd4PsNfWhyP/VZn5+mPPkU9mFbA5ZfOrmqLIS7ibXGvA",
+	"KDb/XD/BVzUXbJsZWxsWgSabSuwa2Ycla3yJrMrcVHiDKfe9jNjrL46c3HgPbJ2obAFhEDEEyJlqZWHX",
+	"Dpb1AEIKtepJhAO/tClHR+hOAE052q7DqA6bTPfzoemMW5/WP5u2feKSFz7x9TgXOHutYJKQb5TpgESp",
+	"CF07NDKo/BfSTLCUkVN9mPEwhhVwRBEOKqrQ7Axb2UdgxyH1+MjkQ4yWltQ6HB36dRKdlwh27IJvwR6H",
+	"3Rtgbck8KUiS+LPYHtzb353A6fgHQQpueXQiWR9Yrirs/gGHwnXHvJ6gNco90Ae/5x9wLCcFVZw8ky3g",
+	"LwBSAl+I8imouIeIaogyzyWIX5CCxWIBu42GT33E6fFZS8gfxABDu9MxQqCMcumho0CgoaYF4lSh5hB4",
+	"wfE9iIERMzY5UqNJAMLzMicRl7+QtrRIygqwJiK0ZrMQlZcx89It29hFhTpPUq1EvBcyWZzfiU9ewliE",
+	"dlYlkUn2mHPLW3IAjcQxKhk0s4DWoSKERdx204gr+F+6RXHKoFDbcvvxDMDjQnsAZ3zEwIwyQmV/jfuM",
+	"hrKW51Vqd8B33hGQW+iQNDXS+NdDhhOCUZF+MCXueiLfAqkHI4pjtYCUwjKFJ+lL+k7VN18G/ztvyN2G",
+	"JN+gE0pKHnlJ1zmJeTgDCkp6ThnTZzAkUrEWrL/Ql3v3ugu/d0/uOQy0EBvl8MOGXXTcuycPAVygFS75",
+	"FniLjEoUOgig0LMrbsN82EWVlgP7cHyhCwDjJK/q1sV2CKzAeK8cchUF0yB3VRjp3OfTndEicuQxK37T",
+	"GVxH4CCfqSp5mHH5N2aKHW51NWbt9rnBSJ/da6dxR+21NbRr3bzvZZ4vDhSb5X5UQoYB+U4EWwULOOwE",
+	"FDnJ0RRAtkcV5pEvJvrhECcMeBLQq5JVpAK85J/wX3RQqNcg+jvKw/z1g0ObS+Ir15ufWFy59kSyHbJk",
+	"3KnICypqjysIYXc8+xPlRSpX1mGnwVogn6tWSXH7sThVnczcEXV/wl0CSOW1d5W9yjgmFl0eZAvZShUr",
+	"X9w+3HUpRCyKeuV6T1wAr6Xrgt8FQyuzqUJ07JcYOS6ySZBMxbR77cRotpchNqA2LuhdK+nz+Zg4e30c",
+	"mN4UcVhYtxcyio+56Ieixok26TCfJesmPVD0T1GEswawUIdwg7kMDSzLyDA3bgrKN1piL0FvQ9O/wlk3",
+	"Dq1qOa8n0jTJvlBX3JrHO2+BiEaMZj0WStVaWcU+E3ziSsxR/Ji7/DAv4GPDrmdqoabcDYsMlCDVY8JR",
+	"ECauQSc4MZObhxSjVRIE7hz7uuTcBWnloetR9i4uypDTIZxHzP3xRmKqlW54HH6i5ED8oykFyZGYKKMG",
+	"LjBrZGxIFOCLxFR0QxodkRA8jD8Q+rl+17JZbW2gYCYGmh/B0/OTf5BSRTAmNX+Y7mtUNU97kjVQYgIz",
+	"gUhdYGgXv7VGm5tBzDTgx1hz5U9cQeelfA3E45DypG5UjIzsDuHETH2VhfKBpz89g5IcNEpsmbpLkvSK",
+	"3Gw24403FT2eVT9KUGBmBxrXevGNPUhMwnDWRZ5i4Jk+sOqjTWKa8tN8WVGgl4rVFjI2SI4uO++hn3eV",
+	"W81eHaeD1htWzRyDjpwnxGGI7UU3GHKLYKCitnxdot7kpf3Yw86WYF9BLVujvc9dIEdGJGCGDXcsRovK",
+	"8Bpq0Ip9ALsCDwRHrnWtK39NxV8BJisniOQ01RbY4brv8uSuv3hO5VuFrd45yTMMsg7XQBtbZxos+Poj",
+	"fXSeMtJEPZ3phvL17ZqTW/B3wGrPM2ZXb4pf2m3rYLzRT/YOsPndcTvebjsbCnnrRFoAcc7ThHx5MHld",
+	"NvP6fRaRt6BzR3TIQvlA/P6jZ6qJ22Hl8CfJoQAA4qDah+C8K5yhbT+AxCrdSFWzXPIN34lxe5/JVhSh",
+	"mjC3pjC+kDdMhb9NuSVGRGLcK94Sv4kyB5mobrNj0r04SJdd7zIKCxaiAhR/TDA6/gcrCFLRjGRPO4Lm",
+	"liITVVKFbvXjJX8lLUQufyU1ErpX+LMSe29b/VCwu1IKSMhB2WbrJ/wHTVzG6d6D/dY8sQePn4SJ32f4",
+	"MgEIieImUT65Djl0WVzvLKqIwxbVtDbCE374wRUftMxDfC1JYuDREoSGZjaFW/9YWX2PoYH+fxwJ4Kb0",
+	"LT6OiuQYXysfX97fYW65Ab8KHOyqw2Thvsc3dXmVHMhOF5fkPPTQRk45QOZs9JVN+d5HR0ka03sAdI2M",
+	"lqg6i3nOY77EIZ0KhxAcyIrSnMNaoT4pBmFHNRujq8xh1JJcSWYs8o00xm+QG84vQEOcISVFJeBnGrxY",
+	"F/VWfuDXWLlxUFxnvcA3n9JovsUOB23bkeGDwdsUsG3s9Sp028OSaUO9rh294Q7HlxJVVWT+xLLnR2gT",
+	"uLouprwkwXk0w382IPB4sNRLttV+IRTwEHQf0jUnKWQa/B1vRdY/S7pZszygidyI43EcmDvjD+TzdVIf",
+	"SPSxQNsnJdYxgLpaXxeDDITTXQUaAcfY9kNIDuGfUkyle357keOS8jqbahBrgzouNFPzRpcpgsGHXlKQ",
+	"qw4e3iAHdsHWnVNHCam/4TK98/LFeXAsL7/qDqds4qGtXDvegOx2GCjeJ5xylINR34M8+hwzBxJ+nrzP",
+	"MGT7eAYkOK+Omwrd+mmUzcV0mQdPVIaK59DmfdaTmr1Zga3cIEHRzACNGOfgknY402N/hPfv3yEje//+",
+	"Qy+msK+LyKncNkKaIERGnzd1qJ4jlGITla7XSZVOZUYjcyLKoVn5EsFgW/uakeN77ZZVN7NRf/lAfrj8",
+	"yI7X5hB2irLHdx1KrkRhk6Gh/f0pl86UMtqoPIgNOiB/XUfFOwDkQxD+96CV5edXKbkhOQK8o7mNN+mS",
+	"K2hfBpCKKziOIeazq5wrr0VU0MaT2rNW0eDUrR3bL+07NJRZgEKFH/cMx96ZUmhxZ9xrIOYfNw8/0e5x",
+	"cD3IeiaS7hpbZaUauvZOddIV9TaoqVdo6S+dC6qQsNWm6ASl+CyyUpGNGG6BpC9zuWLWv5UAKScmg6BA",
+	"IWrS6q6CZ6WqoBhGUnH6Vc6FQjkClfm3KeJIKlNRtu0ma4P11eqhzFsBDOc8NykG98nO1s4ZVvmOJxGp",
+	"JdUjndqHVb2o6Oy75U2A5ir1FqWZURTxRJOE6uM8vqxlHODoet+QqHRWPhxEpQMH7fckPqoftUYc6kYE",
+	"71oZaoczvuUczlrF5wPZxCi9yllkLeR8pb9jkAoKyZuKnkLFQS6TD/MjG4ttNeiC8HiQ7ViFkTmnWvEN",
+	"9otE7x3nvNUsuVN27N0tTpC5cYhrdhKJwC9IJeSa6ATOq5k4REh6OqimgESYdCJG5q0ZshrUwSxUsS3f",
+	"B5qbdkWZGeFCgdHGiC3FYICxzIsc2/7AUff9Z8zuNpTM03Y1WDmidapOxWm7R7QXfSFTeqo8nip5px16",
+	"MSIRJ7q+KcjRtR15RsIOvjxb8sK5sfaK6kxzZoMQjr8sFmgAD0JX+Dgcv3yesBpqLhc5h0BZ+F4QsOk+",
+	"GD2Ci4wtsEm7oIED4HJvbCLdB8hMZsqL1NgUNGf9LdxZTviBEMo4OTqNwiTzBbxKDhDJNwf61uq8fKFh",
+	"AO5JgGzuMkqRzUm3vhmkl1qSRNROIkkZfHnXJ7oOeE74TtlrTXwLXWc1tqSkgHZLcAMQDwsQri2oCF/S",
+	"cqhx5btGx0ztubl9uPrGSkp5LQC6Dyt16lqp5e3Uxtp3c/8mMyx9YrIsq7eNLtr30Y9zlzz465scdBrJ",
+	"N93r2qmQtwMQ2xk0LdHJxYrxjPQ9S33/VQVMgeTgsCVBhBcufyOK84LY7ZnqZmnplKcTpOu7LcvhEr0Y",
+	"xvKvgg5+j6C1iPKC5/nCv7q6KBe4vrd5rnk0P87lIDx7mbe+gktMbE728pDcJs4lYKMfKlIhf8CmbkGh",
+	"HTfLJTKS2M0baFpYeBgnaeOmVznvn5/jtMYGWDUzfKVCrzLw6cOMSro4X7IMTM2PnQYX/JoX/Do62HrH",
+	"nQZsihNjaF9nji/kXHQ47xA7cBCgizj6u+ZF6QCDNKkoBhMR2C/np0Nmxt5hitXYQ4qSBYX/juKRnGux",
+	"FOXBVSQU/YbqHkUfmVJv3RV5zgDcQkl81TH68ahedTHaS9FXGac7WKDdlYPtwIBl5XO97cTMQK3k4ka6",
+	"5do2mb226SjMnHeiIi2GYE+VVKoyWx9RSNpUPmin90RE6Z/F9q/YlpZz9GlydDNDoQvXcsQduH6jt9eJ",
+	"Z4onYOtRy+S/J8ojTDqJWYSkOdVHmtBIkiY1V9bXW2Z1bsvd+YvT128k+Gi7SkVUhlpU8K6K2hVfzKo4",
+	"j/ngKyMZWytldhYlrc3X+aVtO+yG/JwdabRXFcCY11sBymSXXbjDmnZaWaUngJc44BEQhXYIGNsV+wPa",
+	"PoBO5DlD6/F30+LGlZZwcgV7gBv7EixvUHhQdtM73e7TYahrB0+y5xqoAyS975jIvPuwAkVIskURqaL7",
+	"HXMtkkmgz5ygH7mJwwoAcBsYs1mFxJGxp4hiPqixRxjFEZvE43PMmsQaC5tVIxTdDpDWHE5kqvoQPtzN",
+	"chnI3GTJPxu42GJ8mwmfSp3c0zqolMpOmpr71ynKDv255MBsnjbD30TGsOtZdG88AmJYwLCdUwPB+Wqh",
+	"2hyDP1j2+D082/aMvStxwCst6UNSM0dcrtpOJrukaJ//IWFw+and9UyV8ipThXnmcNYnTapwUea/Cbee",
+	"R+qx40GuquCR0Nun31qpTn1x5sa6Y8qsmtm92+2TbmwrVNsb76F62nnLHUUvYpR5FhrRgFwusBVW5yYY",
+	"O+LlmMc3BCNh7oUPp9FmFrlKSaCQgTCdGp9ny5CMjx9lZ50Pjjc9kUVVpoHlPtVtE06JAjCYULR++q1r",
+	"Cgw87WhRwUgGRLW2TDBh51cqM+y2h2myTZRx1Unsx0dJ9sZgdBVosclLSmhUuW3eMZDI2plNDpAfE/bb",
+	"CaDiZJlwzUXYAquonxyIi9UyFcnCiPpBjkQNbMjJxCobKncjTi6TKplh5nZocZ9bUF5EXJt2ZaguuDxY",
+	"5qqi5g9GNF8BSuHQQRdGLKBVC3Wk3mjPzUzUGzR4n1C7+4+Db8hnVSWX4i5iUd7PR0/uPyajK/9x4sk0",
+	"jMVVh7hJTOzkb5KduOmYnHY8Bge+0ahTZ3oerojtZ1wDp4m7jjlL1FLyut1naR1l0VK4gyPWO2DivrSb",
+	"ZEjr4CWLuZwrTJZvg6R2zy/qCPmTJ2Qe2R+Dgb5UWMdaejaqfI30ZCr28aRqOK4NK8vKKLjUR3IQFo5H",
+	"nLdv9uX7zbVqcuP+BJ/baKU88vSAJTGue1UJKnilcuFRnR1dXodxg3Ph0knMIU8+lmSAE0GKRVMvwj/i",
+	"O7wSLglKnuABN5zBLd+vLdQuJ5LtB/it4x2fBZWXbtSXHrJXMoTsi48IsnCNHCW+a56oWKfS68l0h4cp",
+	"jt4NDBweeqxQhqOEXnJrWuQWWZz6RoSXDQx4Q1LU69mLHvde2a1TZlO6ySNqcId+fvtaShlrfPLZz4xq",
+	"jruUOEoBQ4tLCldzbxKOecO9KNNRu3AT6H9fz4MSOS2xTJ1llyLwtEnS+K/myV0nkRmc0fnKafefYcdf",
+	"TPlcvWQ+x85EnKsoy0TqHI7vzF/U3eq4/f+Rj50HON3Itt1kabzczuIM4G0wFVBqQkRvUmNdohZW22+Q",
+	"dKwlvmcKaB6T9dFQWT+xsi6tpDMDnNWicGsWnLqtYhsdJTnAUEf1Vh+NmjIdQ9/co6sudXIl8ANxhJJa",
+	"BOyKcz6fSzIH536NIT4aoY40pfmyXR3oljOadCIUi7mzLhIZOumO9SwD2qNvNpRZAvasd3TGvTllufPR",
+	"TRFtMgqgyJwPXij7g8lkwbkyWi9ITJ0OFehgKKGb+sqOa+kCUoMOjMHKiSfNGllqq6BoMBEfsHEpwlI/",
+	"qfFhAHEp1mgb3eNxjYhSGtsPVZEXu5/VXDJ8ND86j1Um4jovrHiF1gtRe5vJdHvNTca+vi3uJhDAs0TU",
+	"+MHLD95y5hFX7gj6wJFgZO9FOwEXq8JM7qRlT4OX9DYFF9xKHkjarU430cqv3hRpHsUTSnFBzIRn5T5c",
+	"G5aLZS05YUuLq/mLG4yLKd5dleAQsde46qqmnLGw5nXhegmMLc5VA3pubPs+SO2zsTMNnrPGXSl9jifB",
+	"+2GRlJgMR08nZT66I6gsDZDiilTZlnThvwLHV3lTt5Qx9FkFgXXWZ2KUCLcs9MZ13iZBjvaGTYJFdVfw",
+	"syoo1i3coo6UeozcXh7QUcaU4pTZhjJFXAftCjhZBC0bgKyD+D0VGS4PsTd/8BaV6FXQ6/gvVK4wXcv2",
+	"R2mLAhE1z5I5JZeUEm6Ljuhx3Tjf4Yg8nP7qDjLIr3e4nHX7dHihxKK3kp9ihO3bs2/VDjZlUguZtojb",
+	"BlXKucQ6Pi+xGR+soG8jHMt1e3Z9VXpw2WVgRUwKfXeM9RXJlOmd/6wxoziZlJcYUsq8GkPmZTVSaREG",
+	"eVTIvOR4LGzOn7fTaxHPdwYthNq5tefBoDdBHhX/B/z2kzQAUdj8RcJVRyQhyAh9ttlipDsVhMM8UEvM",
+	"U54oUcNe0zvsM6U0egDxh+nrfJnMgZRpDHbQ4rI5GqE/1KmKTVAiILR9hm1lTij9cysQmyeFvnJSf61a",
+	"d7rRq8yLYIePOVROPgu5enx7tAFyGwwqIgkBCQ2TRQFViIIki38ZDeKGIvtoLr1bAj3EUF5BUM2xYxtH",
+	"cEEZXAJbwUFcYw697+XzufVSh6tR2YFhsh6V8wQg6bI47X5D84KfYfwqn122ImLg719T/t2azjmNjDx1",
+	"FJYYyeqRfj0RjO0V8Fz2yP6tMgVuPTxeNzBWBFSbFP9CDFiS7DN8S6DicfrlakmklxJ8TG9gOgVsXTwe",
+	"pYbQpDJ0pacoQ5nc0bI7SH8FFz22Qrr2EITahg63wM/gyaSCbeFop46vu+uF7avFuUYdztj5lNNf0tcg",
+	"bkiqFjoPJd8fjLOdGRV35t182km1ebPpsIq2q9RJTfW11Vh68GlAFzle4s9fvHn74tnp+YvnLHmQEYLe",
+	"WePBlRYAtPnCnYJqJfqEf7XR+Cv1+7WzYE9VVlOU3HGm7MLo6pzQ47PZlv7dJwG4jj/bOwJaBZtRx71V",
+	"3/ZIPcUVOUOITxLHY4KEqJujw0x9PXZhg3FoVmFgux6vMP0Pyiz+paye3ewxFv24brAXKMXZmVt6dSBY",
+	"ztOJVSgWOqfv6n2iTg7QyYYV8YHqzWlljx32gqiGTsD1TT6UvTpiYZeDOnzvIubeZzxRLV/TwioHuTjs",
+	"sztwhoMq6TtD4XZo+QIpOY4SP/d6j1PTemo8jT2IUBWh2wfozyr8PyiiREYsGUbWx6xPXLuhqCaf33il",
+	"MpN1eSTfaof2t6Qd0LPTHO5bmRUXZeKWWb6fZjrh7N2fwylDGZ5CTzBqK2d0bzlJRo9pZPJrnIV/4FVw",
+	"9tkF/bxHYm5q7M3Mfd7Kx+1DF2U6l05wjXN8ImcEHMqGfJCU26jv7mvJ23ErdWjT3iI1n4tGe7WvhrlY",
+	"74mg9czVVPsemVbqVIcsUiBVsIkqSqhZkpOg/fhn9BMEOk3J5Y4nmX/jLOvqud9EmYE436D1QjPRIe2U",
+	"xGZ/s60BaOjF5CA8VjrQG4Pje5AF+L9TBS1qcNZt0brvdTKZEAa4kDnnFnSFBLElXkZpAAYUZRAWVAie",
+	"TE1oiiV4i1VaD4yvOZciSTT0mkfHA1Piu8przoVd93qKT9HZvlebqqybw24zizKTShOjflVhNLhjWI/P",
+	"gldvVIQvcepSYBFCdv7XVMapLrfBy5+ZTjp3zFWBqSn7M/+cJVfGM0UcVtZenCA8OjgH4cMHgcmi9iln",
+	"XOPOWxkPa4WZFeAC+kAPi31qEXoqH47PPIlQTjsl2/R7D7iqFugRE1equDrd60m9B/dsB33LcntIUHES",
+	"pZypEy9SV+U72s7a1R/QBmQ6lw+gkwwOt0za746A0uvjYpTX328DHa2hU3fPoZlzNsoQljfkmFf6GRcc",
+	"tbKDcHFCQVUrSADRePpnI9CXT+UXFk3qyXgdNyxAiCr0Ztt1gKFyDpkQAQKEPCw0o8rHq0CjjKyrKG4X",
+	"zFbDeKpjJCndIodCjp4b4YDva6If2KbsDgb141xWTuwElhMtfYIa7CsWLXV7a85JPuMmVigtA+TPrl1Q",
+	"ThFkryADJkXoOorMffEzUaPJr2tTo75sC5V3Th6KCvcmnganM37IYKAKMnJTqyYoInnzVxlgK+HSs8ae",
+	"FX3SNawe2LANwycbuUshSMp3SiWmtjDqKvS2Rr4ssc+sO+yfTlIczpr0YogMMS8snLcoqdltz/wfOnXO",
+	"pIcYrJXICVfJcgU3fpKXSb3de2bsHajee4OABkiQNwa5NrfBoBmdM9GFScuyEi3deo3rElHwUT5iecTV",
+	"iRxdv/Q8WuKlBuJoMq+c0QPqLg2XjU+mbN+3O8+yN7jk3Iqo6FZjgA/LvLaexw9M4RWiurdY+3pxMvsO",
+	"l+2yNutUtc+Bh0h7hCM3vYdogyafMNLeurFUEzHHoHd4KrSzQzydYF40JTlzd5nzxeYm9503YMDadW/x",
+	"sN0Lyz2guuN2QLrjgt4x9th7dhzIgG5PCvT2fgxTt94fG6ltfHRWwDO7ycpbMcnxuBerfFcysD1yJT/X",
+	"FaVa5Z5kRkZKxKOD2FRuRlGp31TWLZ4lTS5kvl263zlkEFOKqRZON6Qy/fhMWN0MJZwIJnEDvdAzJ+bh",
+	"ZT9JhyN1MT2vnac5HrfQ90a5/dZRPxQAZZ1edBjJkeBaiLJkTZKuFRhbhBiixFxlCI4hVPCzlWshofJW",
+	"12TgvDk935qkpaYOAiO1s0C8T6OEkv+b1KL+OYeQ/Yy/q6wUqvrJCHempNfd5eHUk9uk6iHRpnp8i0JW",
+	"t93ZLq7jMpQW06vMGfbdi/OGExQ3czb02QfDOGg/T/E1O41G7HayvH//LqVM1q+tEJELsT1mB4EqsKe2",
+	"0oaei4byGqwQ9s5uH9Sb6nbOpEtewPIgcP7Obx1A/g89LoZX/XSp3TNwkWCKcRS79WM1T0Hy4BuKQNGB",
+	"xlzokdKDgoSWifjuNAjQb0jlXmTMcbvOUmdyVGcH5r+iWeOGgz6ll2T6PnO/syQxorwhf1PDDHM1WWfj",
+	"ZlPJ8inD+UivPNI5pv2uKJbXwyutek2jooC75cENUTEULinlmsnpxnlzek5JB+nbaYV2+FEuWh5MToDf",
+	"CZnLS3FgT6YVILinJ7OfMGns8mgdxNXIlpQ5JKPRwY27cD8G8cYN71Fp3G+UZ2O85+6QQ+xO7ntGCOW8",
+	"DwjU4Nf7v8IRX1AJsTy4d48muHdvIpv++qD9Gb049+45T+atOe4ZR3IMOa+TYgZKgjns5P0SpCzYklLA",
+	"9XkB/WR3FJcJ/YkK2qRVwGiUm5teNJJ/26ppi1ZzaXhFbdj9cERElcs68TdZYLg31ETmWyf4QjQlT7DG",
+	"F8jjeZjmm0lg10eaBOxtiNkn3k2EasV4cwEo57okD1dl08iNaWPWrjztHjxxpekYLh/VHc2+L5L1DVwC",
+	"PYT6LiWf/SmJr42KridIXj2SBvQuSIzJlUpYRhwHUzFuANmusmEyHLpVEW/B4R5Z8I6oCg1Ld10lUXe8",
+	"YXROp62xCKwb/zifqxy6TE8TNAVBys+j+gX9OpXuPJbzfOOSJtUUKVX8u8kUvZqVnAGL1sbTj9jVPbib",
+	"QnanBFuHg/HjqjAV2bJeeQoN6yQD3KpfXb7Fsdt+Imf5UBqU0BclOrLDDLdrq1SOd9ebMssklqRpIk99",
+	"QEZX68iLjHMwDxWn+8IY4W3wqDa1qCKr1n442NZIfnXmwfUbF8+gdCPShMwT7unVVqpIz2jVjUAbzdO4",
+	"JKF69SBDGCxMk2FW1QMaspWNKrLo5KQjp/D6KOwVD1dQ1JUQHZWC6CmqJ0NERxjGZBK7pPJWvg9TS5ky",
+	"WvwiM6P8LtWcf+Hz5vMx7RWv3ZWACTGOtbYmt6ayMnmMSOIhuzlSdhDPg8ZJvaWErcqcnfziTIT/UkfS",
+	"rUSE50mn+JMZ5ur8QuiUvyburqmU8/sl8HlKP4aGForkr5EbBy+uQG6Dw81ayvd3Zn8QD//4KD55eP8P",
+	"sz+efHsyF4++fXxyEj1+FN1//PC+ePDHbx+diPuL7x7PHsQPHj2YPXrw6LtvH88fPro/e/Td4z/cIfcZ",
+	"gMyAHqn0YEf/i0qeh6dvXoXnCKzBCawagxWpIieSsar1CaeNbs51lKTQTP70P5R6g4WhzfDq1yOZfeho",
+	"VddF9eT4eLPZTO0ux0sykIPk3sxXx2qeXjFQgFNnQmBPGu0oP3JXRV8VKZzSt7cvzs4D6De1HHtPjk6m",
+	"J9P75JoHVgJLhZ8e0k90ela078eS2OD/0PAYUJeSjIB/oL+QnX/4V7WJlsBbprLoKf50+eBYPaQ+/ih5",
+	"zKehb8d2TSH42fahxDt6UtkV+EFmEx1u3UrXKRmp1WEkFP4pKVwQPpEx3vt7G4yPeE1+OlYxhLLHHN95",
+	"NsXxR/oPbfonPoWpcMX/cWaMKDDNJxRbM8tLypMJv+LBUwn6kspqeUSkwFT0KkbqwV7PGAKVipdrEzx5",
+	"54hCo9fpaiQ6akhH5iS0ZjLMri4bYafL16y81d4w9HfAnj98vD+5f/LpP5Bhyz+/ffhpZCDvMz1ucKa5",
+	"8ciGHyi7HbkS6IA8ODnZqwJw78GJWSRvkn574lCueCdC60VJx7HEDToDBRoZO7JwdYZ3VU2GLo/2XPGg",
+	"wbP1HsdR+fhpBNxLJouhue/f3tyv2D6CjDPgiwGafHubq3+FKgo+PKKWVlpVl7njIss3mWqJt3gDV2q5",
+	"Vce4ajGFQG72VIWAoPm7TC7xzdcH8q+4Xk56mEtVR9dgLmfY6ytzuS3mQpt0CObSHujAzOXBngf8y1/x",
+	"V3b6pbHTM2Z349mpFOX46XRfKOQ8ZccVyODVitPxOF8c/A2TYFCqK9mSciWCPL/VaSxUFSlZm7FTQ7ii",
+	"XKyqiC6HKsuY2zuVqZaLL1kpoTglk+Jcz3pK1Ogi1OjQg4GONmWD0b0soXaCucYTmYs2q/DZEQAp8BLZ",
+	"rJJUmJDfCyHIcoKZKilMlsCTCcthhDiXAeQYRqvDFzLMG2R2gUFdV8sCE/bJ9NS4iGD5W0JuF9i5ICrh",
+	"lkJzLhoe0QNP0V2t3VwAbE/wJybLqRpSqriLPEVbMJktZdlJgH3VZBcVg4B025qf1FodOMrDTFrjoBOH",
+	"WhVt93j7znwp6tdELWeKWHZcnKqdhKadWEwua6ouVOAL5dbcqPKmmrTYrTwvR7KvVc1XJuRSH/qlfPe8",
+	"3vJ5LfBhVSm4DpPjDp8lWVQ63o72j76V66XSxDw92sl//UDcHh/+TDBcgx9/Nki6fPnbk4e3D8aZKC+T",
+	"uQjOBfQtozJJt8HPmc7Ud+374sUVvmjr8G27TLjJAOS7OtQVgWm0t/2bY5vNh2wCP2cYWUnPTmRKTOjA",
+	"HHYCP9XytZ18eyHZUrDGP1nsRgcpF98Niogsd33WRJOcwcBvZQ4811Fvw/WXPx99FYC+QAHoLSWgrQJZ",
+	"AcQiJxSS0ShI7g4d285U51M1nbLOSy7DLVxzkOfHQax899IHLS4sBNIvCxNAuxUfA2uwIm2q4Mfo6nQ+",
+	"r1/n+QXVyYFDmaTdlljigvPu5iVGujlv510HYPQ+t1WVgXhfB4KUeGThfdj/w8O7FJRJL8pJKkGWk8tM",
+	"dcdFE1/P+KOTR7cHwVmLYvnNZf3lchpMzOzlBNaVRk/dRSGjHeaYAXfUjQqDWRqZx+51djNuJJUbyzwm",
+	"S0QoFciKFTWlSBRDxVcHKn+JXOwmyWIMwkHlCC9kUV2XZZ21WdagLmFeF7QZDKUwxkk54bDDNldqjugz",
+	"yw0Vk/LpDV+FiX8HYULZlKU4WgsMM2sTF54fjtSUZM2HYfchb+WR8dpVmMmQls5JAfsV6l1XfTenTXXT",
+	"K3/cy5ZuJp1+IEHfYzy0sq+3823ezq3tC2D/gp/ghv5ByUlf7h296/gMOZk6Tuc47hE5Xx1AMk/zeDuA",
+	"IWV8OoS5qJ+Eip84qqcseLX37rRPBxX7EYRXDrmfgvAoY8pCldW0QB2TdEaOPEbkf9MZXJcawaoslYrJ",
+	"+spDvvKQEaa7Qys7n8lkBzzImYauffR7PA0dvRgeuxRZKBlWOAOOpQottwa8EBz11xNUjj+2/pSRRV7r",
+	"3nP6HZ0cJEn1gZ5t4dT2JBju1uW0T7fUtKMHOET6LoiD0v1IR8AQmeNCllgtl8CO5aK+Mp6vjOdGwsvo",
+	"wzPecin1me6dPFFVplx1GaO6P/UYneN3Pa4H2ei+PuPSXzjNBuaDNx/Y8tJF81eW8JUl3NAgIRyHkU6t",
+	"ZBIOortOEF2fQVBGgdhO/8mVv6n6Ezdv0qhEg+5IM8UpjSiNE7fBJW5bSXPiinU0zJ1whfmpQE9ybNhh",
+	"9bavLO4ri/uCAoJ3M5q2ILK3pgPd1lFh9BshS786RabXcEorK0mwSQRZWalt67yTnd6b4FZEc/VCdf2E",
+	"R+tnSUPfiZXijXN6qaxslAAAA+usZGgT30jqTT90Utn6MK6M28eiMM9lu9lfVaLesp02tJck1OlqfkM4",
+	"Pay9STgr9J6b3JEyxTC+oXdk/OXXpRPeAPlelfLZc4JZSiDWybk7OlekzH68690cL2GMXcuxqum/Bnu7",
+	"tthS95ekaCwp9WmpdN7GYecJdj+eRdmYg2tSYCuybmXlrFSqbIoJsZKAWwaV/unGo0Ck1ErTjTGpvvPw",
+	"FKE96JFQ63cm/6ZH2Zx6H0NdS5U3i/J470XdT6PdRUkJlLGUzcmVWyD+O1C3XtZI0j3+yJsxaEF7DXRV",
+	"6XzwXCGlndPdlYq+G/4GfXEnx3jRR+aNd0joMkX8wY1tX73ntyxVUuZi2kwVpsNEcesi5Xm3DMIkmDW1",
+	"Og0ogDVpzABScDpF8cfXPsh41lQaYBh/v/dnyN53H04dnsLqetzwMUUDXJFGcwp2zbaY+fIyyZtKHfqk",
+	"5oCaOKnkBSVjflTcDosIwStVrqAl+OC9Jo+1vHdLTKy3wJIbEy3o6eBETAIo+8s7cD0Nzjt3Jg9RmHvF",
+	"lpDXlUgvRUVPLHBiWUCBQ4s44ijaRFvdIcJHFDQticpYBSJgfJbWvqoLmSqdxcBg5nVebnkB2PACxNkg",
+	"woT2FcV64kuYPjN8+i/KCicuCBR9KKkbILKzRfleR6hu48Ka7n8Na/pCwposfmgJd8gSD8wJn0bZOEFj",
+	"h5hj+FUryYKHhT7vcDdmD5zvy4JDFg/QhXmwDAQzCOBDL1+cB3r+iaofJY2jMulM8EYiCHRrmyHa+nyf",
+	"cRjoxvIPm2uYogf613YxAAcXMalvPpNE9f+VVPNT3q1FtIoqDmeNTPW063nqNGlI8WHE0Sg0DY6yPbUJ",
+	"dmInURKVrH+s8uqvADWzPLfeCmwlhVPE7QSje+er/gPHIeonJVYBcbvmHbNMfWS62GD9dp/yujcyzXQB",
+	"+PfQXrtr2oOE29zdp8uqtzlRdzKr1ISiXStEHSS5bZs88dWtCSq3CmclxuJJ2YfgQsTqPZy0sk/UDFCb",
+	"rvdi7Aur1gwwE7ip6icoE/9eDP2rinyLkpiiAqkj96j6dxEP6TjhCw3F65XC3D3fhxYZ+Sz1sbCfFn0a",
+	"xy7+0L3ZJrKIBCd42aonJvycXy8dFUMjUOakVo4Q/Sj/LtUMY9u0xV+QuVRG4+RWRsgV+vkJdIM1kOM4",
+	"m/N7m+3n0GYxIPkr+/qqSN6AU5DseHt8QoavjmESUtioVk0dw3ADb+CwBEaUBnDYoiVoqJjIQ2UhxAdg",
+	"cgBToTn4C3WN0hRtbfllEiPnQqcOvyOXB4iYhqyXYQp2sFCyIrTMxBLNMTABuYxolmiBXSPLHWuZazpP",
+	"3CRkP3HQrisKpmPakTC6M1/s8UBtNJ318wJ9GtorVQe29fcxZkbB7FKy9DFhqJ9shrMmyESE5udaRClR",
+	"eYJpI1u/AuvFdDLrWf8LVQy3frTSGbp/PeZk0K4vtC++br0Uja6vMoOir1Gep14tEAX0KJgBai6IvEzB",
+	"RDo+eb8cFQ6HKWJAta2pjLsrJXgrubUrX283pqDwZDrmYATOWDyBO2QTkKuUnKOt7MOkb1PxVllv0tie",
+	"1znW91a1z3S9A6vWgcxf3HHDsmBvefCtPPk9/bWT0fkZ8s0qkXbS4UsTSGlOqWEzX2Jl4BRohKLCbuvo",
+	"6vuTCf2NEKedtj6rLXQ7clylhzvKbY3bW7T3vLchSqdi+CdBnkKPmiMqxtcvGaiH4ShngnUiNAH3gfzT",
+	"IG1rycZF1kQ9BSXMx3abXCb35/T5UQn4mQYvqGYSf2AhMcf0T3sEhngLHngWC8CHlCB6MJXCQFZ/UwpE",
+	"JVSms0SSmieZN2+oP6u52nBHvIy8/lQdenweq08JXJRAzNfFlJck7GohHixFV4SlzJ2mPlKJ1dcRlnK+",
+	"1BQyDf4uylyW9isFq3QBTTSUer9yPcenDz6GSSVpY4H1ASnJ9DB7vS4GZbJ6V9HaMdnbXan9fVUIOnY0",
+	"qzhs6/x2SFxTXmdTDWKHE7z3JVqLm/uuxOnXh/D/Lm/gTEDZiF0fEFdbUlgFrCONai0EmkT5duJ5Eg90",
+	"yvl3H/BWrmCZSnIwedSfHB9T9UZUq4+P0MHbzrFuf/yggfyoA94lsJ8+fPp/8aqUhXQpAQA=",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -41,6 +41,9 @@ type ServerInterface interface {
 	// Get a Merkle proof for a transaction in a block.
 	// (GET /v2/blocks/{round}/transactions/{txid}/proof)
 	GetProof(ctx echo.Context, round uint64, txid string, params GetProofParams) error
+	// Get the ledger state delta of a round.
+	// (GET /v2/deltas/{round})
+	GetLedgerStateDelta(ctx echo.Context, round uint64, params GetLedgerStateDeltaParams) error
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
@@ -50,6 +53,9 @@ type ServerInterface interface {
 	// Gets the node status after waiting for the given round.
 	// (GET /v2/status/wait-for-block-after/{round})
 	WaitForBlock(ctx echo.Context, round uint64) error
+	// Stream blocks and their state deltas.
+	// (GET /v2/stream/blocks)
+	StreamBlocks(ctx echo.Context, params StreamBlocksParams) error
 	// Compile TEAL source code to binary, produce its hash
 	// (POST /v2/teal/compile)
 	TealCompile(ctx echo.Context, params TealCompileParams) error
@@ -62,6 +68,9 @@ type ServerInterface interface {
 	// Broadcasts a raw transaction to the network.
 	// (POST /v2/transactions)
 	RawTransaction(ctx echo.Context) error
+	// Estimate the fee of a transaction group.
+	// (GET /v2/transactions/fee)
+	EstimateFee(ctx echo.Context, params EstimateFeeParams) error
 	// Get parameters for constructing a new transaction
 	// (GET /v2/transactions/params)
 	TransactionParams(ctx echo.Context) error
@@ -71,6 +80,9 @@ type ServerInterface interface {
 	// Get a specific pending transaction.
 	// (GET /v2/transactions/pending/{txid})
 	PendingTransactionInformation(ctx echo.Context, txid string, params PendingTransactionInformationParams) error
+	// Simulates a raw transaction or transaction group as it would be evaluated on the network.
+	// (POST /v2/transactions/simulate)
+	SimulateTransaction(ctx echo.Context, params SimulateTransactionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -85,6 +97,7 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		"pretty":  true,
 		"format":  true,
 		"exclude": true,
+		"round":   true,
 	}
 
 	// Check for unknown query parameters.
@@ -127,6 +140,16 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountInformation(ctx, address, params)
 	return err
@@ -138,6 +161,7 @@ func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context)
 	validQueryParams := map[string]bool{
 		"pretty": true,
 		"format": true,
+		"round":  true,
 	}
 
 	// Check for unknown query parameters.
@@ -178,6 +202,16 @@ func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountApplicationInformation(ctx, address, applicationId, params)
 	return err
@@ -189,6 +223,7 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 	validQueryParams := map[string]bool{
 		"pretty": true,
 		"format": true,
+		"round":  true,
 	}
 
 	// Check for unknown query parameters.
@@ -229,6 +264,16 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountAssetInformation(ctx, address, assetId, params)
 	return err
@@ -453,6 +498,49 @@ func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 	return err
 }
 
+// GetLedgerStateDelta converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLedgerStateDelta(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLedgerStateDeltaParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLedgerStateDelta(ctx, round, params)
+	return err
+}
+
 // GetSupply converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 
@@ -529,6 +617,42 @@ func (w *ServerInterfaceWrapper) WaitForBlock(ctx echo.Context) error {
 	return err
 }
 
+// StreamBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamBlocks(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"from":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamBlocksParams
+	// ------------- Optional query parameter "from" -------------
+	if paramValue := ctx.QueryParam("from"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamBlocks(ctx, params)
+	return err
+}
+
 // TealCompile converts echo context to params.
 func (w *ServerInterfaceWrapper) TealCompile(ctx echo.Context) error {
 
@@ -634,6 +758,66 @@ func (w *ServerInterfaceWrapper) RawTransaction(ctx echo.Context) error {
 	return err
 }
 
+// EstimateFee converts echo context to params.
+func (w *ServerInterfaceWrapper) EstimateFee(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"size":   true,
+		"txns":   true,
+		"rounds": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params EstimateFeeParams
+	// ------------- Required query parameter "size" -------------
+	if paramValue := ctx.QueryParam("size"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument size is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "size", ctx.QueryParams(), &params.Size)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter size: %s", err))
+	}
+
+	// ------------- Optional query parameter "txns" -------------
+	if paramValue := ctx.QueryParam("txns"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "txns", ctx.QueryParams(), &params.Txns)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txns: %s", err))
+	}
+
+	// ------------- Optional query parameter "rounds" -------------
+	if paramValue := ctx.QueryParam("rounds"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "rounds", ctx.QueryParams(), &params.Rounds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rounds: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.EstimateFee(ctx, params)
+	return err
+}
+
 // TransactionParams converts echo context to params.
 func (w *ServerInterfaceWrapper) TransactionParams(ctx echo.Context) error {
 
@@ -747,6 +931,42 @@ func (w *ServerInterfaceWrapper) PendingTransactionInformation(ctx echo.Context)
 	return err
 }
 
+// SimulateTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateTransaction(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":     true,
+		"exec-trace": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateTransactionParams
+	// ------------- Optional query parameter "exec-trace" -------------
+	if paramValue := ctx.QueryParam("exec-trace"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "exec-trace", ctx.QueryParams(), &params.ExecTrace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exec-trace: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SimulateTransaction(ctx, params)
+	return err
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router interface {
 	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -772,222 +992,323 @@ func RegisterHandlers(router interface {
 	router.GET("/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
 	router.GET("/v2/blocks/:round", wrapper.GetBlock, m...)
 	router.GET("/v2/blocks/:round/transactions/:txid/proof", wrapper.GetProof, m...)
+	router.GET("/v2/deltas/:round", wrapper.GetLedgerStateDelta, m...)
 	router.GET("/v2/ledger/supply", wrapper.GetSupply, m...)
 	router.GET("/v2/status", wrapper.GetStatus, m...)
 	router.GET("/v2/status/wait-for-block-after/:round", wrapper.WaitForBlock, m...)
+	router.GET("/v2/stream/blocks", wrapper.StreamBlocks, m...)
 	router.POST("/v2/teal/compile", wrapper.TealCompile, m...)
 	router.POST("/v2/teal/disassemble", wrapper.TealDisassemble, m...)
 	router.POST("/v2/teal/dryrun", wrapper.TealDryrun, m...)
 	router.POST("/v2/transactions", wrapper.RawTransaction, m...)
+	router.GET("/v2/transactions/fee", wrapper.EstimateFee, m...)
 	router.GET("/v2/transactions/params", wrapper.TransactionParams, m...)
 	router.GET("/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
 	router.GET("/v2/transactions/pending/:txid", wrapper.PendingTransactionInformation, m...)
+	router.POST("/v2/transactions/simulate", wrapper.SimulateTransaction, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PbuLLgX8Hq3qokvqLkvOaeuGrqrhNnZrwnyaRiz7mPcXYCkS0JxyTAA4C2NNn8",
-	"9y00ABIkQUl+JJnM8afEIh6NRqPR6OfHUSqKUnDgWo0OPo5KKmkBGiT+RdNUVFwnLDN/ZaBSyUrNBB8d",
-	"+G9Eacn4YjQeMfNrSfVyNB5xWkDTxvQfjyT8o2ISstGBlhWMRypdQkHNwHpdmtb1SKtkIRI3xKEd4vho",
-	"9GnDB5plEpTqQ/kzz9eE8TSvMiBaUq5oaj4pcsn0kuglU8R1JowTwYGIOdHLVmMyZ5BnauIX+Y8K5DpY",
-	"pZt8eEmfGhATKXLow/lCFDPGwUMFNVD1hhAtSAZzbLSkmpgZDKy+oRZEAZXpksyF3AKqBSKEF3hVjA5+",
-	"HSngGUjcrRTYBf53LgF+h0RTuQA9ej+OLW6uQSaaFZGlHTvsS1BVrhXBtrjGBbsATkyvCXldKU1mQCgn",
-	"7354QR4/fvzMLKSgWkPmiGxwVc3s4Zps99HBKKMa/Oc+rdF8ISTlWVK3f/fDC5z/xC1w11ZUKYgflkPz",
-	"hRwfDS3Ad4yQEOMaFrgPLeo3PSKHovl5BnMhYcc9sY1vdVPC+b/qrqRUp8tSMK4j+0LwK7Gfozws6L6J",
-	"h9UAtNqXBlPSDPrrfvLs/ceH44f7n/7l18Pkf9yfTx9/2nH5L+pxt2Ag2jCtpASerpOFBIqnZUl5Hx/v",
-	"HD2opajyjCzpBW4+LZDVu77E9LWs84LmlaETlkpxmC+EItSRUQZzWuWa+IlJxXPDpsxojtoJU6SU4oJl",
-	"kI0N971csnRJUqrsENiOXLI8NzRYKciGaC2+ug2H6VOIEgPXtfCBC/rjIqNZ1xZMwAq5QZLmQkGixZbr",
-	"yd84lGckvFCau0pd7bIip0sgOLn5YC9bxB03NJ3na6JxXzNCFaHEX01jwuZkLSpyiZuTs3Ps71ZjsFYQ",
-	"gzTcnNY9ag7vEPp6yIggbyZEDpQj8vy566OMz9mikqDI5RL00t15ElQpuAIiZn+HVJtt/z8nP78hQpLX",
-	"oBRdwFuanhPgqciG99hNGrvB/66E2fBCLUqansev65wVLALya7piRVUQXhUzkGa//P2gBZGgK8mHALIj",
-	"bqGzgq76k57Kiqe4uc20LUHNkBJTZU7XE3I8JwVdfb8/duAoQvOclMAzxhdEr/igkGbm3g5eIkXFsx1k",
-	"GG02LLg1VQkpmzPISD3KBkjcNNvgYfxq8DSSVQCOH2QQnHqWLeBwWEVoxhxd84WUdAEByUzIL45z4Vct",
-	"zoHXDI7M1viplHDBRKXqTgMw4tSbxWsuNCSlhDmL0NiJQ4fhHraNY6+FE3BSwTVlHDLDeRFoocFyokGY",
-	"ggk3P2b6V/SMKvjuydAF3nzdcffnorvrG3d8p93GRok9kpF70Xx1BzYuNrX67/D4C+dWbJHYn3sbyRan",
-	"5iqZsxyvmb+b/fNoqBQygRYi/MWj2IJTXUk4OON75i+SkBNNeUZlZn4p7E+vq1yzE7YwP+X2p1diwdIT",
-	"thhAZg1r9DWF3Qr7jxkvzo71KvpoeCXEeVWGC0pbr9LZmhwfDW2yHfOqhHlYP2XDV8Xpyr80rtpDr+qN",
-	"HAByEHclNQ3PYS3BQEvTOf6zmiM90bn83fxTlnkMp4aA3UWLSgGnLDgsy5yl1GDvnftsvprTD/Z5QJsW",
-	"U7xJDz4GsJVSlCA1s4PSskxykdI8UZpqHOlfJcxHB6N/mTZalantrqbB5K9MrxPsZARRK9wktCyvMMZb",
-	"I9CoDVzCcGb8hPzB8jsUhRi3u2doiBnem8MF5XrSPERajKA+ub+6mRp8WxnG4rvzsBpEOLENZ6CsXGsb",
-	"3lMkQD1BtBJEK4qZi1zM6h/uH5Zlg0H8fliWFh8oEwJDcQtWTGn1AJdPmyMUznN8NCE/hmOjgC14vja3",
-	"gpUxzKUwd9eVu75qjZFbQzPiPUVwO4WcmK3xaDDC+21QHD4WliI34s5WWjGNf3JtQzIzv+/U+dsgsRC3",
-	"w8SFzyeHOftywV+CJ8v9DuX0CccpcSbksNv3emRjRokTzLVoZeN+2nE34LFG4aWkpQXQfbGXKOP49LKN",
-	"LKw35KY7MroozMEZDmgNobr2Wdt6HqKQICl0YHiei/T8Fs77zIzTP3Y4PFkCzUCSjGoanCt3XuKXNXb8",
-	"CfshRwAZkeh/xv/QnJjPhvANX7TDmpc6Q/oVgV49Mw9cKzbbmUwDfHgLUtg3LTFv0StB+aKZvMcjLFp2",
-	"4REv7TOaYA+/CLP0Rkl2OBPyevTSIQROGtUfoWbU4LiMOzuLTasycfiJqA9sg85AjbWlL0WGGOoOH8NV",
-	"Cwsnmn4GLCgz6m1goT3QbWNBFCXL4RbO65KqZX8R5j33+BE5+enw6cNHvz16+p15kJRSLCQtyGytQZH7",
-	"TowmSq9zeNBfGcqzVa7jo3/3xCuM2uPGxlGikikUtOwPZRVR9tKyzYhp18daG8246hrAXY7lKRj2YtFO",
-	"rI7VgHbElLkTi9mtbMYQwrJmlow4SDLYSkxXXV4zzTpcolzL6jYeHyClkBFVCB4xLVKRJxcgFRMRrfZb",
-	"14K4Fl4gKbu/W2jJJVXEzI1auopnICcxytIrjqAxDYXadqHaoU9XvMGNG5BKSdc99Nv1Rlbn5t1lX9rI",
-	"90ofRUqQiV5xksGsWrRk17kUBaEkw454cbwRGZh3R6VugVs2gzXAmI0IQaAzUWlCCRcZ4COlUnE+OmDi",
-	"Qt06mgR0yJr10t7TMzACcUqrxVKTqiSo8O5tbdMxoandlATvVDWgEaxVubaVnc6aT3IJNDOCMnAiZk7t",
-	"5hSCuEiK2nrtOZHj4pGnQwuuUooUlDIPHCu2bgXNt7O7rDfgCQFHgOtZiBJkTuU1gdVC03wLoNgmBm4t",
-	"djldZR/q3abftIHdycNtpNK8cSwVGBnPnO4cNAyhcEecXIBEnd1n3T8/yXW3ryoHLOpOUjllBT6VOOVC",
-	"QSp4pqKD5VTpZNuxNY1a4pRZQXBSYicVBx54rr+iSlvNLeMZitaW3eA89h1vphgGePBGMSP/zV8m/bFT",
-	"wye5qlR9s6iqLIXUkMXWwGG1Ya43sKrnEvNg7Pr60oJUCraNPISlYHyHLLsSiyCqaz2HM230F4faAHMP",
-	"rKOobAHRIGITICe+VYDd0Ko4AIh5h9U9kXCY6lBObcocj5QWZWnOn04qXvcbQtOJbX2of2na9omL6oav",
-	"ZwLM7NrD5CC/tJi19uQlNTIwjkwKem7uJpRorYq5D7M5jIliPIVkE+WbY3liWoVHYMshHXhMOI+VYLbO",
-	"4ejQb5ToBolgyy4MLXjgZfOWSs1SVqIk8VdY37papDtBVENCMtCUGWk7+IAMHHlv3Z9Ym0F3zOsJWjsJ",
-	"oX3we1JoZDk5U3hhtIE/hzWqSt9aY/RpYMK+BUkxMqo53ZQTBNSbuMyFHDaBFU11vjbXnF7CmlyCBKKq",
-	"WcG0tt4FbUFSizIJB4g+8DfM6FQs1pDrd2AXnc8JDhUsr78V45EVWzbDd9oRXFrocAJTKUS+gyq6h4wo",
-	"BDupqkkpzK4z58ziPR48JbWAdEIM6tdq5nlPtdCMKyD/LSqSUo4CWKWhvhGERDaL16+ZwVxg9ZxOKd1g",
-	"CHIowMqV+GVvr7vwvT2350yROVx6DzDTsIuOvT18Jb0VSrcO1y28eM1xO47wdtR8mIvCyXBdnjLZ+rR3",
-	"I++yk287g9fqEnOmlHKEa5Z/YwbQOZmrXdYe0siSquX2teO4Oyk1gqFj67b7LoWY35IiLe4BgI8TZ9Q3",
-	"rci84haoSrnnCNq5vEJDzMe1l4f17j4g6AKwpF4b5/589PS70bgx3dffzZ1sv76PSJQsW8UcNDJYxfbE",
-	"HTF8Td0zT4+1gqhVDBmzmEd8tECe525lHdZBCjBnWi1ZaYZs/EnWGlq+qP/3/n8c/HqY/A9Nft9Pnv3b",
-	"9P3HJ58e7PV+fPTp++//X/unx5++f/Af/xpVK2o2i6s/fzK7JObEsfgVP+bWgDEX0r7H1k7ME/MvD7eW",
-	"ABmUehlz/iwlKGSN1omz1MtmUwE6OpRSigvgY8ImMOmy2GwByiuTcqBzdELEN4XYxShaHwdLb544AqyH",
-	"C9mJj8XoB018SJt4mM2jI1/fgvBiByKyjU//WFf2q5iHnrPuoKi10lD09V22628D0v47Lyv3DpXgOeOQ",
-	"FILDOhoswji8xo+x3va6G+iMgsdQ3+5bogV/B6z2PLts5k3xi7sd8Pe3tWH7Fja/O25H1Rn6DKOqBvKS",
-	"UJLmDBU5gistq1SfcYpPxYBcI+Yk/wAeVh688E3i2oqIMsENdcapMjisH5BRFfgcIlfWDwBeh6CqxQKU",
-	"7gjNc4Az7loxTirONM5VmP1K7IaVINGmM7EtC7omc5qjruN3kILMKt0WI/HSU5rludO7mmmImJ9xqg0P",
-	"Upq8Zvx0hcN5D0JPMxz0pZDnNRbiV9QCOCimkjjf/9F+Rfbvlr90VwHGmdjPnt98ab7vYY853jnIj4/c",
-	"E+v4COXoRuPag/2LqeEKxpMokRm5qGAc/bc7tEXum9eAJ6AHje7W7foZ1ytuCOmC5iwzstN1yKHL4npn",
-	"0Z6ODtW0NqKjVfFrfR9zG1iIpKTpOVqNRwuml9Vskopi6p+W04Won5nTjEIhOH7LprRkU1VCOr14uEXO",
-	"vQG/IhF29Wk8clxH3boixg0cW1B3zlqf6f/Wgtz78eUpmbqdUvesF64dOnCfjGgDnIdQy2BlFm+jyKwb",
-	"8hk/40cwZ5yZ7wdnPKOaTmdUsVRNKwXyOc0pT2GyEOTAOx0dUU3PeI/FDwZ6Bu5epKxmOUvJeXgVN0fT",
-	"Bu/0Rzg7+9UQyNnZ+571o39xuqmiZ9ROkFwyvRSVTlx0QiLhksosArqqvdNxZBtbtGnWMXFjW4p00Q9u",
-	"/DirpmWpus6q/eWXZW6WH5Chcq6YZsuI0kJ6Jmg4o4UG9/eNcE8uSS99aEulQJEPBS1/ZVy/J8lZtb//",
-	"GEjLe/OD4zWGJtcltPRG13Km7eqMcOFWoIKVljQp6QJUdPkaaIm7jxd1gRrKPCfYreU16n0scKhmAR4f",
-	"wxtg4biyBxwu7sT28mGm8SXgJ9xCbGO4U6P4v+5+BX6k196uji9qb5cqvUzM2Y6uShkS9ztTR58tDE/2",
-	"1hjFFtwcAheoNwOSLiE9hwxjhqAo9Xrc6u4Nfu6G86yDKRtbZx3dMAAEVWwzIFWZUScDUL7ueuIr0NqH",
-	"H7yDc1ifiiZ+5Cqu922HcDV0UJFSg8vIEGt4bN0Y3c13xmN0gi1L71eNPoSeLA5quvB9hg+yvSFv4RDH",
-	"iKLlsDyECCojiLDEP4CCayzUjHcj0o8tz4g3M3vzRdQ8nvcT16SR2pwBOFwN+mHb7wVgoK64VGRGFWRE",
-	"uBhT6/QccLFK0QUM6J5CLeeOrsUtzSgOsu3ei950Yt690Hr3TRRk2zgxa45SCpgvhlRQTdgx+/uZrCId",
-	"VzAhmDrCIWyWo5hUexxYpkNlS9tsY+GHQIsTMEjeCBwejDZGQslmSZUPf8UoYX+Wd5IBPqMT/6aYrePA",
-	"Yh2EAtcRWZ7nds9pT2/rIrd8uJaP0QqVtjvEW41Hzokqth2CowCUQQ4Lu3Db2BNKE1DQbJCB4+f5PGcc",
-	"SBIzflOlRMps/HJzzbg5wMjHe4RY3RPZeYQYGQdgo4EIByZvRHg2+eIqQHIXEEH92GhaCv6GuCegdW8y",
-	"Io8oDQtnfMAxzXMA6jwm6vur47eDwxDGx8SwuQuaGzbnlKjNIL0IIhRbO/FCzkT5YEic3aD6sxfLldZk",
-	"r6LrrCaUmTzQcYFuA8SbRYnYFijEl3v61rgaukt3mXrg+h7C1f0g9uhaAHQ0EU16Hvfy2/pCa9/N/Zus",
-	"YenjJpjWe2bGaH+IfqK7NIC/viK4jhZ6272uo4/0tumyHSgVyE8xVmzOSF812lfAKsgBJeKkJUEk5zGF",
-	"uRHsAdntie8WvNwxHIvy9YPAHi5hwZSGRnVlbiWvi/3S5i6K4d9CzIdXp0s5N+t7J0TNo22YoTXfhcv8",
-	"4iu4EBqSOZNKJ6j3iy7BNPpB4YvyB9M0Lii0Le42EwrL4rwBpz2HdZKxvIrTq5v3r0dm2je1EkZVs3NY",
-	"ozgINF2SGWbuifrhbJjaumptXPAru+BX9NbWu9tpME3NxNKQS3uOb+RcdDjvJnYQIcAYcfR3bRClGxgk",
-	"XvxHkOtYxFIgNNjDmZmGk02qx95hyvzYmx5KARTDd5QdKbqW4LW8cRUMvQ/Mc4/pIPFNP2xg4AzQsmTZ",
-	"qqMItKMOPhfplV77PrC4gwXcXTfYFgwESr+YZ6oE1Y4hb6Rbm8KIh2ub7ISZ03akd8gQwqmY8gn4+ogy",
-	"pI1Zorbh6hRo/ldY/820xeWMPo1HN9MbxnDtRtyC67f19kbxjAYxq0dqmQGuiHJallJc0Dxx2tUh0pTi",
-	"wpEmNvfK2C/M6uI6vNOXh6/eOvA/jUdpDlQmtagwuCpsV34zq7Lh6gMHxCf4Mg8eL7NbUTLY/DqMONTI",
-	"Xi7BJVMKpNFe8odG2x4cRaehncft8lv1rc4wYJe4wUAAZW0faHRX1jzQNgnQC8pyrzTy0A7Y0HFxu2UQ",
-	"iXKFcIAbmxYCC1Fyq+ymd7rjp6Ohri08KZxrQ7qnwmY0U0TwrkuWESFRF4WkWlBM3WBVAn3mxKsiMccv",
-	"UTlL4wpGPlOGOLg1HJnGBBsPCKNmxIoN2CF5xYKxTDO1w0O3A2QwRxSZPg3IEO5mwqWirTj7RwWEZcC1",
-	"+STxVHYOKubKcKrm/nVqZIf+XG5gq55uhr+JjBGmLeneeAjEZgEjNFP1wD2qn8x+obU6xvwQ6OOvYO0O",
-	"Z+xdiRss1Y4+HDVbl6Fl29wUZo7t8z9DGDbL2Pa0tf7x6vKnDMwRTUPLVDKX4neIv/PweRxxW/eJWhh6",
-	"Tf4OfBKJ/umymFq702TTbWYf3O4h6SbUQrUt9ANUjzsf2KQwKYZXz1Jut9pmhWz5hcQJJvTlmtrxG4Jx",
-	"MPf833J6OaOxjCFGyDAwHTbWz5YiWQviO3vcO503c7lzJiQwpNZtmQ3oKkE2ESX94OFrCgx22p1FhUYy",
-	"QKoNZYKxNX7lSkSGqfgl5Ta5qOlnj5LrrcAqv0yvSyExHFPFdd4ZpKygeVxyyBD77fDVjC2YTa1ZKQhy",
-	"N7qBbE5iS0Uu/6W1LzeoOZ6T/XGQHdbtRsYumGKzHLDFQ9tiRhVy8loRVXcxywOulwqbP9qh+bLimYRM",
-	"L5VFrBKkFurweVNbbmagLwE42cd2D5+R+2izUuwCHhgsuvt5dPDwGSpd7R/7sQvA5dDdxE0yZCf/6dhJ",
-	"nI7RaGfHMIzbjTqJBhfaxOfDjGvDabJddzlL2NLxuu1nqaCcLiDuJlFsgcn2xd1ERVoHLzyzWXuVlmJN",
-	"mI7PD5oa/jTg82nYnwWDpKIomC6cZUOJwtBTk5jRTuqHsymAXfYgD5f/iAbC0ttHOo/IL6s0tfdbbNVo",
-	"xn1DC2ijdUyojcHNWWO69wm/yLGP5Md0SnUWJYsbM5dZOoo5aMmfk1IyrvFhUel58heSLqmkqWF/kyFw",
-	"k9l3TyIppNpZY/jVAP/ieJegQF7EUS8HyN7LEK4vuc8FTwrDUbIHjY91cCoHLZlxbzHP0bvOgpuH3lUo",
-	"M6Mkg+RWtciNBpz6RoTHNwx4Q1Ks13Mlerzyyr44ZVYyTh60Mjv0y7tXTsoohIzldWmOu5M4JGjJ4AId",
-	"1+KbZMa84V7IfKdduAn0X9fy4EXOQCzzZzn2EHhesTz7WxMz0snCJylPl1G9/8x0/K3Jklwv2Z7jaBqR",
-	"JeUc8uhw9s78zd+tkdv/72LXeQrGd2zbza5nl9tZXAN4G0wPlJ/QoJfp3EwQYrXtRF97XeYLkRGcp8lZ",
-	"0VBZP2FgkEHrHxUoHQvaww/W8wP1O+ZdYBM4EeAZStUT8qOtcrIE0gqpR2mWFVVuw7MhW4B0iseqzAXN",
-	"xsSMc/ry8BWxs9o+NuWnTSC1QGGuvYrOuz5IcLObD6HP3hn3b959nM0Ol2bVSmOGC6VpUcZCV0yLU98A",
-	"42NCXSeKeSF2JuTIStjKy292EkMPcyYLI5nWo1kejzRh/qM1TZcoura4yTDJ7575zFOlChLD13le6xw1",
-	"eO4M3C75mc19NibCvC8umbLFLeAC2tEydeiYezr56Jn28mTFuaWUKI/eFNp4HbR74KxB26tDo5B1EH9F",
-	"wcUmDrxqIrgT7BVN+tDNKtfLCG+jiusUpb5oUUq54CzFlAtBOY0aZFcoYxdbwQ7ZKbrKKH/E3QmNHK5o",
-	"LrvanchhcTC7nWeEDnF9ZWXw1WyqpQ77p8aKDEuqyQK0cpwNsrFPyej0JYwrcDmHsGZKwCeFbNlfkENG",
-	"TXpJrfq9Ihmh7/yAAPyD+fbGPY/QqfSccRSEHNqc/6rVaGAef22kJ6bJQoBy62mH5qtfTZ8JhqdnsHo/",
-	"8Xn/cQxrvjDLtra6/lCH3nLnLGWm7QvTllivw/rnlpuinfSwLN2kwwk7o/KAXvFBBEcsMIlXgQfIrccP",
-	"R9tAbhtN7nifGkKDCzTYQYn3cI8w6uSVnWy9FzSvLEVhC2JdXaLxlYxHwHjFODRVKSIXRBq9EnBj8LwO",
-	"9FOppNqKgDvxtFOgOVrpYgxNaaeivelQnQ1GlOAa/RzD29jk3RxgHHWDRnCjfF0XwzDUHQgTL7AKj0Nk",
-	"P4smSlVOiMrQ7biTVzPGOAzj9pl72xdA/xj0ZSLbXUtqT85VbqKhSLJZlS1AJzTLYsnanuNXgl9JVqHk",
-	"ACtIqzrZVVmSFCO22yHsfWpzE6WCq6rYMJdvcMPpUhGTo9/gBMr7VTeDTwiyX8N6j16+fffyxeHpyyN7",
-	"X5hnuQ0lMzK3hMIwRPOOVRqM6FwpIB9CNH7Afh86C46DGeTTjRBtmNPXEyI61M/W+G8sIdUwATmb+pW9",
-	"urwBHTteWbxvj9QTzs3RSxRbJLtjAq++m6Ojmfp657Hpf6sHMheLNiBfOHPMJmYc7lGMDb8091sYBd7L",
-	"smZvwDpIG32ohE/Nj6/bOrywzTzxxu2lXUPdfZ1lfbP2ZDhf+hjv6AFPyiBfDrVigDUGDflTpoPuv1S7",
-	"KBxNyUZOiUnOYyNYZwybXN3WZYwqwoYcMKz/hfnc672bANt7DuDYGxHqPXv6AP3Vuw2SkjJn6WyYRR+z",
-	"zsG47/K9i+ths8HdRTi3XRwktpJeNsXNFNJz2w5CD2zSu8nu4f+HtRkZjVuYsnwB3OUsbztk7uwWNp9D",
-	"qtnFFjf5/zRPi8YFe+wfH7YgRuA1z2o3I1++84pvogagTV7sG+EJcozcGJwhJ9lzWN9TpEUN0Sx8Y0+o",
-	"14kuRQxg/pXEkIhQMTON1ZY4zTlTNWUgFrxZ1HaHJvXVYPrjIOjjmnN5kiQ0DATZMOWFiD23dprLdL1S",
-	"eBR6zAx50vcTkA7fXkeY71XVqevr+pyBKGpe1d3seJcuuhWDGmoFoY9zBeV/8xFMdhZb97VJ0Izq2Esq",
-	"M98i+r7wT5dkwDet6+1tnepZHOh5PTNrnFj6Ds+RrBDoqpTmQjG+SIb8vdp+I2HpKLSOoSYHM7siXHOQ",
-	"LjG79mV1Ey2808smODahwpU5ug4S1GCOQwvcYHz0uyYAHFNhUVtU2Vn+wgWaxwY10MkgTHt4zk3IfmG/",
-	"ew9fnwpph2eUo9dka5y1d19iqofEkOrnxN2W2z2Hr/NUYZzbuhcqFrPNDSpDlV8pRVal9oIOD0bzMNw1",
-	"I8IGVhKV8tP+KnsCW475QV4FcRjnsJ5aoSldUt4kamkfa5u60a4hiHvs7PatvuLiAmu+sAtY3AqcX/Ml",
-	"NB6VQuTJgI7vuB963j0D5yw9h4yYu8Mb/gdSIJP7qFqqjTiXy7UPtS5L4JA9mBBi3lJFqdfentNOutaZ",
-	"nN/Tm+Zf4axZZbNBuEfa5IzHfVZsmfIb8jc/zGaupsAwvxtOZQfZEtu9Ggh7l/QykhB815pvEQtLN0lz",
-	"Q1QWipiUcs1Av53Od/+hFiH9MERjy/vnvPWqs2mFOlYVIeGWX3eBOvmKr7t+8Mmuy8N1IFerFPTXufMG",
-	"tHA7gPtdEN+oJvrIHdYo6NkuGoV4ChTTHVUaFiGYP4ggqOTDww9EwhzzCQqyt4cT7O2NXdMPj9qfzetr",
-	"by96Mr+YMqNVWs7NG6OYvw1Z4a2lecDho7MfFcuzbYTRct9pcnuig8pvztHpq2QX/c0+kftH1SVavIoa",
-	"tbsJiJjIWluTB1MFjjk7+OS4bpNo8T8FaSWZXmP8lX9Rsd+ice0/1koYV6+09th3DuNanEMdwdeobJpi",
-	"7j8KWyywMHc9KrE1Vj94uaJFmYM7KN/fm/07PP7Lk2z/8cN/n/1l/+l+Ck+ePtvfp8+e0IfPHj+ER395",
-	"+mQfHs6/ezZ7lD168mj25NGT754+Sx8/eTh78t2zf7/nK6lbQJsq5f+FKXiTw7fHyakBtsEJLVld9MSQ",
-	"sU/nSVM8ieZNko8O/E//25+wSSqKZnj/68g5E46WWpfqYDq9vLychF2mC3yjJVpU6XLq5+kXm3h7XDs6",
-	"2QAV3FHrw2JIATfVkcIhfnv38uSUHL49njQEMzoY7U/2Jw8xa3YJnJZsdDB6jD/h6Vnivk8dsY0OPn4a",
-	"j6ZLoDmmUjd/FKAlS/0ndUkXC5ATl9fU/HTxaOr9JKYf3fv0kxl1EYtMsy5bYT3mXrpPp+tCu5d1yWql",
-	"z1Ium9O4TqrmxEeeoSeNffIZ1lYj6zhrEqgcB0V+XRiZjas/+DWSZnrOFpXslGmqtfku4yJTxNbclOS1",
-	"1bm/pel56K0Sq5rvWFmsaL7zaSnUomwbgBtNf6ygSyxvKs5s9jmg1FpV1HAiLSsIIWn4quGV+8mz9x+f",
-	"/uXTaAdAUG/pygZ/oHn+wdbRghUqf9oVvNV4qFz8uFE9dIp0j9GCXX8N83nWbdp+Ux+44PBhaBscYNF9",
-	"oHluGgoOsT14jw7tSAl4iB7t73+GQt/j1iieJL5qxfAnt7jQtgXtxsvtDtdb9HOaYY5FUNou5eE3u5Rj",
-	"jqYDw/GJvdE+jUdPv+G9OeaG59CcYMsgWqx/i/zCz7m45L6lkWaqoqByjbJKkAg2lEo/Dd5W0zBp3fRj",
-	"S7Gc3egu6+XrPD7acr3dU0NMsZ9GoZMTz3yvs76h6tEl/oMVU1o9mJAfw97ImDEqwfr8V5I3laVKKS5Y",
-	"Zliss8n54M0GtnsqDNiIXrbBa/3u3v2s9+5hW+vQisOPAdMi8Y0w9SxPN734+l5inZTm10oZHmTfu0YO",
-	"o8+aV7Vbg3yo2OMODPYOd0OFMgfEmwDeWtJpZ038/HzXvt+Ca6J1H3xGrvyNC2uvaW7oJFhux2PdJqe4",
-	"E+L+aYS42hnBVibBfEybxDpMujr96HOJ3IIo53Kp7CDEhS/doG+Q6+J+h1M8mNjEIGGb67ED51iwVTzD",
-	"DC93gtnnFsz6qZFiYDQJb76eMIYwLJvcSVcpB9JKdXylHE/fqPT1T4ysQXHLQLpd0LoGb+wJUY4Tfzae",
-	"+acUnhzS7sSmf2qxyfrybRCcWnnLnOPnsOwEQZXyoPJLy/FstvZ0OCZKSOf+VEomJNPrMWGcZGDOHloM",
-	"hcQQ7abeuXMyAo7/fX34X+h6+vrwv8j3ZH9ci2AYwRaZ3jr3tGWgH0FH6vE/Xx/W4sBGWegPI2Cc1kga",
-	"qJevhU89hkgr6Or7IZStrF0xJp4VdDXaKImMvx1p8aZCUyf2tE9FruSorbfvyuS0XaoUgRVNdb4mFO+f",
-	"tfX9xUrsPm9Yp3Z8p55/NN5ow4y+CkcsauyqXl2RAH+sdrEZvtNOjqUWOlx+Pix5s10w6SEjCsH1pLy7",
-	"3f1md7cvlpJSmDPNMIFEc5/4u6oFZFOLwYE74LA6If8tKnR2saXGIJb8FGdA514/pxNAg+zFORZ6q7Gz",
-	"t9dd+N6e23OmyBwukYNSjg276Njb+xOIrKs65yQlXPCEYyWsCyCBh9yd3PqHlluf7j/+ZldzAvKCpUBO",
-	"oSiFpJLla/ILr5P03Ewsr3lOxYO0SRv5T89TvpGiA/H9Rrbrrm2a6UYybAVOBSqEumCheyuPm4oH5i2P",
-	"yVV8wLoae9MJOv5Zq4rdj3HPsDKJCemBBef5+vhoF7n8GzGE7pzkK3Kvxffmc98AUX+ad1/Gn2Y3Zvpk",
-	"/8mXgyDchTdCkx9QXfaZWfpn1R3EySpgNle2qDQWk5C1uEDEjUzFnNCxS8yKmULXpA4UMvzEMkJbmqHP",
-	"NcwMu/KLP7B+foeixBG67KL3ji/c8YUb8YUuQTUcAcPt1fQjmgpCdtA7ks9Nyz+RiTGwt0hReIOLIHPQ",
-	"6dKmIeiGxUTYis8VOMxTNmXUv2X7HwIdSVWFa3GhH5jpfceAQOz4k43E+DQepSAjxPezz4djPrM5hnXW",
-	"eSB94Qg05zCfS7lOo+ySzTPlfc5d1htidvFKUL5oJu+H6SBabsNmeIfgqyG4x9ReuqzW9ni5RfwZvNJ9",
-	"yuOEvEFxCA+4T4P4Z1R7fM4b+XMv6I3gYO3SRmK1tHhngqzFBaw9g0jxWRCs4dGVs42LDm2j40e9Ytmn",
-	"aZ2mZ0ioeIsNtggVzU3NmoqfbfUKLUugUl37kt5uDjvtzHh8FPpptLIK1fmEIqAYvFzRkvhvox2lGQz4",
-	"EXOypGpJ5hW3gNa1pdBlxTtRiPm4Vtaa0yDmB+SM7xG1pE8fPvrt0dPv/J+Pnn43II+ZeVz8cV8iawYy",
-	"n+0wu4hlf16zY1uUqJF38KW38mo7NB6xbBVNIQIrnwkpPBdO94nM4Z4iJV0PZh4aSOL1GuR57suzt408",
-	"pABzoaolK79GuXk2i1dc+snskpiTOg/6MX9e888LkGyOZcNqvvCFM8NIgAxKvdyYksFWPSv1stlUcHU5",
-	"mXKpb0opLoCPCZvApGsMyxZNSuEc6LxOnSLELq5qAS8x9OaJI8B6uJBdRM23MfrBcEiXYu5LK1Ualy57",
-	"mXnkyc698lU1LvqraFzeCJ6gPAZc+7dBCy1fT/uC2W7GgYKzrizBhUbFppAoRoZsS012EsBg0NjU4oHW",
-	"dXKQjJ04llKdLqty+hH/g5kHPjUx/raMytQqYjdJZCe2xa262NgxiWxzG5/swimHxZy8ZqkUh5gVyV0j",
-	"aq00FP0im7brb5sKdESvHMFzxiEpBI/lyfgZv77Gj9G8S2i2H+iMDhRDfbulkVrwd8Bqz7MLq7spfid/",
-	"DCXvjR4sndVKKGs3RfTnQPpvTksr8W1zTFo/Tz+2/nT2EtdSLSudicugr81rsfFs2Ra3erbeiAzsuO1U",
-	"MjH/US4ycOk3+keq5hpxidTjt2nXEQ5SWi2W2haJjFagrTsmNLVHweaOVduSbdpWPqncBRCaS6DZmswA",
-	"OBEzs+h20mJCVV31F4nD8sZ4zsgGrlKKFJSCLAmrQ20CrU5qgpKP3oAnBBwBrmchSpA5ldcE1jKJzYB2",
-	"yyLW4NaaQscH+lDvNv2mDexOHm4jlUA8Q8QXjSjKHNybJoLCHXGCsjb7zPvnJ7nu9lUlFiCKZD21X09Z",
-	"gXk7OOVCQSp4poZzE287tpiNOFiLAltz15+UaF0XM/DA1fqKKu3qX7VSOAY5rc0UG5IpDyUkMyP/rU5H",
-	"1hs7NfySq0o1pcGs7AVZtOoqrDbM9QZW9VxiHoxdC3e2IvS2kYewFIxfFwsLsiPrQItlhossDoNgqBPF",
-	"+qhsAdEgYhMgJ75VgN1QwzIACFMNouuUp23KCao1Ky3K0pw/nVS87jeEphPb+lD/0rTtE5cLHkC+nglQ",
-	"oeDtIL+0mLV1AJdUEQcHKei5k9kXzoe/D7M5jIliPHUp3Yfis1gBJ6ZVeAS2HNKu2Bce/9Y56xyODv1G",
-	"iW6QCLbswtCCY4LmH0IsvOq7r6u3+4yq8ragHYhXjaBp/55eUqaTuZAuXT5Wmo9Y3TvZuCjTyj3/7KtY",
-	"C6fqdrXqLUNx4wRVMFXoAG1B8EE4Zvf7Pjdmqh+E3MnI3+jjtSBmYaTimvlIanPeahnzj2cxv5Oe76Tn",
-	"O+n5Tnq+k57vpOc76flOev7c0vPX8dolSeL5tDcNxwKyyOiblPC/oZinLxmk1Aj9tciPjwQjoptzvNGb",
-	"RwPNp672NHorRCuS2rCAsI51aqZjnJQ5NdIQrLQPTiczquC7J94no66BadP3G15jGjx+RE5+OvSOCktn",
-	"SW+3ve8ryym9zuGB83qs82t790fgFCtuovcj9a+f1DmUWGF+znIgyuDqJbY+ggvIjSRvjZ/EvEX6r6NT",
-	"oPkLh5stj6NWBmUz2odx603m0FbQ0os8fq1UEYpOLZ0EyHOaq+EMyHa8gpax8P6aT9tnE7KG5yJbd8jd",
-	"7NoUN7BN6I2fAuNURmpA98m7RxpaYB14V9S89+77dOtONX2i7ZPZNgqLl5KJ12reROXDpcTNhvWGsh5N",
-	"8w6dRNP/d30nRjWAuxgMDT37PSGuCPVXva0IQuSOWMOZ/zCBJ93aeo5pYFsjUDnW860GiXjER08vnv2x",
-	"rz1GmFbEUdwqMY0WwBPHW5KZyNZJizO1L5imJO/WSyZkjXiY6nulrnA/eAV9nRsiKPs82sRuQ3pYJY63",
-	"DjBe6yC2G9utsYUjOs4bYPxzc98hDhmCQBzrib2du9nLrsjPgnLPdzztjqcFp7Fz2TPufBO7TGRyPZ6G",
-	"FdKH2dlLWzBQkfCQ3lcPDMtCjK50S3OfwaxaLGyVvK4WGrNo1cUevw6Xs8vdlcFdjTjs4HXo6U2jJrrD",
-	"9RlH4FR3X0iykKIqH9ichnyNCs6ipHztjRrm5V9UuSt+i5Fet8tD64qNPbnRK9eG9XJvvfot0D65W7T9",
-	"u0UL1nm0+wsZqXgGMl5ObdUpkrUd46cr3nDgjSW0fDHB3urcvLtwf7/LLkKgNuSUtrSqPVCtw+T8lO3J",
-	"ndyFV/9z3Ahvbe7QAQbb97JtGML2i0EGLAtvhk6yLX81tPnpO3oZpu66LaFx99f6EvBOrF+vkcxkRoyU",
-	"gmYpVajU4KAvhTz/zLKkXh1HtMgIJmaY7AeemDfJZKtQiePuJFK2Y738q7yaFUzZqnxfV7hsogkOXcBu",
-	"Cxt3it0/i2L3uT98ilAs8Ns5nNaGg2dyBzZFL/WKR7nUtLQZqof8l4MD4XJZ36onRm/4tkNGkB/aGpQh",
-	"Lwklac7Q3Cy40rJK9RmnaNDqVD/uOGt4M92wKPXCN4nbVCMmTzfUGTdC1ZzUZq6oSDWHiAH7BwAvsalq",
-	"sQClO5x4DnDGXSvGScWZxrmwmHRi/frNdW04+sS2LOiazGmOFtnfQQoyM4+IMGsZmoeUZnnuvEPMNETM",
-	"zzjVJAfD9F8zI9CZ4bwFofZ4snRXY2GgSL4tT5nEtbM/2q8YQ+eW760AaKywn320y/jrFJFNWDYI+fGR",
-	"yyh6fIRJ4hq/kB7sX8xZoGA8iRKZufGdf1WXtsh9I+N5AnrQeJi4XT/jRpjWgiCjp/p65NA16vbOoj0d",
-	"HappbUTH9uvX+j6WzWIhEvNkpAvz+4LpZTXDMq4+y8V0IeqMF9OMQiE4fsumtGRTVUI6vXi4RT64Ab8i",
-	"EXZ1d3P/eUyyIR2Y01JvPFZO6O79wL18Cwnc/9hZ27c6nN7lSL/LkX6XRfsuR/rd7t7lSL/LIH6XQfyf",
-	"NYP4ZKOE6LJubc3pq3uqTUokpHbmmoGHzVrZf/tWSaYnhJwuDf+n5g6AC5A0JylVVjDi1u+5YIulJqpK",
-	"U4Ds4IwnLUhSUbiJ7zf/tc/cs2p//zGQ/QfdPlZvEXDefl8UVfETmprI9+RsdDbqjSShEBfgcoFi86xC",
-	"9xfba+uw/6se92fZ27qCrq1yZUnLEsy1pqr5nKXMojwX5jGwEB1vbS7wC0gDnM17RJi2adcRn+jl7nxi",
-	"qMsmEhO6+/f7FYpGHnaz03zRtGZ/XgF7E5/qb9jt8cCNY/cY4h3L+BIs46szjT9RBta7ZKt/sAWFhtRW",
-	"NvUbSFJ1GdGI3snLSFadbHgzjgBpJZle4w1HS/bbOZj/vzd8XIG88JdfJfPRwWipdXkwnWK9k6VQejoy",
-	"V1PzTXU+mvuBLuwI7nIpJbvAXMnvP/3/AAAA//+MsCXg4BkBAA==",
+	"H4sIAAAAAAACA+19a3fbRpLoX8HV7Dm2tQQlP5Kd+J7sXvmVeMdOfCxlZndj3xgkmiTGJIDBQxST6/9+",
+	"69EvAN0gKFFK7NEnWwTQXV1dXVVdz98Optkqz1KRVuXB498O8qiIVqISBf0VTadZnVZhEuNfsSinRZJX",
+	"SZYePFbPgrIqknR+MDpI8Nc8qhbw/xQGMe/g96ODQvyjTgoBQ1VFLUYH5XQhVhEOXG1yfFuPdBHOs1AO",
+	"ccJDvHx28KnnQRTHhSjLLpQ/pstNkKTTZR2LoCqitIym+KgM1km1CKpFUgbyY3gtAEQE2Qx+brwczBKx",
+	"jMuxWuQ/alFsrFXKyf1L+mRADItsKbpwPs1WkwQml1AJDZTekKDKgljM6KVFVAU4A8KqXoTHpYiK6SKY",
+	"ZcUWUBkIG16R1quDxz8flCKNRUG7NRXJOf13VgjxqwirqJiL6uD9yLW4GUAYVsnKsbSXEvswcb2sAN0z",
+	"Wg2scQ4TpAF+NQ5e12UVTGDdafD2xdPg4cOH3+BCVlFViVgSmXdVZnZ7Tfw5PI+jSqjHXVqLlvMM9joO",
+	"9fsAAM1/Khc49K2oLIX7sJzgkwBo1bMA9aGDhJK0EnPahwb14xeOQ2F+ngiAVAzcE355r5tiz/+77so0",
+	"qqaLPAM8OvYloKcBP3byMOvzPh6mAWi8nyOmChz05+Pwm/e/3R/dP/70p59Pwv+Rf3718NPA5T/V427B",
+	"gPPFaV0UIp1uwnkhIjotiyjt4uOtpIdykdXLOFhE57T50YpYvfw2wG+ZdZ5HyxrpJJkW2QlAAqdbkhGw",
+	"qgiGCtTEQZ0ukU3haJLaAxggL7LzJBbxCLnvepHAXkyjkoeg94AjLpdIg3UpYh+tuVfXc5g+2ShBuC6F",
+	"D1rQHxcZZl1bMCEuiBuE02VWwpHMtognJXGA6gJboBhZVe4mrIIzWCBNjg9Y2BLuUqTpJUjwivYVpoPf",
+	"AyWaAE2zYJPVwZo2Z5l8pO/lahBrqwCRRpvTkKN4eH3o6yDDgbxJBssFvCLy1LnroiydJfMalgsoEAAM",
+	"yzz4G9QtWGk2+buYVrjt/3n64w9BVgSvATPRXLyJph8D2MAs9u+xnNQlwf9eZrjhq3Kew0Bucb1MVokD",
+	"5NfRRbKqVwGMNAFwYb+UfACcFaKqi9QHEI+4hc5W0UV30rOiTqe0uWbahqKGpJSU+TLajIOXswAG+fZ4",
+	"JMEBcoADkYPSAksLqovUq6Th3NvBAzqu03iADlPhhllSs8zFNAHKjQM9Sg8kcppt8CTpbvAYzcoCRw3i",
+	"BUfPsgWcVFw4aAaPLj6BAzYXFsmMg58k56KnVfYRtArF4ILJhh7lhThPsrrUH3lgpKn71es0A20Cxpsl",
+	"Dho7lehA7sHvSPa6kgrONEurCLhVjJyXgIbhmBN5YbIm7L/MdEX0BLj61498Atw8Hbj78GVr13t3fNBu",
+	"00shH0mHXMSn8sC61abG9wMuf/bcZTIP+efORibzMxQls2RJYubvuH8KDXVJTKCBCCV4YMg0Ao4hHr9L",
+	"D/GvIATtCNAeFTH+suKfXsNACUyCPy35p1fZPJnCTx5kalidtyn6bMX/4HhudlxdOC8Nr7LsY53bC5o2",
+	"bqVwiF4+820yj7krYZ7oq6x9qzi7UDeNXb8AKNRGeoD04i6P8MWPYlMIhDaazuifixnRUzQrfsV/8nzp",
+	"wikSsBS0ZBSQxoITeD0BYQPYeysf41M8/YKvB5F544gkKfxmYAP+lYuiSnhQeDdcZtNoGZYVCDD86V+A",
+	"HwAcfzoyVpUj/rw8siZ/hV+d0keoiLJyE8J4O4zxBhWasodLIGemR8QfmN+RKpSkvHtIQwny3qU4j9Jq",
+	"bC4iDUagT+7PciaDb9ZhGN+ti5UX4QG/OBEl67X84h1gzebdgNAaEFpJzZwvs4n+4S6MajBIz+EXxgfp",
+	"hCIhdUtcJGVV3qPlR+YI2fPA+Qm+s8cmBTtDo9FESB0DhcJMiispvrTFSK7BjAjroO1EEwwgRaEBlfd9",
+	"UBxdFhbZEtWdrbSCL38v37XJDH8f9PHnQWI2bv3ERdcniTm+udAv1pXlbotyuoQjjTjj4KT97eXIBkdx",
+	"E8ylaKV3P3ncHjxqFK6LKGcA5RMWoqAYRfr2wrBekZsOZHROmK0zbNEaQXXps7b1PDghIVJowfAE+NfH",
+	"PZz3CY7TPXY0fLAQUQy0GkdVZJ0reV7cwpo+/J6+I44AMzlM5vQf4Ij4GAkf+SIPizf1hOg3s+zqMV5w",
+	"WW3mmfAFunhnwYrvtAHeRXeC8qmZvMMjGC1DeMRzvkYH9IVaBC7dGMlOJllxOXppEUIaGNNfEOGo1nEZ",
+	"tXaWXq3zUOLHYT7gF1oDGW9LV4u0MdQe3oWrBhZAlF4DFkocdR9YaA60byzAcU+WYg/ndRGVi+4i8D73",
+	"8EFw+v3JV/cf/PLgq6/xQgIfzkF8gBJfgbC6K9VoWNlmKe51V0b6LFxO3KN//UgZjJrjusYps7qYAvR5",
+	"dyg2RLHQ4tcCfK+LtSaaadUawCHH8kwge2G0B2xjRdCeJSXKxNVkL5vhQ1hsZokDCUksthLTrssz02zs",
+	"JRabot7H5UMURVY4TCF0xOB67DhMb/iB2uEsx3XD4kFJdjk956CK5SM2uc5/TfIcqQsHD+QM4+ApfYuW",
+	"2klBBp44W9O9lMceKSpaJin+UcNbdUX/T9IUZJetik/RkoeKmQUDcgxjFgGKbrhU/u/d/3iMrpQo/PU4",
+	"/OZfj97/9ujTvcPOjw8+ffvt/2v+9PDTt/f+419cpwOWVmXTbBmeg1qXZKkTi/RGIN9Q2lze/p23OlgD",
+	"AnHjyMRZo3d17JoYbZd0Ta7EqtymjfDQZxepISw5YFQUcG9u0y4Ti2N1ct4hRN2kXGUxK4Mc3S0XsPVi",
+	"Us8biv+syFZAOzF9SFL3hRDPyyqB5/s44LDW5Bxu34W8fHfvJhGsE7WPMvnV5deXG5fBpkXxKiGnJowv",
+	"rzTZuTbZTwE61iFKeSDgNfxR2jzxLVYxcKZx8LJCL4QQcRncPz6W6pE9L4EO6lJUan8aDw/HIKVrCrkv",
+	"XPelEas/IZzAZf+q+2AkD5IywzYW2DcnKH9wUdl4rMCW18Aaz5oYQeYjjlDy7gUFXbtFoeFmzLvBiMUM",
+	"aBkusTPh2fJlthZlFcBzbRZdL9A5JdkZXGNW6KBDtRM2kxxafHFNKiBqdCQBhbKXDcktQzzhW9IwjMcK",
+	"Jmi4K4heynHwN9znhNTfiQBAEIpRh68SvRUCzxkjhyAD1gDTwtf4G8gaooEZ8MQSuaj1HkhbVKwBPOCn",
+	"kmijORxFOQK8mW5HXojHljiqE4uIPjwJ+EYHZ7Bie6gtkzFytpIMvWUtE3AoLuDmjcuD5a6jpFL+lonA",
+	"O7zZHIn1PNqUAwBTo94cYHgb0ltVJPMFXA/W5M2qQL/aSFaxjbqci/Eeg6H07yROYoalBDXN1gRpQl6m",
+	"pQCWRV+tkpSchTgTQCngzG4aQ0meQ/N6ob8KHXoOFok9G3rn3EtYR9hj1sLnUhCUQqSKVaag1rgHRD+a",
+	"dzsUshpBZbC2u2lW6QXeU8DH0vJkv81HHhhmon1U7OivtObhhks6R0PmxtvIfVYj62PGrdyqcro2qgcY",
+	"8xoSuiG42hKlxdp9zKrLV7oHmk9Fi7oaG242q4OeIZrQWwEq2QoD5WJF/VFXcyaF5ztRnW7SKbnL9nGl",
+	"8dOrorASprNssrhxcM+ZuwXqpW2vbTVQOd54qjulAxxExyt6TGb7Z2JZRftQAtlG6SBsab3E8AuMLMF7",
+	"MhnLgimoW3Pj6jW+UqV6DzFSaYNqW/Ump5QDnKc0K8lu+9pDRmSOpbGcHuXu8OQ5YJOuW06Y0Ey5BSpj",
+	"1GZ4pLX8ErBIE6wXGrJ4R3ABd22bQU5pDPXSSo7KYQFCfimqq27gUwWDC8Aqq6Kli1nS73TiUZVVFBax",
+	"SBR4a5g1YdqBlnjwLhNVFK7BGnJCn6r7mTmW0sUV48ljpiWhhI9/ALmG57Iu92AENIOZayKCYV8Oo0lW",
+	"gx5EApUAq0u3edATuXlmCUDL4lgtIql/I0uaRjUqAehEz1yXbvNhGE0Z4wPlpL7bwHQcFbgEeorR/wPK",
+	"QjaR0SSW1oD3xhytmJJCpHHSKbMtuAAjUwEnIA79nK4JmqZKun9XPXgiwAlgPQveNWZRcUlgiT63AErv",
+	"uMDV3oSWetMIqB0wfd8Gtie3txHNWFoBA36IdhfkMz4UDsQJ6MUUinKt+6cmuez21bknUFwa4M/gIe5L",
+	"GqUgS+FQx6Vfq952bEm1tr0ELf3adVL71PVX8IwDkhLQ5dBjJLleU4X3A+y19eHIf1Vmvu7YU+STaQls",
+	"Ttn8yjrPswJkk2sNGMXmn+sHeKrmgm0zY2vDItBkXYptI/uwZI0vkVUaSYUSTLnvZcRed3Hk5EY5sHGi",
+	"sgGEQUQfIKfqLQu7drCsBxC6UKsviXDglybl6AjdEaApQ9t1GFVhnervfGg65bdPqp/Mu13ikgKf+Hqc",
+	"CZy9UjBJyNfKdECqVISuHRoZrvwfpZlgLiOnujDjYQxL4Igi7L2owmun+JZ9BLYcUo+PTCZiNG5JjcPR",
+	"ol8n0XmJYMsu+Bbscdi9AdaWTJOcNIm/iM3evf3tCZyOf1CkQMqjE8l6wHpVbn8fcChce8zLKVqD3ANd",
+	"8Dv+AcdylnAVJ89kA/iPACmBL0TxBK64+4hqiFKPEMQnSMFiNoPdRsOnPuKUfNZQ8nsxwNBudYwQKINc",
+	"eugoEGioaYA4VqjZB15wfA9iYMSUTY700igA5XmekYrLT+i2NEuKErAmIrRmsxKVFTHz0g3b2EWJd56k",
+	"XIh4J2SyOr8Vn7yEoQhtrUoik+wxZ5a3ZA83EseoZNBMA1qHihAWcdNNIy7gf8sNqlMGhdqW241nAB4X",
+	"2gM44yN6ZpQRKrvfuE9pKGt53kvtFvjOWgpyAx2SpgYa/zrIcEIwKNIPpsRdT2QukEoYURyrAaRUlik8",
+	"SQvpO2XXfBn8d1aTuw1JvkYnlNQ8soLEOal5OAMqSnpOGdNnMCSWYiX4/kJPDg/bCz88lHsOA83EWjn8",
+	"8MU2Og4P5SEAAVrikm+At8ioRKGDAHI9u+I2zIddVGk5sPfHF9oAME6ysmoItn1gBcZ76dCrKJgGuavC",
+	"SEuej7dGi8iRh6z4TWtwHYGDfKYs5WHG5V+ZKba41cWQtdvnBiN9tq+dxh2019bQrnXzvhdZNttTbJY7",
+	"qYQMAzJPBN8KZnDYCShykqMpgGyPKswjm4104hAXDHgcUFbJIlIBXvJP+C86KFQ2iH6O+jA/fe+4zSXx",
+	"hSvnJxYXrj2RbIcsGXdK8oKKyuMKQtgdaX+i+LiUK2ux02AlkM+ViyS/+Vicskom7oi673GXAFIp9i7S",
+	"lynHxKLLg2whG3nFymY3D3dVCBGLvFq48olz4LUkLjgvGN4ymypEy36JkeMiHQXJWIzbYidGs70MsYFr",
+	"44zyWuk+nw2Js9fHgelNEYeFdXshg/iYi34oapxokw7zabKql3uK/snzcFIDFqoQJJjL0MC6jAxz41fh",
+	"8o2W2HO4t6HpX+GsHYdWNpzXI2maZF+oK27N4523QEQjRr0aCqV6W1nFrgk+cSGmqH5MXX6Y5/CwZtcz",
+	"vaGm3A6LDJSgq8eIoyBMXIMucGImN4kUg68kCNwZfuvSc2d0Kw9dSdnbuChDTodwGjH3R4nEVCvd8Dj8",
+	"SOmB+EddCNIjsVBGBVxgUsvYkCjAjMSlaIc0OiIheBh/IPQzndeyXmxsoGAmBpqT4Cn95O90qSIYk4of",
+	"jHc1qprUnmQFlJjATKBS5xjaxbnWaHMziBkHnIw1Vf7EBXw8l9lAPA5dnpRExcjI9hBOzFQXaSgTPP3l",
+	"GZTmoFFi69RtkqQscrPZjDfeVPR4lt0oQYGVHWhcK+MbvyA1CcNZZ9kSA8/0gVUPbRLTlL/M5iUFeqlY",
+	"bSFjg+To8uMd7ufty61mr47TQesNy3qKQUfOE+IwxHaiGwy5RTBQXlm+LlGts8JO9rCrJdgiqGFrtPe5",
+	"DeTAiASssOGOxWhQGYqhGq3Ye7Ar8EBw5BpiXflrSn4KMFk1QSSnKTfADlddlyd/+ovnVL5V2OqckyzF",
+	"IOtwBbSxcZbBgqev6aHzlNFN1PMxSSjft21zcgP+FljNeYbs6lXxS7ttHYw3OmVvD5vfHrfl7baroZC3",
+	"TixzIM7pMiFfHkxeFfW0epdG5C1oyYgWWSgfiN9/9FS94nZYOfxJcigAgDio9iE4ZYUztO0FaKzSjVTW",
+	"8zlL+FaM27tUvkURqglzawrjC3nDVPjbmN/EiEiMe0Up8asoMtCJqiY7prsXB+my611GYcFCVIDi6wSj",
+	"419YQZCKZiR72hI0NxepKJMydF8/vuOndAuRy1/IGwnJFX6s1N6bvn4o2F0lBSTkcNlm6yf8B01cxune",
+	"gf3GPLF7j5+Eid+lmJkAhERxk6ifXIYc2iyucxZVxGGDahob4Qk/fO+KD5pnIWZLkhp4MAeloZ6MQeof",
+	"KavvEbyg/x9HArgpPYuPojw5wmzlo/P7W8wtV+BXgYNdtZgsyHvMqcvKZE92urgg56GHNjKqATJlo698",
+	"leU+OkqWMeUDoGtksEbVWswzHvM7HNJ54RCCA1lRm3NYK9QjxSDsqGZjdJU1jBqaK+mMebaWxvg1csPp",
+	"R7ghTpCSogLwMw6er/JqIx9wNlZmHBSXWS/wzSc0mm+x/UHbdmR4b/A2BWwbe70K3fawZNpQr2tHb7jD",
+	"8aVUVRWZP7Ls+RHaBC4uiykvSXAdzfAfNSg8Hix1im01M4QCHoLkIYk5SSHj4H9QKvL9syDJmmYBTeRG",
+	"HI/jwNwpPyCfr5P6QKOPBdo+qbCOAdT19mUxyEA43VVwI+AY224IyT78U4qptM9vJ3JcUl5rUw1ibVCH",
+	"hWZq3ugyRTD48JVU5Mq9hzfIgV2wtefUUULqbxCmd757fhYcSeFX3uGSTTy0VWvHG5DdDANFecIlRzkY",
+	"9R3oo8+wciDh5/G7FEO2jyZAgtPyqC7Rrb+M0qkYz7PgsapQ8QzeeZd2tGZvVWCrNkiQ1xNAI8Y5uLQd",
+	"rvTYHeHdu5+Rkb17974TU9i9i8ip3DZCmiBERp/VVajSEQqxjgpXdlKpS5nRyFyIsm9WFiIYbGuLGTm+",
+	"125ZtisbdZcP5IfLj+x4bQ5hpyh7zOtQeiUqmwwN7e8PmXSmFNFa1UGs0QH5YRXlPwMg74Pw34NGlZ8P",
+	"UnNDcgR4B3Mbb9ElV9C+DCAVF3AcQ6xnVzpXXokop42na89KRYPTZ83YfmnfoaHMAhQq/LhnOHaulEKL",
+	"O+WvemL+cfPwEe0eB9eDrmci6S6xVVapoUvvVKtcUWeD6mqBlv7CuaASCVttii5QimmRpYpsxHALJH1Z",
+	"yxWr/i0EaDkxGQQFKlGjxucqeFZeFRTDSEouv8q1UKhGoDL/1nkcyctUlG7axdpgfZVKlHkrgOGcZabE",
+	"4C7V2Zo1w0rf8SQitbR6pFP7sKqMita+W94EeF2V3qIyM4oiHmuSUN84jy/fMvZwdL05JKqclQ8HUeHA",
+	"QTOfxEf1g9aIQ12J4F0rw9vhhKWcw1mr+HwgXzGXXuUsshZyttDPMUgFleR1SalQcZDJ4sOcZGOxrRpd",
+	"EB4Psh2rMLDmVCO+wc5I9Mo4p1Sz9E75YUe2OEHml0Ncs5NIBD5BKiHXRCtwXs3EIULS00E9BSTCpBMx",
+	"MrlmyGrwDmahim35PtDctCuK1CgXCowmRmwtBgOMZV3k2PYHDpL311jdra+Yp+1qsGpE61KditO2j2gn",
+	"+kKW9FR1PFXxTjv0YkAhTnR9U5CjazuylJQdzDyb88L5Ze0V1ZXmzAYhHD/OZmgAD0JX+Dgcv2ya8DXU",
+	"CBc5h0Bd+DAI2HQfDB7BRcYW2HS7oIED4HJvbCLdBchUVsqL1NgUNGf9LdxVTjhBCHWcDJ1GYZL6Al4l",
+	"B4hkzoGWWq3MFxoG4B4FyObOoyWyOenWN4N0SkuSitoqJCmDL+/5VNcezwnLlJ3WxFLoMquxNSUFtFuD",
+	"64G4X4FwbUFJ+JKWQ40rnxgdMrVHcvtwddcqSnkpANqJlbp0rbzlbb2NNWVzV5IZlj4yVZZVbqOL9n30",
+	"49wlD/66JgddRvJNW1w7L+TNAMRmBU1LdXKxYjwjXc9S139VAlMgPThsaBDhR5e/EdV5Qez2VH1m3dKp",
+	"Tido1/calsM5ejGM5V8FHfweQWsR1QXPspl/dVVezHB9b7NM82hOzuUgPHuZN76CcyxsTvbykNwmziXg",
+	"Sy9KukK+wFfdikIzbpZbZCSxmzfQtLDwME6WtZte5bx/eYbTGhtgWU8wS4WyMjD1YUItXZyZLD1Tc7JT",
+	"74Jf8YJfRXtb77DTgK/ixBja15rjMzkXLc7bxw4cBOgiju6ueVHawyBNKYreQgR25vy4z8zYOUyxGrvv",
+	"omRB4ZdRPJJzLdZFuXcVCUW/4XWPoo9Mq7f2ijxnAKRQEl+0jH48qve6GO100VcVp1tYoN2Vg23BgGXl",
+	"c+V2YmWgRnFxo91yb5vUXtt4EGbOWlGRFkOwp0pK1ZmtiygkbWoftNV7IqLlX8Tmr/guLefg0+jgaoZC",
+	"F67liFtw/UZvrxPPFE/A1qOGyX9HlEdYdBKrCElzqo804SVJmvS6sr7eMKtzW+7Onp+8eiPBR9vVUkRF",
+	"qFUF76rovfyzWRXXMe/NMpKxtVJnZ1XS2nxdX9q2w67Jz9nSRjtdAYx5vRGgTHbZmTusaauVVXoCeIk9",
+	"HgGRa4eAsV2xP6DpA2hFnjO0Hn83LW5YawknV7AHuLIvwfIGhXtlN53T7T4dhrq28CR7rp4+QNL7joXM",
+	"24kVqEKSLYpIFd3vWGuRTAJd5gTfkZs4LAEAt4ExnZRIHCl7iijmg172KKM4Yp14fI5pnVhj4WvlgItu",
+	"C0hrDicyVX8IH+4mmQxkrtPkHzUIthhzM+FRoYt7WgeVStlJU3NXnKLu0J1LDszmaTP8VXQMu59FW+IR",
+	"EP0Khu2c6gnOVwvV5hj8wbLH7+DZtmfsiMQer7SkD0nNHHG5aDqZ7JaiXf6HhMHtp7b3M1WXV1kqzDOH",
+	"sz9pUoazIvtVuO95dD12JOSqDh4J5T792ih16oszN9Yd02bVzO7dbp92Y1uhmt54D9XTzlvuKMqIUeZZ",
+	"eIkG5HaBjbA6N8HYES9HPL4hGAlzJ3x4Ga0nkauVBCoZCNOJ8Xk2DMmY/Cg/1vXgeNMT2VRlHFjuU/1u",
+	"wiVRAAYTitYtv3VJhYGnHawqGM2AqNbWCUbs/FrKCrvNYep0HaXcdRK/46Mkv8ZgdBVosc4KKmhUum3e",
+	"MZDIyllNDpAfE/abBaDiZJ5wz0XYAqupnxyIm9UyFcnGiDohR6IGNuR4ZLUNlbsRJ+dJmUywcju8cZ/f",
+	"oLqIuDbtylCf4PJgmYuSXn8w4PUFoBQOHXzCiAW0aqWOrjfaczMR1RoN3sf03v1vgrvksyqTc3EPsSjl",
+	"88Hj+9+Q0ZX/OPZUGsbmqn3cJCZ28jfJTtx0TE47HoMD32jUsbM8D3fE9jOuntPEnw45S/Sm5HXbz9Iq",
+	"SqO5cAdHrLbAxN/SbpIhrYWXNOZ2rjBZtgmSyj2/qCLkT56QeWR/DAb6UmEdK+nZKLMV0pPp2MeTquG4",
+	"N6xsK6PgUg/JQZg7kjhv3uzL8s21anLj/gCPm2ilOvKUwJIY173qBBW8VLXwqM+Obq/DuMG5cOmk5pAn",
+	"H1sywImgi0VdzcI/Yx5eAUKCiid4wA0nIOW7vYWa7UTS3QC/cbxjWlBx7kZ94SF7pUPIbzGJIA1XyFHi",
+	"eyZFxTqVXk+mOzxMcfR2YGD/0EOVMhwl9JJb3SC3yOLUVyK8tGfAK5KiXs9O9Ljzym6cMuvCTR5RjTv0",
+	"09tXUstYYcpntzKqOe5S4ygEDC3OKVzNvUk45hX3olgO2oWrQP/7eh6UymmpZeosuy4CT+pkGf/VpNy1",
+	"CpnBGZ0unHb/CX74i2mfq5fM59hZiHMRpalYOodjmfmLkq0O6f/3bOg8wOkGvtsulsbLbS3OAN4EUwGl",
+	"JkT0JhX2JWpgtZmDpGMtMZ8poHlM1UdDZd3Cyrq1kq4McFqJ3H2z4NJtJdvoqMgBhjqqXH00aspyDF1z",
+	"j+661KqVwAniCCW9EbArzpk+l6QOzv0KQ3w0Qh1lSrN5szvQDVc0aUUo5lNnXyQydJKM9SwD3kffbCir",
+	"BOzY7+iUv+aS5c6kmzxapxRAkToTXqj6g6lkwbUyGhkkpk+HCnQwlNAufWXHtbQBqeAOjMHKiafMGllq",
+	"yyCvsRAfsHGpwtJ38saHAcSFWKFtdIfkGhEtaWw/VHmWb0+rOWf4aH50HqtKxFWWW/EKjQxRe5vJdHvJ",
+	"TcZvfVvcLiCAZ4mo8b2XH7zlyiOu2hH0gCPByN6LdgJuVoWV3OmWPQ6+o9wUXHCjeCDdbnW5iUZ99Tpf",
+	"ZlE8ohIXxEx4Vv6Ge8Nys6w5F2xpcDV/c4NhMcXbuxLsI/YaV11WVDMW1rzKXZnA+MaZeoHSjW3fB137",
+	"bOyMg2d84y7VfY4nQfkwSwoshqOnkzofyQhqSwOkuKCrbEO78IvA4V3elJQyhj6rIbCu+kyMEuGWjd64",
+	"z9soyNDesE6wqe4CflYNxdqNW9SRUsnIzeUBHaVMKU6dra9SxGXQroCTTdDSHshaiN/xIsPtIXbmD96m",
+	"Ep0Oei3/haoVpnvZvpa2KFBRszSZUnFJqeE26IiS64b5DgfU4fR3d5BBfp3D5ezbp8MLJRa9nfwUI2xK",
+	"z65VO1gXSSVk2SJ+NyiXXEus5fMS6+HBCloa4Vgu6dn2VenB5Sc9K2JS6LpjrKdIpkzv/GeFFcXJpDzH",
+	"kFLm1RgyL7uRSosw6KNC1iXHY2Fz/qxZXot4vjNoIdTOrR0PBuUEea74L/DZD9IARGHzHxPuOiIJQUbo",
+	"s80WI92pIRzWgZpjnfJEqRr2mn7Gb8ZURg8gfj9+lc2TKZAyjcEOWlw2RyN0hzpRsQlKBYR3n+K7siaU",
+	"/rkRiM2TwrdyUn+vWne50YvUi2CHjzlUTj4LuXp8e7QecusNKiINAQkNi0UBVYicNIs/zA3iiir7YC69",
+	"XQPdx1BeRVDNsWUbB3BBGVwCW8FBXEMOvS/z+czK1OFuVHZgmOxH5TwBSLqsTrtzaJ5zGsYHmXbZiIiB",
+	"vz8s+XdrOuc0MvLU0VhiIKtH+vVEMDZXwHPZI/u3yjS49fB4/YKxIuC1SfEvxIClyT7FXAIVj9NtV0sq",
+	"vdTgY8qBaTWwdfF41BpCU8rQVZ6iCGVxR8vuIP0V3PTYCunaQRFqGjrcCj+DJ4sKNpWjrXd8/ble2K63",
+	"ONeo/RU7n3D5S3oaxDVp1ULXoWT5wTjbWlFxa93NJ61Sm1ebDrtou1qdVNRfW42lBx8HJMhRiD97/ubt",
+	"86cnZ8+fseZBRgjKs8aDKy0AaPMFmYLXSvQJf7DR+IG++9BasKcrq2lK7jhTdmN0dU4o+WyyoX93KQCu",
+	"4892joBWwWb04c5X3+ZInYsrcoYQUxKHY4KUqKujw0x9OXZhg7FvVmFguxyvMN/vlVn8oaye7eoxFv24",
+	"JNhz1OLsyi2dPhCs5+nCKhQLndFzlZ+oiwO0qmFFfKA6c1rVY/u9IOpFJ+BakvdVr45Y2eWgDl9exNSb",
+	"xhNVMpsWVtnLxWGf3YEzHFRJzxkKt0PLF0jJcZT4uPP1sGta5xpPY/ciVEXodgH6iwr/D/IokRFLhpF1",
+	"MetT166oqsn0G69WZqouD+RbzdD+hrYD9+xlBvJWVsVFnbhhlu+WmU64evd1OGWowlPoCUZt1IzuLCdJ",
+	"KZlGFr/GWfgHXgVXn53RzzsU5qaXvZW5zxr1uH3ookrn0gmucY4pckbBoWrIeym5jffdXS15W6RSizbt",
+	"LVLzuWi00/uqn4t1UgStNFfT7XtgWakTHbJIgVTBOiqpoGZBToJm8s/gFAQ6Tcn5lpTMv3GVdZXuN1Jm",
+	"IK43aGVoJjqknYrY7G62NQD1ZUz2wmOVA70yOL6ELMD/nTJoUIOzb4u++16mkglhgBuZc21BV0gQW+Jl",
+	"lAZgQFEGYUGF4MnShKZZgrdZpZVgfMm5FEmiodckHfdMiXmVl5wLP90pFZ+is31Zm6qtm8NuM4lSU0oT",
+	"o35VYzSQMXyPT4OXb1SEL3HqQmATQnb+V9TGqSo2wXc/MZ20ZMxFjqUpuzP/lCYXxjNFHFb2XhwhPDo4",
+	"B+HDhMBkVvkuZ9zjztsZD3uFmRXgArpA96t9ahF6Kh+OTz2FUE5aLdt0vgeIqhl6xMSFaq5Ocj2pduCe",
+	"zaBv2W4PCSpOoiVX6kRB6up8R9tZub4HtAGZTmUCdJLC4ZZF+90RUHp93Izy8vttoKM1tPruOW7mXI0y",
+	"hOX1OebV/YwbjlrVQbg5oaCuFaSAaDz9oxboy6f2C7N66al4HdesQIgy9FbbdYChag6ZEAEChDwsNKOq",
+	"x6tAo4qsiyhuNsxWw3i6YyRLkiL7Qo6eG+GA5yuiH9im9A4G9eNcVk3sBJYTzX2KGuwrNi11e2vOSD/j",
+	"V6xQWgbIX107p5oiyF5BB0zy0HUUmfviY6JGU1/XpkYtbHNVd04eihL3Jh4HJxNOZDBQBSm5qdUrqCJ5",
+	"61cZYEvhumcNPSv6pGtYPbDhOwyffMndCkFSvlMrMb2F8a5CuTUys8Q+s+6wfzpJcTiplx/7yBDrwsJ5",
+	"i5KK3fbM/+Gj1pn0EIO1EjnhIpkvQOInWZFUm51nxq8D9fXOIKABEvSNXq7N72DQjK6Z6MKkZVmJ5u57",
+	"jUuIKPioHrE84upEDu5fehbNUaiBOppMS2f0gJKl4bz26ZRNebv1LHuDS86siIp2NwZ4MM8qKz2+Zwqv",
+	"EtWWYk3x4mT2LS7bZm3WqWqeAw+RdghHbnoH0QZNPmWkuXVDqSZijkF5eCq0s0U8rWBeNCU5a3eZ88Xm",
+	"JrfM6zFgbZNbPGxbYLkHVDJuC6RbBPSWsYfK2WEgA7o9JdCb+9FP3Xp/bKQ28dFaAc/sJitvxyRHci92",
+	"+S5lYHvkKn6uO0o12j3JioxUiEcHsanajKJUv6mqWzzLMvko6+2SfOeQQSwppt5wuiGV6cdnwmpXKOFC",
+	"MIkb6JmeOTGJl90iHY7SxZReO11meNxCX45yM9dRJwrAZZ0yOozmSHDNRFHwTZLECowtQgxRYq7SB0cf",
+	"Kjht5VJIKL3dNRk4b03Pt6ZoqemDwEhtLRDlaZRQ8X9TWtQ/Zx+yn/JzVZVCdT8Z4M6U9Lq9PZxKuU3K",
+	"DhJtqsdcFLK6ba92cRmXobSYXqTOsO9OnDecoLiesqHPPhjGQXs9zdfsMhqx28ny7t3PS6pk/coKEfko",
+	"NkfsIFAN9tRW2tBz01BegxXC3trtvXpT3c6Z5ZwXMN8LnL9zrgPo/6HHxfCyWy61fQY+JlhiHNVunazm",
+	"aUge3KUIFB1ozI0eqTwoaGipiO+NgwD9htTuRcYcN/sstSbH62zP/Bc0a1xz0Kf0kozfpe48S1Ijiivy",
+	"NzVMP1eTfTauNpVsn9Jfj/TCo51j2e+SYnk9vNLq1zQoCrjdHtwQFUPh0lIuWZxumDen45R0kL5dVmiL",
+	"H+Vjw4PJBfBbIXNZIfbsybQCBHf0ZHYLJg1dHq2DuBrZklKHZjQ4uHEb7ocg3rjhPVcad47yZIj33B1y",
+	"iJ+T+54RQjXvAwI1+HD/AxzxGbUQy4LDQ5rg8HAkX/3woPkYvTiHh86TeWOOe8aRHEPO66SYnpZgDjt5",
+	"twUpK7Z0KeD+vIB+sjuK84T+xAvaqNHAaJCbmzIayb9t9bRFq7k0vOJt2J04IqLSZZ34m2ww3BlqJOut",
+	"E3whmpJH2OML9PEsXGbrUWD3RxoF7G2I2SfeLoRqxXhzAyjnuiQPV23TyI1pY9buPO0ePHGV6ehvH9Ue",
+	"zZYXyeoKLoEOQn1CyWd/SuJLo6LtCZKiR9KA3gWJMblSCcuA42A6xvUg29U2TIZDNzrizTjcIw1+JqpC",
+	"w9I9V0vULTmMzum0NRaBdeMf53O1Q5flaYI6J0g5Parb0K/V6c5jOc/WLm1STbGkjn9XmaLTs5IrYNHa",
+	"ePoBu7oDd1PIbrVga3EwTq4KlyKdVwtPo2FdZIDf6naXb3Dspp/I2T6UBiX0RYmO7DDDbdsqVePdlVNm",
+	"mcSS5TKRpz4go6t15EXKNZj7mtN9ZozwJnhUk1pUk1VrPxxsayC/OvXg+o2LZ1C5EWlC5gl39Gqrq0jH",
+	"aNWOQBvM07glocp6kCEMFqbJMKv6AfXZygY1WXRy0oFTeH0U9or7OyjqToiOTkGUiuqpENFShrGYxDat",
+	"vFHvw/RSpooWv8jKKL9LN+df+Lz5fEw7xWu3NWBCjGOtjcmtqaxKHgOKeMjPHCU7iOfBy0m1oYKtypyd",
+	"/OIshP+djqRbiAjPky7xJyvMVdlHoUv+mri7ulTO7++Az1P5MTS0UCR/hdw4eH4Behscbr6lfHtn8m/i",
+	"4Z8fxccP7//b5M/HXx1PxaOvvjk+jr55FN3/5uF98eDPXz06FvdnX38zeRA/ePRg8ujBo6+/+mb68NH9",
+	"yaOvv/m3O+Q+A5AZ0ANVHuzgv6jleXjy5mV4hsAanMCqMViROnIiGaten3DaSHKuomQJr8mf/o+63mBj",
+	"aDO8+vVAVh86WFRVXj4+Olqv12P7k6M5GchBc6+niyM1T6cZKMCpKyGwJ412lJPcVdNXRQon9Ozt89Oz",
+	"AL4bW469xwfH4+PxfXLNAyuBpcJPD+knOj0L2vcjSWzwf3jxCFC3JB0B/0B/ITv/8K9yHc2Bt4xl01P8",
+	"6fzBkUqkPvpN8phPOKozoIprOliJ/N1eoDJgkUJ8uWZDo99WKds/jXQXNmm7S2NKtWd7O7I5jayXsem4",
+	"8tIwKlV3lgvxP/65Y8GnuqB1QQZBE72g0wZkT0aA9T9Pf/wBL1uv2Qf2BkuJWOnsRJD/qEWxMQQjWZld",
+	"QV51zJJJ76tynjfzKY20duQ0OJuq0sy4zxalajFgOFFV1MKGxPBV5JXAKN//9tWfPx0MAISCT9HFU2XB",
+	"B9jBD3AJpgad5HlTFXplBcaRozsUWdRGxu9DH5htGlGWoX5qd/zU7zQLK3xIQdB88G2DBMy5DwA+vgif",
+	"D9oD45eJVN9LmH6ZZR+pUqKVh8rJoMHLStfkREuBVP24oMkdVAXDlVhlxYbGoNKu6ySNs7W3XofuL+Ja",
+	"qa5aoNfZ0RfeU1E/Im7iCw+Oj/fW+FiXR+H8Rj2KovJLDNRlmvxIN1BeF1HOvEP1P6ZiM2g51KeY2j0/",
+	"2uNCm9lHV15ue7jOop9EMfWZxEo7tJT7n+1SXrKtCoVYwEIaXvnqM96bl3j7xJwyetOqmOuyZH1Ms3Wq",
+	"3kQFrQZtCU4wql9WC1xb0f7kFcBHduM++NkOVIivJJ47PUtfPtsise+UPj7fbSXR6guIz3XnO3Jly+aH",
+	"4iIpq/LeOPjO/ppkDVVm5LqHAAnGks5UJs55gtYNFamsClgb2O6UdtFKp/5geX9uVYlrVSVOml6sRi8C",
+	"FzANEu+FqXtRvpXlbTbarbfQ6kp/qdbvVlPFS7SmutZ2ua2rOc/03nVz3iozbnHnwZ1PY7Pg1cpbsxnm",
+	"9YsSFa+qJV9DxF2joPnM9c/X0RLpxFpuq0wX9xy51Uv/afRSHa87Z20S22z1aarUSxd+kC1i9qCdyhY5",
+	"A/RS2x5hfWu1MLnb4hSgc56037kcO5Cxt1s1Tmrcc6trXreu2e145QLD9DG61S/3qF8SWhemy9fWhmKq",
+	"P5etGKnuaYO7kX2mCuU/MbK8GiRCul13vAS77+iFUrhcmxj4IvVBibRbTfCfWhPkDJ4eXbDRYU/GPvjV",
+	"QcFx/8uEy/w5YyUwy0QVniizQiY9qGRJCkOKBZ49clVTXR5QG4s6nbKHiacQLKlfn/wXJZzBv8G32OdN",
+	"aZVUXckxPYf0N9U6ALubuVI+2ZxoDadXvfvD6ExnGkmpO5YJO5dxkzxC2iq6+NaHsgt2aLt0EfjsYDfl",
+	"6o+rAF9VaXJGTDWaIyTcCBWjTSgrvZNIUYJYg/+BjIpKrghBGX+6+0Y3eqfK8tAewFlRr2dGie/SVbNx",
+	"11wOR/I4xhhuge+s1Q3MFXHnCw5sKSYdZDghuJyWd7u7n+3udtVSmBLPdEKtDYw8UbKqAaSM2oIXJbie",
+	"NLVx8N9ZTVFWKOprtEN22/TSDJTSp+aUCqjVZxvLAKSVxs7hYXvhh4dyz7HZr1hzWZmUXmyj4/DwC1BZ",
+	"L/TtOgqwJWEq5hEWWguseM1bvfUPrbd+dfzws13NqSjOk6kIzgR8W0RFAqzgp1S3j7maWq55DvAD09Cn",
+	"l/908mONFm2p71eKMGhHECSV0Qwb5RIsEwKVb6D6Y3xXHskOGdgiABR0apKhCm6Cui+9QRRxyo4i3o9R",
+	"x1c0dinpllPqyQaurgP08htyV19rnJbdfsoh19x7c90SwBn19PZmop6GMdNHx49uDgJ7F34Auf+CzGXX",
+	"zNKv1XbgJiuL2ezsJDJOIJu1yPIjvUwFT+hIthCmnrabQJcHQH7CjJCrGHa5Bs4wlF9co8vhWnkE2Y5d",
+	"dNlG7y1fuOULV+ILbYIyHIGK9QJHIFeBzQ46R/IJvvkFeU0tfwsWFtPuw5nAxnGEl3Y+loOtKB+fn6es",
+	"kjRZIZTHo+v2/xHQjkYxtBaZc4S9F4aWAaEPv+cUIHR6wUzd0X9UHR/wMfp2qHOT7FB4JotmkzsnUV2/",
+	"dUI5z0QtYzjZQRVUy5utWbdD+dRM3s0PI7Tsw2d4i+DdENxhas9lajQfL7mILyF3QDXjDUFipMreodrZ",
+	"fYlmj+uUyNe9oB+wDAj5pVFjZVq8dUFqdYHqSxBSVO0zdjzKYBe36tB0Ov6GeeyfjnSRf59S8YZe2KJU",
+	"GEmdpDowomlewQJjUVFeWkgPizWyZ3z5zI7TaPQk0N0IHKBQfv9unsR/PRiozVBaVjYLMP85mNUpA6oa",
+	"GXDIigqiyGYjbazlojiPg3fpYVAuoq/uP/jlwVdfqz/hvx59DOeRVYe6GpkZCB/zMEPUsi/X7dhUJTTy",
+	"Ht/0Vu62Q9jQYltPIftcqPo0yBzulKBnbLz1Rj0tQF6L4uNSrqzl5AH1BQVquUjyRoPrGylkANJigvvR",
+	"hfh73CWAVHfofpk+0fzzXBTJbIOCRvOFG64HWQgRi9xVr8YqxIabRm+ZTRWCa37BWeOCl2glFukoSMZi",
+	"3HaGxXPTb3QpopkumJhlQ0LVLF6C9KaIw8K6vZAhquYbF/1Q0qpsUHPTRhUT0sXCTCGvaMmV39XiUv0u",
+	"FhdQyELSx6gkC98NGmj5/awvVGhsZBk4db176tFR56oFj822yvEgBUx4nU0NHsihk14ylurYFLtc1/nR",
+	"b/QfKnnxyRSX4F6kDjuPOxhMFelV0c4UIS2zduy+bLZiGPyId1D6P1aXwCjOBbWI9UZZM1AyxjqIClCe",
+	"lLPuf9M38gmGmpadsGuOPK2Q28BPr6OLk+m0eqUCtyUcEzHDcpfcx6ejfr4imKwypV+kecsKJxYK54hs",
+	"+ADZ7+dg2VJBjo64GlXPhOvwTSIMXMbeo6qZgiQ/E6m/S3iNLpPgbC/tAOepOTidshql7URFmts93Ock",
+	"z4FrZXXh7mLI/p1+qEy4NcOjyojsDosMFvdCQ2oqHuayt/xvaULIS12TBGgWKFVUV93ApwoGb1yULxaK",
+	"y6YBE9MVc6woVqkjaZh2oCUe3NF/daoq7kiw9pLkcHtubs/N7bnpqHdP7XJvDe2GWzpTxURl7/pirNO3",
+	"hug/2IK4B17C/f7kebXJkPXfW9N0wzS97bjKGw+/dlSmUV4usspchdQDiknpM06f8ht7zTbgMbFzq214",
+	"UQUnZZwMLOc1FvU9obYQii42cD1edatI86e/eLIK30ot3tFkMMUCkuEKdtNRq/JHevqaHjrLrlIEs+dj",
+	"YtW+b9uFnRvwt8BqzjOEsV8Vv+M/RrzLlQ5Ia7XcJdj0BGf67x6UTTrtHhL40TIayIeNnuOen49+a/wp",
+	"g83Um4Lu140/jyZR6vzt6Ddu9tv+/ihOStknsFEys/FOjiU8S7mNnieur8tFXQHrtVZX6pbCXn7Bb+yV",
+	"X/yQxYLHbZaodaUHUkPQUgHRYhPaKOR2OCiaMe+1bL/TqJ4vKsqrz5x9h/WHYTTl482NxcttHdT4LdUp",
+	"6Fw0G+tmE1x0s6M9puUgdelmnWz6cjcCM3ABRqYYchiH/mtREzStwuregz48EeAEsJ4lKLNgFhWXBJYZ",
+	"Xz+gVStnR4OrA0Ekb+tCPWz6vg1sT25vI1qXFJMnh1WG5Ymly8qBwoE4IVdKcs37pya57PbVeejusvGU",
+	"n57BQ9yXNEpVq2N/b+Jtx5b6DVtrKXEF1knxdmj2qAuv4JlUSht9uWgeNifiFH6AvS1jceS/6jLnnbGn",
+	"yC/TEticqoUuTesidq0hFRc9c/0AT9VcsG1mbG27B5qsS7FtZB+WrPG1Bl85apFQl9wL1+KoxkEk1csu",
+	"KhtAGET0AXKq3rKwa9uoPYBgZJj+UvWxa1KO1T+6rLgJb1SFdaq/86HplN8+qX4y73aJS1oHiK/Hmep9",
+	"zu9LyNfKmk92lwi9C9waaBV9lC6ZuerR24EZDyN3EQ77KB+P5Sm+ZR+BLYe0rcrax79xzlqHo0W/TqLz",
+	"EsGWXfAt2KU8/yFU3V1vtm3PxzVeN5uXB0u9Msoz/32EHcvRYsUSM4xmAMJWZ9vf4KNSevfY6cnWT8y/",
+	"ohEkQ5HjyF66pgCXzG9lEJSVDXe/6+vCqV5kxaAYbuM5AnBwYQGI0ETV/sLzpnXMP57b6FZ7vtWeb7Xn",
+	"W+35Vnu+1Z5vtedb7fm6teffJykzCEPFp1Xkr6veRnDwWWr4n1FJi5usQWGUfq3y0yUBVXQ8x73JGsCi",
+	"RbQ6MiqJ80ZySm+VgYCDs6FGY1TvSN9O5swRsXZqx1cJ9wTgJHE9pX5DIOgK7LZOTfZgOEDiOHgeAV+n",
+	"P4gPmTwTyfpB9UL1K8Yac6CRT5cJKxspRtjVK2EmRjYTPseRwpfPVOadXD9pK1ZrtTda+nLYG0EWG6WG",
+	"QcDYFwqCQS7ZWpuF+Y9C5Ny3nUIYx8Gp/RoOjwy4ENiTDrQe2UZzg0GKcC75o+7yKHvPWqMKmbSiHRuh",
+	"CgTFY9aotFBSjbx07dq7Dx8cq49bqqwS5feogbMndlJnEdLKE7pDcfgjN2XGSG/0zBSykXVwolro8KUW",
+	"1rWM9S12BkegVN/ZvAwujqq/Hb6vVSUSbrIZHpMvdnCmmBf5N7/C3XYnArAWY5CJmkTG1zLBYaak3PqC",
+	"X9KDlAuQAFRtVIaKltwEbl0kFd+n4YJIe8Y7VuIX9RLv4tL9JG/muH3dqzefqSd88LZcvV9Qx1F9+WYA",
+	"u8WH5XGh02/RCfCqkToE9ivNwyJ3FbV2PjYJanbkLPVGphbUpO9KdRIqkMdHtBUhL2tHq86J2i51WXJx",
+	"FQpgg+vHkpTKSEbhclCu5h38NW5ZItvgyfPrCfXZAvn1Budc5+QDhfX1grBdWl/n/NclrvnQq1u9FClJ",
+	"I2jFMiJWIloS+MmSbr55VnpLspw9P3kVcOxgMCW+nAb5MkL+DnhShUEpnvPrR7oVtSznxK2nCR584eGD",
+	"4PT7E5UktpBZTM1378qCqgD6ZinuyYxz3VRTpZ6LFPElM88jZZpUAoMtbbOE+g/DoXtObz+DTV0is+TE",
+	"E6y1B0LkT9L8EsRwM5iiOltKi0iZLVFwR3O04/Htht/BXAKMzoQb2Bmg8iUP8CwppLiQUllvK0OZEPRw",
+	"BxCO5AAc56nckS1Mu9GsEdfwYdQw08rNWkW5soIoDEeKR7V6LYJEK/3NFnk8GM7FkvXVjVkycZYnWbxx",
+	"nSkim+ZhMplpSRoVG0fmaecIdQgSVjARgSTnrin4097TKLtHpUvc2+jaZcxAie46+H1ny5k4qDesMxQL",
+	"qFmLTpydhtvZcgcawCFxUUjPak9A4tB3v+sFlk5qII+YYf9/mFJDzTc1q6J3kZlIhve5lgVSiHeeXjr7",
+	"I3WdIzVJUtxFiC/NRRpK3hJOgLmEDc7UFGtxUmKI+mqyXbTZrJEOk5Zm+KRf8F27XHJKiGfW4vrYrU0P",
+	"F6HkrR7GyynBw9iuxhaNKDmvhfHr5r4+DmmDEEjW4zKnt/tV7MjPzDSbW552y9Os09gS9sARMicTGV+O",
+	"pxWbok797Oz5hZjWOK99SO+W95BlEUYvqoYzPxaTej5HI0jXMU2ZMzQeFiH7fbgcL3cog9uNOHhwfQe+",
+	"ajJCe7gu47DSqO9mRTAvsjq/x/eEdEM+z1UO/1NxDugMWNVLxiHX9tovD+Uk8K4JhGIQyEjnd9W9UR45",
+	"yyElpWjzd0ZLsIYbB+8vEEudxqIYOytGXHCFeJ0xth3jZxep4cDNfLEWk+f1OlYn5x3C/dUuy7RrHdsB",
+	"SwthED5QjcMkK1PwyR3fFtT855AIb7hblIfBdusqGIawXTAUFssiydBqr6BEQ5Ofvo3WdrOGfSmNw2/r",
+	"aAAFmahvr45eFKhGFlkUT9FxAn+kolpnxcdr1iWri5cOxzKBST2FuqWG8E4y3qpU0riDVMpmdS91K8em",
+	"H2XJRVN/V+XS1I85kTmyDWzc+nq/FF/vE3X40DxYROv24eSwDjqTA9hUtAaJ6ORSRzMhvK7g52WVrFSV",
+	"MXixxSmJS6ozwh7nMvlVKNdYHqnbKB7yrJQeQ+mF5PdNoBn7G9lFJ9S8GDsZ4YeZzGNXwHTaKkSxdFM3",
+	"2jFk57o6y1SXFWLbtxwRfZ3LTLMWHOsxL4wtzhp0CTa1NdOvWtMCvYzkwEACtHb10oyKaAGbxHIBjfR3",
+	"9gtT0Rs1UJIyDvAjqclhQbfmjGVQJBhLmmJb0gbGomWZIdDUSYhRpWdWXm29ZoMdohlVlUq2dbDt18ts",
+	"Lcjcbq0iMmuQpDClfZ80VsMF36kS0KSFTgzji1TVsRnohOiRF2Ikg7/YpytfWGRF8ivT3Bq90niRsdcS",
+	"F6j8ofvZHgqmBMgbXm9EJnXQRAyRBoxxzRq9ZoU8PRBwwo5fs0yKWyDC1t2g2CFhBpObKC5ynoVCqFdA",
+	"FOJiKphcLKyrxWlnO3cKkUtDKsiwpa0d30GAtE6XxCniD0hBbS6goXulUyf7hdjq2niulYRfhUsCEyjU",
+	"/U8Zv50OC/h6WET4/dGASpw/9PaoIpCa/pT7Pk8K3Tf2AYgKmGAWZ+IXeaskT7S2ayB8POxwCPdcc6ko",
+	"EpDwITJed/xsBHSGxaQ95FE6eGWu40Hc/HnETjF4DX/EweW47LXHmaiNM5+lMrh/fCyPjj0vgQ7kDzJU",
+	"Nd2S7B/jSeiII9N2l6XkFI4ZcK3+VffByKU6ZShxY4F9cy6SEn2Z2+KymwLNqtZLYbGSK8vdY7FJ7esk",
+	"3Ix5NxiKf4ZSM3CETLMwQP6q+NEa8CksVrDC1Hos4wKbSUEXfB6SSsqIrkCwVAPDGLvqwd+M15Z5OwkM",
+	"p0rALL60DiGz54R+a7D5yzD4rcgL0RJBpnwnFhF9eBLwjQ7OYMX2UFsmkzxiG8mYEDJeJloRlYBSaT4y",
+	"AErX+pSbI7GOlVwHAKZGvTnAKAhbbRXrRtGauqX2iN0OdTkX4z0GQ+nfr6821LiXtApdyxC/koyeZsL6",
+	"qhSR2egYObPknQ/6q9Ch52CRELeh96cz9HQ6b6VE2FkX7gEBH36upJBlg4tru4s2LLXAeyappmv54SPP",
+	"F1pPrKSniDE3GB2YrTWrkfUx41Ztez3d4gYUzW1I6IbgakuUFmv3MasuX+keaD4VLepqxeurzeqgZ4gd",
+	"5q3AwGP4TurxdN3oaJ23NtxbW8sebC0dM4eX2lwGFC4H6K0JY1kU3/Cbe81u7QzfTHI1NzsV7rvMrbBz",
+	"AALuYdPqXRpRkpC1sG5/Yx1l7vdFPVWvuPPUHGlkcigAgGoG6tQhp0/KyftfGENJWc/nLNpbQuBdKt8i",
+	"FQ4FL8xFci7k+k9KPoz5TVQZUDFEkfCrKEAuoBfWvl1Qyo0scEwZt5JwYCG6GnGCHrEXlpags8jZcLdF",
+	"qmA3iTIpQ3d423f8lMrOy+Wr4E1SCfixKhB90/XmFexJ7IX85TPZhBv+g31VjY2tA/uNJWDuXcGAid+l",
+	"6I0EQiLujWzmMuTQTpTrnEUlkhtU09gIj3x+76rAOc9C9LnDVRF+n8PVrJ6MgWUfqcqcR/CC/n8ciRWw",
+	"Kvw7Pory5Aj7Ox6d39/iYLkCvwoc7OpWHH85aW42HeBp0RuPunJn7z1ymbXOrWXuVU9npYrbg6BdQOX1",
+	"5kWSFUm1oTtSLLDYbklOSfQIjKwm8VapW/jv65P/grvdDP8Nvg2OTVg4ihfXnCCCXGXq3/CrZ7aHeVsR",
+	"Dw1S6jaXAlOKkzJf0kUZBGJ08a0PwIu09Fko4bOD3foofbn9fVqhOd09kzKPwmJwP7qud7R9wP+wVW7J",
+	"/iGy3GnzRlcxq7I8bEcedDvk+meU+N69irXsddNs0eEsFL0FvrNWJQyXUX/gfbiDDCcEl6urfru7n+3u",
+	"OnIJ8wzPdAJsbmNxbyUOGkBKTY3aa9pllzr2muC/s5r8CyhQ60po/gY8DNVELXBQZddzJrN2J6WlWAku",
+	"Q0NPDg/bCz88lHsOA83EWnk48MU2Og4Px19k0e8vqxz2dapu172a69QEI30i4bSg3kDRT/2ns2tG7dMQ",
+	"ZaNKv6JIkSpRu/MjR0CQGw5mNt4H67URuiaUOtUN606qcYBhAuiXo5oE6JxbAvsohU68h+O9Iit7WU/R",
+	"hfH4XRo2IDEFGu6a//I199+D43vt19lkYTHd7mekpdIjzp3/Nnh38O6gM1IBlz5dQ4Fej2tKHeKvtg77",
+	"v/S4PxadXUMDDNlVFlgLDyVaWc9myTRhbFMsRDTPWsVvUo6SQPeykF0CKXqD/aFJyUWDZD6Rqg3g0re7",
+	"ov2l2b1tavdJu5fbjTYB/XJ16z4W1d2w/bG/3rE7vPCWW1wzt/jd+cVtM5jbZjDXtSA7/vwHuC+8IGPt",
+	"1fQntMYmcBZc1iafZgQHz3Q9aDySmVA9ibzPsRIQmd1d4cJ25QZZs8Zu5kKxX3AfAlZIF+N2HU4qwkeO",
+	"AQ4sQs6oExXIkw5sEm+uUUUyUNV9UDUpdaRP05sTYekfrANBd96bT7E7lVht5oVcqeQE+uWznLKkde4g",
+	"rnoqSuUksrusTSnc1Q4osRueJmkKO9R5f6eaFQhFSABcoWjFzSTEuPOBqByT3KgOkWU0WERGjOtMkYF3",
+	"w0kNB6YKozh2lbRkc4vcen6V+7Wa2icygeDq++/0olkgouG+Xg2FUr2tIoGuCT5DiKUvb/cyZ0XlQ7A/",
+	"gHiEiTXTlafM5FZ9Ots01ys/4OMzOkEOUxwWV6Oalrt3apfh5FxsjTvMozvdJL1WGdVuGylTFf4BpK9K",
+	"s8FVpUgmtYzXA5EDe7IU7XACR3QaDxOu+AriykbEvyak4W5soGAmBnqt4qpVRP9IiQ98sLP32BQ+T1ZA",
+	"iQnMhOkcqLmz7ETBYBCjav/pXskL+Hi+4Nd4HBJjjNMswATc9hDu3loXmMqDybSlq2U7PdDpaRolTd9V",
+	"kyTJxWM2m/HGm2p6Kzct1VKQxyr4Uu08ub2w0NwsW2IwsD6w6qFNYpryl9mcizuqvHyhKkvy6PLjwYeh",
+	"717WPR203lDei5wnxFHyt6u+aHKLYKDc6qhlJWN1JFrTht3wwtv73AZyWKAcfuuJWGpQ2W3i4peXEP1F",
+	"+PuV7uvKdMRIme4VotT8HU+iYVNZeoW8SI6joe5zCB5oAejtJ8U7ypNfPmKTw5/fo3bKFURZJ68LuCgd",
+	"LKoqf3x0RO2JF3ApOjpAw5x5VrYe4smO5jyChCXHMF64Vn16/+n/AysEwIrPjgEA",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
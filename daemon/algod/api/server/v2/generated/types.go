@@ -269,6 +269,31 @@ type BuildVersion struct {
 	Minor       uint64 `json:"minor"`
 }
 
+// DryrunExecTraceStep defines model for DryrunExecTraceStep.
+type DryrunExecTraceStep struct {
+
+	// Evaluation error if any
+	Error *string `json:"error,omitempty"`
+
+	// Line number
+	Line uint64    `json:"line"`
+	Logs *[][]byte `json:"logs,omitempty"`
+
+	// Program counter
+	Pc             uint64                 `json:"pc"`
+	ScratchChanges *[]DryrunScratchChange `json:"scratch-changes,omitempty"`
+
+	// Indexes of the inner transactions submitted by this opcode.
+	SpawnedInners *[]uint64 `json:"spawned-inners,omitempty"`
+
+	// Values pushed onto the stack after any removals.
+	StackAdditions *[]TealValue `json:"stack-additions,omitempty"`
+
+	// Number of values removed from the top of the stack.
+	StackPopCount *uint64              `json:"stack-pop-count,omitempty"`
+	StateChanges  *[]DryrunStateChange `json:"state-changes,omitempty"`
+}
+
 // DryrunRequest defines model for DryrunRequest.
 type DryrunRequest struct {
 	Accounts []Account     `json:"accounts"`
@@ -286,6 +311,14 @@ type DryrunRequest struct {
 	Txns    []json.RawMessage `json:"txns"`
 }
 
+// DryrunScratchChange defines model for DryrunScratchChange.
+type DryrunScratchChange struct {
+
+	// Represents a TEAL value.
+	NewValue TealValue `json:"new-value"`
+	Slot     uint64    `json:"slot"`
+}
+
 // DryrunSource defines model for DryrunSource.
 type DryrunSource struct {
 	AppIndex uint64 `json:"app-index"`
@@ -311,10 +344,27 @@ type DryrunState struct {
 	Stack   []TealValue  `json:"stack"`
 }
 
+// DryrunStateChange defines model for DryrunStateChange.
+type DryrunStateChange struct {
+
+	// The account whose local state changed.
+	Account *string `json:"account,omitempty"`
+
+	// Either `g` for global state or `l` for local state.
+	AppStateType string `json:"app-state-type"`
+	Key          string `json:"key"`
+
+	// Represents a TEAL value delta.
+	NewValue EvalDelta `json:"new-value"`
+}
+
 // DryrunTxnResult defines model for DryrunTxnResult.
 type DryrunTxnResult struct {
-	AppCallMessages *[]string      `json:"app-call-messages,omitempty"`
-	AppCallTrace    *[]DryrunState `json:"app-call-trace,omitempty"`
+
+	// Per-opcode effects of the app call program.
+	AppCallExecTrace *[]DryrunExecTraceStep `json:"app-call-exec-trace,omitempty"`
+	AppCallMessages  *[]string              `json:"app-call-messages,omitempty"`
+	AppCallTrace     *[]DryrunState         `json:"app-call-trace,omitempty"`
 
 	// Budget added during execution of app call transaction.
 	BudgetAdded *uint64 `json:"budget-added,omitempty"`
@@ -333,10 +383,13 @@ type DryrunTxnResult struct {
 	LocalDeltas *[]AccountStateDelta `json:"local-deltas,omitempty"`
 
 	// Disassembled lsig program line by line.
-	LogicSigDisassembly *[]string      `json:"logic-sig-disassembly,omitempty"`
-	LogicSigMessages    *[]string      `json:"logic-sig-messages,omitempty"`
-	LogicSigTrace       *[]DryrunState `json:"logic-sig-trace,omitempty"`
-	Logs                *[][]byte      `json:"logs,omitempty"`
+	LogicSigDisassembly *[]string `json:"logic-sig-disassembly,omitempty"`
+
+	// Per-opcode effects of the lsig program.
+	LogicSigExecTrace *[]DryrunExecTraceStep `json:"logic-sig-exec-trace,omitempty"`
+	LogicSigMessages  *[]string              `json:"logic-sig-messages,omitempty"`
+	LogicSigTrace     *[]DryrunState         `json:"logic-sig-trace,omitempty"`
+	Logs              *[][]byte              `json:"logs,omitempty"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
@@ -366,6 +419,20 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// ExecTrace defines model for ExecTrace.
+type ExecTrace struct {
+
+	// Evaluation error if any
+	Error *string `json:"error,omitempty"`
+
+	// Index of the application call in its group, or in its inner group for inner application calls.
+	GroupIndex uint64 `json:"group-index"`
+
+	// Traces of the inner application calls made by the program, in execution order.
+	InnerTraces *[]ExecTrace          `json:"inner-traces,omitempty"`
+	Steps       []DryrunExecTraceStep `json:"steps"`
+}
+
 // ParticipationKey defines model for ParticipationKey.
 type ParticipationKey struct {
 
@@ -394,6 +461,84 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// PeerBan defines model for PeerBan.
+type PeerBan struct {
+
+	// Unix timestamp, in seconds, of when the ban is lifted.
+	Expires uint64 `json:"expires"`
+
+	// The banned IP address or telemetry GUID.
+	Target string `json:"target"`
+}
+
+// PeerStatus defines model for PeerStatus.
+type PeerStatus struct {
+
+	// The address the peer was dialed at for outgoing connections, or the address the peer announced for incoming ones.
+	Address string `json:"address"`
+
+	// Unix timestamp, in seconds, of when the connection was established.
+	ConnectedSince uint64 `json:"connected-since"`
+
+	// Number of messages not sent to the peer because its outgoing queues were full.
+	DroppedOut uint64 `json:"dropped-out"`
+
+	// Number of messages received from the peer that were dropped because they had already been received.
+	DuplicatesDropped uint64 `json:"duplicates-dropped"`
+
+	// Number of messages not sent to the peer because it already had them, or wasn't interested in their tag.
+	FilteredOut uint64 `json:"filtered-out"`
+
+	// The instance name of the peer.
+	InstanceName string `json:"instance-name"`
+
+	// Round trip time, in microseconds, of the last ping the peer answered. Absent if the peer never answered a ping.
+	LastPingRoundTripUs *uint64 `json:"last-ping-round-trip-us,omitempty"`
+
+	// Unix timestamp, in seconds, of when the peer was last pinged. Absent if the peer was never pinged.
+	LastPingSent *uint64 `json:"last-ping-sent,omitempty"`
+
+	// Whether the node initiated the connection.
+	Outgoing bool `json:"outgoing"`
+
+	// Number of sends waiting in the bulk outgoing queue of the peer.
+	QueuedBulk uint64 `json:"queued-bulk"`
+
+	// Number of sends waiting in the high priority outgoing queue of the peer.
+	QueuedHighPriority uint64 `json:"queued-high-priority"`
+
+	// The remote end of the connection.
+	RemoteAddress string `json:"remote-address"`
+
+	// Traffic exchanged with the peer, by message tag.
+	Tags []PeerTagStatistics `json:"tags"`
+
+	// The telemetry GUID of the peer.
+	TelemetryGuid string `json:"telemetry-guid"`
+
+	// The network protocol version negotiated with the peer.
+	Version string `json:"version"`
+}
+
+// PeerTagStatistics defines model for PeerTagStatistics.
+type PeerTagStatistics struct {
+
+	// Number of bytes received from the peer.
+	BytesIn uint64 `json:"bytes-in"`
+
+	// Number of bytes sent to the peer.
+	BytesOut uint64 `json:"bytes-out"`
+
+	// Number of messages received from the peer.
+	MessagesIn uint64 `json:"messages-in"`
+
+	// Number of messages sent to the peer.
+	MessagesOut uint64 `json:"messages-out"`
+
+	// The message tag.
+	Tag string `json:"tag"`
+}
+
 // PendingTransactionResponse defines model for PendingTransactionResponse.
 type PendingTransactionResponse struct {
 
@@ -467,6 +612,76 @@ type TealValue struct {
 	Uint uint64 `json:"uint"`
 }
 
+// TransactionPoolDroppedGroup defines model for TransactionPoolDroppedGroup.
+type TransactionPoolDroppedGroup struct {
+
+	// The error the group was dropped with.
+	Error string `json:"error"`
+
+	// Why the group was dropped, one of pool-full, fee-too-low, sender-quota, expired or invalid.
+	Reason string `json:"reason"`
+
+	// The sender of the first transaction of the group.
+	Sender string `json:"sender"`
+
+	// Number of transactions in the group.
+	Size uint64 `json:"size"`
+
+	// Unix timestamp, in seconds, of when the group was dropped.
+	Time uint64 `json:"time"`
+
+	// The id of the first transaction of the group.
+	Txid string `json:"txid"`
+}
+
+// TransactionPoolFeeBucket defines model for TransactionPoolFeeBucket.
+type TransactionPoolFeeBucket struct {
+
+	// Number of pending transactions in the bucket.
+	Count uint64 `json:"count"`
+
+	// Exclusive upper bound of the fee per byte, in microalgos.
+	High uint64 `json:"high"`
+
+	// Inclusive lower bound of the fee per byte, in microalgos.
+	Low uint64 `json:"low"`
+}
+
+// TransactionPoolGroup defines model for TransactionPoolGroup.
+type TransactionPoolGroup struct {
+
+	// Total encoded length of the group, in bytes
+	EncodedLength uint64 `json:"encoded-length"`
+
+	// Total fee paid by the group, in microalgos.
+	Fee uint64 `json:"fee"`
+
+	// Number of milliseconds since the group entered the pool.
+	PendingMs uint64 `json:"pending-ms"`
+
+	// The sender of the first transaction of the group.
+	Sender string `json:"sender"`
+
+	// Number of transactions in the group.
+	Size uint64 `json:"size"`
+
+	// The id of the first transaction of the group.
+	Txid string `json:"txid"`
+}
+
+// TransactionPoolSender defines model for TransactionPoolSender.
+type TransactionPoolSender struct {
+
+	// The sender address.
+	Address string `json:"address"`
+
+	// Number of pending groups containing a transaction sent by the address.
+	GroupCount uint64 `json:"group-count"`
+
+	// Number of pending transactions sent by the address.
+	TxnCount uint64 `json:"txn-count"`
+}
+
 // Version defines model for Version.
 type Version struct {
 	Build          BuildVersion `json:"build"`
@@ -632,11 +847,80 @@ type DisassembleResponse struct {
 type DryrunResponse struct {
 	Error string `json:"error"`
 
+	// Profile of the opcode costs of the transaction group, as a gzipped pprof profile. Costs are broken down by opcode, source line, subroutine, inner application call and transaction.
+	Profile *[]byte `json:"profile,omitempty"`
+
 	// Protocol version is the protocol version Dryrun was operated under.
 	ProtocolVersion string            `json:"protocol-version"`
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// FeeEstimateResponse defines model for FeeEstimateResponse.
+type FeeEstimateResponse struct {
+
+	// The average size of the transactions the pool admitted per round over the recent blocks, as a percentage of the block size. It exceeds 100 when transactions arrive faster than blocks can hold them.
+	ArrivalRate uint64 `json:"arrival-rate"`
+
+	// The average percentage of the block size used by the recent blocks.
+	BlockFill uint64 `json:"block-fill"`
+
+	// The number of recent blocks the block fill and the arrival rate were averaged over.
+	BlockHistory uint64 `json:"block-history"`
+
+	// The lowest fee for the whole group, in microalgos, with which it could still be proposed within the requested number of rounds. When it is below fee, the transaction pool rejects the group until its threshold falls, so the group has to be submitted again until then.
+	DeferredFee uint64 `json:"deferred-fee"`
+
+	// The fee per byte, in microalgos, of deferred-fee.
+	DeferredFeePerByte uint64 `json:"deferred-fee-per-byte"`
+
+	// The number of rounds the group is expected to wait before being proposed when it pays deferred-fee.
+	DeferredRounds uint64 `json:"deferred-rounds"`
+
+	// The number of rounds the group is expected to wait before being proposed when it is submitted right away. It may exceed the requested number of rounds.
+	ExpectedRounds uint64 `json:"expected-rounds"`
+
+	// The fee for the whole group, in microalgos, the transaction pool admits right now. It is at least the minimum fee of every transaction of the group.
+	Fee uint64 `json:"fee"`
+
+	// The fee per byte, in microalgos, the transaction pool requires right now.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// The last round seen by the node.
+	LastRound uint64 `json:"last-round"`
+
+	// The minimum transaction fee (not per byte) required for a transaction to be valid in the current protocol.
+	MinFee uint64 `json:"min-fee"`
+
+	// The number of full blocks pending in the transaction pool.
+	PendingBlocks uint64 `json:"pending-blocks"`
+}
+
+// GetSyncRoundResponse defines model for GetSyncRoundResponse.
+type GetSyncRoundResponse struct {
+
+	// The minimum sync round for the ledger.
+	Round uint64 `json:"round"`
+}
+
+// LedgerStateDeltaResponse defines model for LedgerStateDeltaResponse.
+type LedgerStateDeltaResponse struct {
+
+	// Accounts whose base data changed in the round.
+	Accounts []map[string]interface{} `json:"accounts"`
+
+	// Changes to application params and local states.
+	Apps *[]map[string]interface{} `json:"apps,omitempty"`
+
+	// Changes to asset params and holdings.
+	Assets *[]map[string]interface{} `json:"assets,omitempty"`
+
+	// Applications and assets created or deleted in the round.
+	Creatables *[]map[string]interface{} `json:"creatables,omitempty"`
+
+	// Totals of all accounts at the end of the round.
+	Totals map[string]interface{} `json:"totals"`
+}
+
 // NodeStatusResponse defines model for NodeStatusResponse.
 type NodeStatusResponse struct {
 
@@ -692,6 +976,20 @@ type ParticipationKeyResponse ParticipationKey
 // ParticipationKeysResponse defines model for ParticipationKeysResponse.
 type ParticipationKeysResponse []ParticipationKey
 
+// PeerBansResponse defines model for PeerBansResponse.
+type PeerBansResponse struct {
+
+	// The bans in effect, sorted by target.
+	Bans []PeerBan `json:"bans"`
+}
+
+// PeersResponse defines model for PeersResponse.
+type PeersResponse struct {
+
+	// The connected peers, outgoing connections first, each in the order they were established.
+	Peers []PeerStatus `json:"peers"`
+}
+
 // PendingTransactionsResponse defines model for PendingTransactionsResponse.
 type PendingTransactionsResponse struct {
 
@@ -702,6 +1000,13 @@ type PendingTransactionsResponse struct {
 	TotalTransactions uint64 `json:"total-transactions"`
 }
 
+// PersistentPeersResponse defines model for PersistentPeersResponse.
+type PersistentPeersResponse struct {
+
+	// The addresses of the persistent peers, sorted.
+	Peers []string `json:"peers"`
+}
+
 // PostParticipationResponse defines model for PostParticipationResponse.
 type PostParticipationResponse struct {
 
@@ -737,6 +1042,34 @@ type ProofResponse struct {
 	Treedepth uint64 `json:"treedepth"`
 }
 
+// SimulateResponse defines model for SimulateResponse.
+type SimulateResponse struct {
+
+	// Total opcode budget made available to the application calls of the group, including inner application calls.
+	AppBudgetAdded *uint64 `json:"app-budget-added,omitempty"`
+
+	// Total opcode budget consumed by the application calls of the group, including inner application calls.
+	AppBudgetConsumed *uint64 `json:"app-budget-consumed,omitempty"`
+
+	// Execution traces of the application calls of the group, in group order, when requested with the exec-trace parameter.
+	ExecTraces *[]ExecTrace `json:"exec-traces,omitempty"`
+
+	// Index of the transaction in the group that caused the simulation to fail, if the failure can be attributed to a single transaction.
+	FailedAt *uint64 `json:"failed-at,omitempty"`
+
+	// Describes why the simulated group would be rejected, if it would.
+	FailureMessage *string `json:"failure-message,omitempty"`
+
+	// The round immediately preceding this simulation. State changes through this round were used to run this simulation.
+	LastRound uint64 `json:"last-round"`
+
+	// Results of the simulated transactions, in group order. If the group would fail, holds the transactions evaluated before the failing one, followed by the failing transaction with the logs its program emitted before failing.
+	TxnResults []PendingTransactionResponse `json:"txn-results"`
+
+	// Indicates whether the transaction group would be accepted by the network.
+	WouldSucceed bool `json:"would-succeed"`
+}
+
 // SupplyResponse defines model for SupplyResponse.
 type SupplyResponse struct {
 
@@ -777,6 +1110,31 @@ type TransactionParametersResponse struct {
 	MinFee uint64 `json:"min-fee"`
 }
 
+// TransactionPoolCompositionResponse defines model for TransactionPoolCompositionResponse.
+type TransactionPoolCompositionResponse struct {
+
+	// The most recently dropped groups, oldest first.
+	Dropped []TransactionPoolDroppedGroup `json:"dropped"`
+
+	// Histogram of the fee per byte of the pending transactions, with power of two bucket boundaries. Empty buckets are omitted.
+	FeeHistogram []TransactionPoolFeeBucket `json:"fee-histogram"`
+
+	// The minimum fee per byte, in microalgos, the pool currently requires.
+	FeePerByte uint64 `json:"fee-per-byte"`
+
+	// Pending groups, in the order they would be proposed, truncated at max.
+	Groups []TransactionPoolGroup `json:"groups"`
+
+	// The maximum number of transactions a sender may have pending. Zero if there is no quota.
+	SenderQuota uint64 `json:"sender-quota"`
+
+	// Senders of pending transactions, by decreasing number of pending transactions.
+	Senders []TransactionPoolSender `json:"senders"`
+
+	// Number of transactions in the pool.
+	TxnCount uint64 `json:"txn-count"`
+}
+
 // VersionsResponse defines model for VersionsResponse.
 type VersionsResponse Version
 
@@ -788,6 +1146,9 @@ type AccountInformationParams struct {
 
 	// When set to `all` will exclude asset holdings, application local state, created asset parameters, any created application parameters. Defaults to `none`.
 	Exclude *string `json:"exclude,omitempty"`
+
+	// The round at which to look up the account state. It must be within the ledger's in-memory lookback window. Defaults to the latest round.
+	Round *uint64 `json:"round,omitempty"`
 }
 
 // AccountApplicationInformationParams defines parameters for AccountApplicationInformation.
@@ -795,6 +1156,9 @@ type AccountApplicationInformationParams struct {
 
 	// Configures whether the response object is JSON or MessagePack encoded.
 	Format *string `json:"format,omitempty"`
+
+	// The round at which to look up the account state. It must be within the ledger's in-memory lookback window. Defaults to the latest round.
+	Round *uint64 `json:"round,omitempty"`
 }
 
 // AccountAssetInformationParams defines parameters for AccountAssetInformation.
@@ -802,6 +1166,9 @@ type AccountAssetInformationParams struct {
 
 	// Configures whether the response object is JSON or MessagePack encoded.
 	Format *string `json:"format,omitempty"`
+
+	// The round at which to look up the account state. It must be within the ledger's in-memory lookback window. Defaults to the latest round.
+	Round *uint64 `json:"round,omitempty"`
 }
 
 // GetPendingTransactionsByAddressParams defines parameters for GetPendingTransactionsByAddress.
@@ -833,6 +1200,20 @@ type GetProofParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// GetLedgerStateDeltaParams defines parameters for GetLedgerStateDelta.
+type GetLedgerStateDeltaParams struct {
+
+	// Configures whether the response object is JSON or MessagePack encoded.
+	Format *string `json:"format,omitempty"`
+}
+
+// StreamBlocksParams defines parameters for StreamBlocks.
+type StreamBlocksParams struct {
+
+	// First round to stream. Defaults to the round after the latest one, or the round after Last-Event-ID when that header is present.
+	From *uint64 `json:"from,omitempty"`
+}
+
 // TealCompileParams defines parameters for TealCompile.
 type TealCompileParams struct {
 
@@ -843,6 +1224,19 @@ type TealCompileParams struct {
 // TealDryrunJSONBody defines parameters for TealDryrun.
 type TealDryrunJSONBody DryrunRequest
 
+// EstimateFeeParams defines parameters for EstimateFee.
+type EstimateFeeParams struct {
+
+	// Encoded size of the transaction group, in bytes
+	Size uint64 `json:"size"`
+
+	// Number of transactions in the group. Defaults to 1.
+	Txns *uint64 `json:"txns,omitempty"`
+
+	// Number of rounds within which the group should be proposed. Defaults to 1.
+	Rounds *uint64 `json:"rounds,omitempty"`
+}
+
 // GetPendingTransactionsParams defines parameters for GetPendingTransactions.
 type GetPendingTransactionsParams struct {
 
@@ -860,5 +1254,12 @@ type PendingTransactionInformationParams struct {
 	Format *string `json:"format,omitempty"`
 }
 
+// SimulateTransactionParams defines parameters for SimulateTransaction.
+type SimulateTransactionParams struct {
+
+	// When set to `true`, returns the per-opcode execution traces of the application calls of the group, including inner application calls. Defaults to `false`.
+	ExecTrace *bool `json:"exec-trace,omitempty"`
+}
+
 // TealDryrunRequestBody defines body for TealDryrun for application/json ContentType.
 type TealDryrunJSONRequestBody TealDryrunJSONBody
@@ -18,18 +18,22 @@ package v2
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklearray"
@@ -39,10 +43,13 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
@@ -67,6 +74,7 @@ type LedgerForAPI interface {
 	Latest() basics.Round
 	LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error)
 	LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error)
+	LookupAccountWithResources(rnd basics.Round, addr basics.Address) (basics.AccountData, basics.MicroAlgos, error)
 	BlockCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error)
 	LatestTotals() (basics.Round, ledgercore.AccountTotals, error)
 	BlockHdr(rnd basics.Round) (blk bookkeeping.BlockHeader, err error)
@@ -74,6 +82,12 @@ type LedgerForAPI interface {
 	GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
 	EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error)
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
+	Simulate(txgroup []transactions.SignedTxn, trace bool) (ledger.SimulationResult, error)
+	BlockDelta(rnd basics.Round) (bookkeeping.Block, ledgercore.StateDelta, error)
+	LookupStateDelta(rnd basics.Round) (ledgercore.StateDelta, error)
+	RegisterBlockListeners(listeners []ledger.BlockListener)
+	UnregisterBlockListeners(listeners []ledger.BlockListener)
+	WriteAccountsSnapshot(ctx context.Context, format string, w io.Writer) (ledger.CatchpointFileHeader, error)
 }
 
 // NodeInterface represents node fns used by the handlers.
@@ -85,7 +99,9 @@ type NodeInterface interface {
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
+	GetTransactionPoolComposition() (pools.PoolComposition, error)
 	SuggestedFee() basics.MicroAlgos
+	EstimateFee(horizon basics.Round) (pools.FeeEstimate, error)
 	StartCatchup(catchpoint string) error
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
@@ -94,6 +110,17 @@ type NodeInterface interface {
 	GetParticipationKey(account.ParticipationID) (account.ParticipationRecord, error)
 	RemoveParticipationKey(account.ParticipationID) error
 	AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error
+	SetSyncRound(rnd uint64) error
+	GetSyncRound() uint64
+	UnsetSyncRound() error
+	PeerStats() ([]network.PeerStats, error)
+	AddPersistentPeer(addr string) error
+	RemovePersistentPeer(addr string) (bool, error)
+	PersistentPeers() ([]string, error)
+	DisconnectPeer(addr string) (bool, error)
+	BanPeer(target string, duration time.Duration) error
+	UnbanPeer(target string) (bool, error)
+	PeerBans() ([]network.PeerBan, error)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -271,6 +298,169 @@ func (v2 *Handlers) ShutdownNode(ctx echo.Context, params private.ShutdownNodePa
 	return ctx.String(http.StatusNotImplemented, "Endpoint not implemented.")
 }
 
+// GetPeers returns the connected peers and the traffic exchanged with each of them.
+// (GET /v2/peers)
+func (v2 *Handlers) GetPeers(ctx echo.Context) error {
+	stats, err := v2.Node.PeerStats()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	response := private.PeersResponse{
+		Peers: make([]private.PeerStatus, len(stats)),
+	}
+	for i, peer := range stats {
+		status := private.PeerStatus{
+			Address:            peer.Address,
+			RemoteAddress:      peer.RemoteAddress,
+			Outgoing:           peer.Outgoing,
+			TelemetryGuid:      peer.TelemetryGUID,
+			InstanceName:       peer.InstanceName,
+			Version:            peer.Version,
+			ConnectedSince:     uint64(peer.ConnectedSince.Unix()),
+			QueuedHighPriority: uint64(peer.QueuedHighPriority),
+			QueuedBulk:         uint64(peer.QueuedBulk),
+			DuplicatesDropped:  peer.DuplicatesDropped,
+			FilteredOut:        peer.FilteredOut,
+			DroppedOut:         peer.DroppedOut,
+			Tags:               make([]private.PeerTagStatistics, 0, len(peer.Tags)),
+		}
+		if !peer.LastPingSent.IsZero() {
+			sent := uint64(peer.LastPingSent.Unix())
+			status.LastPingSent = &sent
+		}
+		if peer.LastPingRoundTripTime > 0 {
+			rtt := uint64(peer.LastPingRoundTripTime.Microseconds())
+			status.LastPingRoundTripUs = &rtt
+		}
+		for tag, ts := range peer.Tags {
+			status.Tags = append(status.Tags, private.PeerTagStatistics{
+				Tag:         string(tag),
+				MessagesIn:  ts.MessagesIn,
+				BytesIn:     ts.BytesIn,
+				MessagesOut: ts.MessagesOut,
+				BytesOut:    ts.BytesOut,
+			})
+		}
+		sort.Slice(status.Tags, func(i, j int) bool {
+			return status.Tags[i].Tag < status.Tags[j].Tag
+		})
+		response.Peers[i] = status
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetPersistentPeers returns the addresses the node keeps connected to.
+// (GET /v2/peers/persistent)
+func (v2 *Handlers) GetPersistentPeers(ctx echo.Context) error {
+	peers, err := v2.Node.PersistentPeers()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.PersistentPeersResponse{Peers: peers})
+}
+
+// AddPersistentPeer adds an address to the phonebook until it's removed, and connects to it.
+// The persistent peers are persisted to the data directory.
+// (POST /v2/peers/persistent/{address})
+func (v2 *Handlers) AddPersistentPeer(ctx echo.Context, address string) error {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || host == "" || port == "" {
+		return badRequest(ctx, err, errFailedToParsePeerAddress, v2.Log)
+	}
+	err = v2.Node.AddPersistentPeer(address)
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusOK)
+	case node.ErrPeerAdminNotSupported:
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToPersistPersistentPeers, err), v2.Log)
+	}
+}
+
+// RemovePersistentPeer removes an address added with AddPersistentPeer.
+// (DELETE /v2/peers/persistent/{address})
+func (v2 *Handlers) RemovePersistentPeer(ctx echo.Context, address string) error {
+	removed, err := v2.Node.RemovePersistentPeer(address)
+	switch {
+	case err == node.ErrPeerAdminNotSupported:
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	case err != nil:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToPersistPersistentPeers, err), v2.Log)
+	case !removed:
+		return notFound(ctx, errors.New(errNotPersistentPeer), errNotPersistentPeer, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// DisconnectPeer disconnects the peers with the given address or remote address.
+// (POST /v2/peers/disconnect/{address})
+func (v2 *Handlers) DisconnectPeer(ctx echo.Context, address string) error {
+	disconnected, err := v2.Node.DisconnectPeer(address)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	if !disconnected {
+		return notFound(ctx, errors.New(errNoPeerWithAddress), errNoPeerWithAddress, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetPeerBans returns the bans in effect.
+// (GET /v2/peers/bans)
+func (v2 *Handlers) GetPeerBans(ctx echo.Context) error {
+	bans, err := v2.Node.PeerBans()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	response := private.PeerBansResponse{
+		Bans: make([]private.PeerBan, len(bans)),
+	}
+	for i, ban := range bans {
+		response.Bans[i] = private.PeerBan{
+			Target:  ban.Target,
+			Expires: uint64(ban.Expires.Unix()),
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// maxPeerBanSeconds is the longest ban, which keeps its expiry representable
+const maxPeerBanSeconds = uint64(math.MaxInt64 / int64(time.Second))
+
+// BanPeer bans an IP address or a telemetry GUID, and disconnects the matching peers.
+// (POST /v2/peers/bans/{target})
+func (v2 *Handlers) BanPeer(ctx echo.Context, target string, params private.BanPeerParams) error {
+	if params.Duration == 0 || params.Duration > maxPeerBanSeconds {
+		err := fmt.Errorf(errInvalidBanDuration, maxPeerBanSeconds)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	err := v2.Node.BanPeer(target, time.Duration(params.Duration)*time.Second)
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusOK)
+	case network.ErrInvalidBanTarget, network.ErrInvalidBanDuration, node.ErrPeerAdminNotSupported:
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToPersistPeerBans, err), v2.Log)
+	}
+}
+
+// UnbanPeer lifts the ban of an IP address or a telemetry GUID.
+// (DELETE /v2/peers/bans/{target})
+func (v2 *Handlers) UnbanPeer(ctx echo.Context, target string) error {
+	unbanned, err := v2.Node.UnbanPeer(target)
+	switch {
+	case err == network.ErrInvalidBanTarget, err == node.ErrPeerAdminNotSupported:
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	case err != nil:
+		return internalError(ctx, err, fmt.Sprintf(errFailedToPersistPeerBans, err), v2.Log)
+	case !unbanned:
+		return notFound(ctx, errors.New(errNotBanned), errNotBanned, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
 // AccountInformation gets account information for a given account.
 // (GET /v2/accounts/{address})
 func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params generated.AccountInformationParams) error {
@@ -284,24 +474,28 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
+	myLedger := v2.Node.LedgerForAPI()
+	round, err := lookupRound(myLedger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
 	// should we skip fetching apps and assets?
 	if params.Exclude != nil {
 		switch *params.Exclude {
 		case "all":
-			return v2.basicAccountInformation(ctx, addr, handle, contentType)
+			return v2.basicAccountInformation(ctx, addr, round, handle, contentType)
 		case "none", "":
 		default:
 			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
 		}
 	}
 
-	myLedger := v2.Node.LedgerForAPI()
-
 	// count total # of resources, if max limit is set
 	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 {
-		record, _, _, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+		record, _, _, err := myLedger.LookupAccount(round, addr)
 		if err != nil {
-			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+			return v2.lookupError(ctx, err)
 		}
 		totalResults := record.TotalAssets + record.TotalAssetParams + record.TotalAppLocalStates + record.TotalAppParams
 		if totalResults > maxResults {
@@ -320,9 +514,17 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		}
 	}
 
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupLatest(addr)
+	var record basics.AccountData
+	var lastRound basics.Round
+	var amountWithoutPendingRewards basics.MicroAlgos
+	if params.Round == nil {
+		record, lastRound, amountWithoutPendingRewards, err = myLedger.LookupLatest(addr)
+	} else {
+		lastRound = round
+		record, amountWithoutPendingRewards, err = myLedger.LookupAccountWithResources(round, addr)
+	}
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.lookupError(ctx, err)
 	}
 
 	// check against configured total limit on assets/apps
@@ -348,12 +550,35 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// lookupRound returns the round at which account state is looked up: the requested round if
+// one was given, and otherwise the latest round.
+func lookupRound(ledgerForAPI LedgerForAPI, round *uint64) (basics.Round, error) {
+	latest := ledgerForAPI.Latest()
+	if round == nil {
+		return latest, nil
+	}
+	if basics.Round(*round) > latest {
+		return 0, fmt.Errorf(errRoundAheadOfLedger, *round, latest)
+	}
+	return basics.Round(*round), nil
+}
+
+// lookupError reports a failed account lookup, telling apart rounds which are no longer in the
+// ledger's in-memory lookback window from other ledger errors.
+func (v2 *Handlers) lookupError(ctx echo.Context, err error) error {
+	var roundOffsetErr *ledger.RoundOffsetError
+	if errors.As(err, &roundOffsetErr) {
+		return badRequest(ctx, err, errRoundOutsideLookbackWindow, v2.Log)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
 // basicAccountInformation handles the case when no resources (assets or apps) are requested.
-func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string) error {
+func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, round basics.Round, handle codec.Handle, contentType string) error {
 	myLedger := v2.Node.LedgerForAPI()
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(round, addr)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.lookupError(ctx, err)
 	}
 
 	if handle == protocol.CodecHandle {
@@ -431,10 +656,13 @@ func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, as
 
 	ledger := v2.Node.LedgerForAPI()
 
-	lastRound := ledger.Latest()
+	lastRound, err := lookupRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 	record, err := ledger.LookupAsset(lastRound, addr, basics.AssetIndex(assetID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.lookupError(ctx, err)
 	}
 
 	if record.AssetParams == nil && record.AssetHolding == nil {
@@ -484,10 +712,13 @@ func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address stri
 
 	ledger := v2.Node.LedgerForAPI()
 
-	lastRound := ledger.Latest()
+	lastRound, err := lookupRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 	record, err := ledger.LookupApplication(lastRound, addr, basics.AppIndex(applicationID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.lookupError(ctx, err)
 	}
 
 	if record.AppParams == nil && record.AppLocalState == nil {
@@ -751,8 +982,25 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 	}
 	proto := config.Consensus[stat.LastVersion]
 
+	txgroup, err := decodeTxGroup(ctx.Request().Body, proto.MaxTxGroupSize)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	err = v2.Node.BroadcastSignedTxGroup(txgroup)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	// For backwards compatibility, return txid of first tx in group
+	txid := txgroup[0].ID()
+	return ctx.JSON(http.StatusOK, generated.PostTransactionsResponse{TxId: txid.String()})
+}
+
+// decodeTxGroup reads a msgpack encoded transaction group from body.
+func decodeTxGroup(body io.Reader, maxGroupSize int) ([]transactions.SignedTxn, error) {
 	var txgroup []transactions.SignedTxn
-	dec := protocol.NewDecoder(ctx.Request().Body)
+	dec := protocol.NewDecoder(body)
 	for {
 		var st transactions.SignedTxn
 		err := dec.Decode(&st)
@@ -760,29 +1008,94 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 			break
 		}
 		if err != nil {
-			return badRequest(ctx, err, err.Error(), v2.Log)
+			return nil, err
 		}
 		txgroup = append(txgroup, st)
 
-		if len(txgroup) > proto.MaxTxGroupSize {
-			err := fmt.Errorf("max group size is %d", proto.MaxTxGroupSize)
-			return badRequest(ctx, err, err.Error(), v2.Log)
+		if len(txgroup) > maxGroupSize {
+			return nil, fmt.Errorf("max group size is %d", maxGroupSize)
 		}
 	}
 
 	if len(txgroup) == 0 {
-		err := errors.New("empty txgroup")
-		return badRequest(ctx, err, err.Error(), v2.Log)
+		return nil, errors.New("empty txgroup")
 	}
+	return txgroup, nil
+}
 
-	err = v2.Node.BroadcastSignedTxGroup(txgroup)
+// preEncodedSimulateResponse mirrors generated.SimulateResponse, using
+// preEncodedTxInfo so that the transactions are encoded properly. FailedAt
+// is not omitempty since the codec would drop a failure at index zero.
+type preEncodedSimulateResponse struct {
+	AppBudgetAdded    *uint64               `codec:"app-budget-added,omitempty"`
+	AppBudgetConsumed *uint64               `codec:"app-budget-consumed,omitempty"`
+	ExecTraces        []generated.ExecTrace `codec:"exec-traces,omitempty"`
+	FailedAt          *uint64               `codec:"failed-at"`
+	FailureMessage    *string               `codec:"failure-message,omitempty"`
+	LastRound         uint64                `codec:"last-round"`
+	TxnResults        []preEncodedTxInfo    `codec:"txn-results"`
+	WouldSucceed      bool                  `codec:"would-succeed"`
+}
+
+// SimulateTransaction evaluates a raw transaction group against the latest ledger state without broadcasting it.
+// (POST /v2/transactions/simulate)
+func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params generated.SimulateTransactionParams) error {
+	if !v2.Node.Config().EnableDeveloperAPI {
+		return ctx.String(http.StatusNotFound, "/transactions/simulate was not enabled in the configuration file by setting the EnableDeveloperAPI to true")
+	}
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("SimulateTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	txgroup, err := decodeTxGroup(ctx.Request().Body, proto.MaxTxGroupSize)
 	if err != nil {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 
-	// For backwards compatibility, return txid of first tx in group
-	txid := txgroup[0].ID()
-	return ctx.JSON(http.StatusOK, generated.PostTransactionsResponse{TxId: txid.String()})
+	trace := params.ExecTrace != nil && *params.ExecTrace
+	result, err := v2.Node.LedgerForAPI().Simulate(txgroup, trace)
+	if err != nil {
+		return internalError(ctx, err, errFailedToSimulate, v2.Log)
+	}
+
+	response := preEncodedSimulateResponse{
+		LastRound:    uint64(result.Round),
+		WouldSucceed: result.WouldSucceed(),
+		TxnResults:   make([]preEncodedTxInfo, len(result.TxnGroup)),
+	}
+	if !result.WouldSucceed() {
+		response.FailureMessage = &result.FailureMessage
+		if result.FailedAt >= 0 {
+			failedAt := uint64(result.FailedAt)
+			response.FailedAt = &failedAt
+		}
+	}
+	if result.AppBudgetAdded > 0 {
+		response.AppBudgetAdded = &result.AppBudgetAdded
+		response.AppBudgetConsumed = &result.AppBudgetConsumed
+	}
+	for i := range result.TxnGroup {
+		// simulated transactions carry their ApplyData, just like inner transactions do
+		response.TxnResults[i] = convertInnerTxn(&result.TxnGroup[i])
+	}
+	for _, execTrace := range result.ExecTraces {
+		if execTrace != nil {
+			response.ExecTraces = append(response.ExecTraces, convertExecTrace(execTrace))
+		}
+	}
+
+	data, err := encode(protocol.JSONStrictHandle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, "application/json", data)
 }
 
 // TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
@@ -880,6 +1193,70 @@ func (v2 *Handlers) TransactionParams(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// EstimateFee returns the fee a transaction group of the given size should pay to be proposed within the given number of rounds.
+// (GET /v2/transactions/fee)
+func (v2 *Handlers) EstimateFee(ctx echo.Context, params generated.EstimateFeeParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("EstimateFee failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	proto := config.Consensus[stat.LastVersion]
+	if params.Size == 0 {
+		return badRequest(ctx, errors.New(errInvalidFeeEstimateSize), errInvalidFeeEstimateSize, v2.Log)
+	}
+	txns := uint64(1)
+	if params.Txns != nil {
+		txns = *params.Txns
+	}
+	if txns == 0 || txns > uint64(proto.MaxTxGroupSize) {
+		err = fmt.Errorf(errInvalidFeeEstimateTxns, proto.MaxTxGroupSize)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	rounds := uint64(1)
+	if params.Rounds != nil {
+		rounds = *params.Rounds
+	}
+	if rounds == 0 || rounds > proto.MaxTxnLife {
+		err = fmt.Errorf(errInvalidFeeEstimateRounds, proto.MaxTxnLife)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	estimate, err := v2.Node.EstimateFee(basics.Round(rounds))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
+	}
+
+	minFee := basics.MulSaturate(proto.MinTxnFee, txns)
+	groupFee := func(feePerByte uint64) uint64 {
+		fee := basics.MulSaturate(feePerByte, params.Size)
+		if fee < minFee {
+			fee = minFee
+		}
+		return fee
+	}
+
+	response := generated.FeeEstimateResponse{
+		Fee:                groupFee(estimate.FeePerByte),
+		FeePerByte:         estimate.FeePerByte,
+		ExpectedRounds:     estimate.ExpectedRounds,
+		DeferredFee:        groupFee(estimate.DeferredFeePerByte),
+		DeferredFeePerByte: estimate.DeferredFeePerByte,
+		DeferredRounds:     estimate.DeferredExpectedRounds,
+		MinFee:             proto.MinTxnFee,
+		PendingBlocks:      estimate.PendingWholeBlocks,
+		BlockFill:          uint64(math.Round(estimate.AverageBlockFill * 100)),
+		ArrivalRate:        uint64(math.Round(estimate.AverageArrivalRate * 100)),
+		BlockHistory:       uint64(estimate.BlockHistory),
+		LastRound:          uint64(stat.LastRound),
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 type preEncodedTxInfo struct {
 	AssetIndex         *uint64                        `codec:"asset-index,omitempty"`
 	AssetClosingAmount *uint64                        `codec:"asset-closing-amount,omitempty"`
@@ -1077,6 +1454,155 @@ func (v2 *Handlers) abortCatchup(ctx echo.Context, catchpoint string) error {
 	})
 }
 
+// SetSyncRound sets the minimum round a node in follow mode keeps available, pausing the catchup past it.
+// (POST /v2/ledger/sync/{round})
+func (v2 *Handlers) SetSyncRound(ctx echo.Context, round uint64) error {
+	err := v2.Node.SetSyncRound(round)
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusOK)
+	case node.ErrNotFollowMode, catchup.ErrSyncRoundInvalid:
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedSettingSyncRound, err), v2.Log)
+	}
+}
+
+// GetSyncRound returns the minimum round a node in follow mode keeps available.
+// (GET /v2/ledger/sync)
+func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
+	if !v2.Node.Config().EnableFollowMode {
+		return badRequest(ctx, node.ErrNotFollowMode, node.ErrNotFollowMode.Error(), v2.Log)
+	}
+	rnd := v2.Node.GetSyncRound()
+	if rnd == 0 {
+		return notFound(ctx, errors.New(errSyncRoundNotSet), errSyncRoundNotSet, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, private.GetSyncRoundResponse{Round: rnd})
+}
+
+// UnsetSyncRound removes the sync round of a node in follow mode.
+// (DELETE /v2/ledger/sync)
+func (v2 *Handlers) UnsetSyncRound(ctx echo.Context) error {
+	err := v2.Node.UnsetSyncRound()
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusOK)
+	case node.ErrNotFollowMode:
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	default:
+		return internalError(ctx, err, fmt.Sprintf(errFailedSettingSyncRound, err), v2.Log)
+	}
+}
+
+// GetLedgerSnapshot writes a snapshot of the account state as of the accounts database round.
+// (GET /v2/ledger/snapshot)
+func (v2 *Handlers) GetLedgerSnapshot(ctx echo.Context, params private.GetLedgerSnapshotParams) error {
+	format := ledger.SnapshotFormatMsgpack
+	if params.Format != nil {
+		format = *params.Format
+	}
+	var contentType string
+	switch format {
+	case ledger.SnapshotFormatMsgpack:
+		contentType = "application/gzip"
+	case ledger.SnapshotFormatJSON:
+		contentType = "application/x-ndjson"
+	default:
+		return badRequest(ctx, fmt.Errorf("unknown snapshot format '%s'", format), errFailedToParseSnapshotFormat, v2.Log)
+	}
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		return serviceUnavailable(ctx, fmt.Errorf("GetLedgerSnapshot failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	// the snapshot is streamed to the response as it is read. errors found before anything was
+	// written are reported with a regular error response, later ones cut the snapshot short.
+	w := ctx.Response()
+	w.Header().Set(echo.HeaderContentType, contentType)
+	_, err = v2.Node.LedgerForAPI().WriteAccountsSnapshot(ctx.Request().Context(), format, w)
+	if err != nil {
+		if w.Committed {
+			v2.Log.Warnf("GetLedgerSnapshot: snapshot was cut short: %v", err)
+			return nil
+		}
+		return internalError(ctx, err, errFailedToWriteSnapshot, v2.Log)
+	}
+	return nil
+}
+
+// GetTransactionPoolComposition returns a breakdown of the content of the transaction pool.
+// (GET /v2/transactions/pool)
+func (v2 *Handlers) GetTransactionPoolComposition(ctx echo.Context, params private.GetTransactionPoolCompositionParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("GetTransactionPoolComposition failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	comp, err := v2.Node.GetTransactionPoolComposition()
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpTransactionPool, v2.Log)
+	}
+
+	response := private.TransactionPoolCompositionResponse{
+		FeePerByte:   comp.FeePerByte,
+		SenderQuota:  uint64(comp.SenderQuota),
+		TxnCount:     uint64(comp.TxnCount),
+		FeeHistogram: make([]private.TransactionPoolFeeBucket, len(comp.FeePerByteHistogram)),
+		Senders:      make([]private.TransactionPoolSender, len(comp.Senders)),
+		Groups:       make([]private.TransactionPoolGroup, 0, len(comp.Groups)),
+		Dropped:      make([]private.TransactionPoolDroppedGroup, len(comp.Dropped)),
+	}
+	for i, bucket := range comp.FeePerByteHistogram {
+		response.FeeHistogram[i] = private.TransactionPoolFeeBucket{
+			Low:   bucket.Low,
+			High:  bucket.High,
+			Count: uint64(bucket.Count),
+		}
+	}
+	for i, sender := range comp.Senders {
+		response.Senders[i] = private.TransactionPoolSender{
+			Address:    sender.Sender.String(),
+			TxnCount:   uint64(sender.TxnCount),
+			GroupCount: uint64(sender.GroupCount),
+		}
+	}
+	now := time.Now()
+	for _, group := range comp.Groups {
+		if params.Max != nil && *params.Max != 0 && uint64(len(response.Groups)) >= *params.Max {
+			break
+		}
+		response.Groups = append(response.Groups, private.TransactionPoolGroup{
+			Txid:          group.Txid.String(),
+			Sender:        group.Sender.String(),
+			Size:          uint64(group.Size),
+			Fee:           group.Fee.Raw,
+			EncodedLength: uint64(group.EncodedLength),
+			PendingMs:     uint64(now.Sub(group.Added).Milliseconds()),
+		})
+	}
+	for i, dropped := range comp.Dropped {
+		response.Dropped[i] = private.TransactionPoolDroppedGroup{
+			Txid:   dropped.Txid.String(),
+			Sender: dropped.Sender.String(),
+			Size:   uint64(dropped.Size),
+			Reason: string(dropped.Reason),
+			Error:  dropped.Error,
+			Time:   uint64(dropped.Time.Unix()),
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetPendingTransactions returns the list of unconfirmed transactions currently in the transaction pool.
 // (GET /v2/transactions/pending)
 func (v2 *Handlers) GetPendingTransactions(ctx echo.Context, params generated.GetPendingTransactionsParams) error {
@@ -1185,7 +1711,11 @@ func (v2 *Handlers) TealCompile(ctx echo.Context, params generated.TealCompilePa
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 	source := buf.String()
-	ops, err := logic.AssembleString(source)
+	var includes logic.IncludeResolver
+	if dir := v2.Node.Config().TealIncludeDir; dir != "" {
+		includes = logic.FileIncludeResolver(dir)
+	}
+	ops, err := logic.AssembleStringWithIncludes(source, "", includes)
 	if err != nil {
 		sb := strings.Builder{}
 		ops.ReportProblems("", &sb)
@@ -1198,6 +1728,10 @@ func (v2 *Handlers) TealCompile(ctx echo.Context, params generated.TealCompilePa
 	var sourcemap *logic.SourceMap
 	if *params.Sourcemap {
 		rawmap := logic.GetSourceMap([]string{}, ops.OffsetToLine)
+		if len(ops.Sources) > 1 {
+			// Map bytes from #include'd files to their own sources
+			rawmap = logic.GetSourceMapWithSources(ops.Sources, ops.OffsetToSource)
+		}
 		sourcemap = &rawmap
 	}
 
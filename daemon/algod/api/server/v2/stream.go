@@ -0,0 +1,235 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	// blockStreamBacklog is how many notified blocks a stream buffers before
+	// it starts dropping them. Dropped rounds are read back from the block
+	// database and the ledger's in-memory deltas; a stream that falls behind
+	// those deltas ends with an error event.
+	blockStreamBacklog = 64
+
+	// blockStreamKeepalive is how often an idle stream sends a comment line
+	// so that proxies keep the connection open.
+	blockStreamKeepalive = 30 * time.Second
+
+	// blockStreamMargin is how long before the REST write timeout a stream
+	// ends itself, so that the last event is never cut off. Clients resume
+	// with the Last-Event-ID header.
+	blockStreamMargin = 5 * time.Second
+)
+
+// streamedBlock is the data of a block event
+type streamedBlock struct {
+	Block bookkeeping.Block `codec:"block"`
+	Delta encodedStateDelta `codec:"delta"`
+}
+
+// blockSubscriber is a ledger.BlockListener that queues blocks for a single
+// stream without ever blocking the ledger's notifier.
+type blockSubscriber struct {
+	blocks chan streamedBlock
+}
+
+// OnNewBlock implements ledger.BlockListener
+func (bs *blockSubscriber) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	select {
+	case bs.blocks <- streamedBlock{Block: block, Delta: makeEncodedStateDelta(delta)}:
+	default:
+		// the stream fell behind, it reads the dropped round from the block database
+	}
+}
+
+// writeEvent writes a single server-sent event. Multi-line data is split into
+// several data fields, which clients join back together with newlines.
+func writeEvent(w io.Writer, id string, event string, data []byte) error {
+	var buf bytes.Buffer
+	if id != "" {
+		fmt.Fprintf(&buf, "id: %s\n", id)
+	}
+	fmt.Fprintf(&buf, "event: %s\n", event)
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		fmt.Fprintf(&buf, "data: %s\n", line)
+	}
+	buf.WriteString("\n")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// roundNotStreamable returns a descriptive error if err reports that the state
+// delta of rnd is no longer kept in memory, and nil otherwise
+func roundNotStreamable(rnd basics.Round, err error) error {
+	var roundOffsetErr *ledger.RoundOffsetError
+	if !errors.As(err, &roundOffsetErr) {
+		return nil
+	}
+	return fmt.Errorf(errRoundNotStreamable, rnd, roundOffsetErr.DBRound()+1)
+}
+
+// StreamBlocks streams committed blocks together with their state deltas as server-sent events.
+// Past rounds can only be streamed while the ledger still keeps their state deltas in memory,
+// since the deltas are not rebuilt from the block database.
+// (GET /v2/stream/blocks)
+func (v2 *Handlers) StreamBlocks(ctx echo.Context, params generated.StreamBlocksParams) error {
+	ledgerForAPI := v2.Node.LedgerForAPI()
+
+	next := ledgerForAPI.Latest() + 1
+	if params.From != nil {
+		next = basics.Round(*params.From)
+	} else if lastEventID := ctx.Request().Header.Get("Last-Event-ID"); lastEventID != "" {
+		last, err := strconv.ParseUint(lastEventID, 10, 64)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseLastEventID, v2.Log)
+		}
+		next = basics.Round(last) + 1
+	}
+	if next == 0 {
+		// the genesis block has no delta
+		next = 1
+	}
+
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		return serviceUnavailable(ctx, fmt.Errorf("StreamBlocks failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	// the deltas of past rounds are only kept in memory for a while
+	if next <= ledgerForAPI.Latest() {
+		if _, err := ledgerForAPI.LookupStateDelta(next); err != nil {
+			if notStreamable := roundNotStreamable(next, err); notStreamable != nil {
+				return badRequest(ctx, err, notStreamable.Error(), v2.Log)
+			}
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+	}
+
+	subscriber := &blockSubscriber{blocks: make(chan streamedBlock, blockStreamBacklog)}
+	ledgerForAPI.RegisterBlockListeners([]ledger.BlockListener{subscriber})
+	defer ledgerForAPI.UnregisterBlockListeners([]ledger.BlockListener{subscriber})
+
+	w := ctx.Response()
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	w.Flush()
+
+	// Once the header is written failures can only be reported in the stream.
+	send := func(sb streamedBlock) error {
+		data, err := encode(protocol.JSONStrictHandle, sb)
+		if err != nil {
+			return err
+		}
+		err = writeEvent(w, strconv.FormatUint(uint64(sb.Block.Round()), 10), "block", data)
+		if err != nil {
+			return err
+		}
+		w.Flush()
+		return nil
+	}
+	sendError := func(err error) error {
+		v2.Log.Info(err)
+		data, encErr := encode(protocol.JSONStrictHandle, generated.ErrorResponse{Message: err.Error()})
+		if encErr == nil {
+			writeEvent(w, "", "error", data)
+			w.Flush()
+		}
+		return nil
+	}
+	// catchUp sends the rounds up to and including last from the block database
+	catchUp := func(last basics.Round) error {
+		for ; next <= last; next++ {
+			block, delta, err := ledgerForAPI.BlockDelta(next)
+			if err != nil {
+				if notStreamable := roundNotStreamable(next, err); notStreamable != nil {
+					// the client consumes blocks slower than they are committed
+					return notStreamable
+				}
+				return err
+			}
+			err = send(streamedBlock{Block: block, Delta: makeEncodedStateDelta(delta)})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := catchUp(ledgerForAPI.Latest()); err != nil {
+		return sendError(err)
+	}
+
+	var deadline <-chan time.Time
+	if timeout := time.Duration(v2.Node.Config().RestWriteTimeoutSeconds) * time.Second; timeout > 0 {
+		duration := timeout - blockStreamMargin
+		if duration <= 0 {
+			duration = timeout / 2
+		}
+		deadline = time.After(duration)
+	}
+	keepalive := time.NewTicker(blockStreamKeepalive)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case sb := <-subscriber.blocks:
+			rnd := sb.Block.Round()
+			if rnd < next {
+				// already sent from the block database
+				continue
+			}
+			if err := catchUp(rnd - 1); err != nil {
+				return sendError(err)
+			}
+			if err := send(sb); err != nil {
+				return sendError(err)
+			}
+			next = rnd + 1
+		case <-keepalive.C:
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return nil
+			}
+			w.Flush()
+		case <-deadline:
+			return nil
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-v2.Shutdown:
+			return nil
+		}
+	}
+}
@@ -17,7 +17,9 @@
 package test
 
 import (
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -28,6 +30,8 @@ import (
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
@@ -58,6 +62,10 @@ func (l *mockLedger) LookupLatest(addr basics.Address) (basics.AccountData, basi
 	return ad, l.latest, basics.MicroAlgos{Raw: 0}, nil
 }
 
+func (l *mockLedger) LookupAccountWithResources(round basics.Round, addr basics.Address) (basics.AccountData, basics.MicroAlgos, error) {
+	return l.accounts[addr], basics.MicroAlgos{Raw: 0}, nil
+}
+
 func (l *mockLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
 	return config.Consensus[protocol.ConsensusFuture], nil
 }
@@ -111,6 +119,24 @@ func (l *mockLedger) EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte
 func (l *mockLedger) Block(rnd basics.Round) (blk bookkeeping.Block, err error) {
 	panic("not implemented")
 }
+func (l *mockLedger) Simulate(txgroup []transactions.SignedTxn, trace bool) (ledger.SimulationResult, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) BlockDelta(rnd basics.Round) (bookkeeping.Block, ledgercore.StateDelta, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) LookupStateDelta(rnd basics.Round) (ledgercore.StateDelta, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) RegisterBlockListeners(listeners []ledger.BlockListener) {
+	panic("not implemented")
+}
+func (l *mockLedger) UnregisterBlockListeners(listeners []ledger.BlockListener) {
+	panic("not implemented")
+}
+func (l *mockLedger) WriteAccountsSnapshot(ctx context.Context, format string, w io.Writer) (ledger.CatchpointFileHeader, error) {
+	panic("not implemented")
+}
 
 func randomAccountWithResources(N int) basics.AccountData {
 	a := ledgertesting.RandomAccountData(0)
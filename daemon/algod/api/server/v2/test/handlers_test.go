@@ -18,12 +18,18 @@ package test
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
@@ -31,18 +37,24 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklearray"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
@@ -102,6 +114,99 @@ func TestAccountInformation(t *testing.T) {
 	accountInformationTest(t, "bad account", 400)
 }
 
+func TestAccountInformationAtRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, rootkeys, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+
+	// round 1 pays the pool
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	eval, err := mockLedger.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+	require.NoError(t, err)
+	pay := txntest.Txn{
+		Type:        protocol.PaymentTx,
+		Sender:      rootkeys[0].Address(),
+		Receiver:    poolAddr,
+		Amount:      1000,
+		GenesisHash: genesisHash,
+	}
+	pay.FillDefaults(config.Consensus[protocol.ConsensusCurrentVersion])
+	require.NoError(t, eval.Transaction(pay.SignedTxn(), transactions.ApplyData{}))
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*vb, agreement.Certificate{}))
+
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	accountAt := func(round *uint64, exclude string, expectedCode int) (response generatedV2.AccountResponse) {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.AccountInformation(c, poolAddr.String(), generatedV2.AccountInformationParams{Round: round, Exclude: &exclude})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		if expectedCode == http.StatusOK {
+			require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+		}
+		return
+	}
+
+	round0, round1, round2 := uint64(0), uint64(1), uint64(2)
+	latest := accountAt(nil, "none", http.StatusOK)
+	require.Equal(t, uint64(1), latest.Round)
+	for _, exclude := range []string{"none", "all"} {
+		before := accountAt(&round0, exclude, http.StatusOK)
+		require.Equal(t, uint64(0), before.Round)
+		require.Equal(t, poolAddrResponseGolden.AmountWithoutPendingRewards, before.AmountWithoutPendingRewards)
+		after := accountAt(&round1, exclude, http.StatusOK)
+		require.Equal(t, uint64(1), after.Round)
+		require.Equal(t, before.AmountWithoutPendingRewards+1000, after.AmountWithoutPendingRewards)
+		require.Equal(t, latest.Amount, after.Amount)
+	}
+	accountAt(&round2, "none", http.StatusBadRequest)
+
+	// the logic sig account of the testing environment opted into app 1 at genesis
+	program := logic.Program(retOneProgram)
+	lhash := crypto.HashObj(&program)
+	var appAddr basics.Address
+	copy(appAddr[:], lhash[:])
+	for _, round := range []*uint64{&round0, &round1, &round2} {
+		expectedCode := http.StatusOK
+		if *round == round2 {
+			expectedCode = http.StatusBadRequest
+		}
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.AccountApplicationInformation(c, appAddr.String(), 1, generatedV2.AccountApplicationInformationParams{Round: round})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		if expectedCode == http.StatusOK {
+			var response generatedV2.AccountApplicationResponse
+			require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+			require.Equal(t, *round, response.Round)
+			require.NotNil(t, response.AppLocalState)
+		}
+	}
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	err = handler.AccountAssetInformation(c, appAddr.String(), 1, generatedV2.AccountAssetInformationParams{Round: &round2})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func getBlockTest(t *testing.T, blockNum uint64, format string, expectedCode int) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
 	defer releasefunc()
@@ -339,6 +444,206 @@ func getPendingTransactionsTest(t *testing.T, format string, max uint64, expecte
 	}
 }
 
+func estimateFeeTest(t *testing.T, size uint64, txns *uint64, rounds *uint64, expectedCode int) generatedV2.FeeEstimateResponse {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	params := generatedV2.EstimateFeeParams{Size: size, Txns: txns, Rounds: rounds}
+	err := handler.EstimateFee(c, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+
+	var response generatedV2.FeeEstimateResponse
+	if rec.Code == 200 {
+		data := rec.Body.Bytes()
+		err = protocol.DecodeJSON(data, &response)
+		require.NoError(t, err, string(data))
+	}
+	return response
+}
+
+func TestEstimateFee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	u := func(v uint64) *uint64 { return &v }
+
+	// small groups pay the minimum fee of each of their transactions.
+	response := estimateFeeTest(t, 200, nil, nil, 200)
+	require.Equal(t, proto.MinTxnFee, response.Fee)
+	require.Equal(t, uint64(2), response.FeePerByte)
+	require.Equal(t, uint64(75), response.BlockFill)
+	require.Equal(t, uint64(50), response.ArrivalRate)
+	require.Equal(t, uint64(3), response.ExpectedRounds)
+	response = estimateFeeTest(t, 200, u(3), u(1), 200)
+	require.Equal(t, 3*proto.MinTxnFee, response.Fee)
+
+	// larger groups pay by the byte. Longer horizons may allow for a cheaper deferred fee,
+	// but the fee stays the one the pool admits right now.
+	size := proto.MinTxnFee * 10
+	response = estimateFeeTest(t, size, nil, u(1), 200)
+	require.Equal(t, 2*size, response.Fee)
+	require.Equal(t, 2*size, response.DeferredFee)
+	response = estimateFeeTest(t, size, nil, u(10), 200)
+	require.Equal(t, uint64(2), response.FeePerByte)
+	require.Equal(t, 2*size, response.Fee)
+	require.Equal(t, uint64(3), response.ExpectedRounds)
+	require.Equal(t, uint64(1), response.DeferredFeePerByte)
+	require.Equal(t, size, response.DeferredFee)
+	require.Equal(t, uint64(5), response.DeferredRounds)
+
+	estimateFeeTest(t, 0, nil, nil, 400)
+	estimateFeeTest(t, 200, u(0), nil, 400)
+	estimateFeeTest(t, 200, u(uint64(proto.MaxTxGroupSize)+1), nil, 400)
+	estimateFeeTest(t, 200, nil, u(0), 400)
+	estimateFeeTest(t, 200, nil, u(proto.MaxTxnLife+1), 400)
+}
+
+func TestGetTransactionPoolComposition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for _, max := range []uint64{0, 1, 3} {
+		handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+		params := private.GetTransactionPoolCompositionParams{Max: &max}
+		err := handler.GetTransactionPoolComposition(c, params)
+		require.NoError(t, err)
+		require.Equal(t, 200, rec.Code)
+
+		var response private.TransactionPoolCompositionResponse
+		data := rec.Body.Bytes()
+		err = protocol.DecodeJSON(data, &response)
+		require.NoError(t, err, string(data))
+		releasefunc()
+
+		require.Equal(t, uint64(len(txnPoolGolden)), response.TxnCount)
+		if max == 0 || max >= uint64(len(txnPoolGolden)) {
+			require.Len(t, response.Groups, len(txnPoolGolden))
+		} else {
+			require.Len(t, response.Groups, int(max))
+		}
+		require.Equal(t, []private.TransactionPoolFeeBucket{{Low: 0, High: 1, Count: uint64(len(txnPoolGolden))}}, response.FeeHistogram)
+		require.Len(t, response.Senders, 1)
+		require.Len(t, response.Dropped, 1)
+		require.Equal(t, string(pools.DropReasonFeeTooLow), response.Dropped[0].Reason)
+	}
+}
+
+func TestGetPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	err := handler.GetPeers(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response private.PeersResponse
+	data := rec.Body.Bytes()
+	err = protocol.DecodeJSON(data, &response)
+	require.NoError(t, err, string(data))
+	require.Len(t, response.Peers, 2)
+
+	relay := response.Peers[0]
+	require.True(t, relay.Outgoing)
+	require.Equal(t, "r1.algorand.network:4160", relay.Address)
+	require.Equal(t, "guid-1", relay.TelemetryGuid)
+	require.Equal(t, uint64(1600000000), relay.ConnectedSince)
+	require.NotNil(t, relay.LastPingSent)
+	require.Equal(t, uint64(1600000100), *relay.LastPingSent)
+	require.NotNil(t, relay.LastPingRoundTripUs)
+	require.Equal(t, uint64(1500), *relay.LastPingRoundTripUs)
+	require.Equal(t, uint64(2), relay.QueuedBulk)
+	require.Equal(t, uint64(3), relay.FilteredOut)
+	require.Equal(t, []private.PeerTagStatistics{
+		{Tag: "AV", MessagesIn: 20, BytesIn: 4000},
+		{Tag: "TX", MessagesIn: 10, BytesIn: 2000, MessagesOut: 5, BytesOut: 1000},
+	}, relay.Tags)
+
+	incoming := response.Peers[1]
+	require.False(t, incoming.Outgoing)
+	require.Equal(t, "10.0.0.2:52000", incoming.RemoteAddress)
+	require.Nil(t, incoming.LastPingSent)
+	require.Nil(t, incoming.LastPingRoundTripUs)
+	require.Equal(t, uint64(7), incoming.DuplicatesDropped)
+	require.Empty(t, incoming.Tags)
+}
+
+func TestPeerControls(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	call := func(method string, f func(c echo.Context) error, expectedCode int) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(method, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, f(c))
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+	withAddress := func(f func(c echo.Context, address string) error, address string) func(c echo.Context) error {
+		return func(c echo.Context) error { return f(c, address) }
+	}
+	ban := func(target string, duration uint64) func(c echo.Context) error {
+		return func(c echo.Context) error {
+			return handler.BanPeer(c, target, private.BanPeerParams{Duration: duration})
+		}
+	}
+
+	// persistent peers
+	call(http.MethodPost, withAddress(handler.AddPersistentPeer, "r1.algorand.network:4160"), http.StatusOK)
+	call(http.MethodPost, withAddress(handler.AddPersistentPeer, "r2.algorand.network"), http.StatusBadRequest)
+	rec := call(http.MethodGet, handler.GetPersistentPeers, http.StatusOK)
+	var peers private.PersistentPeersResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &peers))
+	require.Equal(t, []string{"r1.algorand.network:4160"}, peers.Peers)
+	call(http.MethodDelete, withAddress(handler.RemovePersistentPeer, "r1.algorand.network:4160"), http.StatusOK)
+	call(http.MethodDelete, withAddress(handler.RemovePersistentPeer, "r1.algorand.network:4160"), http.StatusNotFound)
+
+	// disconnecting, by address or by remote address
+	call(http.MethodPost, withAddress(handler.DisconnectPeer, "r1.algorand.network:4160"), http.StatusOK)
+	call(http.MethodPost, withAddress(handler.DisconnectPeer, "10.0.0.2:52000"), http.StatusOK)
+	call(http.MethodPost, withAddress(handler.DisconnectPeer, "10.0.0.3:52000"), http.StatusNotFound)
+
+	// bans
+	call(http.MethodPost, ban("10.0.0.2", 3600), http.StatusOK)
+	call(http.MethodPost, ban("10.0.0.3", 0), http.StatusBadRequest)
+	call(http.MethodPost, ban("10.0.0.3", math.MaxUint64), http.StatusBadRequest)
+	call(http.MethodPost, ban("10.0.0.0/24", 3600), http.StatusBadRequest)
+	rec = call(http.MethodGet, handler.GetPeerBans, http.StatusOK)
+	var bans private.PeerBansResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &bans))
+	require.Equal(t, []private.PeerBan{{Target: "10.0.0.2", Expires: 1600003600}}, bans.Bans)
+	call(http.MethodDelete, withAddress(handler.UnbanPeer, "10.0.0.2"), http.StatusOK)
+	call(http.MethodDelete, withAddress(handler.UnbanPeer, "10.0.0.2"), http.StatusNotFound)
+
+	// bans that could not be persisted are reported
+	mockNode.err = errors.New("disk full")
+	call(http.MethodPost, ban("guid-2", 3600), http.StatusInternalServerError)
+	bansInEffect, err := mockNode.PeerBans()
+	require.NoError(t, err)
+	require.Len(t, bansInEffect, 1)
+	call(http.MethodDelete, withAddress(handler.UnbanPeer, "guid-2"), http.StatusInternalServerError)
+
+	// so are persistent peers
+	call(http.MethodPost, withAddress(handler.AddPersistentPeer, "r3.algorand.network:4160"), http.StatusInternalServerError)
+	call(http.MethodDelete, withAddress(handler.RemovePersistentPeer, "r3.algorand.network:4160"), http.StatusInternalServerError)
+}
+
 func TestPendingTransactionLogsEncoding(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -450,6 +755,157 @@ func TestPostTransaction(t *testing.T) {
 	postTransactionTest(t, 0, 200)
 }
 
+func simulateTransactionTest(t *testing.T, txnToUse int, enableDeveloperAPI bool, execTrace bool, expectedCode int) (response generatedV2.SimulateResponse) {
+	numAccounts := 5
+	numTransactions := 5
+	offlineAccounts := true
+	mockLedger, _, _, stxns, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	mockNode.config.EnableDeveloperAPI = enableDeveloperAPI
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+	e := echo.New()
+	var body io.Reader
+	if txnToUse >= 0 {
+		stxn := stxns[txnToUse]
+		bodyBytes := protocol.Encode(&stxn)
+		body = bytes.NewReader(bodyBytes)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := handler.SimulateTransaction(c, generatedV2.SimulateTransactionParams{ExecTrace: &execTrace})
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if rec.Code == http.StatusOK {
+		err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+	}
+	return
+}
+
+func TestSimulateTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	simulateTransactionTest(t, 0, false, false, 404)
+	simulateTransactionTest(t, -1, true, false, 400)
+
+	response := simulateTransactionTest(t, 0, true, false, 200)
+	// the testing environment's senders are unfunded, so the simulated payment overspends
+	require.False(t, response.WouldSucceed)
+	// the failing transaction is reported, without the effects it didn't have
+	require.Len(t, response.TxnResults, 1)
+	require.Nil(t, response.TxnResults[0].Logs)
+	require.NotNil(t, response.FailedAt)
+	require.Equal(t, uint64(0), *response.FailedAt)
+	require.NotNil(t, response.FailureMessage)
+	require.Contains(t, *response.FailureMessage, "overspend")
+	require.Nil(t, response.ExecTraces)
+
+	// payments run no program, so there is nothing to trace
+	response = simulateTransactionTest(t, 0, true, true, 200)
+	require.False(t, response.WouldSucceed)
+	require.Nil(t, response.ExecTraces)
+}
+
+func streamBlocksTest(t *testing.T, handler v2.Handlers, from *uint64, lastEventID string, expectedCode int) (rounds []uint64) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := handler.StreamBlocks(c, generatedV2.StreamBlocksParams{From: from})
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if rec.Code != http.StatusOK {
+		return
+	}
+	require.Equal(t, "text/event-stream", rec.Header().Get(echo.HeaderContentType))
+
+	body := strings.TrimSpace(rec.Body.String())
+	if body == "" {
+		return
+	}
+	// events are separated by blank lines, and an event's data may span lines
+	for _, event := range strings.Split(body, "\n\n") {
+		var id uint64
+		var data []string
+		for _, line := range strings.Split(event, "\n") {
+			switch {
+			case strings.HasPrefix(line, "id: "):
+				id, err = strconv.ParseUint(strings.TrimPrefix(line, "id: "), 10, 64)
+				require.NoError(t, err)
+			case strings.HasPrefix(line, "data: "):
+				data = append(data, strings.TrimPrefix(line, "data: "))
+			}
+		}
+		require.Contains(t, event, "event: block\n", event)
+
+		var decoded struct {
+			Block bookkeeping.Block `codec:"block"`
+			Delta struct {
+				Accounts []struct {
+					Address basics.Address     `codec:"address"`
+					Data    basics.AccountData `codec:"data"`
+				} `codec:"accounts"`
+				Totals ledgercore.AccountTotals `codec:"totals"`
+			} `codec:"delta"`
+		}
+		err = protocol.DecodeJSON([]byte(strings.Join(data, "\n")), &decoded)
+		require.NoError(t, err)
+		require.Equal(t, id, uint64(decoded.Block.Round()))
+		rounds = append(rounds, id)
+	}
+	return
+}
+
+func TestStreamBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+
+	for i := 0; i < 3; i++ {
+		hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+		require.NoError(t, err)
+		eval, err := mockLedger.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+		require.NoError(t, err)
+		vb, err := eval.GenerateBlock()
+		require.NoError(t, err)
+		require.NoError(t, mockLedger.AddValidatedBlock(*vb, agreement.Certificate{}))
+	}
+
+	// a closed shutdown channel ends the stream once the past rounds are sent
+	shutdownChan := make(chan struct{})
+	close(shutdownChan)
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: shutdownChan,
+	}
+
+	// by default only blocks committed after the request are streamed
+	require.Empty(t, streamBlocksTest(t, handler, nil, "", http.StatusOK))
+
+	from := uint64(1)
+	require.Equal(t, []uint64{1, 2, 3}, streamBlocksTest(t, handler, &from, "", http.StatusOK))
+	require.Equal(t, []uint64{3}, streamBlocksTest(t, handler, nil, "2", http.StatusOK))
+	streamBlocksTest(t, handler, nil, "two", http.StatusBadRequest)
+}
+
 func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
@@ -523,6 +979,167 @@ func TestAbortCatchup(t *testing.T) {
 	abortCatchupTest(t, badCatchPoint, 400)
 }
 
+func TestGetLedgerStateDelta(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	eval, err := mockLedger.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+	require.NoError(t, err)
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*vb, agreement.Certificate{}))
+
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+
+	getDelta := func(round uint64, format *string, expectedCode int) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		err := handler.GetLedgerStateDelta(c, round, generated.GetLedgerStateDeltaParams{Format: format})
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+
+	rec := getDelta(1, nil, http.StatusOK)
+	var decoded struct {
+		Accounts []struct {
+			Address basics.Address     `codec:"address"`
+			Data    basics.AccountData `codec:"data"`
+		} `codec:"accounts"`
+		Totals ledgercore.AccountTotals `codec:"totals"`
+	}
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &decoded))
+	delta := vb.Delta()
+	require.Equal(t, delta.Totals, decoded.Totals)
+	require.Len(t, decoded.Accounts, delta.Accts.Len())
+
+	msgpack := "msgpack"
+	getDelta(1, &msgpack, http.StatusOK)
+	bogus := "bogus"
+	getDelta(1, &bogus, http.StatusBadRequest)
+	getDelta(0, nil, http.StatusNotFound)
+	getDelta(2, nil, http.StatusNotFound)
+}
+
+func TestSyncRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+
+	hdr, err := mockLedger.BlockHdr(mockLedger.Latest())
+	require.NoError(t, err)
+	eval, err := mockLedger.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+	require.NoError(t, err)
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*vb, agreement.Certificate{}))
+
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	mockNode.config.EnableFollowMode = true
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+
+	call := func(method string, f func(c echo.Context) error, expectedCode int) *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(method, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		require.NoError(t, f(c))
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+	setSyncRound := func(round uint64) func(c echo.Context) error {
+		return func(c echo.Context) error { return handler.SetSyncRound(c, round) }
+	}
+
+	call(http.MethodGet, handler.GetSyncRound, http.StatusNotFound)
+	call(http.MethodPost, setSyncRound(2), http.StatusOK)
+	rec := call(http.MethodGet, handler.GetSyncRound, http.StatusOK)
+	var response private.GetSyncRoundResponse
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(2), response.Round)
+
+	// the sync round cannot be moved behind the ledger
+	call(http.MethodPost, setSyncRound(0), http.StatusBadRequest)
+	require.Equal(t, uint64(2), mockNode.syncRound)
+
+	call(http.MethodDelete, handler.UnsetSyncRound, http.StatusOK)
+	call(http.MethodGet, handler.GetSyncRound, http.StatusNotFound)
+
+	mockNode.config.EnableFollowMode = false
+	call(http.MethodGet, handler.GetSyncRound, http.StatusBadRequest)
+	mockNode.err = node.ErrNotFollowMode
+	call(http.MethodPost, setSyncRound(2), http.StatusBadRequest)
+	call(http.MethodDelete, handler.UnsetSyncRound, http.StatusBadRequest)
+}
+
+func TestGetLedgerSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	snapshot := func(format string, expectedCode int) *httptest.ResponseRecorder {
+		e := echo.New()
+		rec := httptest.NewRecorder()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		require.NoError(t, handler.GetLedgerSnapshot(c, private.GetLedgerSnapshotParams{Format: &format}))
+		require.Equal(t, expectedCode, rec.Code)
+		return rec
+	}
+
+	snapshot("csv", http.StatusBadRequest)
+
+	rec := snapshot("msgpack", http.StatusOK)
+	require.Equal(t, "application/gzip", rec.Header().Get(echo.HeaderContentType))
+	// gzip magic number
+	require.Equal(t, []byte{0x1f, 0x8b}, rec.Body.Bytes()[:2])
+
+	rec = snapshot("json", http.StatusOK)
+	lines := strings.Split(strings.TrimSpace(rec.Body.String()), "\n")
+	var header ledger.CatchpointFileHeader
+	require.NoError(t, protocol.DecodeJSON([]byte(lines[0]), &header))
+	require.Equal(t, basics.Round(0), header.BalancesRound)
+	require.Equal(t, header.TotalAccounts, uint64(len(lines)-1))
+	var account ledger.SnapshotAccount
+	require.NoError(t, protocol.DecodeJSON([]byte(lines[1]), &account))
+}
+
 func tealCompileTest(t *testing.T, bytesToUse []byte, expectedCode int,
 	enableDeveloperAPI bool, params generated.TealCompileParams,
 	expectedSourcemap *logic.SourceMap,
@@ -595,6 +1212,55 @@ int 1`, logic.AssemblerMaxVersion)
 	tealCompileTest(t, badProgramBytes, 400, true, params, nil)
 }
 
+func TestTealCompileIncludes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	mockNode.config.EnableDeveloperAPI = true
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "lib.teal"), []byte("#define ONE 1\nint ONE\n"), 0600))
+	program := []byte(fmt.Sprintf("#pragma version %d\n#include \"lib.teal\"\nassert\nint ONE", logic.AssemblerMaxVersion))
+
+	compile := func() *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(program))
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		sourcemap := true
+		err := handler.TealCompile(c, generated.TealCompileParams{Sourcemap: &sourcemap})
+		require.NoError(t, err)
+		return rec
+	}
+
+	// #include is only available with an include directory
+	rec := compile()
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "#include is not available")
+
+	mockNode.config.TealIncludeDir = dir
+	rec = compile()
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response v2.CompileResponseWithSourceMap
+	require.NoError(t, protocol.DecodeJSON(rec.Body.Bytes(), &response))
+	require.Equal(t, []string{"", "lib.teal"}, response.Sourcemap.Sources)
+
+	expected, err := logic.AssembleString(fmt.Sprintf("#pragma version %d\nint 1\nassert\nint 1", logic.AssemblerMaxVersion))
+	require.NoError(t, err)
+	require.Equal(t, base64.StdEncoding.EncodeToString(expected.Program), response.Result)
+}
+
 func tealDisassembleTest(t *testing.T, program []byte, expectedCode int,
 	expectedString string, enableDeveloperAPI bool,
 ) (response generatedV2.DisassembleResponse) {
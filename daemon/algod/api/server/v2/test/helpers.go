@@ -20,9 +20,12 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
@@ -31,10 +34,12 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/node/indexer"
 	"github.com/algorand/go-algorand/protocol"
@@ -88,6 +93,10 @@ type mockNode struct {
 	err       error
 	id        account.ParticipationID
 	keys      account.StateProofKeys
+	syncRound uint64
+
+	persistentPeers []string
+	peerBans        []network.PeerBan
 }
 
 func (m mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -150,10 +159,53 @@ func (m mockNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn, error) {
 	return txnPoolGolden, m.err
 }
 
+func (m mockNode) GetTransactionPoolComposition() (pools.PoolComposition, error) {
+	comp := pools.PoolComposition{
+		TxnCount: len(txnPoolGolden),
+		FeePerByteHistogram: []pools.FeePerByteBucket{
+			{Low: 0, High: 1, Count: len(txnPoolGolden)},
+		},
+		Senders: []pools.SenderPendingStats{
+			{TxnCount: len(txnPoolGolden), GroupCount: len(txnPoolGolden)},
+		},
+		Dropped: []pools.DroppedTxGroup{
+			{Size: 1, Reason: pools.DropReasonFeeTooLow, Error: "fee too low", Time: time.Now()},
+		},
+	}
+	for _, stxn := range txnPoolGolden {
+		comp.Groups = append(comp.Groups, pools.PendingTxGroupInfo{
+			Txid:   stxn.ID(),
+			Sender: stxn.Txn.Sender,
+			Size:   1,
+			Fee:    stxn.Txn.Fee,
+			Added:  time.Now(),
+		})
+	}
+	return comp, m.err
+}
+
 func (m mockNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{Raw: 1}
 }
 
+func (m mockNode) EstimateFee(horizon basics.Round) (pools.FeeEstimate, error) {
+	estimate := pools.FeeEstimate{
+		FeePerByte:             2,
+		ExpectedRounds:         3,
+		DeferredFeePerByte:     2,
+		DeferredExpectedRounds: 3,
+		PendingWholeBlocks:     2,
+		AverageBlockFill:       0.75,
+		AverageArrivalRate:     0.5,
+		BlockHistory:           3,
+	}
+	if horizon >= 5 {
+		estimate.DeferredFeePerByte = 1
+		estimate.DeferredExpectedRounds = 5
+	}
+	return estimate, m.err
+}
+
 // unused by handlers:
 func (m mockNode) Config() config.Local {
 	return m.config
@@ -208,6 +260,109 @@ func (m mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
 
+func (m *mockNode) SetSyncRound(rnd uint64) error {
+	if m.err != nil {
+		return m.err
+	}
+	if basics.Round(rnd) < m.ledger.Latest() {
+		return catchup.ErrSyncRoundInvalid
+	}
+	m.syncRound = rnd
+	return nil
+}
+
+func (m mockNode) GetSyncRound() uint64 {
+	return m.syncRound
+}
+
+func (m *mockNode) UnsetSyncRound() error {
+	if m.err != nil {
+		return m.err
+	}
+	m.syncRound = 0
+	return nil
+}
+
+var peerStatsGolden = []network.PeerStats{
+	{
+		Address:               "r1.algorand.network:4160",
+		RemoteAddress:         "10.0.0.1:4160",
+		Outgoing:              true,
+		TelemetryGUID:         "guid-1",
+		Version:               "2.1",
+		ConnectedSince:        time.Unix(1600000000, 0),
+		LastPingSent:          time.Unix(1600000100, 0),
+		LastPingRoundTripTime: 1500 * time.Microsecond,
+		QueuedBulk:            2,
+		FilteredOut:           3,
+		Tags: map[protocol.Tag]network.PeerTagStats{
+			protocol.TxnTag:           {MessagesIn: 10, BytesIn: 2000, MessagesOut: 5, BytesOut: 1000},
+			protocol.AgreementVoteTag: {MessagesIn: 20, BytesIn: 4000},
+		},
+	},
+	{
+		RemoteAddress:     "10.0.0.2:52000",
+		TelemetryGUID:     "guid-2",
+		Version:           "2.1",
+		ConnectedSince:    time.Unix(1600000050, 0),
+		DuplicatesDropped: 7,
+	},
+}
+
+func (m mockNode) PeerStats() ([]network.PeerStats, error) {
+	return peerStatsGolden, nil
+}
+
+func (m *mockNode) AddPersistentPeer(addr string) error {
+	m.persistentPeers = append(m.persistentPeers, addr)
+	return m.err
+}
+
+func (m *mockNode) RemovePersistentPeer(addr string) (bool, error) {
+	for i, peer := range m.persistentPeers {
+		if peer == addr {
+			m.persistentPeers = append(m.persistentPeers[:i], m.persistentPeers[i+1:]...)
+			return true, m.err
+		}
+	}
+	return false, nil
+}
+
+func (m mockNode) PersistentPeers() ([]string, error) {
+	return m.persistentPeers, nil
+}
+
+func (m mockNode) DisconnectPeer(addr string) (bool, error) {
+	for _, peer := range peerStatsGolden {
+		if peer.Address == addr || peer.RemoteAddress == addr {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockNode) BanPeer(target string, duration time.Duration) error {
+	if strings.Contains(target, "/") {
+		return network.ErrInvalidBanTarget
+	}
+	m.peerBans = append(m.peerBans, network.PeerBan{Target: target, Expires: time.Unix(1600000000, 0).Add(duration)})
+	return m.err
+}
+
+func (m *mockNode) UnbanPeer(target string) (bool, error) {
+	for i, ban := range m.peerBans {
+		if ban.Target == target {
+			m.peerBans = append(m.peerBans[:i], m.peerBans[i+1:]...)
+			return true, m.err
+		}
+	}
+	return false, nil
+}
+
+func (m mockNode) PeerBans() ([]network.PeerBan, error) {
+	return m.peerBans, nil
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
@@ -84,6 +84,62 @@ func apiWalletFromMetadata(metadata wallet.Metadata) kmdapi.APIV1Wallet {
 	}
 }
 
+// apiPolicyFromPolicy converts a wallet.Policy into its API representation
+func apiPolicyFromPolicy(policy wallet.Policy) kmdapi.APIV1Policy {
+	var apiPolicy kmdapi.APIV1Policy
+	for _, rule := range policy.Rules {
+		apiRule := kmdapi.APIV1PolicyRule{
+			AllowedTxTypes:       rule.AllowedTxTypes,
+			MaxPaymentAmount:     rule.MaxPaymentAmount,
+			AllowedReceivers:     encodeAddresses(rule.AllowedReceivers),
+			AllowedAppIDs:        rule.AllowedAppIDs,
+			MaxSignaturesPerHour: rule.MaxSignaturesPerHour,
+		}
+		if !rule.Address.IsZero() {
+			apiRule.Address = encodeAddress(rule.Address)
+		}
+		apiPolicy.Rules = append(apiPolicy.Rules, apiRule)
+	}
+	return apiPolicy
+}
+
+// policyFromAPIPolicy decodes the addresses in an API policy
+func policyFromAPIPolicy(apiPolicy kmdapi.APIV1Policy) (policy wallet.Policy, err error) {
+	for _, apiRule := range apiPolicy.Rules {
+		rule := wallet.PolicyRule{
+			AllowedTxTypes:       apiRule.AllowedTxTypes,
+			MaxPaymentAmount:     apiRule.MaxPaymentAmount,
+			AllowedAppIDs:        apiRule.AllowedAppIDs,
+			MaxSignaturesPerHour: apiRule.MaxSignaturesPerHour,
+		}
+		if apiRule.Address != "" {
+			addr, err := basics.UnmarshalChecksumAddress(apiRule.Address)
+			if err != nil {
+				return wallet.Policy{}, errCouldNotDecodeAddress
+			}
+			rule.Address = crypto.Digest(addr)
+		}
+		for _, receiver := range apiRule.AllowedReceivers {
+			addr, err := basics.UnmarshalChecksumAddress(receiver)
+			if err != nil {
+				return wallet.Policy{}, errCouldNotDecodeAddress
+			}
+			rule.AllowedReceivers = append(rule.AllowedReceivers, crypto.Digest(addr))
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+	return policy, nil
+}
+
+// exportErrorStatus returns the status of a failed key export or backup:
+// forbidden if the wallet's signing policy refused it
+func exportErrorStatus(err error) int {
+	if err == wallet.ErrPolicyRestrictsExport {
+		return http.StatusForbidden
+	}
+	return http.StatusBadRequest
+}
+
 // getWalletsHandler handles `GET /v1/wallets`
 func getWalletsHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation GET /v1/wallets ListWallets
@@ -351,7 +407,7 @@ func postMasterKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 	// Export the master derivation key
 	mdk, err := wallet.ExportMasterDerivationKey([]byte(req.WalletPassword))
 	if err != nil {
-		errorResponse(w, http.StatusBadRequest, err)
+		errorResponse(w, exportErrorStatus(err), err)
 		return
 	}
 
@@ -529,6 +585,248 @@ func postWalletRenameHandler(ctx reqContext, w http.ResponseWriter, r *http.Requ
 	successResponse(w, resp)
 }
 
+// postWalletBackupHandler handles `POST /v1/wallet/backup`
+func postWalletBackupHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/backup BackupWallet
+	//---
+	//    Summary: Back up a wallet
+	//    Description: >
+	//      Export the entire wallet (keys, multisig preimages, master derivation key, key
+	//      derivation index and metadata) as a single archive encrypted with the wallet password.
+	//      The archive can be restored with `POST /v1/wallet/restore`.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Backup Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/BackupWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/BackupWalletResponse"
+	var req kmdapi.APIV1POSTWalletBackupRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wallet, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Back up the wallet
+	backup, err := driver.BackupWallet(wallet, []byte(req.WalletPassword))
+	if err != nil {
+		errorResponse(w, exportErrorStatus(err), err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletBackupResponse{
+		Backup: backup,
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletRestoreHandler handles `POST /v1/wallet/restore`
+func postWalletRestoreHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/restore RestoreWallet
+	//---
+	//    Summary: Restore a wallet from a backup
+	//    Description: >
+	//      Create a wallet from an archive produced by `POST /v1/wallet/backup`. The password must
+	//      be the one the backup was made with, and becomes the password of the restored wallet.
+	//      The wallet keeps its original ID, and its original name unless a new one is given.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Restore Wallet Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/RestoreWalletRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/RestoreWalletResponse"
+	var req kmdapi.APIV1POSTWalletRestoreRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Restore the wallet via its driver
+	walletID, err := driver.RestoreWallet(req.Backup, []byte(req.WalletPassword), []byte(req.WalletName))
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Fetch the wallet
+	wallet, err := driver.FetchWalletByID(walletID)
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Fetch metadata about the wallet we just restored
+	metadata, err := wallet.Metadata()
+	if err != nil {
+		errorResponse(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletRestoreResponse{
+		Wallet: apiWalletFromMetadata(metadata),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletPolicyHandler handles `POST /v1/wallet/policy`
+func postWalletPolicyHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/policy GetWalletPolicy
+	//---
+	//    Summary: Get a wallet's signing policy
+	//    Description: >
+	//      Returns the signing policy of the wallet associated with the passed wallet handle token.
+	//      A policy with no rules allows every signature.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Get Wallet Policy Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/GetWalletPolicyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/WalletPolicyResponse"
+	var req kmdapi.APIV1POSTWalletPolicyRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wlt, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	// Wallets that can't store a policy have no restrictions
+	var policy wallet.Policy
+	if pw, ok := wlt.(wallet.PolicyWallet); ok {
+		policy, err = pw.Policy()
+		if err != nil {
+			errorResponse(w, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletPolicyResponse{
+		Policy: apiPolicyFromPolicy(policy),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
+// postWalletPolicySetHandler handles `POST /v1/wallet/policy/set`
+func postWalletPolicySetHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
+	// swagger:operation POST /v1/wallet/policy/set SetWalletPolicy
+	//---
+	//    Summary: Set a wallet's signing policy
+	//    Description: >
+	//      Replace the signing policy of the wallet associated with the passed wallet handle token.
+	//      The policy is enforced on every signature kmd makes with the wallet's keys, through any
+	//      wallet handle token. Besides the wallet password, changing a policy requires the policy
+	//      password, which is chosen when a policy is set on a wallet without one, so that holders
+	//      of the wallet password cannot lift their own restrictions. Pass a policy with no rules
+	//      to remove all restrictions, along with the policy password. While a policy restricts
+	//      any key, the wallet's keys cannot be exported and the wallet cannot be backed up.
+	//    Produces:
+	//    - application/json
+	//    Parameters:
+	//      - name: Set Wallet Policy Request
+	//        in: body
+	//        required: true
+	//        schema:
+	//          "$ref": "#/definitions/SetWalletPolicyRequest"
+	//    Responses:
+	//      "200":
+	//        "$ref": "#/responses/WalletPolicyResponse"
+	var req kmdapi.APIV1POSTWalletPolicySetRequest
+
+	// Decode the request
+	decoder := protocol.NewJSONDecoder(r.Body)
+	err := decoder.Decode(&req)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, errCouldNotDecode)
+		return
+	}
+
+	// Fetch the wallet from the WalletHandleToken
+	wlt, _, err := ctx.sm.AuthWithWalletHandleToken([]byte(req.WalletHandleToken))
+	if err != nil {
+		errorResponse(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	pw, ok := wlt.(wallet.PolicyWallet)
+	if !ok {
+		errorResponse(w, http.StatusBadRequest, errPolicyNotSupported)
+		return
+	}
+
+	// Decode the policy
+	policy, err := policyFromAPIPolicy(req.Policy)
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Store the policy
+	err = pw.SetPolicy(policy, []byte(req.WalletPassword), []byte(req.PolicyPassword))
+	if err == wallet.ErrPolicyPassword {
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+	if err != nil {
+		errorResponse(w, http.StatusBadRequest, err)
+		return
+	}
+
+	// Build the response
+	resp := kmdapi.APIV1POSTWalletPolicyResponse{
+		Policy: apiPolicyFromPolicy(policy),
+	}
+
+	// Return and encode the response
+	successResponse(w, resp)
+}
+
 // postKeyImportHandler handles `POST /v1/key/import`
 func postKeyImportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request) {
 	// swagger:operation POST /v1/key/import ImportKey
@@ -626,7 +924,7 @@ func postKeyExportHandler(ctx reqContext, w http.ResponseWriter, r *http.Request
 	// Export the key
 	secretKey, err := wallet.ExportKey(crypto.Digest(reqAddr), []byte(req.WalletPassword))
 	if err != nil {
-		errorResponse(w, http.StatusBadRequest, err)
+		errorResponse(w, exportErrorStatus(err), err)
 		return
 	}
 
@@ -842,6 +1140,18 @@ func postTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// Check the wallet's signing policy. If no key was given, the wallet
+	// signs with the sender's key
+	signer := crypto.Digest(req.PublicKey)
+	if signer.IsZero() {
+		signer = crypto.Digest(tx.Sender)
+	}
+	err = ctx.sm.AuthorizeTransaction(wallet, []byte(req.WalletPassword), signer, tx)
+	if err != nil {
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
 	// Sign the transaction
 	stx, err := wallet.SignTransaction(tx, req.PublicKey, []byte(req.WalletPassword))
 	if err != nil {
@@ -901,6 +1211,13 @@ func postProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *http.Reque
 		return
 	}
 
+	// Check the wallet's signing policy
+	err = ctx.sm.AuthorizeProgram(wallet, []byte(req.WalletPassword), crypto.Digest(reqAddr))
+	if err != nil {
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
 	stx, err := wallet.SignProgram(req.Program, crypto.Digest(reqAddr), []byte(req.WalletPassword))
 	if err != nil {
 		errorResponse(w, http.StatusBadRequest, err)
@@ -1126,6 +1443,13 @@ func postMultisigTransactionSignHandler(ctx reqContext, w http.ResponseWriter, r
 		return
 	}
 
+	// Check the wallet's signing policy
+	err = ctx.sm.AuthorizeTransaction(wallet, []byte(req.WalletPassword), crypto.Digest(req.PublicKey), tx)
+	if err != nil {
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
 	// Sign the transaction
 	msig, err := wallet.MultisigSignTransaction(tx, req.PublicKey, req.PartialMsig, []byte(req.WalletPassword), req.AuthAddr)
 	if err != nil {
@@ -1185,6 +1509,13 @@ func postMultisigProgramSignHandler(ctx reqContext, w http.ResponseWriter, r *ht
 		return
 	}
 
+	// Check the wallet's signing policy
+	err = ctx.sm.AuthorizeProgram(wallet, []byte(req.WalletPassword), crypto.Digest(req.PublicKey))
+	if err != nil {
+		errorResponse(w, http.StatusForbidden, err)
+		return
+	}
+
 	// Sign the transaction
 	msig, err := wallet.MultisigSignProgram(req.Program, crypto.Digest(reqAddr), req.PublicKey, req.PartialMsig, []byte(req.WalletPassword))
 	if err != nil {
@@ -1299,6 +1630,10 @@ func RegisterHandlers(router *mux.Router, sm *session.Manager, log logging.Logge
 	router.HandleFunc("/wallet/renew", wrapCtx(ctx, postWalletRenewHandler)).Methods("POST")
 	router.HandleFunc("/wallet/rename", wrapCtx(ctx, postWalletRenameHandler)).Methods("POST")
 	router.HandleFunc("/wallet/info", wrapCtx(ctx, postWalletInfoHandler)).Methods("POST")
+	router.HandleFunc("/wallet/backup", wrapCtx(ctx, postWalletBackupHandler)).Methods("POST")
+	router.HandleFunc("/wallet/restore", wrapCtx(ctx, postWalletRestoreHandler)).Methods("POST")
+	router.HandleFunc("/wallet/policy", wrapCtx(ctx, postWalletPolicyHandler)).Methods("POST")
+	router.HandleFunc("/wallet/policy/set", wrapCtx(ctx, postWalletPolicySetHandler)).Methods("POST")
 	router.HandleFunc("/master-key/export", wrapCtx(ctx, postMasterKeyExportHandler)).Methods("POST")
 
 	router.HandleFunc("/key/list", wrapCtx(ctx, postKeyListHandler)).Methods("POST")
@@ -99,6 +99,18 @@ func getPathAndMethod(req kmdapi.APIV1Request) (reqPath string, reqMethod string
 	case kmdapi.APIV1POSTWalletInfoRequest:
 		reqPath = "v1/wallet/info"
 		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletBackupRequest:
+		reqPath = "v1/wallet/backup"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletRestoreRequest:
+		reqPath = "v1/wallet/restore"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletPolicyRequest:
+		reqPath = "v1/wallet/policy"
+		reqMethod = "POST"
+	case kmdapi.APIV1POSTWalletPolicySetRequest:
+		reqPath = "v1/wallet/policy/set"
+		reqMethod = "POST"
 	case kmdapi.APIV1POSTMasterKeyExportRequest:
 		reqPath = "v1/master-key/export"
 		reqMethod = "POST"
@@ -197,6 +197,48 @@ func (kcl KMDClient) ExportMasterDerivationKey(walletHandle []byte, walletPasswo
 	return
 }
 
+// BackupWallet wraps kmdapi.APIV1POSTWalletBackupRequest
+func (kcl KMDClient) BackupWallet(walletHandle []byte, walletPassword []byte) (resp kmdapi.APIV1POSTWalletBackupResponse, err error) {
+	req := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// RestoreWallet wraps kmdapi.APIV1POSTWalletRestoreRequest
+func (kcl KMDClient) RestoreWallet(walletName []byte, walletPassword []byte, backup []byte) (resp kmdapi.APIV1POSTWalletRestoreResponse, err error) {
+	req := kmdapi.APIV1POSTWalletRestoreRequest{
+		WalletName:     string(walletName),
+		WalletPassword: string(walletPassword),
+		Backup:         backup,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// GetWalletPolicy wraps kmdapi.APIV1POSTWalletPolicyRequest
+func (kcl KMDClient) GetWalletPolicy(walletHandle []byte) (resp kmdapi.APIV1POSTWalletPolicyResponse, err error) {
+	req := kmdapi.APIV1POSTWalletPolicyRequest{
+		WalletHandleToken: string(walletHandle),
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
+// SetWalletPolicy wraps kmdapi.APIV1POSTWalletPolicySetRequest
+func (kcl KMDClient) SetWalletPolicy(walletHandle []byte, walletPassword []byte, policyPassword []byte, policy kmdapi.APIV1Policy) (resp kmdapi.APIV1POSTWalletPolicyResponse, err error) {
+	req := kmdapi.APIV1POSTWalletPolicySetRequest{
+		WalletHandleToken: string(walletHandle),
+		WalletPassword:    string(walletPassword),
+		PolicyPassword:    string(policyPassword),
+		Policy:            policy,
+	}
+	err = kcl.DoV1Request(req, &resp)
+	return
+}
+
 // SignTransaction wraps kmdapi.APIV1POSTTransactionSignRequest
 func (kcl KMDClient) SignTransaction(walletHandle, pw []byte, pk crypto.PublicKey, tx transactions.Transaction) (resp kmdapi.APIV1POSTTransactionSignResponse, err error) {
 	txBytes := protocol.Encode(&tx)
@@ -19,6 +19,7 @@ package config
 import (
 	"encoding/json"
 	"io/ioutil"
+	"net/url"
 	"path/filepath"
 
 	"github.com/algorand/go-algorand/util/codecs"
@@ -46,6 +47,8 @@ type KMDConfig struct {
 type DriverConfig struct {
 	SQLiteWalletDriverConfig SQLiteWalletDriverConfig `json:"sqlite"`
 	LedgerWalletDriverConfig LedgerWalletDriverConfig `json:"ledger"`
+
+	ExternalSignerWalletDriverConfig ExternalSignerWalletDriverConfig `json:"external_signer"`
 }
 
 // SQLiteWalletDriverConfig is configuration specific to the SQLiteWalletDriver
@@ -60,6 +63,21 @@ type LedgerWalletDriverConfig struct {
 	Disable bool `json:"disable"`
 }
 
+// ExternalSignerWalletDriverConfig is configuration specific to the
+// ExternalSignerWalletDriver. Each configured signer is exposed as a wallet
+type ExternalSignerWalletDriverConfig struct {
+	Signers []ExternalSignerConfig `json:"signers"`
+}
+
+// ExternalSignerConfig describes how to reach a single external signer. URL
+// is either unix:///absolute/path/to/socket or an http(s):// base URL
+type ExternalSignerConfig struct {
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Token       string `json:"token"`
+	TimeoutSecs uint64 `json:"timeout_secs"`
+}
+
 // ScryptParams stores the parameters used for key derivation. This allows
 // upgrading security parameters over time
 type ScryptParams struct {
@@ -95,6 +113,35 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+
+	// Each external signer needs a unique name and a URL we know how to reach
+	signerNames := make(map[string]bool)
+	for _, signer := range k.DriverConfig.ExternalSignerWalletDriverConfig.Signers {
+		if signer.Name == "" {
+			return ErrExternalSignerNoName
+		}
+		if signerNames[signer.Name] {
+			return ErrExternalSignerDuplicateName
+		}
+		signerNames[signer.Name] = true
+
+		u, err := url.Parse(signer.URL)
+		if err != nil {
+			return ErrExternalSignerBadURL
+		}
+		switch u.Scheme {
+		case "unix":
+			if !filepath.IsAbs(u.Path) {
+				return ErrExternalSignerBadURL
+			}
+		case "http", "https":
+			if u.Host == "" {
+				return ErrExternalSignerBadURL
+			}
+		default:
+			return ErrExternalSignerBadURL
+		}
+	}
 	return nil
 }
 
@@ -22,3 +22,12 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrExternalSignerNoName is returned when an external signer is configured without a name
+var ErrExternalSignerNoName = fmt.Errorf("external signer must have a name")
+
+// ErrExternalSignerDuplicateName is returned when two external signers share a name
+var ErrExternalSignerDuplicateName = fmt.Errorf("external signer names must be unique")
+
+// ErrExternalSignerBadURL is returned when an external signer URL is neither an absolute unix socket path nor an http(s) URL
+var ErrExternalSignerBadURL = fmt.Errorf("external signer url must be unix:///absolute/path or http(s)://host[:port]")
@@ -53,3 +53,21 @@ type APIV1WalletHandle struct {
 	Wallet         APIV1Wallet `json:"wallet"`
 	ExpiresSeconds int64       `json:"expires_seconds"`
 }
+
+// APIV1Policy is the API's representation of a wallet signing policy. A
+// policy with no rules allows every signature.
+type APIV1Policy struct {
+	Rules []APIV1PolicyRule `json:"rules"`
+}
+
+// APIV1PolicyRule restricts signing with the key at Address, or with every
+// key without a rule of its own if Address is empty. Zero-valued limits are
+// not enforced.
+type APIV1PolicyRule struct {
+	Address              string            `json:"address"`
+	AllowedTxTypes       []protocol.TxType `json:"allowed_tx_types"`
+	MaxPaymentAmount     uint64            `json:"max_payment_amount"`
+	AllowedReceivers     []string          `json:"allowed_receivers"`
+	AllowedAppIDs        []uint64          `json:"allowed_app_ids"`
+	MaxSignaturesPerHour uint64            `json:"max_signatures_per_hour"`
+}
@@ -90,6 +90,49 @@ type APIV1POSTWalletRenameRequest struct {
 	NewWalletName  string `json:"wallet_name"`
 }
 
+// APIV1POSTWalletBackupRequest is the request for `POST /v1/wallet/backup`
+//
+// swagger:model BackupWalletRequest
+type APIV1POSTWalletBackupRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+	WalletPassword    string `json:"wallet_password"`
+}
+
+// APIV1POSTWalletRestoreRequest is the request for `POST /v1/wallet/restore`
+//
+// swagger:model RestoreWalletRequest
+type APIV1POSTWalletRestoreRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletName     string `json:"wallet_name"`
+	WalletPassword string `json:"wallet_password"`
+	// swagger:strfmt byte
+	Backup []byte `json:"backup"`
+}
+
+// APIV1POSTWalletPolicyRequest is the request for `POST /v1/wallet/policy`
+//
+// swagger:model GetWalletPolicyRequest
+type APIV1POSTWalletPolicyRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string `json:"wallet_handle_token"`
+}
+
+// APIV1POSTWalletPolicySetRequest is the request for `POST /v1/wallet/policy/set`
+//
+// swagger:model SetWalletPolicyRequest
+type APIV1POSTWalletPolicySetRequest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	WalletHandleToken string      `json:"wallet_handle_token"`
+	WalletPassword    string      `json:"wallet_password"`
+	PolicyPassword    string      `json:"policy_password"`
+	Policy            APIV1Policy `json:"policy"`
+}
+
 // APIV1POSTWalletInfoRequest is the request for `POST /v1/wallet/info`
 //
 // swagger:model WalletInfoRequest
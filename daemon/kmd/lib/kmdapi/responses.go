@@ -145,6 +145,51 @@ type renameWalletResponse struct {
 	Body *APIV1POSTWalletRenameResponse
 }
 
+// APIV1POSTWalletBackupResponse is the response to `POST /v1/wallet/backup`
+// friendly:BackupWalletResponse
+type APIV1POSTWalletBackupResponse struct {
+	APIV1ResponseEnvelope
+
+	// swagger:strfmt byte
+	Backup []byte `json:"backup"`
+}
+
+// Response to `POST /v1/wallet/backup`
+// swagger:response BackupWalletResponse
+type backupWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletBackupResponse
+}
+
+// APIV1POSTWalletRestoreResponse is the response to `POST /v1/wallet/restore`
+// friendly:RestoreWalletResponse
+type APIV1POSTWalletRestoreResponse struct {
+	APIV1ResponseEnvelope
+	Wallet APIV1Wallet `json:"wallet"`
+}
+
+// Response to `POST /v1/wallet/restore`
+// swagger:response RestoreWalletResponse
+type restoreWalletResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletRestoreResponse
+}
+
+// APIV1POSTWalletPolicyResponse is the response to `POST /v1/wallet/policy`
+// and `POST /v1/wallet/policy/set`
+// friendly:WalletPolicyResponse
+type APIV1POSTWalletPolicyResponse struct {
+	APIV1ResponseEnvelope
+	Policy APIV1Policy `json:"policy"`
+}
+
+// Response to `POST /v1/wallet/policy` and `POST /v1/wallet/policy/set`
+// swagger:response WalletPolicyResponse
+type walletPolicyResponse struct {
+	//	in:body
+	Body *APIV1POSTWalletPolicyResponse
+}
+
 // APIV1POSTWalletInfoResponse is the response to `POST /v1/wallet/info`
 // friendly:WalletInfoResponse
 type APIV1POSTWalletInfoResponse struct {
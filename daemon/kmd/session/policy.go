@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+const signatureWindow = time.Hour
+
+// AuthorizeTransaction checks the wallet password and that the wallet's
+// signing policy allows signer to sign tx, and counts the signature against
+// the signer's rate limit. It must be called before the wallet is asked to
+// sign.
+func (sm *Manager) AuthorizeTransaction(w wallet.Wallet, pw []byte, signer crypto.Digest, tx transactions.Transaction) error {
+	return sm.authorize(w, pw, signer, func(rule wallet.PolicyRule) error {
+		return rule.CheckTransaction(tx)
+	})
+}
+
+// AuthorizeProgram is like AuthorizeTransaction, for signing a program
+func (sm *Manager) AuthorizeProgram(w wallet.Wallet, pw []byte, signer crypto.Digest) error {
+	return sm.authorize(w, pw, signer, func(rule wallet.PolicyRule) error {
+		return rule.CheckProgram()
+	})
+}
+
+func (sm *Manager) authorize(w wallet.Wallet, pw []byte, signer crypto.Digest, check func(wallet.PolicyRule) error) error {
+	// Check the password first, so that failed attempts don't count against
+	// the rate limit
+	err := w.CheckPassword(pw)
+	if err != nil {
+		return err
+	}
+
+	policyWallet, ok := w.(wallet.PolicyWallet)
+	if !ok {
+		return nil
+	}
+
+	policy, err := policyWallet.Policy()
+	if err != nil {
+		return err
+	}
+
+	rule, ok := policy.RuleFor(signer)
+	if !ok {
+		return nil
+	}
+
+	err = check(rule)
+	if err != nil {
+		return err
+	}
+
+	if rule.MaxSignaturesPerHour == 0 {
+		return nil
+	}
+
+	metadata, err := w.Metadata()
+	if err != nil {
+		return err
+	}
+	return sm.recordSignature(string(metadata.ID)+"/"+basics.Address(signer).String(), rule.MaxSignaturesPerHour)
+}
+
+// recordSignature counts a signature made under key, failing instead if
+// limit signatures have already been made in the last signatureWindow
+func (sm *Manager) recordSignature(key string, limit uint64) error {
+	sm.mux.Lock()
+	defer sm.mux.Unlock()
+
+	now := time.Now()
+	cutoff := now.Add(-signatureWindow)
+
+	// Drop signatures that have aged out of the window
+	times := sm.signatureTimes[key]
+	for len(times) > 0 && !times[0].After(cutoff) {
+		times = times[1:]
+	}
+
+	if uint64(len(times)) >= limit {
+		sm.signatureTimes[key] = times
+		return fmt.Errorf("policy allows at most %d signatures per hour", limit)
+	}
+
+	sm.signatureTimes[key] = append(times, now)
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package session
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestRecordSignatureLimit(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sm := &Manager{signatureTimes: make(map[string][]time.Time)}
+
+	require.NoError(t, sm.recordSignature("w/a", 2))
+	require.NoError(t, sm.recordSignature("w/a", 2))
+	require.Error(t, sm.recordSignature("w/a", 2))
+
+	// Other keys have their own count
+	require.NoError(t, sm.recordSignature("w/b", 2))
+
+	// Signatures older than the window no longer count
+	sm.signatureTimes["w/a"][0] = time.Now().Add(-signatureWindow)
+	require.NoError(t, sm.recordSignature("w/a", 2))
+	require.Error(t, sm.recordSignature("w/a", 2))
+}
@@ -41,6 +41,11 @@ type Manager struct {
 	Kill            context.CancelFunc
 	ctx             context.Context
 	mux             deadlock.Mutex
+
+	// signatureTimes records recent signatures for policy rate limits,
+	// keyed by wallet ID and signing address. It is only kept in memory, so
+	// the limits start over when kmd restarts. Protected by mux
+	signatureTimes map[string][]time.Time
 }
 
 // MakeManager initializes and returns a *Manager using the kmd global
@@ -50,6 +55,7 @@ func MakeManager(cfg config.KMDConfig) *Manager {
 	sm := &Manager{
 		Initialized:     true,
 		walletHandles:   make(map[string]walletHandle),
+		signatureTimes:  make(map[string][]time.Time),
 		sessionLifetime: time.Duration(cfg.SessionLifetimeSecs * uint64(time.Second)),
 		Kill:            cancel,
 		ctx:             ctx,
@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+const defaultTimeout = 30 * time.Second
+
+// Client talks to an external signer over the signer protocol
+type Client struct {
+	httpClient http.Client
+	baseURL    string
+	token      string
+}
+
+// MakeClient returns a Client for the signer at rawURL, which is either
+// unix:///path/to/socket or an http(s) base URL. A zero timeout selects a
+// default.
+func MakeClient(rawURL string, token string, timeout time.Duration) (*Client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	c := &Client{
+		httpClient: http.Client{Timeout: timeout},
+		token:      token,
+	}
+
+	switch u.Scheme {
+	case "unix":
+		sockPath := u.Path
+		var dialer net.Dialer
+		c.httpClient.Transport = &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return dialer.DialContext(ctx, "unix", sockPath)
+			},
+		}
+		// The host is ignored by the dialer above
+		c.baseURL = "http://signer"
+	case "http", "https":
+		c.baseURL = strings.TrimSuffix(u.String(), "/")
+	default:
+		return nil, fmt.Errorf("unsupported signer url scheme %q", u.Scheme)
+	}
+	return c, nil
+}
+
+// Version returns the protocol versions supported by the signer
+func (c *Client) Version() ([]uint32, error) {
+	var resp VersionResponse
+	err := c.do(http.MethodGet, versionPath, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Versions, nil
+}
+
+// CheckVersion returns an error unless the signer speaks ProtocolVersion
+func (c *Client) CheckVersion() error {
+	versions, err := c.Version()
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		if v == ProtocolVersion {
+			return nil
+		}
+	}
+	return fmt.Errorf("signer does not support protocol version %d (supports %v)", ProtocolVersion, versions)
+}
+
+// Keys returns the public keys the signer is able to sign with
+func (c *Client) Keys() ([]crypto.PublicKey, error) {
+	var resp KeysResponse
+	err := c.do(http.MethodGet, keysPath, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	pks := make([]crypto.PublicKey, len(resp.PublicKeys))
+	for i, raw := range resp.PublicKeys {
+		if len(raw) != len(pks[i]) {
+			return nil, fmt.Errorf("signer returned a %d byte public key", len(raw))
+		}
+		copy(pks[i][:], raw)
+	}
+	return pks, nil
+}
+
+// Sign asks the signer to sign data with pk. data must already include the
+// domain separation prefix for kind. The returned signature is checked
+// against pk before it is handed back.
+func (c *Client) Sign(pk crypto.PublicKey, kind Kind, data []byte) (sig crypto.Signature, err error) {
+	req := SignRequest{
+		PublicKey: pk[:],
+		Kind:      kind,
+		Data:      data,
+	}
+	var resp SignResponse
+	err = c.do(http.MethodPost, signPath, &req, &resp)
+	if err != nil {
+		return
+	}
+
+	if len(resp.Signature) != len(sig) {
+		err = fmt.Errorf("signer returned a %d byte signature", len(resp.Signature))
+		return
+	}
+	copy(sig[:], resp.Signature)
+
+	if !crypto.SignatureVerifier(pk).VerifyBytes(data, sig) {
+		err = fmt.Errorf("signer returned a signature that does not verify")
+		return
+	}
+	return
+}
+
+func (c *Client) do(method string, path string, body interface{}, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		enc, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(enc)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	dec := json.NewDecoder(io.LimitReader(resp.Body, maxMessageBytes))
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		if dec.Decode(&errResp) == nil && errResp.Error != "" {
+			return fmt.Errorf("signer error (%d): %s", resp.StatusCode, errResp.Error)
+		}
+		return fmt.Errorf("signer error (%d): %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	return dec.Decode(out)
+}
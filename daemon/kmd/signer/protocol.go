@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package signer defines the protocol kmd uses to talk to an external signer
+// process or remote signing service, a client for it, and a reference signer
+// that keeps its keys in memory.
+//
+// The protocol is JSON over HTTP. The transport is either a unix socket
+// (unix:///path/to/socket) or a plain http(s) base URL. If a token is
+// configured it is sent as "Authorization: Bearer <token>". Byte strings
+// (public keys, data, signatures) are standard base64.
+//
+//	GET  /version   -> {"versions": [1]}
+//	GET  /v1/keys   -> {"public_keys": ["<32-byte ed25519 key>", ...]}
+//	POST /v1/sign   {"public_key": "...", "kind": "transaction"|"program", "data": "..."}
+//	                -> {"signature": "<64-byte ed25519 signature>"}
+//
+// data is the exact message to sign, including its domain separation prefix
+// ("TX" for transactions, "Program" for programs). A signer should refuse
+// data whose prefix does not match kind. Any non-200 response carries
+// {"error": "<message>"}.
+package signer
+
+import (
+	"bytes"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ProtocolVersion is the version of the signer protocol implemented here
+const ProtocolVersion = 1
+
+const (
+	versionPath = "/version"
+	keysPath    = "/v1/keys"
+	signPath    = "/v1/sign"
+
+	// maxMessageBytes bounds the size of any request or response body
+	maxMessageBytes = 1 << 20
+)
+
+// Kind identifies what sort of message is being signed
+type Kind string
+
+const (
+	// KindTransaction is a transaction, prefixed with protocol.Transaction
+	KindTransaction Kind = "transaction"
+	// KindProgram is a logic program, prefixed with protocol.Program
+	KindProgram Kind = "program"
+)
+
+// hasDomainPrefix reports whether data carries the domain separation prefix
+// expected for kind
+func (k Kind) hasDomainPrefix(data []byte) bool {
+	switch k {
+	case KindTransaction:
+		return bytes.HasPrefix(data, []byte(protocol.Transaction))
+	case KindProgram:
+		return bytes.HasPrefix(data, []byte(protocol.Program))
+	default:
+		return false
+	}
+}
+
+// VersionResponse is returned by GET /version
+type VersionResponse struct {
+	Versions []uint32 `json:"versions"`
+}
+
+// KeysResponse is returned by GET /v1/keys
+type KeysResponse struct {
+	PublicKeys [][]byte `json:"public_keys"`
+}
+
+// SignRequest is the body of POST /v1/sign
+type SignRequest struct {
+	PublicKey []byte `json:"public_key"`
+	Kind      Kind   `json:"kind"`
+	Data      []byte `json:"data"`
+}
+
+// SignResponse is returned by POST /v1/sign
+type SignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// ErrorResponse is returned alongside any non-200 status
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// ReferenceSigner is a minimal signer that implements the signer protocol
+// with keys held in memory. It is meant as a stand-in for a real external
+// signer in tests and as an example for people writing their own.
+type ReferenceSigner struct {
+	mu    deadlock.Mutex
+	keys  map[crypto.PublicKey]*crypto.SignatureSecrets
+	token string
+}
+
+// MakeReferenceSigner returns an empty ReferenceSigner. If token is not
+// empty, requests must present it as a bearer token.
+func MakeReferenceSigner(token string) *ReferenceSigner {
+	return &ReferenceSigner{
+		keys:  make(map[crypto.PublicKey]*crypto.SignatureSecrets),
+		token: token,
+	}
+}
+
+// GenerateKey adds a freshly generated key to the signer and returns its
+// public half
+func (rs *ReferenceSigner) GenerateKey() crypto.PublicKey {
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	return rs.AddKey(crypto.GenerateSignatureSecrets(seed))
+}
+
+// AddKey adds an existing key to the signer and returns its public half
+func (rs *ReferenceSigner) AddKey(secrets *crypto.SignatureSecrets) crypto.PublicKey {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	pk := crypto.PublicKey(secrets.SignatureVerifier)
+	rs.keys[pk] = secrets
+	return pk
+}
+
+// ServeHTTP implements http.Handler
+func (rs *ReferenceSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if rs.token != "" {
+		expected := []byte("Bearer " + rs.token)
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			writeError(w, http.StatusUnauthorized, fmt.Errorf("invalid token"))
+			return
+		}
+	}
+
+	switch {
+	case r.URL.Path == versionPath && r.Method == http.MethodGet:
+		writeJSON(w, VersionResponse{Versions: []uint32{ProtocolVersion}})
+	case r.URL.Path == keysPath && r.Method == http.MethodGet:
+		writeJSON(w, KeysResponse{PublicKeys: rs.publicKeys()})
+	case r.URL.Path == signPath && r.Method == http.MethodPost:
+		rs.sign(w, r)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Errorf("no handler for %s %s", r.Method, r.URL.Path))
+	}
+}
+
+func (rs *ReferenceSigner) publicKeys() [][]byte {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	pks := make([][]byte, 0, len(rs.keys))
+	for pk := range rs.keys {
+		pk := pk
+		pks = append(pks, pk[:])
+	}
+	sort.Slice(pks, func(i, j int) bool {
+		return bytes.Compare(pks[i], pks[j]) < 0
+	})
+	return pks
+}
+
+func (rs *ReferenceSigner) sign(w http.ResponseWriter, r *http.Request) {
+	var req SignRequest
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxMessageBytes)).Decode(&req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	if !req.Kind.hasDomainPrefix(req.Data) {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("data is not a %q message", req.Kind))
+		return
+	}
+
+	var pk crypto.PublicKey
+	if len(req.PublicKey) != len(pk) {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("public key must be %d bytes", len(pk)))
+		return
+	}
+	copy(pk[:], req.PublicKey)
+
+	rs.mu.Lock()
+	secrets, ok := rs.keys[pk]
+	rs.mu.Unlock()
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown public key"))
+		return
+	}
+
+	sig := secrets.SignBytes(req.Data)
+	writeJSON(w, SignResponse{Signature: sig[:]})
+}
+
+func writeJSON(w http.ResponseWriter, obj interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(obj)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Error: err.Error()})
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package signer
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestClientReferenceSignerHTTP(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rs := MakeReferenceSigner("secret")
+	pk := rs.GenerateKey()
+
+	srv := httptest.NewServer(rs)
+	defer srv.Close()
+
+	client, err := MakeClient(srv.URL, "secret", 0)
+	require.NoError(t, err)
+	require.NoError(t, client.CheckVersion())
+
+	pks, err := client.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.PublicKey{pk}, pks)
+
+	data := crypto.HashRep(logic.Program([]byte{0x01, 0x20}))
+	sig, err := client.Sign(pk, KindProgram, data)
+	require.NoError(t, err)
+	require.True(t, crypto.SignatureVerifier(pk).VerifyBytes(data, sig))
+
+	// Data that does not carry the prefix for its kind is refused
+	_, err = client.Sign(pk, KindTransaction, data)
+	require.Error(t, err)
+
+	// Unknown keys are refused
+	var other crypto.PublicKey
+	other[0] = 1
+	_, err = client.Sign(other, KindProgram, data)
+	require.Error(t, err)
+
+	// A wrong token is refused
+	badClient, err := MakeClient(srv.URL, "wrong", 0)
+	require.NoError(t, err)
+	_, err = badClient.Keys()
+	require.Error(t, err)
+}
+
+func TestClientReferenceSignerUnixSocket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rs := MakeReferenceSigner("")
+	pk := rs.GenerateKey()
+
+	sockPath := filepath.Join(t.TempDir(), "signer.sock")
+	listener, err := net.Listen("unix", sockPath)
+	require.NoError(t, err)
+	srv := &http.Server{Handler: rs}
+	go srv.Serve(listener)
+	defer srv.Close()
+
+	client, err := MakeClient("unix://"+sockPath, "", 0)
+	require.NoError(t, err)
+	require.NoError(t, client.CheckVersion())
+
+	data := append([]byte(protocol.Transaction), 0xaa, 0xbb)
+	sig, err := client.Sign(pk, KindTransaction, data)
+	require.NoError(t, err)
+	require.True(t, crypto.SignatureVerifier(pk).VerifyBytes(data, sig))
+}
+
+func TestClientRejectsBadSignature(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// A signer that signs with a key other than the one requested
+	rs := MakeReferenceSigner("")
+	pk := rs.GenerateKey()
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	impostor := crypto.GenerateSignatureSecrets(seed)
+	rs.keys[pk] = impostor
+
+	srv := httptest.NewServer(rs)
+	defer srv.Close()
+
+	client, err := MakeClient(srv.URL, "", 0)
+	require.NoError(t, err)
+
+	_, err = client.Sign(pk, KindTransaction, []byte(protocol.Transaction))
+	require.Error(t, err)
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+)
+
+const (
+	walletBackupFormat  = "algorand-kmd-wallet-backup"
+	walletBackupVersion = 1
+)
+
+// walletBackupArchive is the unencrypted envelope around a wallet backup. It
+// names the driver that can restore the backup, and carries the driver's
+// password-encrypted backup contents.
+type walletBackupArchive struct {
+	Format        string `codec:"format"`
+	Version       uint32 `codec:"version"`
+	DriverName    string `codec:"driver_name"`
+	DriverVersion uint32 `codec:"driver_version"`
+	Contents      []byte `codec:"contents"`
+}
+
+// backupWallet is implemented by wallets that can export their entire
+// contents as a single archive
+type backupWallet interface {
+	Backup(pw []byte) ([]byte, error)
+}
+
+// restoreDriver is implemented by drivers that can recreate a wallet from an
+// archive produced by one of their wallets
+type restoreDriver interface {
+	RestoreWallet(archive walletBackupArchive, pw []byte, name []byte) (id []byte, err error)
+}
+
+// BackupWallet exports the whole of w (keys, multisig preimages, derivation
+// state and metadata) as a single archive encrypted with the wallet password
+func BackupWallet(w wallet.Wallet, pw []byte) ([]byte, error) {
+	bw, ok := w.(backupWallet)
+	if !ok {
+		return nil, errNotSupported
+	}
+	return bw.Backup(pw)
+}
+
+// RestoreWallet recreates a wallet from an archive produced by BackupWallet,
+// returning the ID of the restored wallet. pw must be the password the backup
+// was made with, and becomes the password of the restored wallet. If name is
+// empty, the wallet keeps the name it was backed up with.
+func RestoreWallet(archive []byte, pw []byte, name []byte) ([]byte, error) {
+	var wba walletBackupArchive
+	err := msgpackDecode(archive, &wba)
+	if err != nil || wba.Format != walletBackupFormat {
+		return nil, errBackupFormat
+	}
+	if wba.Version != walletBackupVersion {
+		return nil, errBackupVersion
+	}
+
+	d, err := FetchWalletDriver(wba.DriverName)
+	if err != nil {
+		return nil, err
+	}
+	rd, ok := d.(restoreDriver)
+	if !ok {
+		return nil, errNotSupported
+	}
+	return rd.RestoreWallet(wba, pw, name)
+}
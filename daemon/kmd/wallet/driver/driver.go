@@ -26,8 +26,9 @@ import (
 )
 
 var walletDrivers = map[string]Driver{
-	sqliteWalletDriverName: &SQLiteWalletDriver{},
-	ledgerWalletDriverName: &LedgerWalletDriver{},
+	sqliteWalletDriverName:         &SQLiteWalletDriver{},
+	ledgerWalletDriverName:         &LedgerWalletDriver{},
+	externalSignerWalletDriverName: &ExternalSignerWalletDriver{},
 }
 
 // Driver is the interface that all wallet drivers must expose in order to be
@@ -0,0 +1,337 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/signer"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	externalSignerWalletDriverName    = "external"
+	externalSignerWalletDriverVersion = 1
+	externalSignerIDLen               = 16
+)
+
+var externalSignerWalletSupportedTxs = []protocol.TxType{protocol.PaymentTx, protocol.KeyRegistrationTx}
+
+// ExternalSignerWalletDriver exposes signers running outside of kmd, either
+// as a separate process listening on a unix socket or as a remote signing
+// service, as wallets. Keys never enter kmd; signing requests are forwarded
+// to the signer using the protocol defined in the signer package.
+type ExternalSignerWalletDriver struct {
+	mu      deadlock.Mutex
+	wallets map[string]*ExternalSignerWallet
+	log     logging.Logger
+}
+
+// ExternalSignerWallet represents a single configured external signer
+type ExternalSignerWallet struct {
+	mu     deadlock.Mutex
+	id     string
+	name   string
+	client *signer.Client
+
+	// versionChecked is set once the signer has agreed on a protocol version
+	versionChecked bool
+}
+
+// InitWithConfig creates a wallet for each configured external signer. The
+// signers are not contacted until a wallet is used, so kmd can start before
+// they do.
+func (ewd *ExternalSignerWalletDriver) InitWithConfig(cfg config.KMDConfig, log logging.Logger) error {
+	ewd.mu.Lock()
+	defer ewd.mu.Unlock()
+
+	ewd.log = log
+	ewd.wallets = make(map[string]*ExternalSignerWallet)
+
+	for _, sc := range cfg.DriverConfig.ExternalSignerWalletDriverConfig.Signers {
+		client, err := signer.MakeClient(sc.URL, sc.Token, time.Duration(sc.TimeoutSecs)*time.Second)
+		if err != nil {
+			return fmt.Errorf("external signer %s: %v", sc.Name, err)
+		}
+
+		walletID := signerNameToID(sc.Name)
+		ewd.wallets[walletID] = &ExternalSignerWallet{
+			id:     walletID,
+			name:   sc.Name,
+			client: client,
+		}
+	}
+
+	return nil
+}
+
+// ListWalletMetadatas returns a wallet for each configured external signer
+func (ewd *ExternalSignerWalletDriver) ListWalletMetadatas() (metadatas []wallet.Metadata, err error) {
+	ewd.mu.Lock()
+	defer ewd.mu.Unlock()
+
+	for _, w := range ewd.wallets {
+		md, err := w.Metadata()
+		if err != nil {
+			return nil, err
+		}
+
+		metadatas = append(metadatas, md)
+	}
+
+	// Sort metadatas by ID
+	sort.Slice(metadatas, func(i, j int) bool {
+		return bytes.Compare(metadatas[i].ID, metadatas[j].ID) < 0
+	})
+
+	return metadatas, nil
+}
+
+// CreateWallet implements the Driver interface. External signer wallets are
+// defined in the kmd config rather than created through the API.
+func (ewd *ExternalSignerWalletDriver) CreateWallet(name []byte, id []byte, pw []byte, mdk crypto.MasterDerivationKey) error {
+	return errNotSupported
+}
+
+// RenameWallet implements the Driver interface.
+func (ewd *ExternalSignerWalletDriver) RenameWallet(newName []byte, id []byte, pw []byte) error {
+	return errNotSupported
+}
+
+// FetchWallet looks up a wallet by ID and returns it
+func (ewd *ExternalSignerWalletDriver) FetchWallet(id []byte) (w wallet.Wallet, err error) {
+	ewd.mu.Lock()
+	defer ewd.mu.Unlock()
+
+	ew, ok := ewd.wallets[string(id)]
+	if !ok {
+		return nil, errWalletNotFound
+	}
+
+	return ew, nil
+}
+
+func signerNameToID(name string) string {
+	nameHash := sha512.Sum512_256([]byte(externalSignerWalletDriverName + "/" + name))
+	return fmt.Sprintf("%x", nameHash[:externalSignerIDLen])
+}
+
+// Init implements the Wallet interface. Authorization is left to the
+// external signer.
+func (ew *ExternalSignerWallet) Init(pw []byte) error {
+	return nil
+}
+
+// CheckPassword implements the Wallet interface.
+func (ew *ExternalSignerWallet) CheckPassword(pw []byte) error {
+	return nil
+}
+
+// ExportMasterDerivationKey implements the Wallet interface.
+func (ew *ExternalSignerWallet) ExportMasterDerivationKey(pw []byte) (crypto.MasterDerivationKey, error) {
+	return crypto.MasterDerivationKey{}, errNotSupported
+}
+
+// Metadata implements the Wallet interface.
+func (ew *ExternalSignerWallet) Metadata() (wallet.Metadata, error) {
+	return wallet.Metadata{
+		ID:                    []byte(ew.id),
+		Name:                  []byte(ew.name),
+		DriverName:            externalSignerWalletDriverName,
+		DriverVersion:         externalSignerWalletDriverVersion,
+		SupportedTransactions: externalSignerWalletSupportedTxs,
+	}, nil
+}
+
+// connect returns the signer client, checking the protocol version the
+// first time it is used
+func (ew *ExternalSignerWallet) connect() (*signer.Client, error) {
+	ew.mu.Lock()
+	defer ew.mu.Unlock()
+
+	if !ew.versionChecked {
+		err := ew.client.CheckVersion()
+		if err != nil {
+			return nil, fmt.Errorf("external signer %s: %v", ew.name, err)
+		}
+		ew.versionChecked = true
+	}
+	return ew.client, nil
+}
+
+// ListKeys implements the Wallet interface.
+func (ew *ExternalSignerWallet) ListKeys() ([]crypto.Digest, error) {
+	client, err := ew.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	pks, err := client.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]crypto.Digest, len(pks))
+	for i, pk := range pks {
+		addrs[i] = publicKeyToAddress(pk)
+	}
+	return addrs, nil
+}
+
+// ImportKey implements the Wallet interface.
+func (ew *ExternalSignerWallet) ImportKey(sk crypto.PrivateKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// ExportKey implements the Wallet interface.
+func (ew *ExternalSignerWallet) ExportKey(pk crypto.Digest, pw []byte) (crypto.PrivateKey, error) {
+	return crypto.PrivateKey{}, errNotSupported
+}
+
+// GenerateKey implements the Wallet interface.
+func (ew *ExternalSignerWallet) GenerateKey(displayMnemonic bool) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// DeleteKey implements the Wallet interface.
+func (ew *ExternalSignerWallet) DeleteKey(pk crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// ImportMultisigAddr implements the Wallet interface.
+func (ew *ExternalSignerWallet) ImportMultisigAddr(version, threshold uint8, pks []crypto.PublicKey) (crypto.Digest, error) {
+	return crypto.Digest{}, errNotSupported
+}
+
+// LookupMultisigPreimage implements the Wallet interface.
+func (ew *ExternalSignerWallet) LookupMultisigPreimage(crypto.Digest) (version, threshold uint8, pks []crypto.PublicKey, err error) {
+	return 0, 0, nil, errNotSupported
+}
+
+// ListMultisigAddrs implements the Wallet interface.
+func (ew *ExternalSignerWallet) ListMultisigAddrs() (addrs []crypto.Digest, err error) {
+	return nil, nil
+}
+
+// DeleteMultisigAddr implements the Wallet interface.
+func (ew *ExternalSignerWallet) DeleteMultisigAddr(addr crypto.Digest, pw []byte) error {
+	return errNotSupported
+}
+
+// SignTransaction implements the Wallet interface. If pk is empty, the
+// transaction is signed with the sender's key.
+func (ew *ExternalSignerWallet) SignTransaction(tx transactions.Transaction, pk crypto.PublicKey, pw []byte) ([]byte, error) {
+	if (pk == crypto.PublicKey{}) {
+		pk = crypto.PublicKey(tx.Sender)
+	}
+
+	sig, err := ew.sign(pk, signer.KindTransaction, crypto.HashRep(tx))
+	if err != nil {
+		return nil, err
+	}
+
+	stxn := transactions.SignedTxn{
+		Txn: tx,
+		Sig: sig,
+	}
+
+	// Set the AuthAddr if the key we signed with doesn't match the txn sender
+	if basics.Address(pk) != tx.Sender {
+		stxn.AuthAddr = basics.Address(pk)
+	}
+
+	return protocol.Encode(&stxn), nil
+}
+
+// SignProgram implements the Wallet interface.
+func (ew *ExternalSignerWallet) SignProgram(data []byte, src crypto.Digest, pw []byte) ([]byte, error) {
+	sig, err := ew.sign(crypto.PublicKey(src), signer.KindProgram, crypto.HashRep(logic.Program(data)))
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:], nil
+}
+
+// MultisigSignTransaction implements the Wallet interface. The partial
+// multisig must already list pk as one of its subsignature keys.
+func (ew *ExternalSignerWallet) MultisigSignTransaction(tx transactions.Transaction, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte, signerAddr crypto.Digest) (crypto.MultisigSig, error) {
+	return ew.multisigSign(pk, partial, func() (crypto.Signature, error) {
+		return ew.sign(pk, signer.KindTransaction, crypto.HashRep(tx))
+	})
+}
+
+// MultisigSignProgram implements the Wallet interface.
+func (ew *ExternalSignerWallet) MultisigSignProgram(data []byte, src crypto.Digest, pk crypto.PublicKey, partial crypto.MultisigSig, pw []byte) (crypto.MultisigSig, error) {
+	return ew.multisigSign(pk, partial, func() (crypto.Signature, error) {
+		return ew.sign(pk, signer.KindProgram, crypto.HashRep(logic.Program(data)))
+	})
+}
+
+func (ew *ExternalSignerWallet) multisigSign(pk crypto.PublicKey, partial crypto.MultisigSig, sign func() (crypto.Signature, error)) (crypto.MultisigSig, error) {
+	isValidKey := false
+	for i := 0; i < len(partial.Subsigs); i++ {
+		if partial.Subsigs[i].Key == pk {
+			isValidKey = true
+			break
+		}
+	}
+
+	if !isValidKey {
+		return partial, errMsigWrongKey
+	}
+
+	sig, err := sign()
+	if err != nil {
+		return partial, err
+	}
+
+	for i := 0; i < len(partial.Subsigs); i++ {
+		subsig := &partial.Subsigs[i]
+		if subsig.Key == pk {
+			subsig.Sig = sig
+		}
+	}
+
+	return partial, nil
+}
+
+func (ew *ExternalSignerWallet) sign(pk crypto.PublicKey, kind signer.Kind, data []byte) (crypto.Signature, error) {
+	client, err := ew.connect()
+	if err != nil {
+		return crypto.Signature{}, err
+	}
+
+	sig, err := client.Sign(pk, kind, data)
+	if err != nil {
+		return crypto.Signature{}, fmt.Errorf("external signer %s: %v", ew.name, err)
+	}
+	return sig, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/daemon/kmd/signer"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestExternalSignerWallet(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	rs := signer.MakeReferenceSigner("token")
+	pk := rs.GenerateKey()
+	srv := httptest.NewServer(rs)
+	defer srv.Close()
+
+	var cfg config.KMDConfig
+	cfg.DriverConfig.ExternalSignerWalletDriverConfig.Signers = []config.ExternalSignerConfig{
+		{Name: "reference", URL: srv.URL, Token: "token"},
+	}
+	require.NoError(t, cfg.Validate())
+
+	var ewd ExternalSignerWalletDriver
+	require.NoError(t, ewd.InitWithConfig(cfg, logging.TestingLog(t)))
+
+	mds, err := ewd.ListWalletMetadatas()
+	require.NoError(t, err)
+	require.Len(t, mds, 1)
+	require.Equal(t, "reference", string(mds[0].Name))
+	require.Equal(t, externalSignerWalletDriverName, mds[0].DriverName)
+
+	w, err := ewd.FetchWallet(mds[0].ID)
+	require.NoError(t, err)
+
+	keys, err := w.ListKeys()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{publicKeyToAddress(pk)}, keys)
+
+	// Sign a transaction from the signer's own address
+	tx := transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: transactions.Header{Sender: basics.Address(pk), Fee: basics.MicroAlgos{Raw: 1000}},
+	}
+	enc, err := w.SignTransaction(tx, crypto.PublicKey{}, nil)
+	require.NoError(t, err)
+	var stxn transactions.SignedTxn
+	require.NoError(t, protocol.Decode(enc, &stxn))
+	require.True(t, crypto.SignatureVerifier(pk).Verify(tx, stxn.Sig))
+	require.Equal(t, basics.Address{}, stxn.AuthAddr)
+
+	// Sign a transaction for a rekeyed account
+	tx.Sender = basics.Address{0x01}
+	enc, err = w.SignTransaction(tx, pk, nil)
+	require.NoError(t, err)
+	require.NoError(t, protocol.Decode(enc, &stxn))
+	require.True(t, crypto.SignatureVerifier(pk).Verify(tx, stxn.Sig))
+	require.Equal(t, basics.Address(pk), stxn.AuthAddr)
+
+	// Sign a program
+	program := []byte{0x01, 0x20, 0x01, 0x01, 0x22}
+	sigBytes, err := w.SignProgram(program, publicKeyToAddress(pk), nil)
+	require.NoError(t, err)
+	var sig crypto.Signature
+	copy(sig[:], sigBytes)
+	require.True(t, crypto.SignatureVerifier(pk).Verify(logic.Program(program), sig))
+
+	// Multisig only signs for keys listed in the partial multisig
+	var otherPK crypto.PublicKey
+	otherPK[0] = 1
+	partial := crypto.MultisigSig{
+		Version:   1,
+		Threshold: 1,
+		Subsigs:   []crypto.MultisigSubsig{{Key: otherPK}, {Key: pk}},
+	}
+	msig, err := w.MultisigSignTransaction(tx, pk, partial, nil, crypto.Digest{})
+	require.NoError(t, err)
+	require.True(t, msig.Subsigs[0].Sig.Blank())
+	require.True(t, crypto.SignatureVerifier(pk).Verify(tx, msig.Subsigs[1].Sig))
+
+	var outsiderPK crypto.PublicKey
+	outsiderPK[0] = 2
+	_, err = w.MultisigSignProgram(program, crypto.Digest{}, outsiderPK, partial, nil)
+	require.Equal(t, errMsigWrongKey, err)
+
+	// Keys cannot be managed through kmd
+	_, err = w.GenerateKey(false)
+	require.Equal(t, errNotSupported, err)
+}
@@ -79,7 +79,7 @@ CREATE TABLE IF NOT EXISTS msig_addrs (
 	threshold INT NOT NULL,
 	pks BLOB NOT NULL
 );
-`
+` + policySchema
 
 // SQLiteWalletDriver is the default wallet driver used by kmd. Keys are stored
 // as authenticated-encrypted blobs in a sqlite 3 database.
@@ -727,6 +727,12 @@ func (sw *SQLiteWallet) ExportMasterDerivationKey(pw []byte) (mdk crypto.MasterD
 		return
 	}
 
+	// The master derivation key derives every key, so no key may be restricted
+	err = sw.checkExportAllowed(nil)
+	if err != nil {
+		return
+	}
+
 	// Copy master derivation key into the result
 	copy(mdk[:], sw.masterDerivationKey)
 	return
@@ -779,6 +785,11 @@ func (sw *SQLiteWallet) ExportKey(addr crypto.Digest, pw []byte) (sk crypto.Priv
 		return
 	}
 
+	err = sw.checkExportAllowed(&addr)
+	if err != nil {
+		return
+	}
+
 	// Export the key
 	return sw.fetchSecretKey(addr)
 }
@@ -0,0 +1,382 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"bytes"
+	"database/sql"
+	"os"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// sqliteBackupKey is a single key in a sqlite wallet backup. Derived keys
+// record the index they were derived at so that restore can check them
+// against the master derivation key.
+type sqliteBackupKey struct {
+	SecretKey crypto.PrivateKey `codec:"sk"`
+	Derived   bool              `codec:"derived"`
+	KeyIdx    uint64            `codec:"key_idx"`
+}
+
+// sqliteBackupMultisig is a multisig preimage in a sqlite wallet backup
+type sqliteBackupMultisig struct {
+	Version   uint8              `codec:"version"`
+	Threshold uint8              `codec:"threshold"`
+	PKs       []crypto.PublicKey `codec:"pks"`
+}
+
+// sqliteBackupContents is everything needed to recreate a sqlite wallet. It
+// repeats the archive envelope fields so that they are covered by the
+// backup's authenticated encryption.
+type sqliteBackupContents struct {
+	Format        string `codec:"format"`
+	Version       uint32 `codec:"version"`
+	DriverName    string `codec:"driver_name"`
+	DriverVersion uint32 `codec:"driver_version"`
+
+	WalletID            []byte                     `codec:"wallet_id"`
+	WalletName          []byte                     `codec:"wallet_name"`
+	MasterDerivationKey crypto.MasterDerivationKey `codec:"mdk"`
+	MaxKeyIdx           uint64                     `codec:"max_key_idx"`
+	Keys                []sqliteBackupKey          `codec:"keys"`
+	Multisigs           []sqliteBackupMultisig     `codec:"msigs"`
+}
+
+// Backup exports the entire wallet, encrypted with the wallet password using
+// the same scrypt/secretbox scheme as the wallet database itself. A backup
+// holds every key, so it is refused while a signing policy restricts any of
+// them, and it does not include the policy.
+func (sw *SQLiteWallet) Backup(pw []byte) (archive []byte, err error) {
+	// Check the password
+	err = sw.CheckPassword(pw)
+	if err != nil {
+		return
+	}
+
+	err = sw.checkExportAllowed(nil)
+	if err != nil {
+		return
+	}
+
+	meta, err := sw.Metadata()
+	if err != nil {
+		return
+	}
+
+	contents := sqliteBackupContents{
+		Format:        walletBackupFormat,
+		Version:       walletBackupVersion,
+		DriverName:    sqliteWalletDriverName,
+		DriverVersion: sqliteWalletDriverVersion,
+		WalletID:      meta.ID,
+		WalletName:    meta.Name,
+	}
+	copy(contents.MasterDerivationKey[:], sw.masterDerivationKey)
+
+	contents.MaxKeyIdx, err = sw.fetchMaxKeyIdx()
+	if err != nil {
+		return
+	}
+
+	contents.Keys, err = sw.backupKeys()
+	if err != nil {
+		return
+	}
+
+	msigAddrs, err := sw.ListMultisigAddrs()
+	if err != nil {
+		return
+	}
+	for _, addr := range msigAddrs {
+		var msig sqliteBackupMultisig
+		msig.Version, msig.Threshold, msig.PKs, err = sw.LookupMultisigPreimage(addr)
+		if err != nil {
+			return
+		}
+		contents.Multisigs = append(contents.Multisigs, msig)
+	}
+
+	encrypted, err := encryptBlobWithPasswordBlankOK(msgpackEncode(contents), PTWalletBackup, pw, &sw.cfg.ScryptParams)
+	if err != nil {
+		return
+	}
+
+	archive = msgpackEncode(walletBackupArchive{
+		Format:        walletBackupFormat,
+		Version:       walletBackupVersion,
+		DriverName:    sqliteWalletDriverName,
+		DriverVersion: sqliteWalletDriverVersion,
+		Contents:      encrypted,
+	})
+	return
+}
+
+// fetchMaxKeyIdx decrypts the highest derived key index
+func (sw *SQLiteWallet) fetchMaxKeyIdx() (maxKeyIdx uint64, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var encryptedIdxBlob []byte
+	err = db.Get(&encryptedIdxBlob, "SELECT max_key_idx_encrypted FROM metadata LIMIT 1")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	idxBlob, err := decryptBlobWithPassword(encryptedIdxBlob, PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+
+	err = msgpackDecode(idxBlob, &maxKeyIdx)
+	return
+}
+
+// backupKeys decrypts every key in the wallet
+func (sw *SQLiteWallet) backupKeys() (keys []sqliteBackupKey, err error) {
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	var rows []struct {
+		Address []byte        `db:"address"`
+		KeyIdx  sql.NullInt64 `db:"key_idx"`
+	}
+	err = db.Select(&rows, "SELECT address, key_idx FROM keys")
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	for _, row := range rows {
+		var addr crypto.Digest
+		copy(addr[:], row.Address)
+
+		// fetchSecretKey checks that the key matches its address
+		var sk crypto.PrivateKey
+		sk, err = sw.fetchSecretKey(addr)
+		if err != nil {
+			return
+		}
+
+		keys = append(keys, sqliteBackupKey{
+			SecretKey: sk,
+			Derived:   row.KeyIdx.Valid,
+			KeyIdx:    uint64(row.KeyIdx.Int64),
+		})
+	}
+	return
+}
+
+// openBackup decrypts the contents of a wallet backup and checks that they
+// are consistent before anything is written to disk
+func openBackup(archive walletBackupArchive, pw []byte) (contents sqliteBackupContents, err error) {
+	if archive.DriverVersion != sqliteWalletDriverVersion {
+		err = errWrongDriverVer
+		return
+	}
+
+	plaintext, err := decryptBlobWithPassword(archive.Contents, PTWalletBackup, pw)
+	if err != nil {
+		return
+	}
+	err = msgpackDecode(plaintext, &contents)
+	if err != nil {
+		err = errBackupCorrupt
+		return
+	}
+
+	// The envelope is not authenticated, so it must agree with the contents
+	if contents.Format != archive.Format || contents.Version != archive.Version ||
+		contents.DriverName != archive.DriverName || contents.DriverVersion != archive.DriverVersion {
+		err = errBackupCorrupt
+		return
+	}
+
+	if len(contents.WalletID) == 0 || len(contents.WalletID) > sqliteMaxWalletIDLen || len(contents.WalletName) > sqliteMaxWalletNameLen {
+		err = errBackupCorrupt
+		return
+	}
+
+	seen := make(map[crypto.PublicKey]bool)
+	for _, key := range contents.Keys {
+		pk, err1 := crypto.SecretKeyToPublicKey(key.SecretKey)
+		if err1 != nil || seen[pk] {
+			err = errBackupCorrupt
+			return
+		}
+		seen[pk] = true
+
+		// Derived keys must be reproducible from the master derivation key
+		if key.Derived {
+			if key.KeyIdx > contents.MaxKeyIdx {
+				err = errBackupCorrupt
+				return
+			}
+			derivedPK, _, err1 := extractKeyWithIndex(contents.MasterDerivationKey[:], key.KeyIdx)
+			if err1 != nil || derivedPK != pk {
+				err = errBackupCorrupt
+				return
+			}
+		}
+	}
+
+	for _, msig := range contents.Multisigs {
+		_, err1 := crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err1 != nil {
+			err = errBackupCorrupt
+			return
+		}
+	}
+
+	return
+}
+
+// RestoreWallet creates a new wallet holding the contents of a backup. The
+// restored wallet keeps the backed up wallet ID, so it cannot be restored
+// next to the wallet it was taken from.
+func (swd *SQLiteWalletDriver) RestoreWallet(archive walletBackupArchive, pw []byte, name []byte) (id []byte, err error) {
+	contents, err := openBackup(archive, pw)
+	if err != nil {
+		return
+	}
+
+	if len(name) == 0 {
+		name = contents.WalletName
+	}
+	id = contents.WalletID
+
+	err = swd.CreateWallet(name, id, pw, contents.MasterDerivationKey)
+	if err != nil {
+		return nil, err
+	}
+
+	w, err := swd.FetchWallet(id)
+	if err != nil {
+		return nil, err
+	}
+	sw, ok := w.(*SQLiteWallet)
+	if !ok {
+		return nil, errSQLiteWrongType
+	}
+
+	err = sw.restoreContents(pw, contents)
+	if err != nil {
+		// Don't leave a partially restored wallet behind
+		os.Remove(sw.dbPath)
+		swd.releaseWalletNameID(name, id)
+		return nil, err
+	}
+	return id, nil
+}
+
+// releaseWalletNameID drops a name/id claim made by claimWalletNameID
+func (swd *SQLiteWalletDriver) releaseWalletNameID(name []byte, id []byte) {
+	swd.mux.Lock()
+	defer swd.mux.Unlock()
+
+	for i, nameID := range swd.claimedWallets {
+		if bytes.Equal(nameID[0], name) && bytes.Equal(nameID[1], id) {
+			swd.claimedWallets = append(swd.claimedWallets[:i], swd.claimedWallets[i+1:]...)
+			return
+		}
+	}
+}
+
+// restoreContents writes the keys, multisig preimages and key index from a
+// backup into a freshly created wallet
+func (sw *SQLiteWallet) restoreContents(pw []byte, contents sqliteBackupContents) error {
+	err := sw.Init(pw)
+	if err != nil {
+		return err
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	for _, key := range contents.Keys {
+		pk, err := crypto.SecretKeyToPublicKey(key.SecretKey)
+		if err != nil {
+			return errSKToPK
+		}
+		addr := publicKeyToAddress(pk)
+
+		skEncrypted, err := encryptBlobWithKey(msgpackEncode(key.SecretKey), PTSecretKey, sw.masterEncryptionKey)
+		if err != nil {
+			return err
+		}
+
+		var keyIdx interface{}
+		if key.Derived {
+			keyIdx = key.KeyIdx
+		}
+		_, err = tx.Exec("INSERT INTO keys (address, secret_key_encrypted, key_idx) VALUES(?, ?, ?)", addr[:], skEncrypted, keyIdx)
+		err = checkDBError(err)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, msig := range contents.Multisigs {
+		addr, err := crypto.MultisigAddrGen(msig.Version, msig.Threshold, msig.PKs)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO msig_addrs (address, version, threshold, pks) VALUES (?, ?, ?, ?)", addr[:], msig.Version, msig.Threshold, msgpackEncode(msig.PKs))
+		err = checkDBError(err)
+		if err != nil {
+			return err
+		}
+	}
+
+	encryptedIdxBlob, err := encryptBlobWithKey(msgpackEncode(contents.MaxKeyIdx), PTMaxKeyIdx, sw.masterEncryptionKey)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("UPDATE metadata SET max_key_idx_encrypted = ?", encryptedIdxBlob)
+	if err != nil {
+		return errDatabase
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestSQLiteDriver(t *testing.T) *SQLiteWalletDriver {
+	var cfg config.KMDConfig
+	cfg.DataDir = t.TempDir()
+	cfg.DriverConfig.SQLiteWalletDriverConfig.UnsafeScrypt = true
+	cfg.DriverConfig.SQLiteWalletDriverConfig.ScryptParams = config.ScryptParams{ScryptN: 2, ScryptR: 1, ScryptP: 1}
+
+	swd := &SQLiteWalletDriver{}
+	require.NoError(t, swd.InitWithConfig(cfg, logging.TestingLog(t)))
+	return swd
+}
+
+func TestSQLiteWalletBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pw := []byte("password")
+	src := makeTestSQLiteDriver(t)
+	require.NoError(t, src.CreateWallet([]byte("orig"), []byte("abc123"), pw, crypto.MasterDerivationKey{}))
+	w, err := src.FetchWallet([]byte("abc123"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+
+	// Two derived keys, one imported key and a multisig preimage
+	gen1, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	gen2, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	imported, err := w.ImportKey(crypto.PrivateKey(crypto.GenerateSignatureSecrets(seed).SK))
+	require.NoError(t, err)
+	msigAddr, err := w.ImportMultisigAddr(1, 2, []crypto.PublicKey{crypto.PublicKey(gen1), crypto.PublicKey(gen2)})
+	require.NoError(t, err)
+
+	archive, err := BackupWallet(w, pw)
+	require.NoError(t, err)
+
+	_, err = BackupWallet(w, []byte("wrong"))
+	require.Error(t, err)
+
+	var wba walletBackupArchive
+	require.NoError(t, msgpackDecode(archive, &wba))
+	require.Equal(t, sqliteWalletDriverName, wba.DriverName)
+
+	dst := makeTestSQLiteDriver(t)
+
+	// The backup only opens with the password it was made with
+	_, err = dst.RestoreWallet(wba, []byte("wrong"), nil)
+	require.Equal(t, errDecrypt, err)
+
+	// Tampering with the envelope or the ciphertext is detected
+	tampered := wba
+	tampered.DriverVersion++
+	_, err = dst.RestoreWallet(tampered, pw, nil)
+	require.Error(t, err)
+	tampered = wba
+	tampered.Contents = append([]byte{}, wba.Contents...)
+	tampered.Contents[len(tampered.Contents)-1] ^= 0x01
+	_, err = dst.RestoreWallet(tampered, pw, nil)
+	require.Error(t, err)
+
+	id, err := dst.RestoreWallet(wba, pw, []byte("restored"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("abc123"), id)
+
+	rw, err := dst.FetchWallet(id)
+	require.NoError(t, err)
+	require.NoError(t, rw.Init(pw))
+	md, err := rw.Metadata()
+	require.NoError(t, err)
+	require.Equal(t, []byte("restored"), md.Name)
+
+	keys, err := rw.ListKeys()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []crypto.Digest{gen1, gen2, imported}, keys)
+
+	msigs, err := rw.ListMultisigAddrs()
+	require.NoError(t, err)
+	require.Equal(t, []crypto.Digest{msigAddr}, msigs)
+
+	mdk1, err := w.ExportMasterDerivationKey(pw)
+	require.NoError(t, err)
+	mdk2, err := rw.ExportMasterDerivationKey(pw)
+	require.NoError(t, err)
+	require.Equal(t, mdk1, mdk2)
+
+	// Derivation continues where the original wallet left off
+	next, err := w.GenerateKey(false)
+	require.NoError(t, err)
+	restoredNext, err := rw.GenerateKey(false)
+	require.NoError(t, err)
+	require.Equal(t, next, restoredNext)
+
+	// The wallet ID is kept, so restoring twice conflicts
+	_, err = dst.RestoreWallet(wba, pw, []byte("again"))
+	require.Equal(t, errSameID, err)
+
+	_, err = RestoreWallet([]byte("not a backup"), pw, nil)
+	require.Equal(t, errBackupFormat, err)
+}
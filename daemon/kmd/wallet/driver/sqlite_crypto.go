@@ -51,6 +51,12 @@ var (
 	PTMasterDerivationKey plaintextType = "master_derivation_key"
 	// PTMaxKeyIdx is the plaintext type for the maximum key index
 	PTMaxKeyIdx plaintextType = "max_key_idx"
+	// PTWalletBackup is the plaintext type for the contents of a wallet backup
+	PTWalletBackup plaintextType = "wallet_backup"
+	// PTPolicy is the plaintext type for a wallet's signing policy
+	PTPolicy plaintextType = "policy"
+	// PTPolicyPassword is the plaintext type for the check of a wallet's policy password
+	PTPolicyPassword plaintextType = "policy_password"
 )
 
 // typedPlaintext prevents us from confusing differently typed data encrypted
@@ -75,6 +81,12 @@ type encryptedDBBlob struct {
 func deriveEncryptionKeyWithSalt(password []byte, salt *[saltLen]byte, cfg config.ScryptParams) (*[masterKeyLen]byte, error) {
 	var key [masterKeyLen]byte
 
+	// scrypt.Key panics rather than failing on non-positive r or p, and the
+	// parameters may come from an untrusted blob
+	if cfg.ScryptR <= 0 || cfg.ScryptP <= 0 {
+		return nil, errDeriveKey
+	}
+
 	// derive the encryption key from the password + salt
 	keySlice, err := scrypt.Key(password, salt[:], cfg.ScryptN, cfg.ScryptR, cfg.ScryptP, masterKeyLen)
 	if err != nil {
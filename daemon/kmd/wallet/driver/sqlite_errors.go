@@ -45,3 +45,6 @@ var errNameTooLong = fmt.Errorf("wallet name too long, must be <= %d bytes", sql
 var errIDTooLong = fmt.Errorf("wallet id too long, must be <= %d bytes", sqliteMaxWalletIDLen)
 var errMsigWrongAddr = fmt.Errorf("given multisig preimage hashes to neither Sender nor AuthAddr")
 var errMsigWrongKey = fmt.Errorf("given key is not a possible signer for this multisig")
+var errBackupFormat = fmt.Errorf("not a kmd wallet backup")
+var errBackupVersion = fmt.Errorf("unsupported wallet backup version")
+var errBackupCorrupt = fmt.Errorf("wallet backup failed integrity checks")
@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+)
+
+// policySchema is part of walletSchema, and is also applied on its own so
+// that wallets created before policies existed gain the table on first use.
+// password_check is a blob encrypted with the policy password, which is only
+// used to check the password.
+var policySchema = `
+CREATE TABLE IF NOT EXISTS policy (
+	policy_encrypted BLOB NOT NULL,
+	password_check BLOB NOT NULL
+);
+`
+
+// policyPasswordCheck is the plaintext of the password_check blob
+var policyPasswordCheck = []byte("policy_password")
+
+var errBlankPolicyPassword = fmt.Errorf("a policy password is required to set a policy")
+
+// Policy returns the wallet's signing policy. The policy is encrypted with
+// the master encryption key, so the wallet must be initialized.
+func (sw *SQLiteWallet) Policy() (policy wallet.Policy, err error) {
+	if sw.masterEncryptionKey == nil {
+		err = errDecrypt
+		return
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		err = errDatabaseConnect
+		return
+	}
+	defer db.Close()
+
+	_, err = db.Exec(policySchema)
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	var blob []byte
+	err = db.Get(&blob, "SELECT policy_encrypted FROM policy LIMIT 1")
+	if err == sql.ErrNoRows {
+		// No policy has been set
+		return wallet.Policy{}, nil
+	}
+	if err != nil {
+		err = errDatabase
+		return
+	}
+
+	encoded, err := decryptBlobWithPassword(blob, PTPolicy, sw.masterEncryptionKey)
+	if err != nil {
+		return
+	}
+
+	err = msgpackDecode(encoded, &policy)
+	return
+}
+
+// SetPolicy replaces the wallet's signing policy. Setting a policy with
+// rules on a wallet without one makes policyPw the policy password, which
+// must then be given to change the policy. Setting a policy without rules
+// removes the policy, along with its password.
+func (sw *SQLiteWallet) SetPolicy(policy wallet.Policy, pw []byte, policyPw []byte) error {
+	// Check the password
+	err := sw.CheckPassword(pw)
+	if err != nil {
+		return err
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		return err
+	}
+
+	// Connect to the database
+	db, err := sqlx.Connect("sqlite3", dbConnectionURL(sw.dbPath))
+	if err != nil {
+		return errDatabaseConnect
+	}
+	defer db.Close()
+
+	_, err = db.Exec(policySchema)
+	if err != nil {
+		return errDatabase
+	}
+
+	tx, err := db.Beginx()
+	if err != nil {
+		return errDatabase
+	}
+	defer tx.Rollback()
+
+	// Check the policy password, or choose it if there is no policy yet
+	var passwordCheck []byte
+	err = tx.Get(&passwordCheck, "SELECT password_check FROM policy LIMIT 1")
+	switch {
+	case err == sql.ErrNoRows:
+		if len(policy.Rules) == 0 {
+			return nil
+		}
+		if len(policyPw) == 0 {
+			return errBlankPolicyPassword
+		}
+		passwordCheck, err = encryptBlobWithPasswordBlankOK(policyPasswordCheck, PTPolicyPassword, policyPw, &sw.cfg.ScryptParams)
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return errDatabase
+	default:
+		_, err = decryptBlobWithPassword(passwordCheck, PTPolicyPassword, policyPw)
+		if err != nil {
+			return wallet.ErrPolicyPassword
+		}
+	}
+
+	_, err = tx.Exec("DELETE FROM policy")
+	if err != nil {
+		return errDatabase
+	}
+
+	if len(policy.Rules) > 0 {
+		blob, err := encryptBlobWithKey(msgpackEncode(policy), PTPolicy, sw.masterEncryptionKey)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec("INSERT INTO policy (policy_encrypted, password_check) VALUES(?, ?)", blob, passwordCheck)
+		if err != nil {
+			return errDatabase
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return errDatabase
+	}
+	return nil
+}
+
+// checkExportAllowed returns wallet.ErrPolicyRestrictsExport if the wallet's
+// policy restricts signing with addr, or with any key if addr is nil
+func (sw *SQLiteWallet) checkExportAllowed(addr *crypto.Digest) error {
+	policy, err := sw.Policy()
+	if err != nil {
+		return err
+	}
+	if (addr == nil && policy.RestrictsAny()) || (addr != nil && policy.Restricts(*addr)) {
+		return wallet.ErrPolicyRestrictsExport
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package driver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/daemon/kmd/wallet"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestSQLiteWalletPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pw := []byte("password")
+	swd := makeTestSQLiteDriver(t)
+	require.NoError(t, swd.CreateWallet([]byte("policy"), []byte("abc123"), pw, crypto.MasterDerivationKey{}))
+	w, err := swd.FetchWallet([]byte("abc123"))
+	require.NoError(t, err)
+	require.NoError(t, w.Init(pw))
+	sw := w.(*SQLiteWallet)
+
+	// New wallets have no policy
+	policy, err := sw.Policy()
+	require.NoError(t, err)
+	require.Empty(t, policy.Rules)
+
+	key, err := sw.GenerateKey(false)
+	require.NoError(t, err)
+
+	expected := wallet.Policy{Rules: []wallet.PolicyRule{
+		{MaxSignaturesPerHour: 10},
+		{
+			Address:          key,
+			AllowedTxTypes:   []protocol.TxType{protocol.PaymentTx},
+			MaxPaymentAmount: 1000,
+		},
+	}}
+
+	policyPw := []byte("policy password")
+	require.Equal(t, errDecrypt, sw.SetPolicy(expected, []byte("wrong"), policyPw))
+	require.Error(t, sw.SetPolicy(wallet.Policy{Rules: []wallet.PolicyRule{{}, {}}}, pw, policyPw))
+	require.Equal(t, errBlankPolicyPassword, sw.SetPolicy(expected, pw, nil))
+	require.NoError(t, sw.SetPolicy(expected, pw, policyPw))
+
+	policy, err = sw.Policy()
+	require.NoError(t, err)
+	require.Equal(t, expected, policy)
+
+	// Changing the policy requires the policy password, not only the wallet password
+	require.Equal(t, wallet.ErrPolicyPassword, sw.SetPolicy(wallet.Policy{}, pw, pw))
+	require.Equal(t, wallet.ErrPolicyPassword, sw.SetPolicy(wallet.Policy{}, pw, nil))
+	require.NoError(t, sw.SetPolicy(expected, pw, policyPw))
+
+	// Restricted keys, and the wallet as a whole, can't be exported
+	_, err = sw.ExportKey(key, pw)
+	require.Equal(t, wallet.ErrPolicyRestrictsExport, err)
+	_, err = sw.ExportMasterDerivationKey(pw)
+	require.Equal(t, wallet.ErrPolicyRestrictsExport, err)
+	_, err = BackupWallet(sw, pw)
+	require.Equal(t, wallet.ErrPolicyRestrictsExport, err)
+
+	// Setting an empty policy removes all restrictions, along with the policy password
+	require.NoError(t, sw.SetPolicy(wallet.Policy{}, pw, policyPw))
+	policy, err = sw.Policy()
+	require.NoError(t, err)
+	require.Empty(t, policy.Rules)
+	_, err = sw.ExportKey(key, pw)
+	require.NoError(t, err)
+	_, err = BackupWallet(sw, pw)
+	require.NoError(t, err)
+
+	// The next policy chooses a new policy password
+	require.NoError(t, sw.SetPolicy(expected, pw, []byte("new policy password")))
+	require.Equal(t, wallet.ErrPolicyPassword, sw.SetPolicy(wallet.Policy{}, pw, policyPw))
+}
@@ -0,0 +1,221 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package wallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ErrPolicyPassword is returned when changing a signing policy without the
+// policy password that protects it
+var ErrPolicyPassword = errors.New("wrong policy password")
+
+// ErrPolicyRestrictsExport is returned when exporting keys, or the whole
+// wallet, while a signing policy restricts them
+var ErrPolicyRestrictsExport = errors.New("signing policy does not allow exporting restricted keys")
+
+// Policy restricts what a wallet is willing to sign. A wallet with no rules
+// signs anything its keys can sign.
+type Policy struct {
+	Rules []PolicyRule `codec:"rules"`
+}
+
+// PolicyRule restricts signing with a single key, or with every key that has
+// no rule of its own if Address is zero. Zero-valued limits are not enforced.
+type PolicyRule struct {
+	Address crypto.Digest `codec:"addr"`
+
+	// AllowedTxTypes, if not empty, lists the transaction types that may be signed
+	AllowedTxTypes []protocol.TxType `codec:"txtypes"`
+
+	// MaxPaymentAmount, if not zero, caps the amount of a single payment in
+	// microAlgos. Payments that close out the sender are refused.
+	MaxPaymentAmount uint64 `codec:"maxpay"`
+
+	// AllowedReceivers, if not empty, lists the addresses that payments and
+	// asset transfers may send to or close out to
+	AllowedReceivers []crypto.Digest `codec:"rcvs"`
+
+	// AllowedAppIDs, if not empty, lists the applications that may be called.
+	// Include 0 to allow creating applications.
+	AllowedAppIDs []uint64 `codec:"apps"`
+
+	// MaxSignaturesPerHour, if not zero, limits how many signatures the key
+	// may produce in any one hour window. kmd counts the signatures in memory,
+	// so the count starts over when kmd restarts.
+	MaxSignaturesPerHour uint64 `codec:"sigsph"`
+}
+
+// PolicyWallet is implemented by wallets that can store a signing Policy.
+// A policy with rules is protected by a policy password, separate from the
+// wallet password, which is chosen when the policy is first set and is then
+// required to change or remove it. While a policy restricts any key, the
+// wallet refuses to export its keys or back itself up.
+type PolicyWallet interface {
+	Policy() (Policy, error)
+	SetPolicy(p Policy, pw []byte, policyPw []byte) error
+}
+
+// Validate checks that no two rules apply to the same key
+func (p Policy) Validate() error {
+	seen := make(map[crypto.Digest]bool)
+	for _, rule := range p.Rules {
+		if seen[rule.Address] {
+			if rule.Address.IsZero() {
+				return fmt.Errorf("policy has more than one default rule")
+			}
+			return fmt.Errorf("policy has more than one rule for %s", basics.Address(rule.Address))
+		}
+		seen[rule.Address] = true
+	}
+	return nil
+}
+
+// RuleFor returns the rule governing signatures made with addr: its own rule
+// if it has one, otherwise the default rule. ok is false if neither exists.
+func (p Policy) RuleFor(addr crypto.Digest) (rule PolicyRule, ok bool) {
+	for _, r := range p.Rules {
+		if r.Address == addr {
+			return r, true
+		}
+		if r.Address.IsZero() {
+			rule, ok = r, true
+		}
+	}
+	return
+}
+
+// Restricts reports whether a rule restricts signing with addr
+func (p Policy) Restricts(addr crypto.Digest) bool {
+	rule, ok := p.RuleFor(addr)
+	return ok && rule.restricts()
+}
+
+// RestrictsAny reports whether any rule restricts signing, with any key
+func (p Policy) RestrictsAny() bool {
+	for _, rule := range p.Rules {
+		if rule.restricts() {
+			return true
+		}
+	}
+	return false
+}
+
+// restricts reports whether the rule limits signing in any way
+func (r PolicyRule) restricts() bool {
+	return r.restrictsTransactions() || r.MaxSignaturesPerHour > 0
+}
+
+// restrictsTransactions reports whether the rule limits which transactions
+// may be signed, as opposed to only how often
+func (r PolicyRule) restrictsTransactions() bool {
+	return len(r.AllowedTxTypes) > 0 || r.MaxPaymentAmount > 0 || len(r.AllowedReceivers) > 0 || len(r.AllowedAppIDs) > 0
+}
+
+func (r PolicyRule) receiverAllowed(addr basics.Address) bool {
+	for _, allowed := range r.AllowedReceivers {
+		if allowed == crypto.Digest(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransaction returns an error if the rule does not allow signing tx
+func (r PolicyRule) CheckTransaction(tx transactions.Transaction) error {
+	if !r.restrictsTransactions() {
+		return nil
+	}
+
+	// Rekeying would hand the account to a key this policy does not govern
+	if !tx.RekeyTo.IsZero() {
+		return fmt.Errorf("policy does not allow rekeying")
+	}
+
+	if len(r.AllowedTxTypes) > 0 {
+		allowed := false
+		for _, t := range r.AllowedTxTypes {
+			if t == tx.Type {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("policy does not allow %s transactions", tx.Type)
+		}
+	}
+
+	switch tx.Type {
+	case protocol.PaymentTx:
+		if r.MaxPaymentAmount > 0 {
+			if !tx.CloseRemainderTo.IsZero() {
+				return fmt.Errorf("policy does not allow closing out an account")
+			}
+			if tx.Amount.Raw > r.MaxPaymentAmount {
+				return fmt.Errorf("payment of %d exceeds policy limit of %d", tx.Amount.Raw, r.MaxPaymentAmount)
+			}
+		}
+		if len(r.AllowedReceivers) > 0 {
+			if !r.receiverAllowed(tx.Receiver) {
+				return fmt.Errorf("policy does not allow paying %s", tx.Receiver)
+			}
+			if !tx.CloseRemainderTo.IsZero() && !r.receiverAllowed(tx.CloseRemainderTo) {
+				return fmt.Errorf("policy does not allow closing out to %s", tx.CloseRemainderTo)
+			}
+		}
+	case protocol.AssetTransferTx:
+		if len(r.AllowedReceivers) > 0 {
+			if !r.receiverAllowed(tx.AssetReceiver) {
+				return fmt.Errorf("policy does not allow transferring assets to %s", tx.AssetReceiver)
+			}
+			if !tx.AssetCloseTo.IsZero() && !r.receiverAllowed(tx.AssetCloseTo) {
+				return fmt.Errorf("policy does not allow closing out assets to %s", tx.AssetCloseTo)
+			}
+		}
+	case protocol.ApplicationCallTx:
+		if len(r.AllowedAppIDs) > 0 {
+			allowed := false
+			for _, id := range r.AllowedAppIDs {
+				if basics.AppIndex(id) == tx.ApplicationID {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				return fmt.Errorf("policy does not allow calling application %d", tx.ApplicationID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckProgram returns an error if the rule does not allow signing a
+// program. A signed program can authorize arbitrary transactions, so keys
+// whose transactions are restricted may not sign programs.
+func (r PolicyRule) CheckProgram() error {
+	if r.restrictsTransactions() {
+		return fmt.Errorf("policy does not allow signing programs with a restricted key")
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package wallet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPolicyRuleFor(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var a, b crypto.Digest
+	a[0] = 1
+	b[0] = 2
+
+	var p Policy
+	_, ok := p.RuleFor(a)
+	require.False(t, ok)
+
+	p.Rules = []PolicyRule{{Address: a, MaxPaymentAmount: 1}}
+	rule, ok := p.RuleFor(a)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), rule.MaxPaymentAmount)
+	_, ok = p.RuleFor(b)
+	require.False(t, ok)
+
+	// The default rule covers keys without a rule of their own, regardless of order
+	p.Rules = append(p.Rules, PolicyRule{MaxPaymentAmount: 2})
+	rule, ok = p.RuleFor(b)
+	require.True(t, ok)
+	require.Equal(t, uint64(2), rule.MaxPaymentAmount)
+	rule, ok = p.RuleFor(a)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), rule.MaxPaymentAmount)
+
+	require.NoError(t, p.Validate())
+	require.Error(t, Policy{Rules: []PolicyRule{{Address: a}, {Address: a}}}.Validate())
+	require.Error(t, Policy{Rules: []PolicyRule{{}, {}}}.Validate())
+}
+
+func TestPolicyRestricts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var a, b crypto.Digest
+	a[0] = 1
+	b[0] = 2
+
+	// Rules without limits restrict nothing
+	p := Policy{Rules: []PolicyRule{{Address: a}}}
+	require.False(t, p.Restricts(a))
+	require.False(t, p.RestrictsAny())
+
+	// A rate limit alone restricts the key
+	p.Rules = []PolicyRule{{Address: a, MaxSignaturesPerHour: 1}}
+	require.True(t, p.Restricts(a))
+	require.False(t, p.Restricts(b))
+	require.True(t, p.RestrictsAny())
+
+	// The default rule restricts keys without a rule of their own
+	p.Rules = append(p.Rules, PolicyRule{AllowedAppIDs: []uint64{1}})
+	require.True(t, p.Restricts(b))
+}
+
+func TestPolicyRuleCheckTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var allowed, other basics.Address
+	allowed[0] = 1
+	other[0] = 2
+
+	pay := func(amount uint64, receiver basics.Address) transactions.Transaction {
+		return transactions.Transaction{
+			Type: protocol.PaymentTx,
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: receiver,
+				Amount:   basics.MicroAlgos{Raw: amount},
+			},
+		}
+	}
+
+	// An unrestricted rule allows anything, including programs
+	var open PolicyRule
+	require.NoError(t, open.CheckTransaction(pay(1000000, other)))
+	require.NoError(t, open.CheckProgram())
+
+	rule := PolicyRule{
+		AllowedTxTypes:   []protocol.TxType{protocol.PaymentTx, protocol.ApplicationCallTx},
+		MaxPaymentAmount: 100,
+		AllowedReceivers: []crypto.Digest{crypto.Digest(allowed)},
+		AllowedAppIDs:    []uint64{5},
+	}
+	require.NoError(t, rule.CheckTransaction(pay(100, allowed)))
+	require.Error(t, rule.CheckTransaction(pay(101, allowed)))
+	require.Error(t, rule.CheckTransaction(pay(1, other)))
+	require.Error(t, rule.CheckProgram())
+
+	closeOut := pay(1, allowed)
+	closeOut.CloseRemainderTo = allowed
+	require.Error(t, rule.CheckTransaction(closeOut))
+
+	rekey := pay(1, allowed)
+	rekey.RekeyTo = other
+	require.Error(t, rule.CheckTransaction(rekey))
+
+	keyreg := transactions.Transaction{Type: protocol.KeyRegistrationTx}
+	require.Error(t, rule.CheckTransaction(keyreg))
+
+	appl := transactions.Transaction{Type: protocol.ApplicationCallTx}
+	appl.ApplicationID = 5
+	require.NoError(t, rule.CheckTransaction(appl))
+	appl.ApplicationID = 6
+	require.Error(t, rule.CheckTransaction(appl))
+
+	// Receivers are also checked for asset transfers
+	rule = PolicyRule{AllowedReceivers: []crypto.Digest{crypto.Digest(allowed)}}
+	axfer := transactions.Transaction{Type: protocol.AssetTransferTx}
+	axfer.AssetReceiver = allowed
+	require.NoError(t, rule.CheckTransaction(axfer))
+	axfer.AssetCloseTo = other
+	require.Error(t, rule.CheckTransaction(axfer))
+
+	// Only MaxPaymentAmount refuses close outs
+	closeOut.CloseRemainderTo = allowed
+	require.NoError(t, rule.CheckTransaction(closeOut))
+}
@@ -0,0 +1,227 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Arg is an argument of an ARC-4 method description
+type Arg struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// Return is the return value of an ARC-4 method description
+type Return struct {
+	Type string `json:"type"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// Method is an ARC-4 method description
+type Method struct {
+	Name    string `json:"name"`
+	Desc    string `json:"desc,omitempty"`
+	Args    []Arg  `json:"args"`
+	Returns Return `json:"returns"`
+	// ReadOnly marks methods which do not change the state of the application,
+	// and may therefore be evaluated without being committed. goal app method
+	// simulates them instead of submitting them.
+	ReadOnly bool `json:"readonly,omitempty"`
+}
+
+// ContractNetworkInfo holds the deployment of a contract on a given network
+type ContractNetworkInfo struct {
+	AppID uint64 `json:"appID"`
+}
+
+// Interface is an ARC-4 interface description
+type Interface struct {
+	Name    string   `json:"name"`
+	Desc    string   `json:"desc,omitempty"`
+	Methods []Method `json:"methods"`
+}
+
+// Contract is an ARC-4 contract description. Networks maps the base64 encoded
+// genesis hash of a network to the deployment of the contract on that network.
+type Contract struct {
+	Name     string                         `json:"name"`
+	Desc     string                         `json:"desc,omitempty"`
+	Networks map[string]ContractNetworkInfo `json:"networks,omitempty"`
+	Methods  []Method                       `json:"methods"`
+}
+
+// Signature returns the method signature, of format `method(argType1,argType2,...)retType`
+func (m Method) Signature() string {
+	argTypes := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		argTypes[i] = arg.Type
+	}
+	return fmt.Sprintf("%s(%s)%s", m.Name, strings.Join(argTypes, ","), m.Returns.Type)
+}
+
+// Selector returns the 4 byte method selector, the first app argument of a call to the method
+func (m Method) Selector() []byte {
+	hash := sha512.Sum512_256([]byte(m.Signature()))
+	return hash[:4]
+}
+
+// IsVoid checks if the method does not return a value
+func (m Method) IsVoid() bool {
+	return m.Returns.Type == VoidReturnType
+}
+
+// Validate checks that the method has a name, that its argument types are ABI,
+// transaction or reference types, and that its return type is an ABI type or void
+func (m Method) Validate() error {
+	if m.Name == "" {
+		return fmt.Errorf("method has no name")
+	}
+	if strings.ContainsAny(m.Name, "(),") {
+		return fmt.Errorf("method name %q contains invalid characters", m.Name)
+	}
+	for i, arg := range m.Args {
+		if IsReferenceType(arg.Type) || IsTransactionType(arg.Type) {
+			continue
+		}
+		if _, err := TypeOf(arg.Type); err != nil {
+			return fmt.Errorf("method %s: error parsing argument type at index %d: %w", m.Name, i, err)
+		}
+	}
+	if m.Returns.Type == "" {
+		return fmt.Errorf("method %s has no return type, use %s for methods which do not return a value", m.Name, VoidReturnType)
+	}
+	if !m.IsVoid() {
+		if _, err := TypeOf(m.Returns.Type); err != nil {
+			return fmt.Errorf("method %s: error parsing return type: %w", m.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateMethods(methods []Method) error {
+	selectors := make(map[string]string, len(methods))
+	for _, m := range methods {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+		sig := m.Signature()
+		if other, ok := selectors[string(m.Selector())]; ok {
+			if other == sig {
+				return fmt.Errorf("method %s is described more than once", sig)
+			}
+			return fmt.Errorf("methods %s and %s have the same selector", other, sig)
+		}
+		selectors[string(m.Selector())] = sig
+	}
+	return nil
+}
+
+// getMethod returns the method whose name or signature is nameOrSignature. A name
+// only identifies a method when no other method has the same name.
+func getMethod(methods []Method, nameOrSignature string) (Method, error) {
+	var matches []Method
+	for _, m := range methods {
+		if m.Signature() == nameOrSignature {
+			return m, nil
+		}
+		if m.Name == nameOrSignature {
+			matches = append(matches, m)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return Method{}, fmt.Errorf("no method named %s", nameOrSignature)
+	case 1:
+		return matches[0], nil
+	default:
+		signatures := make([]string, len(matches))
+		for i, m := range matches {
+			signatures[i] = m.Signature()
+		}
+		return Method{}, fmt.Errorf("method name %s is ambiguous, use one of the signatures %s", nameOrSignature, strings.Join(signatures, ", "))
+	}
+}
+
+// Validate checks that the interface has a name and that its methods are valid and distinct
+func (i Interface) Validate() error {
+	if i.Name == "" {
+		return fmt.Errorf("interface has no name")
+	}
+	return validateMethods(i.Methods)
+}
+
+// GetMethod returns the method of the interface whose name or signature is nameOrSignature
+func (i Interface) GetMethod(nameOrSignature string) (Method, error) {
+	return getMethod(i.Methods, nameOrSignature)
+}
+
+// Validate checks that the contract has a name and that its methods are valid and distinct
+func (c Contract) Validate() error {
+	if c.Name == "" {
+		return fmt.Errorf("contract has no name")
+	}
+	for network, info := range c.Networks {
+		if info.AppID == 0 {
+			return fmt.Errorf("contract %s has no app ID on network %s", c.Name, network)
+		}
+	}
+	return validateMethods(c.Methods)
+}
+
+// GetMethod returns the method of the contract whose name or signature is nameOrSignature
+func (c Contract) GetMethod(nameOrSignature string) (Method, error) {
+	return getMethod(c.Methods, nameOrSignature)
+}
+
+// AppID returns the ID of the application implementing the contract on the network
+// with the given base64 encoded genesis hash
+func (c Contract) AppID(genesisHash string) (uint64, error) {
+	info, ok := c.Networks[genesisHash]
+	if !ok {
+		return 0, fmt.Errorf("contract %s is not deployed on network %s", c.Name, genesisHash)
+	}
+	return info.AppID, nil
+}
+
+// ContractFromJSON parses and validates an ARC-4 contract description
+func ContractFromJSON(jsonEncoded []byte) (Contract, error) {
+	var c Contract
+	if err := json.Unmarshal(jsonEncoded, &c); err != nil {
+		return Contract{}, fmt.Errorf("cannot parse contract description: %w", err)
+	}
+	if err := c.Validate(); err != nil {
+		return Contract{}, err
+	}
+	return c, nil
+}
+
+// InterfaceFromJSON parses and validates an ARC-4 interface description
+func InterfaceFromJSON(jsonEncoded []byte) (Interface, error) {
+	var i Interface
+	if err := json.Unmarshal(jsonEncoded, &i); err != nil {
+		return Interface{}, fmt.Errorf("cannot parse interface description: %w", err)
+	}
+	if err := i.Validate(); err != nil {
+		return Interface{}, err
+	}
+	return i, nil
+}
@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractJSON = `{
+  "name": "Calculator",
+  "desc": "Calculator contract",
+  "networks": {
+    "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=": {"appID": 1234},
+    "SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=": {"appID": 5678}
+  },
+  "methods": [
+    {
+      "name": "add",
+      "desc": "Calculate the sum of two 64-bit integers",
+      "args": [
+        {"name": "a", "type": "uint64", "desc": "The first term to add"},
+        {"name": "b", "type": "uint64", "desc": "The second term to add"}
+      ],
+      "returns": {"type": "uint128", "desc": "The sum of a and b"},
+      "readonly": true
+    },
+    {
+      "name": "deposit",
+      "args": [
+        {"name": "payment", "type": "pay"},
+        {"name": "sender", "type": "account"}
+      ],
+      "returns": {"type": "void"}
+    },
+    {
+      "name": "add",
+      "args": [
+        {"type": "uint32"},
+        {"type": "uint32"}
+      ],
+      "returns": {"type": "uint64"}
+    }
+  ]
+}`
+
+func TestContractFromJSON(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	contract, err := ContractFromJSON([]byte(testContractJSON))
+	require.NoError(t, err)
+	require.Equal(t, "Calculator", contract.Name)
+	require.Len(t, contract.Methods, 3)
+
+	add := contract.Methods[0]
+	require.Equal(t, "add(uint64,uint64)uint128", add.Signature())
+	require.Equal(t, []byte{0x8a, 0xa3, 0xb6, 0x1f}, add.Selector())
+	require.True(t, add.ReadOnly)
+	require.False(t, add.IsVoid())
+
+	deposit, err := contract.GetMethod("deposit")
+	require.NoError(t, err)
+	require.Equal(t, "deposit(pay,account)void", deposit.Signature())
+	require.True(t, deposit.IsVoid())
+	require.False(t, deposit.ReadOnly)
+
+	_, err = contract.GetMethod("add")
+	require.ErrorContains(t, err, "ambiguous")
+	method, err := contract.GetMethod("add(uint32,uint32)uint64")
+	require.NoError(t, err)
+	require.Equal(t, contract.Methods[2], method)
+	_, err = contract.GetMethod("sub")
+	require.Error(t, err)
+
+	appID, err := contract.AppID("wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1234), appID)
+	_, err = contract.AppID("mFgazF+2uRS1tMiL9dsj01hJGySEmPN28B/TjjvpVW0=")
+	require.Error(t, err)
+}
+
+func TestInterfaceFromJSON(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	iface, err := InterfaceFromJSON([]byte(`{
+  "name": "Greeter",
+  "methods": [
+    {"name": "hello", "args": [{"type": "string"}], "returns": {"type": "string"}}
+  ]
+}`))
+	require.NoError(t, err)
+	method, err := iface.GetMethod("hello")
+	require.NoError(t, err)
+	require.Equal(t, "hello(string)string", method.Signature())
+}
+
+func TestContractValidation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testcases := []struct {
+		json string
+		err  string
+	}{
+		{`{"methods": []}`, "contract has no name"},
+		{`{"name": "c", "methods": [{"args": [], "returns": {"type": "void"}}]}`, "method has no name"},
+		{`{"name": "c", "methods": [{"name": "m(", "args": [], "returns": {"type": "void"}}]}`, "invalid characters"},
+		{`{"name": "c", "methods": [{"name": "m", "args": [{"type": "uint63"}], "returns": {"type": "void"}}]}`, "argument type at index 0"},
+		{`{"name": "c", "methods": [{"name": "m", "args": [], "returns": {}}]}`, "has no return type"},
+		{`{"name": "c", "methods": [{"name": "m", "args": [], "returns": {"type": "pay"}}]}`, "error parsing return type"},
+		{`{"name": "c", "methods": [{"name": "m", "args": [], "returns": {"type": "void"}}, {"name": "m", "args": [], "returns": {"type": "void"}}]}`, "more than once"},
+		{`{"name": "c", "networks": {"net": {}}, "methods": []}`, "no app ID"},
+		{`{"name": "c", "methods": {}}`, "cannot parse contract description"},
+	}
+	for _, testcase := range testcases {
+		_, err := ContractFromJSON([]byte(testcase.json))
+		require.ErrorContains(t, err, testcase.err, testcase.json)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"errors"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// DropReason describes why a transaction group left the pool, or never made it
+// into the pool, without being committed.
+type DropReason string
+
+const (
+	// DropReasonPoolFull means the pool had no room left for the group.
+	DropReasonPoolFull DropReason = "pool-full"
+	// DropReasonFeeTooLow means the group did not pay the pool's current fee per byte.
+	DropReasonFeeTooLow DropReason = "fee-too-low"
+	// DropReasonSenderQuota means the group would have taken a sender past TxPoolSenderQuota.
+	DropReasonSenderQuota DropReason = "sender-quota"
+	// DropReasonExpired means the group's last valid round has passed.
+	DropReasonExpired DropReason = "expired"
+	// DropReasonInvalid means the block evaluator rejected the group.
+	DropReasonInvalid DropReason = "invalid"
+)
+
+// DroppedTxGroup describes a transaction group that was rejected by, or evicted from, the pool.
+type DroppedTxGroup struct {
+	// Txid is the id of the first transaction of the group.
+	Txid transactions.Txid
+	// Sender is the sender of the first transaction of the group.
+	Sender basics.Address
+	// Size is the number of transactions in the group.
+	Size   int
+	Reason DropReason
+	Error  string
+	Time   time.Time
+}
+
+// dropReason classifies an error returned while remembering or re-adding a
+// transaction group. ok is false for errors which do not amount to dropping the
+// group, such as the group being committed already.
+func dropReason(err error) (reason DropReason, ok bool) {
+	var quotaErr *ErrSenderQuotaExceeded
+	var feeErr *ErrTxPoolFeeError
+	var deadErr transactions.TxnDeadError
+	var inLedgerErr *ledgercore.TransactionInLedgerError
+	switch {
+	case errors.As(err, &inLedgerErr):
+		return "", false
+	case errors.Is(err, ErrPendingQueueReachedMaxCap):
+		return DropReasonPoolFull, true
+	case errors.As(err, &feeErr):
+		return DropReasonFeeTooLow, true
+	case errors.As(err, &quotaErr):
+		return DropReasonSenderQuota, true
+	case errors.As(err, &deadErr):
+		return DropReasonExpired, true
+	default:
+		return DropReasonInvalid, true
+	}
+}
+
+// dropLog is a bounded ring of the most recently dropped transaction groups.
+type dropLog struct {
+	mu      deadlock.Mutex
+	entries []DroppedTxGroup
+	next    int
+	full    bool
+}
+
+func makeDropLog(sz int) *dropLog {
+	if sz < 0 {
+		sz = 0
+	}
+	return &dropLog{
+		entries: make([]DroppedTxGroup, sz),
+	}
+}
+
+// put records a dropped transaction group, overwriting the oldest entry once the ring is full.
+func (dl *dropLog) put(txgroup []transactions.SignedTxn, err error) {
+	if len(dl.entries) == 0 || len(txgroup) == 0 {
+		return
+	}
+	reason, ok := dropReason(err)
+	if !ok {
+		return
+	}
+	entry := DroppedTxGroup{
+		Txid:   txgroup[0].ID(),
+		Sender: txgroup[0].Txn.Sender,
+		Size:   len(txgroup),
+		Reason: reason,
+		Error:  err.Error(),
+		Time:   time.Now(),
+	}
+
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+	dl.entries[dl.next] = entry
+	dl.next++
+	if dl.next == len(dl.entries) {
+		dl.next = 0
+		dl.full = true
+	}
+}
+
+// list returns the recorded entries, oldest first.
+func (dl *dropLog) list() []DroppedTxGroup {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+	if !dl.full {
+		return append([]DroppedTxGroup(nil), dl.entries[:dl.next]...)
+	}
+	out := make([]DroppedTxGroup, 0, len(dl.entries))
+	out = append(out, dl.entries[dl.next:]...)
+	return append(out, dl.entries[:dl.next]...)
+}
+
+func (dl *dropLog) reset() {
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+	dl.next = 0
+	dl.full = false
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"math"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+)
+
+// FeeEstimate is the fee per byte the pool recommends for a transaction group
+// that should be proposed within a given number of rounds.
+type FeeEstimate struct {
+	// FeePerByte is the fee per byte, in microalgos, the pool requires right now. It does
+	// not account for the flat minimum fee of each transaction.
+	FeePerByte uint64
+	// ExpectedRounds is the number of rounds the group is expected to wait before being
+	// proposed when it is submitted right away. It exceeds the requested horizon when the
+	// blocks already pending in the pool do not leave room for the group within the horizon.
+	ExpectedRounds uint64
+	// DeferredFeePerByte is the lowest fee per byte, in microalgos, the group could pay
+	// and still be proposed within the horizon. When it is below FeePerByte, the pool
+	// rejects the group until its threshold falls to it, so the group has to be submitted
+	// again until then. Otherwise it equals FeePerByte.
+	DeferredFeePerByte uint64
+	// DeferredExpectedRounds is the number of rounds the group is expected to wait before
+	// being proposed when it pays DeferredFeePerByte.
+	DeferredExpectedRounds uint64
+	// PendingWholeBlocks is the number of full blocks pending in the pool.
+	PendingWholeBlocks uint64
+	// AverageBlockFill is the average fraction of the block size used by the recent blocks.
+	AverageBlockFill float64
+	// AverageArrivalRate is the average number of blocks worth of transactions the pool
+	// admitted per round over the recent blocks. Unlike the block fill, it exceeds 1 when
+	// transactions arrive faster than blocks can hold them.
+	AverageArrivalRate float64
+	// BlockHistory is the number of recent blocks the averages were computed over.
+	BlockHistory int
+}
+
+type blockFill struct {
+	round basics.Round
+	fill  float64
+	// arrivals is the number of blocks worth of transactions admitted to the pool
+	// during the round.
+	arrivals float64
+}
+
+// blockFillRatio returns the fraction of the maximum block size used by the block's payset.
+func blockFillRatio(block bookkeeping.Block) float64 {
+	proto, ok := config.Consensus[block.CurrentProtocol]
+	if !ok || proto.MaxTxnBytesPerBlock == 0 {
+		return 0
+	}
+	paysetBytes := 0
+	for _, txib := range block.Payset {
+		paysetBytes += txib.GetEncodedLength()
+	}
+	return math.Min(1, float64(paysetBytes)/float64(proto.MaxTxnBytesPerBlock))
+}
+
+// blockArrivals returns the number of blocks of the block's protocol that admittedBytes fill.
+func blockArrivals(block bookkeeping.Block, admittedBytes int) float64 {
+	proto, ok := config.Consensus[block.CurrentProtocol]
+	if !ok || proto.MaxTxnBytesPerBlock == 0 {
+		return 0
+	}
+	return float64(admittedBytes) / float64(proto.MaxTxnBytesPerBlock)
+}
+
+// recordBlockFill adds the fill ratio of a new block, and the transactions the pool admitted
+// while it was the latest round, to the recent block history.
+// The caller is assumed to be holding pool.mu.
+func (pool *TransactionPool) recordBlockFill(block bookkeeping.Block, arrivals float64) {
+	if pool.feeHistoryLength <= 0 {
+		return
+	}
+	if n := len(pool.recentBlockFills); n > 0 && block.Round() <= pool.recentBlockFills[n-1].round {
+		return
+	}
+	pool.recentBlockFills = append(pool.recentBlockFills, blockFill{round: block.Round(), fill: blockFillRatio(block), arrivals: arrivals})
+	if len(pool.recentBlockFills) > pool.feeHistoryLength {
+		pool.recentBlockFills = pool.recentBlockFills[len(pool.recentBlockFills)-pool.feeHistoryLength:]
+	}
+}
+
+// loadRecentBlockFills fills the recent block history from the ledger, so that
+// estimates are meaningful right after the node starts.
+func (pool *TransactionPool) loadRecentBlockFills() {
+	latest := pool.ledger.Latest()
+	first := basics.Round(0)
+	if latest > basics.Round(pool.feeHistoryLength) {
+		first = latest - basics.Round(pool.feeHistoryLength) + 1
+	}
+	for rnd := first; rnd <= latest && pool.feeHistoryLength > 0; rnd++ {
+		block, err := pool.ledger.Block(rnd)
+		if err != nil {
+			pool.log.Debugf("TransactionPool.loadRecentBlockFills: cannot read block %d: %v", rnd, err)
+			continue
+		}
+		// the pool did not see the transactions of the blocks it loads, so their own fill
+		// stands for the arrivals.
+		pool.recordBlockFill(block, blockFillRatio(block))
+	}
+}
+
+// EstimateFee recommends a fee per byte for a transaction group which should be
+// proposed within horizon rounds.
+//
+// The pool proposes groups in the order they were admitted, so a group admitted in a
+// round waits for the whole blocks pending in the pool at that round. A group only pays
+// the fee threshold of the round it is admitted in: the pool is recomputed after every
+// block without checking the fees again. The threshold follows the backlog: it doubles
+// every round two or more full blocks are pending, and halves every round there is less
+// than one. The estimate replays these rules, assuming transactions keep arriving at
+// the rate the pool admitted them over the recent blocks, and recommends the lowest
+// threshold of a round the group could be admitted in and still be proposed within the
+// horizon as the deferred fee. As the backlog drains, the deferred fee falls when the
+// horizon grows.
+func (pool *TransactionPool) EstimateFee(horizon basics.Round) FeeEstimate {
+	pool.mu.Lock()
+	multiplier := pool.feeThresholdMultiplier
+	pendingWholeBlocks := pool.numPendingWholeBlocks
+	fills := append([]blockFill(nil), pool.recentBlockFills...)
+	pool.mu.Unlock()
+
+	estimate := FeeEstimate{
+		FeePerByte:         pool.FeePerByte(),
+		ExpectedRounds:     uint64(pendingWholeBlocks) + 1,
+		PendingWholeBlocks: uint64(pendingWholeBlocks),
+		BlockHistory:       len(fills),
+	}
+	for _, f := range fills {
+		estimate.AverageBlockFill += f.fill
+		estimate.AverageArrivalRate += f.arrivals
+	}
+	if len(fills) > 0 {
+		estimate.AverageBlockFill /= float64(len(fills))
+		estimate.AverageArrivalRate /= float64(len(fills))
+	}
+
+	estimate.DeferredFeePerByte = estimate.FeePerByte
+	estimate.DeferredExpectedRounds = estimate.ExpectedRounds
+	backlog := float64(pendingWholeBlocks)
+	for r := basics.Round(1); r < horizon; r++ {
+		multiplier = nextFeeThresholdMultiplier(multiplier, basics.Round(backlog), pool.expFeeFactor)
+		// one block worth of transactions gets proposed, and new transactions arrive
+		// at the recent arrival rate.
+		backlog = math.Max(0, backlog+estimate.AverageArrivalRate-1)
+		// the backlog shrinks by at most a block per round, so once a group admitted
+		// in round r can no longer be proposed within the horizon, no later one can.
+		proposed := uint64(r) + uint64(backlog) + 1
+		if proposed > uint64(horizon) {
+			break
+		}
+		feePerByte := feePerByteThreshold(multiplier, basics.Round(backlog), pool.expFeeFactor)
+		if feePerByte < estimate.DeferredFeePerByte {
+			estimate.DeferredFeePerByte = feePerByte
+			estimate.DeferredExpectedRounds = proposed
+		}
+	}
+	return estimate
+}
@@ -0,0 +1,170 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"bytes"
+	"math"
+	"math/bits"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// FeePerByteBucket counts the pending transactions whose fee per encoded byte
+// falls in [Low, High).
+type FeePerByteBucket struct {
+	Low   uint64
+	High  uint64
+	Count int
+}
+
+// SenderPendingStats summarizes the pending transactions of a single sender.
+type SenderPendingStats struct {
+	Sender basics.Address
+	// TxnCount is the number of pending transactions sent by Sender.
+	TxnCount int
+	// GroupCount is the number of pending groups containing at least one transaction sent by Sender.
+	GroupCount int
+}
+
+// PendingTxGroupInfo describes a single pending transaction group.
+type PendingTxGroupInfo struct {
+	// Txid is the id of the first transaction of the group.
+	Txid transactions.Txid
+	// Sender is the sender of the first transaction of the group.
+	Sender        basics.Address
+	Size          int
+	Fee           basics.MicroAlgos
+	EncodedLength int
+	// Added is the time the group first entered the pool.
+	Added time.Time
+}
+
+// PoolComposition is a snapshot of the content of the transaction pool.
+type PoolComposition struct {
+	// FeePerByte is the minimum fee per byte the pool currently requires.
+	FeePerByte uint64
+	// SenderQuota is the per-sender quota of pending transactions, zero if disabled.
+	SenderQuota int
+	TxnCount    int
+	// FeePerByteHistogram holds the non-empty buckets, in increasing order of fee per byte.
+	// Bucket boundaries are powers of two.
+	FeePerByteHistogram []FeePerByteBucket
+	// Senders is sorted by decreasing number of pending transactions.
+	Senders []SenderPendingStats
+	// Groups holds the pending groups in the order they would be proposed.
+	Groups []PendingTxGroupInfo
+	// Dropped holds the most recently rejected or evicted groups, oldest first.
+	Dropped []DroppedTxGroup
+}
+
+// feePerByteBucket returns the index of the histogram bucket for the given fee per byte;
+// bucket 0 holds [0, 1) and bucket i > 0 holds [2^(i-1), 2^i).
+func feePerByteBucket(feePerByte uint64) int {
+	return bits.Len64(feePerByte)
+}
+
+func feePerByteBucketBounds(bucket int) (low uint64, high uint64) {
+	if bucket == 0 {
+		return 0, 1
+	}
+	low = uint64(1) << (bucket - 1)
+	if bucket == 64 {
+		return low, math.MaxUint64
+	}
+	return low, uint64(1) << bucket
+}
+
+// Composition returns a snapshot of the pending transaction groups, broken down by
+// fee per byte and sender, along with the recently dropped groups.
+func (pool *TransactionPool) Composition() PoolComposition {
+	pool.pendingMu.RLock()
+	txgroups := pool.pendingTxGroups
+	groupTimes := make(map[transactions.Txid]time.Time, len(pool.pendingTxGroupTimes))
+	for txid, added := range pool.pendingTxGroupTimes {
+		groupTimes[txid] = added
+	}
+	pool.pendingMu.RUnlock()
+
+	comp := PoolComposition{
+		FeePerByte:  pool.FeePerByte(),
+		SenderQuota: pool.senderQuota,
+		Groups:      make([]PendingTxGroupInfo, 0, len(txgroups)),
+		Dropped:     pool.dropLog.list(),
+	}
+
+	var histogram [65]int
+	senders := make(map[basics.Address]*SenderPendingStats)
+	for _, txgroup := range txgroups {
+		if len(txgroup) == 0 {
+			continue
+		}
+		info := PendingTxGroupInfo{
+			Txid:   txgroup[0].ID(),
+			Sender: txgroup[0].Txn.Sender,
+			Size:   len(txgroup),
+		}
+		info.Added = groupTimes[info.Txid]
+
+		for _, t := range txgroup {
+			encodedLength := t.GetEncodedLength()
+			info.EncodedLength += encodedLength
+			info.Fee.Raw += t.Txn.Fee.Raw
+			histogram[feePerByteBucket(t.Txn.Fee.Raw/uint64(encodedLength))]++
+
+			stats, ok := senders[t.Txn.Sender]
+			if !ok {
+				stats = &SenderPendingStats{Sender: t.Txn.Sender}
+				senders[t.Txn.Sender] = stats
+			}
+			stats.TxnCount++
+		}
+		comp.TxnCount += len(txgroup)
+		comp.Groups = append(comp.Groups, info)
+
+		counted := make(map[basics.Address]bool, len(txgroup))
+		for _, t := range txgroup {
+			if !counted[t.Txn.Sender] {
+				counted[t.Txn.Sender] = true
+				senders[t.Txn.Sender].GroupCount++
+			}
+		}
+	}
+
+	for bucket, count := range histogram {
+		if count == 0 {
+			continue
+		}
+		low, high := feePerByteBucketBounds(bucket)
+		comp.FeePerByteHistogram = append(comp.FeePerByteHistogram, FeePerByteBucket{Low: low, High: high, Count: count})
+	}
+
+	comp.Senders = make([]SenderPendingStats, 0, len(senders))
+	for _, stats := range senders {
+		comp.Senders = append(comp.Senders, *stats)
+	}
+	sort.Slice(comp.Senders, func(i, j int) bool {
+		if comp.Senders[i].TxnCount != comp.Senders[j].TxnCount {
+			return comp.Senders[i].TxnCount > comp.Senders[j].TxnCount
+		}
+		return bytes.Compare(comp.Senders[i].Sender[:], comp.Senders[j].Sender[:]) < 0
+	})
+	return comp
+}
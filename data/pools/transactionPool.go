@@ -58,6 +58,7 @@ type TransactionPool struct {
 	logAssembleStats     bool
 	expFeeFactor         uint64
 	txPoolMaxSize        int
+	senderQuota          int
 	ledger               *ledger.Ledger
 
 	mu                     deadlock.Mutex
@@ -67,6 +68,12 @@ type TransactionPool struct {
 	numPendingWholeBlocks  basics.Round
 	feeThresholdMultiplier uint64
 	statusCache            *statusCache
+	dropLog                *dropLog
+	// recentBlockFills holds the fill ratio of the latest feeHistoryLength blocks, oldest first.
+	recentBlockFills []blockFill
+	feeHistoryLength int
+	// admittedBytes is the encoded length of the transactions admitted to the pool since the latest block.
+	admittedBytes int
 
 	assemblyMu       deadlock.Mutex
 	assemblyCond     sync.Cond
@@ -75,18 +82,26 @@ type TransactionPool struct {
 	assemblyRound   basics.Round
 	assemblyResults poolAsmResults
 
-	// pendingMu protects pendingTxGroups and pendingTxids
+	// pendingMu protects pendingTxGroups, pendingTxids, pendingTxGroupTimes
+	// and pendingSenderTxns
 	pendingMu       deadlock.RWMutex
 	pendingTxGroups [][]transactions.SignedTxn
 	pendingTxids    map[transactions.Txid]transactions.SignedTxn
+	// pendingTxGroupTimes maps the id of the first transaction of each pending
+	// group to the time the group first entered the pool.
+	pendingTxGroupTimes map[transactions.Txid]time.Time
+	// pendingSenderTxns counts the pending transactions of every sender.
+	pendingSenderTxns map[basics.Address]int
 
 	// Calls to remember() add transactions to rememberedTxGroups and
 	// rememberedTxids.  Calling rememberCommit() adds them to the
 	// pendingTxGroups and pendingTxids.  This allows us to batch the
 	// changes in OnNewBlock() without preventing a concurrent call
 	// to PendingTxGroups() or Verified().
-	rememberedTxGroups [][]transactions.SignedTxn
-	rememberedTxids    map[transactions.Txid]transactions.SignedTxn
+	rememberedTxGroups     [][]transactions.SignedTxn
+	rememberedTxids        map[transactions.Txid]transactions.SignedTxn
+	rememberedTxGroupTimes map[transactions.Txid]time.Time
+	rememberedSenderTxns   map[basics.Address]int
 
 	log logging.Logger
 
@@ -111,20 +126,28 @@ func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Lo
 		cfg.TxPoolExponentialIncreaseFactor = 1
 	}
 	pool := TransactionPool{
-		pendingTxids:         make(map[transactions.Txid]transactions.SignedTxn),
-		rememberedTxids:      make(map[transactions.Txid]transactions.SignedTxn),
-		expiredTxCount:       make(map[basics.Round]int),
-		ledger:               ledger,
-		statusCache:          makeStatusCache(cfg.TxPoolSize),
-		logProcessBlockStats: cfg.EnableProcessBlockStats,
-		logAssembleStats:     cfg.EnableAssembleStats,
-		expFeeFactor:         cfg.TxPoolExponentialIncreaseFactor,
-		txPoolMaxSize:        cfg.TxPoolSize,
-		proposalAssemblyTime: cfg.ProposalAssemblyTime,
-		log:                  log,
+		pendingTxids:           make(map[transactions.Txid]transactions.SignedTxn),
+		pendingTxGroupTimes:    make(map[transactions.Txid]time.Time),
+		pendingSenderTxns:      make(map[basics.Address]int),
+		rememberedTxids:        make(map[transactions.Txid]transactions.SignedTxn),
+		rememberedTxGroupTimes: make(map[transactions.Txid]time.Time),
+		rememberedSenderTxns:   make(map[basics.Address]int),
+		expiredTxCount:         make(map[basics.Round]int),
+		ledger:                 ledger,
+		statusCache:            makeStatusCache(cfg.TxPoolSize),
+		dropLog:                makeDropLog(cfg.TxPoolDropLogSize),
+		logProcessBlockStats:   cfg.EnableProcessBlockStats,
+		logAssembleStats:       cfg.EnableAssembleStats,
+		expFeeFactor:           cfg.TxPoolExponentialIncreaseFactor,
+		txPoolMaxSize:          cfg.TxPoolSize,
+		senderQuota:            cfg.TxPoolSenderQuota,
+		feeHistoryLength:       cfg.SuggestedFeeBlockHistory,
+		proposalAssemblyTime:   cfg.ProposalAssemblyTime,
+		log:                    log,
 	}
 	pool.cond.L = &pool.mu
 	pool.assemblyCond.L = &pool.assemblyMu
+	pool.loadRecentBlockFills()
 	pool.recomputeBlockEvaluator(make(map[transactions.Txid]basics.Round), 0)
 	return &pool
 }
@@ -168,6 +191,35 @@ const (
 // i.e. typically it means that we're trying to make a proposal for an older round than what the ledger is currently pointing at.
 var ErrStaleBlockAssemblyRequest = fmt.Errorf("AssembleBlock: requested block assembly specified a round that is older than current transaction pool round")
 
+// ErrPendingQueueReachedMaxCap is returned when the pool has no room left for a transaction group.
+var ErrPendingQueueReachedMaxCap = errors.New("TransactionPool.checkPendingQueueSize: transaction pool have reached capacity")
+
+// ErrTxPoolFeeError is returned when a transaction does not pay the pool's current fee per byte.
+type ErrTxPoolFeeError struct {
+	fee          basics.MicroAlgos
+	feeThreshold uint64
+	feePerByte   uint64
+	encodedLen   int
+}
+
+func (e *ErrTxPoolFeeError) Error() string {
+	return fmt.Sprintf("fee %d below threshold %d (%d per byte * %d bytes)",
+		e.fee, e.feeThreshold, e.feePerByte, e.encodedLen)
+}
+
+// ErrSenderQuotaExceeded is returned when a transaction group would take a
+// sender past the pool's per-sender quota.
+type ErrSenderQuotaExceeded struct {
+	Sender  basics.Address
+	Pending int
+	Quota   int
+}
+
+func (e *ErrSenderQuotaExceeded) Error() string {
+	return fmt.Sprintf("sender %v already has %d transactions pending, the group would exceed the per-sender quota of %d",
+		e.Sender, e.Pending, e.Quota)
+}
+
 // Reset resets the content of the transaction pool
 func (pool *TransactionPool) Reset() {
 	pool.mu.Lock()
@@ -175,12 +227,17 @@ func (pool *TransactionPool) Reset() {
 	defer pool.cond.Broadcast()
 	pool.pendingTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.pendingTxGroups = nil
+	pool.pendingTxGroupTimes = make(map[transactions.Txid]time.Time)
+	pool.pendingSenderTxns = make(map[basics.Address]int)
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
 	pool.rememberedTxGroups = nil
+	pool.rememberedTxGroupTimes = make(map[transactions.Txid]time.Time)
+	pool.rememberedSenderTxns = make(map[basics.Address]int)
 	pool.expiredTxCount = make(map[basics.Round]int)
 	pool.numPendingWholeBlocks = 0
 	pool.pendingBlockEvaluator = nil
 	pool.statusCache.reset()
+	pool.dropLog.reset()
 	pool.recomputeBlockEvaluator(make(map[transactions.Txid]basics.Round), 0)
 }
 
@@ -239,6 +296,8 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 	if flush {
 		pool.pendingTxGroups = pool.rememberedTxGroups
 		pool.pendingTxids = pool.rememberedTxids
+		pool.pendingTxGroupTimes = pool.rememberedTxGroupTimes
+		pool.pendingSenderTxns = pool.rememberedSenderTxns
 		pool.ledger.VerifiedTransactionCache().UpdatePinned(pool.pendingTxids)
 	} else {
 		pool.pendingTxGroups = append(pool.pendingTxGroups, pool.rememberedTxGroups...)
@@ -246,10 +305,18 @@ func (pool *TransactionPool) rememberCommit(flush bool) {
 		for txid, txn := range pool.rememberedTxids {
 			pool.pendingTxids[txid] = txn
 		}
+		for txid, added := range pool.rememberedTxGroupTimes {
+			pool.pendingTxGroupTimes[txid] = added
+		}
+		for sender, count := range pool.rememberedSenderTxns {
+			pool.pendingSenderTxns[sender] += count
+		}
 	}
 
 	pool.rememberedTxGroups = nil
 	pool.rememberedTxids = make(map[transactions.Txid]transactions.SignedTxn)
+	pool.rememberedTxGroupTimes = make(map[transactions.Txid]time.Time)
+	pool.rememberedSenderTxns = make(map[basics.Address]int)
 }
 
 // PendingCount returns the number of transactions currently pending in the pool.
@@ -276,7 +343,7 @@ func (pool *TransactionPool) pendingCountNoLock() int {
 func (pool *TransactionPool) checkPendingQueueSize(txCount int) error {
 	pendingSize := pool.pendingTxIDsCount()
 	if pendingSize+txCount > pool.txPoolMaxSize {
-		return fmt.Errorf("TransactionPool.checkPendingQueueSize: transaction pool have reached capacity")
+		return ErrPendingQueueReachedMaxCap
 	}
 	return nil
 }
@@ -297,6 +364,43 @@ func (pool *TransactionPool) computeFeePerByte() uint64 {
 	// is not under load, the total MinFee dominates for small txns,
 	// but once the pool comes under load, the fee-per-byte will quickly
 	// come to dominate.
+	feePerByte := feePerByteThreshold(pool.feeThresholdMultiplier, pool.numPendingWholeBlocks, pool.expFeeFactor)
+
+	// Update the counter for fast reads
+	atomic.StoreUint64(&pool.feePerByte, feePerByte)
+
+	return feePerByte
+}
+
+// nextFeeThresholdMultiplier returns the fee threshold multiplier after a new block, given
+// the number of whole blocks that were pending in the pool.  The rules are:
+//   - If there was less than one full block in the pool, reduce
+//     the multiplier by 2x.  It will eventually go to 0, so that
+//     only the flat MinTxnFee matters if the pool is idle.
+//   - If there were less than two full blocks in the pool, keep
+//     the multiplier as-is.
+//   - If there were two or more full blocks in the pool, grow
+//     the multiplier by 2x (or increment by 1, if 0).
+func nextFeeThresholdMultiplier(feeThresholdMultiplier uint64, numPendingWholeBlocks basics.Round, expFeeFactor uint64) uint64 {
+	switch numPendingWholeBlocks {
+	case 0:
+		return feeThresholdMultiplier / expFeeFactor
+
+	case 1:
+		// Keep the fee multiplier the same.
+		return feeThresholdMultiplier
+
+	default:
+		if feeThresholdMultiplier == 0 {
+			return 1
+		}
+		return feeThresholdMultiplier * expFeeFactor
+	}
+}
+
+// feePerByteThreshold returns the minimum microalgos per byte the pool requires given
+// its fee threshold multiplier and the number of whole blocks pending in the pool.
+func feePerByteThreshold(feeThresholdMultiplier uint64, numPendingWholeBlocks basics.Round, expFeeFactor uint64) uint64 {
 	feePerByte := uint64(1)
 
 	// The threshold is multiplied by the feeThresholdMultiplier that
@@ -304,11 +408,11 @@ func (pool *TransactionPool) computeFeePerByte() uint64 {
 	// is mostly idle, feeThresholdMultiplier will be 0, and all txns
 	// are accepted (assuming the BlockEvaluator approves them, which
 	// requires a flat MinTxnFee).
-	feePerByte = feePerByte * pool.feeThresholdMultiplier
+	feePerByte = feePerByte * feeThresholdMultiplier
 
 	// The feePerByte should be bumped to 1 to make the exponentially
 	// threshold growing valid.
-	if feePerByte == 0 && pool.numPendingWholeBlocks > 1 {
+	if feePerByte == 0 && numPendingWholeBlocks > 1 {
 		feePerByte = uint64(1)
 	}
 
@@ -316,13 +420,9 @@ func (pool *TransactionPool) computeFeePerByte() uint64 {
 	// pending in the pool.
 	// golang has no convenient integer exponentiation, so we just
 	// do this in a loop
-	for i := 0; i < int(pool.numPendingWholeBlocks)-1; i++ {
-		feePerByte *= pool.expFeeFactor
+	for i := 0; i < int(numPendingWholeBlocks)-1; i++ {
+		feePerByte *= expFeeFactor
 	}
-
-	// Update the counter for fast reads
-	atomic.StoreUint64(&pool.feePerByte, feePerByte)
-
 	return feePerByte
 }
 
@@ -345,14 +445,45 @@ func (pool *TransactionPool) checkSufficientFee(txgroup []transactions.SignedTxn
 	for _, t := range txgroup {
 		feeThreshold := feePerByte * uint64(t.GetEncodedLength())
 		if t.Txn.Fee.Raw < feeThreshold {
-			return fmt.Errorf("fee %d below threshold %d (%d per byte * %d bytes)",
-				t.Txn.Fee, feeThreshold, feePerByte, t.GetEncodedLength())
+			return &ErrTxPoolFeeError{
+				fee:          t.Txn.Fee,
+				feeThreshold: feeThreshold,
+				feePerByte:   feePerByte,
+				encodedLen:   t.GetEncodedLength(),
+			}
 		}
 	}
 
 	return nil
 }
 
+// checkSenderQuota verifies that adding the transaction group would not take any of
+// its senders past the per-sender quota. The caller is assumed to be holding pool.mu.
+func (pool *TransactionPool) checkSenderQuota(txgroup []transactions.SignedTxn) error {
+	if pool.senderQuota <= 0 {
+		return nil
+	}
+
+	groupTxns := make(map[basics.Address]int)
+	for _, t := range txgroup {
+		groupTxns[t.Txn.Sender]++
+	}
+
+	pool.pendingMu.RLock()
+	defer pool.pendingMu.RUnlock()
+	for sender, count := range groupTxns {
+		pending := pool.pendingSenderTxns[sender] + pool.rememberedSenderTxns[sender]
+		if pending+count > pool.senderQuota {
+			return &ErrSenderQuotaExceeded{
+				Sender:  sender,
+				Pending: pending,
+				Quota:   pool.senderQuota,
+			}
+		}
+	}
+	return nil
+}
+
 // Test performs basic duplicate detection and well-formedness checks
 // on a transaction group without storing the group.
 func (pool *TransactionPool) Test(txgroup []transactions.SignedTxn) error {
@@ -420,6 +551,11 @@ func (pool *TransactionPool) ingest(txgroup []transactions.SignedTxn, params poo
 		if err != nil {
 			return err
 		}
+
+		err = pool.checkSenderQuota(txgroup)
+		if err != nil {
+			return err
+		}
 	}
 
 	err := pool.addToPendingBlockEvaluator(txgroup, params.recomputing, params.stats)
@@ -427,9 +563,25 @@ func (pool *TransactionPool) ingest(txgroup []transactions.SignedTxn, params poo
 		return err
 	}
 
+	if !params.recomputing {
+		for _, t := range txgroup {
+			pool.admittedBytes += t.GetEncodedLength()
+		}
+	}
+
 	pool.rememberedTxGroups = append(pool.rememberedTxGroups, txgroup)
-	for _, t := range txgroup {
-		pool.rememberedTxids[t.ID()] = t
+	for i, t := range txgroup {
+		txid := t.ID()
+		pool.rememberedTxids[txid] = t
+		pool.rememberedSenderTxns[t.Txn.Sender]++
+		if i == 0 {
+			// groups re-added while recomputing keep the time they first entered the pool.
+			added, ok := pool.pendingTxGroupTimes[txid]
+			if !ok {
+				added = time.Now()
+			}
+			pool.rememberedTxGroupTimes[txid] = added
+		}
 	}
 	return nil
 }
@@ -444,6 +596,7 @@ func (pool *TransactionPool) RememberOne(t transactions.SignedTxn) error {
 // Precondition: Only Remember() properly-signed and well-formed transactions (i.e., ensure t.WellFormed())
 func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 	if err := pool.checkPendingQueueSize(len(txgroup)); err != nil {
+		pool.dropLog.put(txgroup, err)
 		return err
 	}
 
@@ -452,7 +605,8 @@ func (pool *TransactionPool) Remember(txgroup []transactions.SignedTxn) error {
 
 	err := pool.remember(txgroup)
 	if err != nil {
-		return fmt.Errorf("TransactionPool.Remember: %v", err)
+		pool.dropLog.put(txgroup, err)
+		return fmt.Errorf("TransactionPool.Remember: %w", err)
 	}
 
 	pool.rememberCommit(false)
@@ -504,28 +658,8 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 	defer pool.mu.Unlock()
 	defer pool.cond.Broadcast()
 	if pool.pendingBlockEvaluator == nil || block.Round() >= pool.pendingBlockEvaluator.Round() {
-		// Adjust the pool fee threshold.  The rules are:
-		// - If there was less than one full block in the pool, reduce
-		//   the multiplier by 2x.  It will eventually go to 0, so that
-		//   only the flat MinTxnFee matters if the pool is idle.
-		// - If there were less than two full blocks in the pool, keep
-		//   the multiplier as-is.
-		// - If there were two or more full blocks in the pool, grow
-		//   the multiplier by 2x (or increment by 1, if 0).
-		switch pool.numPendingWholeBlocks {
-		case 0:
-			pool.feeThresholdMultiplier = pool.feeThresholdMultiplier / pool.expFeeFactor
-
-		case 1:
-			// Keep the fee multiplier the same.
-
-		default:
-			if pool.feeThresholdMultiplier == 0 {
-				pool.feeThresholdMultiplier = 1
-			} else {
-				pool.feeThresholdMultiplier = pool.feeThresholdMultiplier * pool.expFeeFactor
-			}
-		}
+		// Adjust the pool fee threshold.
+		pool.feeThresholdMultiplier = nextFeeThresholdMultiplier(pool.feeThresholdMultiplier, pool.numPendingWholeBlocks, pool.expFeeFactor)
 
 		// Recompute the pool by starting from the new latest block.
 		// This has the side-effect of discarding transactions that
@@ -538,6 +672,8 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 
 	proto := config.Consensus[block.CurrentProtocol]
 	pool.expiredTxCount[block.Round()] = int(stats.ExpiredCount)
+	pool.recordBlockFill(block, blockArrivals(block, pool.admittedBytes))
+	pool.admittedBytes = 0
 	delete(pool.expiredTxCount, block.Round()-expiredHistory*basics.Round(proto.MaxTxnLife))
 
 	if pool.logProcessBlockStats {
@@ -722,6 +858,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 			for _, tx := range txgroup {
 				pool.statusCache.put(tx, err.Error())
 			}
+			pool.dropLog.put(txgroup, err)
 
 			switch err.(type) {
 			case *ledgercore.TransactionInLedgerError:
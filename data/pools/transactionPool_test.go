@@ -561,6 +561,11 @@ func TestRememberForget(t *testing.T) {
 	pending := transactionPool.PendingTxGroups()
 	numberOfTxns := numOfAccounts*numOfAccounts - numOfAccounts
 	require.Len(t, pending, numberOfTxns)
+	admittedBytes := 0
+	for _, txgroup := range pending {
+		admittedBytes += txgroup[0].GetEncodedLength()
+	}
+	require.Equal(t, admittedBytes, transactionPool.admittedBytes)
 
 	blk, err := eval.GenerateBlock()
 	require.NoError(t, err)
@@ -571,6 +576,10 @@ func TestRememberForget(t *testing.T) {
 
 	pending = transactionPool.PendingTxGroups()
 	require.Len(t, pending, 0)
+	// the transactions admitted during the round are recorded with the block.
+	require.Zero(t, transactionPool.admittedBytes)
+	fills := transactionPool.recentBlockFills
+	require.Equal(t, blockArrivals(blk.Block(), admittedBytes), fills[len(fills)-1].arrivals)
 }
 
 //	Test that clean up works
@@ -1271,3 +1280,226 @@ func TestTxPoolSizeLimits(t *testing.T) {
 		}
 	}
 }
+
+func TestTxPoolSenderQuota(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	// Generate accounts
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		addr := basics.Address(secret.SignatureVerifier)
+		secrets[i] = secret
+		addresses[i] = addr
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.TxPoolSenderQuota = 3
+	cfg.EnableProcessBlockStats = false
+
+	ledger := makeMockLedger(t, initAccFixed(addresses[:2], 1<<32))
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	makeTx := func(sender int, note int) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[sender],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee + uint64(note)},
+				FirstValid:  0,
+				LastValid:   10,
+				Note:        []byte{byte(note)},
+				GenesisHash: ledger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[2],
+				Amount:   basics.MicroAlgos{Raw: proto.MinBalance},
+			},
+		}
+		return tx.Sign(secrets[sender])
+	}
+
+	for i := 0; i < cfg.TxPoolSenderQuota; i++ {
+		require.NoError(t, transactionPool.RememberOne(makeTx(0, i)))
+	}
+	err := transactionPool.RememberOne(makeTx(0, cfg.TxPoolSenderQuota))
+	var quotaErr *ErrSenderQuotaExceeded
+	require.ErrorAs(t, err, &quotaErr)
+	require.Equal(t, addresses[0], quotaErr.Sender)
+	require.Equal(t, cfg.TxPoolSenderQuota, quotaErr.Pending)
+
+	// other senders are not affected by the quota of the first one.
+	require.NoError(t, transactionPool.RememberOne(makeTx(1, 0)))
+
+	comp := transactionPool.Composition()
+	require.Equal(t, cfg.TxPoolSenderQuota+1, comp.TxnCount)
+	require.Equal(t, cfg.TxPoolSenderQuota, comp.SenderQuota)
+	require.Len(t, comp.Groups, cfg.TxPoolSenderQuota+1)
+	for _, group := range comp.Groups {
+		require.Equal(t, 1, group.Size)
+		require.False(t, group.Added.IsZero())
+	}
+	require.Equal(t, []SenderPendingStats{
+		{Sender: addresses[0], TxnCount: cfg.TxPoolSenderQuota, GroupCount: cfg.TxPoolSenderQuota},
+		{Sender: addresses[1], TxnCount: 1, GroupCount: 1},
+	}, comp.Senders)
+	histogramCount := 0
+	for _, bucket := range comp.FeePerByteHistogram {
+		require.Less(t, bucket.Low, bucket.High)
+		histogramCount += bucket.Count
+	}
+	require.Equal(t, comp.TxnCount, histogramCount)
+
+	require.Len(t, comp.Dropped, 1)
+	require.Equal(t, DropReasonSenderQuota, comp.Dropped[0].Reason)
+	require.Equal(t, addresses[0], comp.Dropped[0].Sender)
+
+	// committing a block frees the quota, and keeps the drop log.
+	eval := newBlockEvaluator(t, ledger)
+	for _, txgroup := range transactionPool.PendingTxGroups() {
+		require.NoError(t, eval.Transaction(txgroup[0], transactions.ApplyData{}))
+	}
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, ledger.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), ledgercore.StateDelta{})
+
+	require.NoError(t, transactionPool.RememberOne(makeTx(0, cfg.TxPoolSenderQuota+1)))
+	comp = transactionPool.Composition()
+	require.Equal(t, 1, comp.TxnCount)
+	require.Len(t, comp.Dropped, 1)
+}
+
+func TestDropLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var txgroups [][]transactions.SignedTxn
+	for i := 0; i < 5; i++ {
+		var stxn transactions.SignedTxn
+		stxn.Txn.Note = []byte{byte(i)}
+		txgroups = append(txgroups, []transactions.SignedTxn{stxn})
+	}
+
+	dl := makeDropLog(3)
+	dl.put(txgroups[0], ErrPendingQueueReachedMaxCap)
+	dl.put(txgroups[1], &ledgercore.TransactionInLedgerError{Txid: txgroups[1][0].ID()})
+	dl.put(txgroups[1], transactions.TxnDeadError{})
+	require.Equal(t, []DropReason{DropReasonPoolFull, DropReasonExpired}, dropReasons(dl.list()))
+
+	dl.put(txgroups[2], &ErrTxPoolFeeError{})
+	dl.put(txgroups[3], fmt.Errorf("overspend"))
+	dropped := dl.list()
+	require.Equal(t, []DropReason{DropReasonExpired, DropReasonFeeTooLow, DropReasonInvalid}, dropReasons(dropped))
+	require.Equal(t, txgroups[3][0].ID(), dropped[2].Txid)
+	require.Equal(t, "overspend", dropped[2].Error)
+
+	dl.reset()
+	require.Empty(t, dl.list())
+
+	dl = makeDropLog(0)
+	dl.put(txgroups[4], ErrPendingQueueReachedMaxCap)
+	require.Empty(t, dl.list())
+}
+
+func dropReasons(dropped []DroppedTxGroup) []DropReason {
+	reasons := make([]DropReason, len(dropped))
+	for i, d := range dropped {
+		reasons[i] = d.Reason
+	}
+	return reasons
+}
+
+func TestTxPoolEstimateFee(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	ledger := makeMockLedger(t, initAcc(map[basics.Address]uint64{}))
+	transactionPool := MakeTransactionPool(ledger, cfg, logging.Base())
+
+	// an idle pool recommends the current fee per byte, whatever the horizon.
+	estimate := transactionPool.EstimateFee(10)
+	require.Equal(t, uint64(0), estimate.FeePerByte)
+	require.Equal(t, uint64(1), estimate.ExpectedRounds)
+	require.Equal(t, uint64(0), estimate.DeferredFeePerByte)
+	require.Equal(t, uint64(1), estimate.DeferredExpectedRounds)
+	require.Equal(t, 1, estimate.BlockHistory)
+	require.Equal(t, float64(0), estimate.AverageBlockFill)
+
+	setPressure := func(multiplier uint64, pendingWholeBlocks basics.Round, arrivals float64) {
+		transactionPool.mu.Lock()
+		defer transactionPool.mu.Unlock()
+		transactionPool.feeThresholdMultiplier = multiplier
+		transactionPool.numPendingWholeBlocks = pendingWholeBlocks
+		transactionPool.recentBlockFills = []blockFill{{round: 1, fill: 1, arrivals: arrivals}, {round: 2, fill: 1, arrivals: arrivals}, {round: 3, fill: 1, arrivals: arrivals}}
+		transactionPool.computeFeePerByte()
+	}
+
+	// three blocks pending, and transactions keep arriving as fast as blocks hold them:
+	// the threshold only grows, so the group should be admitted right away.
+	setPressure(1, 3, 1)
+	estimate = transactionPool.EstimateFee(1)
+	require.Equal(t, uint64(4), estimate.FeePerByte)
+	require.Equal(t, uint64(4), estimate.ExpectedRounds)
+	require.Equal(t, uint64(4), estimate.DeferredFeePerByte)
+	require.Equal(t, uint64(4), estimate.DeferredExpectedRounds)
+	require.Equal(t, uint64(3), estimate.PendingWholeBlocks)
+	require.Equal(t, float64(1), estimate.AverageBlockFill)
+	require.Equal(t, float64(1), estimate.AverageArrivalRate)
+
+	estimate = transactionPool.EstimateFee(10)
+	require.Equal(t, uint64(4), estimate.DeferredFeePerByte)
+	require.Equal(t, uint64(4), estimate.DeferredExpectedRounds)
+
+	// no new transactions: the backlog drains, and the threshold falls once it is empty,
+	// so the deferred fee falls as the horizon grows while the admissible one stays put.
+	setPressure(4, 3, 0)
+	for _, test := range []struct {
+		horizon        basics.Round
+		feePerByte     uint64
+		expectedRounds uint64
+	}{
+		{horizon: 4, feePerByte: 16, expectedRounds: 4},
+		{horizon: 6, feePerByte: 4, expectedRounds: 6},
+		{horizon: 10, feePerByte: 0, expectedRounds: 9},
+	} {
+		estimate = transactionPool.EstimateFee(test.horizon)
+		require.Equal(t, uint64(16), estimate.FeePerByte)
+		require.Equal(t, uint64(4), estimate.ExpectedRounds)
+		require.Equal(t, test.feePerByte, estimate.DeferredFeePerByte, "horizon %d", test.horizon)
+		require.Equal(t, test.expectedRounds, estimate.DeferredExpectedRounds, "horizon %d", test.horizon)
+	}
+
+	// transactions arriving faster than blocks hold them keep the backlog from draining,
+	// even though the block fill cannot show it.
+	setPressure(4, 3, 1.5)
+	estimate = transactionPool.EstimateFee(10)
+	require.Equal(t, float64(1), estimate.AverageBlockFill)
+	require.Equal(t, uint64(16), estimate.DeferredFeePerByte)
+	require.Equal(t, uint64(4), estimate.DeferredExpectedRounds)
+}
+
+func TestTxPoolRecordBlockFill(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pool := TransactionPool{feeHistoryLength: 2}
+	var block bookkeeping.Block
+	block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	block.Payset = make(transactions.Payset, 1)
+	txibLength := block.Payset[0].GetEncodedLength()
+	fill := float64(txibLength) / float64(proto.MaxTxnBytesPerBlock)
+
+	for rnd := basics.Round(1); rnd <= 3; rnd++ {
+		block.BlockHeader.Round = rnd
+		pool.recordBlockFill(block, blockArrivals(block, int(rnd)*txibLength))
+	}
+	// older blocks are ignored.
+	block.BlockHeader.Round = 2
+	pool.recordBlockFill(block, 0)
+	require.Equal(t, []blockFill{{round: 2, fill: fill, arrivals: 2 * fill}, {round: 3, fill: fill, arrivals: 3 * fill}}, pool.recentBlockFills)
+}
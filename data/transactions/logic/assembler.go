@@ -48,6 +48,9 @@ type Writer interface {
 type labelReference struct {
 	sourceLine int
 
+	// index into OpStream.Sources of the source that refers to the label
+	source int
+
 	// position of the opcode start that refers to the label
 	position int
 
@@ -237,15 +240,38 @@ type OpStream struct {
 	// current sourceLine during assembly
 	sourceLine int
 
+	// index into Sources of the source being assembled. While assembling an
+	// #include'd file, topLevelLine is the line of the top-level source that
+	// included it.
+	source       int
+	topLevelLine int
+
+	// resolves #include directives, nil if they are not allowed
+	includes IncludeResolver
+
+	// names of the sources currently being included, to detect cycles
+	includeStack []string
+
+	// #define'd macros, mapped to the expanded instructions of their bodies
+	macros map[string][][]string
+
 	// map label string to position within pending buffer
 	labels map[string]int
 
 	// track references in order to patch in jump offsets
 	labelReferences []labelReference
 
-	// map opcode offsets to source line
+	// map opcode offsets to source line. Bytes assembled from an #include'd
+	// file map to the line of the top-level #include.
 	OffsetToLine map[int]int
 
+	// names of the sources assembled, starting with the top-level source and
+	// followed by each file it #include'd
+	Sources []string
+
+	// map opcode offsets to the source and line they were assembled from
+	OffsetToSource map[int]SourceLocation
+
 	HasStatefulOps bool
 }
 
@@ -253,10 +279,13 @@ type OpStream struct {
 // OpStream must be used for each call to assemble().
 func newOpStream(version uint64) OpStream {
 	o := OpStream{
-		labels:       make(map[string]int),
-		OffsetToLine: make(map[int]int),
-		typeTracking: true,
-		Version:      version,
+		labels:         make(map[string]int),
+		macros:         make(map[string][][]string),
+		OffsetToLine:   make(map[int]int),
+		Sources:        []string{""},
+		OffsetToSource: make(map[int]SourceLocation),
+		typeTracking:   true,
+		Version:        version,
 	}
 
 	for i := range o.known.scratchSpace {
@@ -337,12 +366,17 @@ func (ops *OpStream) createLabel(label string) {
 
 // recordSourceLine adds an entry to pc to line mapping
 func (ops *OpStream) recordSourceLine() {
-	ops.OffsetToLine[ops.pending.Len()] = ops.sourceLine - 1
+	line := ops.sourceLine
+	if ops.source != 0 {
+		line = ops.topLevelLine
+	}
+	ops.OffsetToLine[ops.pending.Len()] = line - 1
+	ops.OffsetToSource[ops.pending.Len()] = SourceLocation{Source: ops.source, Line: ops.sourceLine - 1}
 }
 
 // referToLabel records an opcode label reference to resolve later
 func (ops *OpStream) referToLabel(pc int, label string) {
-	ops.labelReferences = append(ops.labelReferences, labelReference{ops.sourceLine, pc, label})
+	ops.labelReferences = append(ops.labelReferences, labelReference{ops.sourceLine, ops.source, pc, label})
 }
 
 type refineFunc func(pgm *ProgramKnowledge, immediates []string) (StackTypes, StackTypes)
@@ -1200,9 +1234,15 @@ var keywords = map[string]OpSpec{
 type lineError struct {
 	Line int
 	Err  error
+
+	// Source names the #include'd file Line is in, empty for the top-level source
+	Source string
 }
 
 func (le lineError) Error() string {
+	if le.Source != "" {
+		return fmt.Sprintf("%s:%d: %s", le.Source, le.Line, le.Err.Error())
+	}
 	return fmt.Sprintf("%d: %s", le.Line, le.Err.Error())
 }
 
@@ -1225,6 +1265,17 @@ func typecheck(expected, got StackType) bool {
 var spaces = [256]uint8{'\t': 1, ' ': 1}
 
 func fieldsFromLine(line string) []string {
+	return splitFields(line, false)
+}
+
+// macroFieldsFromLine splits a #define line like fieldsFromLine, but also
+// returns each ; outside a string literal as a field of its own, because ;
+// separates the instructions of a macro body
+func macroFieldsFromLine(line string) []string {
+	return splitFields(line, true)
+}
+
+func splitFields(line string, semicolons bool) []string {
 	var fields []string
 
 	i := 0
@@ -1264,6 +1315,20 @@ func fieldsFromLine(line string) []string {
 				if inBase64 {
 					inBase64 = false
 				}
+			case ';': // separates macro body instructions, unless in a string literal
+				if semicolons && !inString {
+					if start != i {
+						fields = append(fields, line[start:i])
+					}
+					fields = append(fields, ";")
+					inBase64 = false
+					i++
+					for i < len(line) && spaces[line[i]] != 0 {
+						i++
+					}
+					start = i
+					continue
+				}
 			default:
 			}
 			i++
@@ -1356,10 +1421,42 @@ func (ops *OpStream) trackStack(args StackTypes, returns StackTypes, instruction
 
 // assemble reads text from an input and accumulates the program
 func (ops *OpStream) assemble(text string) error {
-	fin := strings.NewReader(text)
 	if ops.Version > LogicVersion && ops.Version != assemblerNoVersion {
 		return ops.errorf("Can not assemble version %d", ops.Version)
 	}
+	ops.assembleSource(text)
+
+	// backward compatibility: do not allow jumps behind last instruction in TEAL v1
+	if ops.Version <= 1 {
+		for label, dest := range ops.labels {
+			if dest == ops.pending.Len() {
+				ops.errorf("label %#v is too far away", label)
+			}
+		}
+	}
+
+	if ops.Version >= optimizeConstantsEnabledVersion {
+		ops.optimizeIntcBlock()
+		ops.optimizeBytecBlock()
+	}
+
+	ops.resolveLabels()
+	program := ops.prependCBlocks()
+	if ops.Errors != nil {
+		l := len(ops.Errors)
+		if l == 1 {
+			return errors.New("1 error")
+		}
+		return fmt.Errorf("%d errors", l)
+	}
+	ops.Program = program
+	return nil
+}
+
+// assembleSource assembles the lines of a single source, the top-level
+// program or an #include'd file
+func (ops *OpStream) assembleSource(text string) {
+	fin := strings.NewReader(text)
 	scanner := bufio.NewScanner(fin)
 	for scanner.Scan() {
 		ops.sourceLine++
@@ -1378,103 +1475,97 @@ func (ops *OpStream) assemble(text string) error {
 			ops.pragma(line)
 			continue
 		}
+		if strings.HasPrefix(line, "#define") {
+			ops.trace("%3d: #define line\n", ops.sourceLine)
+			ops.define(line)
+			continue
+		}
+		if strings.HasPrefix(line, "#include") {
+			ops.trace("%3d: #include line\n", ops.sourceLine)
+			ops.include(line)
+			continue
+		}
 		fields := fieldsFromLine(line)
 		if len(fields) == 0 {
 			ops.trace("%3d: no fields\n", ops.sourceLine)
 			continue
 		}
-		// we're about to begin processing opcodes, so settle the Version
-		if ops.Version == assemblerNoVersion {
-			ops.Version = AssemblerDefaultVersion
-		}
-		opstring := fields[0]
-
-		if opstring[len(opstring)-1] == ':' {
-			ops.createLabel(opstring[:len(opstring)-1])
-			fields = fields[1:]
-			if len(fields) == 0 {
-				ops.trace("%3d: label only\n", ops.sourceLine)
-				continue
+		// a macro may expand to several instructions
+		for _, instruction := range ops.expandMacros(fields) {
+			if len(instruction) > 0 {
+				ops.assembleInstruction(instruction)
 			}
-			opstring = fields[0]
 		}
+	}
+}
 
-		spec, ok := OpsByName[ops.Version][opstring]
-		if !ok {
-			spec, ok = keywords[opstring]
-			if spec.Version > 1 && spec.Version > ops.Version {
-				ok = false
-			}
+// assembleInstruction assembles a single instruction, optionally preceded by a label
+func (ops *OpStream) assembleInstruction(fields []string) {
+	// we're about to begin processing opcodes, so settle the Version
+	if ops.Version == assemblerNoVersion {
+		ops.Version = AssemblerDefaultVersion
+	}
+	opstring := fields[0]
+
+	if opstring[len(opstring)-1] == ':' {
+		ops.createLabel(opstring[:len(opstring)-1])
+		fields = fields[1:]
+		if len(fields) == 0 {
+			ops.trace("%3d: label only\n", ops.sourceLine)
+			return
 		}
-		if !ok {
-			// If the problem is only the version, it's useful to lookup the
-			// opcode from latest version, so we proceed with assembly well
-			// enough to report follow-on errors.  Of course, we still have to
-			// bail out on the assembly as a whole.
-			spec, ok = OpsByName[AssemblerMaxVersion][opstring]
-			if ok {
-				ops.errorf("%s opcode was introduced in TEAL v%d", opstring, spec.Version)
-			} else {
-				spec, ok = keywords[opstring]
-			}
+		opstring = fields[0]
+	}
+
+	spec, ok := OpsByName[ops.Version][opstring]
+	if !ok {
+		spec, ok = keywords[opstring]
+		if spec.Version > 1 && spec.Version > ops.Version {
+			ok = false
 		}
+	}
+	if !ok {
+		// If the problem is only the version, it's useful to lookup the
+		// opcode from latest version, so we proceed with assembly well
+		// enough to report follow-on errors.  Of course, we still have to
+		// bail out on the assembly as a whole.
+		spec, ok = OpsByName[AssemblerMaxVersion][opstring]
 		if ok {
-			ops.trace("%3d: %s\t", ops.sourceLine, opstring)
-			ops.recordSourceLine()
-			if spec.Modes == modeApp {
-				ops.HasStatefulOps = true
-			}
-			args, returns := spec.Arg.Types, spec.Return.Types
-			if spec.OpDetails.refine != nil {
-				nargs, nreturns := spec.OpDetails.refine(&ops.known, fields[1:])
-				if nargs != nil {
-					args = nargs
-				}
-				if nreturns != nil {
-					returns = nreturns
-				}
-			}
-			ops.trackStack(args, returns, fields)
-			spec.asm(ops, &spec, fields[1:])
-			if spec.deadens() { // An unconditional branch deadens the following code
-				ops.known.deaden()
-			}
-			if spec.Name == "callsub" {
-				// since retsub comes back to the callsub, it is an entry point like a label
-				ops.known.label()
-			}
-			ops.trace("\n")
-			continue
+			ops.errorf("%s opcode was introduced in TEAL v%d", opstring, spec.Version)
 		} else {
-			ops.errorf("unknown opcode: %s", opstring)
+			spec, ok = keywords[opstring]
 		}
 	}
-
-	// backward compatibility: do not allow jumps behind last instruction in TEAL v1
-	if ops.Version <= 1 {
-		for label, dest := range ops.labels {
-			if dest == ops.pending.Len() {
-				ops.errorf("label %#v is too far away", label)
-			}
-		}
+	if !ok {
+		ops.errorf("unknown opcode: %s", opstring)
+		return
 	}
 
-	if ops.Version >= optimizeConstantsEnabledVersion {
-		ops.optimizeIntcBlock()
-		ops.optimizeBytecBlock()
+	ops.trace("%3d: %s\t", ops.sourceLine, opstring)
+	ops.recordSourceLine()
+	if spec.Modes == modeApp {
+		ops.HasStatefulOps = true
 	}
-
-	ops.resolveLabels()
-	program := ops.prependCBlocks()
-	if ops.Errors != nil {
-		l := len(ops.Errors)
-		if l == 1 {
-			return errors.New("1 error")
+	args, returns := spec.Arg.Types, spec.Return.Types
+	if spec.OpDetails.refine != nil {
+		nargs, nreturns := spec.OpDetails.refine(&ops.known, fields[1:])
+		if nargs != nil {
+			args = nargs
+		}
+		if nreturns != nil {
+			returns = nreturns
 		}
-		return fmt.Errorf("%d errors", l)
 	}
-	ops.Program = program
-	return nil
+	ops.trackStack(args, returns, fields)
+	spec.asm(ops, &spec, fields[1:])
+	if spec.deadens() { // An unconditional branch deadens the following code
+		ops.known.deaden()
+	}
+	if spec.Name == "callsub" {
+		// since retsub comes back to the callsub, it is an entry point like a label
+		ops.known.label()
+	}
+	ops.trace("\n")
 }
 
 func (ops *OpStream) pragma(line string) error {
@@ -1536,12 +1627,153 @@ func (ops *OpStream) pragma(line string) error {
 	}
 }
 
+// define records a #define macro. Later occurrences of the macro's name as
+// a field are replaced by its body, which is itself expanded when the macro
+// is defined, so macros may only refer to macros defined before them. A
+// body may hold several instructions separated by ;, which is not an
+// instruction separator anywhere else.
+func (ops *OpStream) define(line string) error {
+	fields := macroFieldsFromLine(line)
+	if fields[0] != "#define" {
+		return ops.errorf("invalid syntax: %s", fields[0])
+	}
+	if len(fields) < 2 {
+		return ops.error("#define needs a name")
+	}
+	name := fields[1]
+	if !validMacroName(name) {
+		return ops.errorf("invalid #define name: %#v", name)
+	}
+	if _, ok := OpsByName[AssemblerMaxVersion][name]; ok {
+		return ops.errorf("#define can not redefine opcode %#v", name)
+	}
+	if _, ok := keywords[name]; ok {
+		return ops.errorf("#define can not redefine pseudo-op %#v", name)
+	}
+	if _, ok := ops.macros[name]; ok {
+		return ops.errorf("duplicate #define %#v", name)
+	}
+	var body [][]string
+	rest := fields[2:]
+	for {
+		end := 0
+		for end < len(rest) && rest[end] != ";" {
+			end++
+		}
+		if end > 0 {
+			body = append(body, ops.expandMacros(rest[:end])...)
+		}
+		if end == len(rest) {
+			break
+		}
+		rest = rest[end+1:]
+	}
+	ops.macros[name] = body
+	return nil
+}
+
+// validMacroName reports whether name is a letter or underscore followed by
+// letters, digits and underscores
+func validMacroName(name string) bool {
+	for i, c := range name {
+		switch {
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+		case i > 0 && c >= '0' && c <= '9':
+		default:
+			return false
+		}
+	}
+	return len(name) > 0
+}
+
+// expandMacros replaces the fields that name a macro with the macro's body
+// and returns the resulting instructions. The first instruction of a body
+// continues the instruction the macro is used in, and its last instruction
+// is continued by the fields that follow the macro.
+func (ops *OpStream) expandMacros(fields []string) [][]string {
+	if len(ops.macros) == 0 {
+		return [][]string{fields}
+	}
+	var instructions [][]string
+	current := make([]string, 0, len(fields))
+	for _, field := range fields {
+		body, ok := ops.macros[field]
+		if !ok {
+			current = append(current, field)
+			continue
+		}
+		for i, instruction := range body {
+			if i > 0 {
+				instructions = append(instructions, current)
+				current = nil
+			}
+			current = append(current, instruction...)
+		}
+	}
+	return append(instructions, current)
+}
+
+// include assembles the file named by an #include directive in place
+func (ops *OpStream) include(line string) error {
+	fields := fieldsFromLine(line)
+	if fields[0] != "#include" {
+		return ops.errorf("invalid syntax: %s", fields[0])
+	}
+	if len(fields) != 2 {
+		return ops.error("#include needs a quoted file name")
+	}
+	name, err := parseStringLiteral(fields[1])
+	if err != nil {
+		return ops.errorf("bad #include file name: %s", err)
+	}
+	if ops.includes == nil {
+		return ops.error("#include is not available")
+	}
+
+	resolved, text, err := ops.includes(ops.Sources[ops.source], string(name))
+	if err != nil {
+		return ops.errorf("#include %#v: %s", string(name), err)
+	}
+	for _, active := range ops.includeStack {
+		if active == resolved {
+			cycle := append(append([]string(nil), ops.includeStack...), resolved)
+			return ops.errorf("#include cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+
+	source := -1
+	for i, name := range ops.Sources {
+		if name == resolved {
+			source = i
+			break
+		}
+	}
+	if source < 0 {
+		source = len(ops.Sources)
+		ops.Sources = append(ops.Sources, resolved)
+	}
+
+	savedSource, savedLine := ops.source, ops.sourceLine
+	if ops.source == 0 {
+		ops.topLevelLine = ops.sourceLine
+	}
+	ops.includeStack = append(ops.includeStack, resolved)
+	ops.source, ops.sourceLine = source, 0
+
+	ops.assembleSource(string(text))
+
+	ops.includeStack = ops.includeStack[:len(ops.includeStack)-1]
+	ops.source, ops.sourceLine = savedSource, savedLine
+	return nil
+}
+
 func (ops *OpStream) resolveLabels() {
-	saved := ops.sourceLine
+	saved, savedSource := ops.sourceLine, ops.source
 	raw := ops.pending.Bytes()
 	reported := make(map[string]bool)
 	for _, lr := range ops.labelReferences {
 		ops.sourceLine = lr.sourceLine // so errors get reported where the label was used
+		ops.source = lr.source
 		dest, ok := ops.labels[lr.label]
 		if !ok {
 			if !reported[lr.label] {
@@ -1565,7 +1797,7 @@ func (ops *OpStream) resolveLabels() {
 		raw[lr.position+2] = uint8(jump & 0x0ff)
 	}
 	ops.pending = *bytes.NewBuffer(raw)
-	ops.sourceLine = saved
+	ops.sourceLine, ops.source = saved, savedSource
 }
 
 // AssemblerDefaultVersion what version of code do we emit by default
@@ -1808,6 +2040,16 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 			}
 		}
 		ops.OffsetToLine = fixedOffsetsToLine
+
+		fixedOffsetsToSource := make(map[int]SourceLocation, len(ops.OffsetToSource))
+		for pos, location := range ops.OffsetToSource {
+			if pos > position {
+				fixedOffsetsToSource[pos+positionDelta] = location
+			} else {
+				fixedOffsetsToSource[pos] = location
+			}
+		}
+		ops.OffsetToSource = fixedOffsetsToSource
 	}
 
 	ops.pending = *bytes.NewBuffer(raw)
@@ -1870,6 +2112,12 @@ func (ops *OpStream) prependCBlocks() []byte {
 	}
 	ops.OffsetToLine = newOffsetToLine
 
+	newOffsetToSource := make(map[int]SourceLocation, len(ops.OffsetToSource))
+	for o, l := range ops.OffsetToSource {
+		newOffsetToSource[o+pbl] = l
+	}
+	ops.OffsetToSource = newOffsetToSource
+
 	return out
 }
 
@@ -1887,10 +2135,20 @@ func (ops *OpStream) lineError(line int, problem interface{}) error {
 	default:
 		err = lineError{Line: line, Err: fmt.Errorf("%#v", p)}
 	}
+	err.Source = ops.sourceName()
 	ops.Errors = append(ops.Errors, err)
 	return err
 }
 
+// sourceName returns the name of the #include'd file being assembled, or ""
+// while assembling the top-level source
+func (ops *OpStream) sourceName() string {
+	if ops.source == 0 {
+		return ""
+	}
+	return ops.Sources[ops.source]
+}
+
 func (ops *OpStream) errorf(format string, a ...interface{}) error {
 	return ops.error(fmt.Errorf(format, a...))
 }
@@ -1909,6 +2167,7 @@ func (ops *OpStream) warn(problem interface{}) error {
 	default:
 		le = &lineError{Line: ops.sourceLine, Err: fmt.Errorf("%#v", p)}
 	}
+	le.Source = ops.sourceName()
 	warning := fmt.Errorf("warning: %w", le)
 	ops.Warnings = append(ops.Warnings, warning)
 	return warning
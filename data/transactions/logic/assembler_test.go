@@ -2182,6 +2182,163 @@ func TestPragmas(t *testing.T) {
 	require.Equal(t, uint64(5), ops.Version)
 }
 
+func TestAssembleSemicolons(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	require.Equal(t, []string{"int", "1", ";", "int", "2"}, macroFieldsFromLine("int 1; int 2"))
+	require.Equal(t, []string{"int", "1", ";", "int", "2"}, macroFieldsFromLine("int 1 ;int 2"))
+	require.Equal(t, []string{"byte", `"a;b"`, ";", "pop"}, macroFieldsFromLine(`byte "a;b"; pop`))
+	require.Equal(t, []string{"byte", "b64", "AAAA", ";", "pop"}, macroFieldsFromLine("byte b64 AAAA; pop"))
+	require.Equal(t, []string{"int", "1"}, macroFieldsFromLine("int 1 // ; int 2"))
+
+	// Outside of #define bodies ; is not special
+	require.Equal(t, []string{"int", "1;", "int", "2"}, fieldsFromLine("int 1; int 2"))
+	require.Equal(t, []string{"byte", `"a;b"`}, fieldsFromLine(`byte "a;b"`))
+	// (testProg turns ; into newlines, so assemble these directly)
+	for _, source := range []string{"int 1; int 2", "int 1 ; int 2"} {
+		ops, err := AssembleString(source)
+		require.Error(t, err, source)
+		require.Len(t, ops.Errors, 1, source)
+		require.Contains(t, ops.Errors[0].Error(), "int needs one argument", source)
+	}
+
+	expected := testProg(t, "#pragma version 5\nlbl: int 1\nint 2\n+\npop", 5)
+	ops, err := AssembleString("#pragma version 5\n#define BODY int 1; int 2;; +; pop;\nlbl: BODY")
+	require.NoError(t, err)
+	require.Equal(t, expected.Program, ops.Program)
+
+	// A macro body is spliced into the line it is used on
+	ops, err = AssembleString("#pragma version 5\n#define ONE_TWO 1; int 2; +\nlbl: int ONE_TWO\npop")
+	require.NoError(t, err)
+	require.Equal(t, expected.Program, ops.Program)
+
+	// Programs assembled from macros with ; survive a disassembly round trip
+	for _, source := range []string{
+		"#pragma version 5\n#define BODY int 1; int 2; +\nBODY\npop",
+		"#pragma version 5\n#define STR byte \"a;b\"; len\nSTR\nint 3\n==",
+		"#pragma version 5\n#define B64 byte b64 AAAA; len\nB64\npop\nint 1",
+		"#pragma version 5\n#define SKIP b end; err\nSKIP\nend:\nint 1",
+	} {
+		ops, err := AssembleString(source)
+		require.NoError(t, err, source)
+		dis, err := Disassemble(ops.Program)
+		require.NoError(t, err, source)
+		again, err := AssembleString(dis)
+		require.NoError(t, err, dis)
+		require.Equal(t, ops.Program, again.Program, source)
+	}
+}
+
+func TestAssembleMacros(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	expected := testProg(t, `#pragma version 5
+txn Fee
+int 1000
+==
+txn Fee
+int 1000
+==
+&&`, 5)
+
+	// A single token macro is a named constant, and macros may use earlier
+	// macros and span several instructions
+	ops, err := AssembleString(`#pragma version 5
+#define FEE 1000
+#define FEE_OK txn Fee; int FEE; ==
+FEE_OK
+FEE_OK
+&&`)
+	require.NoError(t, err)
+	require.Equal(t, expected.Program, ops.Program)
+
+	// Macros may be used as immediates
+	testProg(t, "#define FIELD Fee\ntxn FIELD", AssemblerMaxVersion)
+
+	// Each byte maps to the line the macro was used on
+	ops, err = AssembleString("#pragma version 5\n#define TWO int 1; int 1; +\nTWO\npop")
+	require.NoError(t, err)
+	for _, line := range ops.OffsetToLine {
+		require.Contains(t, []int{2, 3}, line)
+	}
+
+	testProg(t, "#define", AssemblerMaxVersion, Expect{1, "#define needs a name"})
+	testProg(t, "#define 1x 1", AssemblerMaxVersion, Expect{1, `invalid #define name: "1x"`})
+	testProg(t, "#define pop 1", AssemblerMaxVersion, Expect{1, `#define can not redefine opcode "pop"`})
+	testProg(t, "#define int 1", AssemblerMaxVersion, Expect{1, `#define can not redefine pseudo-op "int"`})
+	testProg(t, "#define A 1\n#define A 2", AssemblerMaxVersion, Expect{2, `duplicate #define "A"`})
+
+	// Macros are not expanded before they are defined
+	testProg(t, "int A\n#define A 1", AssemblerMaxVersion, Expect{1, "strconv.ParseUint: parsing \"A\": invalid syntax"})
+}
+
+func TestAssembleIncludes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	files := map[string]string{
+		"lib.teal":    "#define ONE 1\nint 2\nint 3\n+\n",
+		"nested.teal": "#include \"lib.teal\"\nint ONE\n+",
+		"bad.teal":    "int 1\nbogus",
+		"a.teal":      "#include \"b.teal\"",
+		"b.teal":      "\n#include \"a.teal\"",
+	}
+	resolver := func(from, name string) (string, []byte, error) {
+		text, ok := files[name]
+		if !ok {
+			return "", nil, fmt.Errorf("not found")
+		}
+		return name, []byte(text), nil
+	}
+
+	expected := testProg(t, "#pragma version 5\nint 2\nint 3\n+\nint 1\n+\nint 1\n+", 5)
+	ops, err := AssembleStringWithIncludes("#pragma version 5\n#include \"nested.teal\"\nint ONE\n+", "main.teal", resolver)
+	require.NoError(t, err)
+	require.Equal(t, expected.Program, ops.Program)
+	require.Equal(t, []string{"main.teal", "nested.teal", "lib.teal"}, ops.Sources)
+
+	// Bytes from included files map to their own file in OffsetToSource,
+	// and to the top-level #include in OffsetToLine
+	sources := make(map[SourceLocation]bool)
+	for pc, location := range ops.OffsetToSource {
+		sources[location] = true
+		if location.Source == 0 {
+			require.Equal(t, location.Line, ops.OffsetToLine[pc])
+		} else {
+			require.Equal(t, 1, ops.OffsetToLine[pc])
+		}
+	}
+	require.Equal(t, map[SourceLocation]bool{
+		{Source: 2, Line: 1}: true,
+		{Source: 2, Line: 2}: true,
+		{Source: 2, Line: 3}: true,
+		{Source: 1, Line: 1}: true,
+		{Source: 1, Line: 2}: true,
+		{Source: 0, Line: 2}: true,
+		{Source: 0, Line: 3}: true,
+	}, sources)
+
+	// Errors in included files name the file
+	ops, err = AssembleStringWithIncludes("#include \"bad.teal\"\nint 1", "main.teal", resolver)
+	require.Error(t, err)
+	require.Len(t, ops.Errors, 1)
+	require.Equal(t, "bad.teal:2: unknown opcode: bogus", ops.Errors[0].Error())
+
+	ops, err = AssembleStringWithIncludes("#include \"a.teal\"", "main.teal", resolver)
+	require.Error(t, err)
+	require.Equal(t, "b.teal:2: #include cycle: main.teal -> a.teal -> b.teal -> a.teal", ops.Errors[0].Error())
+
+	ops, err = AssembleStringWithIncludes("#include \"missing.teal\"", "main.teal", resolver)
+	require.Error(t, err)
+	require.Equal(t, `1: #include "missing.teal": not found`, ops.Errors[0].Error())
+
+	testProg(t, `#include "lib.teal"`, AssemblerMaxVersion, Expect{1, "#include is not available"})
+	testProg(t, `#include lib.teal`, AssemblerMaxVersion, Expect{1, "bad #include file name: no quotes"})
+	testProg(t, `#include`, AssemblerMaxVersion, Expect{1, "#include needs a quoted file name"})
+}
+
 func TestAssemblePragmaVersion(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
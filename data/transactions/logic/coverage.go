@@ -0,0 +1,257 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// Coverage is a DebuggerHook that counts the instructions executed by every
+// evaluation it is attached to, so that the parts of a program exercised by
+// tests or dryruns can be reported. A Coverage may be shared by concurrent
+// evaluations.
+type Coverage struct {
+	mu   deadlock.Mutex
+	hits map[string]map[int]int // execution ID -> pc -> count
+}
+
+// NewCoverage creates an empty Coverage
+func NewCoverage() *Coverage {
+	return &Coverage{hits: make(map[string]map[int]int)}
+}
+
+// Register is fired on program creation (DebuggerHook interface)
+func (c *Coverage) Register(state *DebugState) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.hits[state.ExecID] == nil {
+		c.hits[state.ExecID] = make(map[int]int)
+	}
+	return nil
+}
+
+// Update is fired on every step (DebuggerHook interface)
+func (c *Coverage) Update(state *DebugState) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hits[state.ExecID][state.PC]++
+	return nil
+}
+
+// Complete is called when the program exits (DebuggerHook interface)
+func (c *Coverage) Complete(state *DebugState) error {
+	return nil
+}
+
+// Hits returns the number of times each instruction of program was executed,
+// by offset. Instructions that never executed are absent.
+func (c *Coverage) Hits(program []byte) map[int]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	hits := make(map[int]int, len(c.hits[GetProgramID(program)]))
+	for pc, count := range c.hits[GetProgramID(program)] {
+		hits[pc] = count
+	}
+	return hits
+}
+
+// Executed reports whether program was evaluated at all
+func (c *Coverage) Executed(program []byte) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.hits[GetProgramID(program)]
+	return ok
+}
+
+// LineCoverage holds the number of times each line of each source was
+// executed, keyed by source name and zero-based line. Only lines that
+// assembled to instructions are present.
+type LineCoverage map[string]map[int]int
+
+// MapLines maps the instructions of program executed so far to the source
+// lines they were assembled from, through sm, and adds their counts to lc. A
+// line with several instructions counts as executed as often as its most
+// executed instruction.
+func (c *Coverage) MapLines(program []byte, sm SourceMap, lc LineCoverage) error {
+	locations, err := sm.Locations()
+	if err != nil {
+		return err
+	}
+	hits := c.Hits(program)
+
+	lines := make(map[SourceLocation]int)
+	for pc, location := range locations {
+		if count, ok := lines[location]; !ok || hits[pc] > count {
+			lines[location] = hits[pc]
+		}
+	}
+	for location, count := range lines {
+		name := sm.Sources[location.Source]
+		if lc[name] == nil {
+			lc[name] = make(map[int]int)
+		}
+		lc[name][location.Line] += count
+	}
+	return nil
+}
+
+// sourceNames returns the sources of lc in order
+func (lc LineCoverage) sourceNames() []string {
+	names := make([]string, 0, len(lc))
+	for name := range lc {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// sortedLines returns the lines of a source in order, and how many of them
+// were executed
+func (lc LineCoverage) sortedLines(name string) (lines []int, hit int) {
+	for line, count := range lc[name] {
+		lines = append(lines, line)
+		if count > 0 {
+			hit++
+		}
+	}
+	sort.Ints(lines)
+	return lines, hit
+}
+
+// WriteLcov writes lc as an lcov tracefile
+func (lc LineCoverage) WriteLcov(w io.Writer) error {
+	out := bufio.NewWriter(w)
+	for _, name := range lc.sourceNames() {
+		lines, hit := lc.sortedLines(name)
+		fmt.Fprintf(out, "TN:\nSF:%s\n", name)
+		for _, line := range lines {
+			fmt.Fprintf(out, "DA:%d,%d\n", line+1, lc[name][line])
+		}
+		fmt.Fprintf(out, "LF:%d\nLH:%d\nend_of_record\n", len(lines), hit)
+	}
+	return out.Flush()
+}
+
+type coverageHTMLLine struct {
+	Text  string
+	Class string
+	Title string
+}
+
+type coverageHTMLFile struct {
+	Name    string
+	Percent float64
+	Lines   []coverageHTMLLine
+}
+
+// WriteHTML writes lc as an HTML page showing each source with its executed
+// lines highlighted, in the style of go tool cover. readSource returns the
+// text of a source given its name.
+func (lc LineCoverage) WriteHTML(w io.Writer, readSource func(name string) ([]byte, error)) error {
+	var files []coverageHTMLFile
+	for _, name := range lc.sourceNames() {
+		text, err := readSource(name)
+		if err != nil {
+			return err
+		}
+		lines, hit := lc.sortedLines(name)
+		file := coverageHTMLFile{Name: name, Percent: 100}
+		if len(lines) > 0 {
+			file.Percent = 100 * float64(hit) / float64(len(lines))
+		}
+		for i, text := range strings.Split(strings.TrimSuffix(string(text), "\n"), "\n") {
+			line := coverageHTMLLine{Text: text}
+			if count, ok := lc[name][i]; ok {
+				line.Class = "cov0"
+				if count > 0 {
+					line.Class = "cov8"
+				}
+				line.Title = fmt.Sprintf("%d", count)
+			}
+			file.Lines = append(file.Lines, line)
+		}
+		files = append(files, file)
+	}
+	return coverageHTML.Execute(w, files)
+}
+
+var coverageHTML = template.Must(template.New("coverage").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta http-equiv="Content-Type" content="text/html; charset=utf-8">
+<title>TEAL coverage</title>
+<style>
+body { background: black; color: rgb(80, 80, 80); }
+body, pre, #legend span { font-family: Menlo, monospace; font-weight: bold; }
+#topbar { background: black; position: fixed; top: 0; left: 0; right: 0; height: 42px; border-bottom: 1px solid rgb(80, 80, 80); }
+#content { margin-top: 50px; }
+#nav, #legend { float: left; margin-left: 10px; }
+#legend { margin-top: 12px; }
+#nav { margin-top: 10px; }
+#legend span { margin: 0 5px; }
+.cov0 { color: rgb(192, 0, 0) }
+.cov8 { color: rgb(44, 212, 149) }
+</style>
+</head>
+<body>
+<div id="topbar">
+<div id="nav">
+<select id="files">
+{{range $i, $f := .}}<option value="file{{$i}}">{{$f.Name}} ({{printf "%.1f" $f.Percent}}%)</option>
+{{end}}</select>
+</div>
+<div id="legend">
+<span>not tracked</span>
+<span class="cov0">not covered</span>
+<span class="cov8">covered</span>
+</div>
+</div>
+<div id="content">
+{{range $i, $f := .}}<pre class="file" id="file{{$i}}" style="display: none">{{range $f.Lines}}{{if .Class}}<span class="{{.Class}}" title="{{.Title}}">{{.Text}}</span>{{else}}{{.Text}}{{end}}
+{{end}}</pre>
+{{end}}</div>
+</body>
+<script>
+(function() {
+	var files = document.getElementById('files');
+	var visible;
+	function select(part) {
+		if (visible) visible.style.display = 'none';
+		visible = document.getElementById(part);
+		if (!visible) return;
+		files.value = part;
+		visible.style.display = 'block';
+		location.hash = part;
+	}
+	files.addEventListener('change', function() { select(files.value); window.scrollTo(0, 0); }, false);
+	if (location.hash != "") {
+		select(location.hash.substr(1));
+	}
+	if (!visible) {
+		select("file0");
+	}
+})();
+</script>
+</html>
+`))
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+const coverageSource = `#pragma version 5
+#define SUM int 1; int 0; +
+txn Fee
+int 1000
+>
+bnz expensive
+int 1
+return
+expensive:
+SUM
+return
+`
+
+func TestCoverage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, err := AssembleStringWithIncludes(coverageSource, "cov.teal", nil)
+	require.NoError(t, err)
+	cov := NewCoverage()
+	require.False(t, cov.Executed(ops.Program))
+
+	for _, fee := range []uint64{1, 2, 3, 5000} {
+		var txn transactions.SignedTxn
+		txn.Txn.Fee.Raw = fee
+		ep := defaultEvalParams(&txn)
+		ep.Debugger = cov
+		testLogicBytes(t, ops.Program, ep)
+	}
+	require.True(t, cov.Executed(ops.Program))
+
+	hits := cov.Hits(ops.Program)
+	var total int
+	for _, count := range hits {
+		total += count
+	}
+	// The intcblock, 4 instructions for the comparison, then 2 or 4 more
+	require.Equal(t, 4*(1+4)+3*2+4, total)
+
+	lc := make(LineCoverage)
+	sm := GetSourceMapWithSources(ops.Sources, ops.OffsetToSource)
+	require.NoError(t, cov.MapLines(ops.Program, sm, lc))
+	require.Equal(t, LineCoverage{"cov.teal": {2: 4, 3: 4, 4: 4, 5: 4, 6: 3, 7: 3, 9: 1, 10: 1}}, lc)
+
+	// Lines add up across programs
+	require.NoError(t, cov.MapLines(ops.Program, sm, lc))
+	require.Equal(t, 8, lc["cov.teal"][2])
+
+	// Programs that didn't run have every line uncovered
+	other, err := AssembleStringWithIncludes("int 1\nint 2\n+", "other.teal", nil)
+	require.NoError(t, err)
+	require.NoError(t, cov.MapLines(other.Program, GetSourceMapWithSources(other.Sources, other.OffsetToSource), lc))
+	require.Equal(t, map[int]int{0: 0, 1: 0, 2: 0}, lc["other.teal"])
+
+	var lcov bytes.Buffer
+	require.NoError(t, lc.WriteLcov(&lcov))
+	require.Equal(t, `TN:
+SF:cov.teal
+DA:3,8
+DA:4,8
+DA:5,8
+DA:6,8
+DA:7,6
+DA:8,6
+DA:10,2
+DA:11,2
+LF:8
+LH:8
+end_of_record
+TN:
+SF:other.teal
+DA:1,0
+DA:2,0
+DA:3,0
+LF:3
+LH:0
+end_of_record
+`, lcov.String())
+
+	var html bytes.Buffer
+	sources := map[string]string{"cov.teal": coverageSource, "other.teal": "int 1\nint 2\n+"}
+	require.NoError(t, lc.WriteHTML(&html, func(name string) ([]byte, error) {
+		text, ok := sources[name]
+		if !ok {
+			return nil, fmt.Errorf("no source %s", name)
+		}
+		return []byte(text), nil
+	}))
+	require.Contains(t, html.String(), "cov.teal (100.0%)")
+	require.Contains(t, html.String(), "other.teal (0.0%)")
+	require.Contains(t, html.String(), `<span class="cov8" title="2">SUM</span>`)
+	require.Contains(t, html.String(), `<span class="cov0" title="0">&#43;</span>`)
+	require.Contains(t, html.String(), "\nexpensive:\n")
+
+	require.Error(t, lc.WriteHTML(&html, func(name string) ([]byte, error) {
+		return nil, fmt.Errorf("no source %s", name)
+	}))
+}
+
+func TestSourceMapLocations(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	offsetToSource := map[int]SourceLocation{
+		1:   {Source: 0, Line: 0},
+		2:   {Source: 1, Line: 17},
+		40:  {Source: 0, Line: 1000},
+		500: {Source: 1, Line: 3},
+	}
+	sm := GetSourceMapWithSources([]string{"a.teal", "b.teal"}, offsetToSource)
+	locations, err := sm.Locations()
+	require.NoError(t, err)
+	require.Equal(t, offsetToSource, locations)
+
+	sm.Sources = sm.Sources[:1]
+	_, err = sm.Locations()
+	require.Error(t, err)
+
+	_, err = SourceMap{Mapping: "A"}.Locations()
+	require.Error(t, err)
+	_, err = SourceMap{Mapping: "AAg"}.Locations()
+	require.Error(t, err)
+	_, err = SourceMap{Mapping: "AA!A"}.Locations()
+	require.Error(t, err)
+}
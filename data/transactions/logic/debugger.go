@@ -74,6 +74,8 @@ type DebugState struct {
 	GroupIndex  int                            `codec:"gindex"`
 	Proto       *config.ConsensusParams        `codec:"proto"`
 	Globals     []basics.TealValue             `codec:"globals"`
+	// ExecID of the program that made this inner app call, if it is one
+	Caller string `codec:"caller,omitempty"`
 
 	// fields updated every step
 	PC           int                `codec:"pc"`
@@ -126,6 +128,10 @@ func makeDebugState(cx *EvalContext) *DebugState {
 	}
 	ds.Globals = globals
 
+	if cx.caller != nil {
+		ds.Caller = GetProgramID(cx.caller.program)
+	}
+
 	if (cx.runModeFlags & modeApp) != 0 {
 		ds.EvalDelta = cx.txn.EvalDelta
 	}
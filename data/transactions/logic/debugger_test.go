@@ -123,6 +123,51 @@ func TestDebuggerHook(t *testing.T) {
 	require.Len(t, testDbg.state.Stack, 1)
 }
 
+// registerLog records the programs registered with it, and their callers
+type registerLog struct {
+	testDbgHook
+	registered []DebugState
+}
+
+func (d *registerLog) Register(state *DebugState) error {
+	d.registered = append(d.registered, *state)
+	return d.testDbgHook.Register(state)
+}
+
+func TestDebuggerInnerCalls(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := makeSampleEnv()
+	inner := testProg(t, "pushint 1", AssemblerMaxVersion)
+	ledger.NewApp(tx.Receiver, 222, basics.AppParams{ApprovalProgram: inner.Program})
+	ledger.NewApp(tx.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(appAddr(888), 50_000)
+	tx.ForeignApps = []basics.AppIndex{222}
+	outer := testProg(t, `itxn_begin
+pushint 6; itxn_field TypeEnum
+pushint 222; itxn_field ApplicationID
+itxn_submit
+pushint 1`, AssemblerMaxVersion)
+
+	var dbg registerLog
+	ep.Debugger = &dbg
+	testAppBytes(t, outer.Program, ep)
+	require.Len(t, dbg.registered, 1)
+	require.Equal(t, 1, dbg.complete)
+
+	dbg = registerLog{}
+	ep.DebugInnerCalls = true
+	testAppBytes(t, outer.Program, ep)
+	require.Len(t, dbg.registered, 2)
+	require.Equal(t, 2, dbg.complete)
+	require.Equal(t, GetProgramID(outer.Program), dbg.registered[0].ExecID)
+	require.Empty(t, dbg.registered[0].Caller)
+	require.Equal(t, GetProgramID(inner.Program), dbg.registered[1].ExecID)
+	require.Equal(t, GetProgramID(outer.Program), dbg.registered[1].Caller)
+	require.Equal(t, basics.AppIndex(222), dbg.registered[1].TxnGroup[0].Txn.ApplicationID)
+}
+
 func TestLineToPC(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
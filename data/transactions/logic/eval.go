@@ -242,6 +242,13 @@ type EvalParams struct {
 	// optional debugger
 	Debugger DebuggerHook
 
+	// DebugInnerCalls passes Debugger on to inner app calls, which are
+	// otherwise not debugged
+	DebugInnerCalls bool
+
+	// optional cost profiler, shared with inner app calls
+	Profiler *Profiler
+
 	// MinTealVersion is the minimum allowed TEAL version of this program.
 	// The program must reject if its version is less than this version. If
 	// MinTealVersion is nil, we will compute it ourselves
@@ -378,6 +385,8 @@ func NewInnerEvalParams(txg []transactions.SignedTxnWithAD, caller *EvalContext)
 	ep := &EvalParams{
 		Proto:                   caller.Proto,
 		Trace:                   caller.Trace,
+		Profiler:                caller.Profiler,
+		DebugInnerCalls:         caller.DebugInnerCalls,
 		TxnGroup:                txg,
 		pastScratch:             make([]*scratchSpace, len(txg)),
 		MinTealVersion:          &minTealVersion,
@@ -390,6 +399,9 @@ func NewInnerEvalParams(txg []transactions.SignedTxnWithAD, caller *EvalContext)
 		appAddrCache:            caller.appAddrCache,
 		caller:                  caller,
 	}
+	if caller.DebugInnerCalls {
+		ep.Debugger = caller.Debugger
+	}
 	return ep
 }
 
@@ -677,8 +689,9 @@ func eval(program []byte, cx *EvalContext) (pass bool, err error) {
 	}()
 
 	defer func() {
-		// Ensure we update the debugger before exiting
-		if cx.Debugger != nil {
+		// Ensure we update the debugger before exiting, if it was told about
+		// the program at all
+		if cx.Debugger != nil && cx.debugState != nil {
 			errDbg := cx.Debugger.Complete(cx.refreshDebugState(err))
 			if err == nil {
 				err = errDbg
@@ -946,6 +959,10 @@ func (cx *EvalContext) step() error {
 			cx.pc, spec.Name, cx.cost)
 	}
 
+	if cx.Profiler != nil {
+		cx.Profiler.record(cx, opcost)
+	}
+
 	preheight := len(cx.stack)
 	err := spec.op(cx)
 
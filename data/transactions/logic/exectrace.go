@@ -0,0 +1,267 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/base64"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// ScratchChange records a write to a scratch slot
+type ScratchChange struct {
+	Slot     uint64           `codec:"slot"`
+	NewValue basics.TealValue `codec:"new-value"`
+}
+
+// StateChange records a write to global or local application state. Account
+// is only set for local state changes.
+type StateChange struct {
+	AppStateType string            `codec:"app-state-type"`
+	Account      basics.Address    `codec:"account,omitempty"`
+	Key          string            `codec:"key"`
+	NewValue     basics.ValueDelta `codec:"new-value"`
+}
+
+// ExecTraceStep describes the effects of executing a single opcode. Byte
+// values (stack, scratch, state keys and values, logs) are base64 encoded,
+// matching DebugState.
+type ExecTraceStep struct {
+	PC             int                `codec:"pc"`
+	Line           int                `codec:"line"`
+	Op             string             `codec:"op"`
+	StackPopCount  int                `codec:"stack-pop-count,omitempty"`
+	StackAdditions []basics.TealValue `codec:"stack-additions,omitempty"`
+	ScratchChanges []ScratchChange    `codec:"scratch-changes,omitempty"`
+	StateChanges   []StateChange      `codec:"state-changes,omitempty"`
+	SpawnedInners  []int              `codec:"spawned-inners,omitempty"`
+	Logs           []string           `codec:"logs,omitempty"`
+	Error          string             `codec:"error,omitempty"`
+}
+
+// ExecTrace is the structured, per-opcode record of a single program
+// evaluation. GroupIndex is the position of the traced transaction in its
+// group, or in its inner group for app calls made by another app. InnerTraces
+// holds the traces of those app calls in execution order, when the evaluator
+// reports them (EvalParams.DebugInnerCalls).
+type ExecTrace struct {
+	ExecID      string          `codec:"execid"`
+	GroupIndex  int             `codec:"group-index"`
+	Steps       []ExecTraceStep `codec:"steps"`
+	Error       string          `codec:"error,omitempty"`
+	InnerTraces []ExecTrace     `codec:"inner-traces,omitempty"`
+}
+
+// traceSnapshot is the subset of a DebugState needed to compute the effects of
+// the next opcode. DebugState shares its EvalDelta maps with the evaluator, so
+// state deltas are copied rather than referenced.
+type traceSnapshot struct {
+	pc      int
+	line    int
+	stack   []basics.TealValue
+	scratch []basics.TealValue
+	global  basics.StateDelta
+	local   map[uint64]basics.StateDelta
+	logs    int
+	inners  int
+}
+
+// traceFrame is the in-progress trace of a program that is waiting for an
+// inner app call to finish
+type traceFrame struct {
+	trace   ExecTrace
+	lines   []string
+	state   *DebugState
+	pending *traceSnapshot
+}
+
+// ExecTraceRecorder is a DebuggerHook that builds an ExecTrace by comparing the
+// states reported before and after each opcode. Registering a top-level
+// program starts a new trace; inner app calls are traced separately and
+// attached to their caller's InnerTraces on completion.
+type ExecTraceRecorder struct {
+	Trace ExecTrace
+
+	lines   []string
+	state   *DebugState
+	pending *traceSnapshot
+	outer   []traceFrame
+}
+
+func snapshotDebugState(state *DebugState) *traceSnapshot {
+	snap := &traceSnapshot{
+		pc:      state.PC,
+		line:    state.Line,
+		stack:   state.Stack,
+		scratch: state.Scratch,
+		logs:    len(state.Logs),
+		inners:  len(state.InnerTxns),
+	}
+	if len(state.GlobalDelta) > 0 {
+		snap.global = make(basics.StateDelta, len(state.GlobalDelta))
+		for k, v := range state.GlobalDelta {
+			snap.global[k] = v
+		}
+	}
+	if len(state.LocalDeltas) > 0 {
+		snap.local = make(map[uint64]basics.StateDelta, len(state.LocalDeltas))
+		for idx, sd := range state.LocalDeltas {
+			local := make(basics.StateDelta, len(sd))
+			for k, v := range sd {
+				local[k] = v
+			}
+			snap.local[idx] = local
+		}
+	}
+	return snap
+}
+
+// changedKeys returns the sorted keys of after whose values differ from before
+func changedKeys(before, after basics.StateDelta) []string {
+	var keys []string
+	for k, v := range after {
+		if old, ok := before[k]; !ok || old != v {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (r *ExecTraceRecorder) stateChanges(before, after *traceSnapshot) []StateChange {
+	var changes []StateChange
+	for _, k := range changedKeys(before.global, after.global) {
+		vd := after.global[k]
+		changes = append(changes, StateChange{
+			AppStateType: "g",
+			Key:          base64.StdEncoding.EncodeToString([]byte(k)),
+			NewValue:     valueDeltaToValueDelta(&vd),
+		})
+	}
+
+	indices := make([]uint64, 0, len(after.local))
+	for idx := range after.local {
+		indices = append(indices, idx)
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	for _, idx := range indices {
+		var account basics.Address
+		if r.state != nil && r.state.GroupIndex < len(r.state.TxnGroup) {
+			txn := &r.state.TxnGroup[r.state.GroupIndex].Txn
+			// an unresolvable index leaves the zero address
+			account, _ = txn.AddressByIndex(idx, txn.Sender)
+		}
+		for _, k := range changedKeys(before.local[idx], after.local[idx]) {
+			vd := after.local[idx][k]
+			changes = append(changes, StateChange{
+				AppStateType: "l",
+				Account:      account,
+				Key:          base64.StdEncoding.EncodeToString([]byte(k)),
+				NewValue:     valueDeltaToValueDelta(&vd),
+			})
+		}
+	}
+	return changes
+}
+
+// makeStep attributes the differences between before and after to the opcode
+// at before.pc
+func (r *ExecTraceRecorder) makeStep(before, after *traceSnapshot, state *DebugState) ExecTraceStep {
+	step := ExecTraceStep{
+		PC:   before.pc,
+		Line: before.line,
+	}
+	if before.line >= 0 && before.line < len(r.lines) {
+		step.Op = strings.TrimSpace(r.lines[before.line])
+	}
+
+	common := 0
+	for common < len(before.stack) && common < len(after.stack) && before.stack[common] == after.stack[common] {
+		common++
+	}
+	step.StackPopCount = len(before.stack) - common
+	if common < len(after.stack) {
+		step.StackAdditions = append([]basics.TealValue(nil), after.stack[common:]...)
+	}
+
+	for i, sv := range after.scratch {
+		if i >= len(before.scratch) || before.scratch[i] != sv {
+			step.ScratchChanges = append(step.ScratchChanges, ScratchChange{Slot: uint64(i), NewValue: sv})
+		}
+	}
+
+	step.StateChanges = r.stateChanges(before, after)
+
+	for i := before.inners; i < after.inners; i++ {
+		step.SpawnedInners = append(step.SpawnedInners, i)
+	}
+	for i := before.logs; i < after.logs && i < len(state.Logs); i++ {
+		step.Logs = append(step.Logs, base64.StdEncoding.EncodeToString([]byte(state.Logs[i])))
+	}
+	return step
+}
+
+// Register is fired on program creation (DebuggerHook interface)
+func (r *ExecTraceRecorder) Register(state *DebugState) error {
+	if state.Caller != "" && r.state != nil {
+		r.outer = append(r.outer, traceFrame{trace: r.Trace, lines: r.lines, state: r.state, pending: r.pending})
+	} else {
+		r.outer = nil
+	}
+	r.Trace = ExecTrace{ExecID: state.ExecID, GroupIndex: state.GroupIndex}
+	r.lines = strings.Split(state.Disassembly, "\n")
+	r.state = state
+	r.pending = nil
+	return nil
+}
+
+// Update is fired on every step (DebuggerHook interface)
+func (r *ExecTraceRecorder) Update(state *DebugState) error {
+	snap := snapshotDebugState(state)
+	if r.pending != nil {
+		r.Trace.Steps = append(r.Trace.Steps, r.makeStep(r.pending, snap, state))
+	}
+	r.pending = snap
+	return nil
+}
+
+// Complete is called when the program exits (DebuggerHook interface)
+func (r *ExecTraceRecorder) Complete(state *DebugState) error {
+	if r.pending != nil {
+		snap := snapshotDebugState(state)
+		step := r.makeStep(r.pending, snap, state)
+		// a failing opcode does not advance the program counter, while errors
+		// detected after the last opcode (e.g. a bad final stack) do
+		if state.Error != "" && state.PC == r.pending.pc {
+			step.Error = state.Error
+		}
+		r.Trace.Steps = append(r.Trace.Steps, step)
+		r.pending = nil
+	}
+	r.Trace.Error = state.Error
+
+	if n := len(r.outer); n > 0 {
+		inner := r.Trace
+		frame := r.outer[n-1]
+		r.outer = r.outer[:n-1]
+		r.Trace, r.lines, r.state, r.pending = frame.trace, frame.lines, frame.state, frame.pending
+		r.Trace.InnerTraces = append(r.Trace.InnerTraces, inner)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecTraceRecorderApp(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := makeSampleEnv()
+	ledger.NewAccount(tx.Sender, 1)
+	ledger.NewApp(tx.Sender, 888, basics.AppParams{})
+	ledger.NewLocals(tx.Sender, 888)
+
+	var rec ExecTraceRecorder
+	ep.Debugger = &rec
+	testApp(t, `int 5
+store 3
+byte "k"
+int 7
+app_global_put
+int 0
+byte "lk"
+byte "v"
+app_local_put
+byte "hi"
+log
+int 1
+`, ep)
+
+	b64 := func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) }
+
+	steps := rec.Trace.Steps
+	require.Len(t, steps, 12)
+	require.Empty(t, rec.Trace.Error)
+	require.NotEmpty(t, rec.Trace.ExecID)
+
+	require.Equal(t, "pushint 5", steps[0].Op)
+	require.Zero(t, steps[0].StackPopCount)
+	require.Equal(t, []basics.TealValue{{Type: basics.TealUintType, Uint: 5}}, steps[0].StackAdditions)
+
+	require.Equal(t, "store 3", steps[1].Op)
+	require.Equal(t, 1, steps[1].StackPopCount)
+	require.Empty(t, steps[1].StackAdditions)
+	require.Equal(t, []ScratchChange{{Slot: 3, NewValue: basics.TealValue{Type: basics.TealUintType, Uint: 5}}}, steps[1].ScratchChanges)
+
+	require.Equal(t, "app_global_put", steps[4].Op)
+	require.Equal(t, 2, steps[4].StackPopCount)
+	require.Equal(t, []StateChange{{
+		AppStateType: "g",
+		Key:          b64("k"),
+		NewValue:     basics.ValueDelta{Action: basics.SetUintAction, Uint: 7, Bytes: b64("")},
+	}}, steps[4].StateChanges)
+
+	require.Equal(t, "app_local_put", steps[8].Op)
+	require.Equal(t, []StateChange{{
+		AppStateType: "l",
+		Account:      tx.Sender,
+		Key:          b64("lk"),
+		NewValue:     basics.ValueDelta{Action: basics.SetBytesAction, Bytes: b64("v")},
+	}}, steps[8].StateChanges)
+
+	require.Equal(t, "log", steps[10].Op)
+	require.Equal(t, []string{b64("hi")}, steps[10].Logs)
+
+	for i, step := range steps {
+		if i != 4 && i != 8 {
+			require.Empty(t, step.StateChanges, step.Op)
+		}
+		require.Empty(t, step.SpawnedInners)
+		require.Empty(t, step.Error)
+	}
+}
+
+func TestExecTraceRecorderErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	var rec ExecTraceRecorder
+	ep := defaultEvalParams(nil)
+	ep.Debugger = &rec
+	testLogic(t, "int 1; int 0; /", AssemblerMaxVersion, ep, "/ 0")
+
+	require.Len(t, rec.Trace.Steps, 3)
+	require.Contains(t, rec.Trace.Error, "/ 0")
+	require.Contains(t, rec.Trace.Steps[2].Error, "/ 0")
+	require.Empty(t, rec.Trace.Steps[1].Error)
+
+	// a bad final stack is not the fault of the last opcode
+	ep = defaultEvalParams(nil)
+	ep.Debugger = &rec
+	testLogic(t, "int 1; int 2", AssemblerMaxVersion, ep, "stack len is 2")
+
+	require.Len(t, rec.Trace.Steps, 2)
+	require.Contains(t, rec.Trace.Error, "stack len is 2")
+	for _, step := range rec.Trace.Steps {
+		require.Empty(t, step.Error)
+	}
+}
+
+func TestExecTraceRecorderInnerCalls(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := makeSampleEnv()
+	inner := testProg(t, "pushbytes 0x696e6e6572; log; pushint 1", AssemblerMaxVersion)
+	ledger.NewApp(tx.Receiver, 222, basics.AppParams{ApprovalProgram: inner.Program})
+	ledger.NewApp(tx.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(appAddr(888), 50_000)
+	tx.ForeignApps = []basics.AppIndex{222}
+	outer := testProg(t, `itxn_begin
+pushint 6; itxn_field TypeEnum
+pushint 222; itxn_field ApplicationID
+itxn_submit
+pushint 1`, AssemblerMaxVersion)
+
+	var rec ExecTraceRecorder
+	ep.Debugger = &rec
+	ep.DebugInnerCalls = true
+	testAppBytes(t, outer.Program, ep)
+
+	require.Equal(t, GetProgramID(outer.Program), rec.Trace.ExecID)
+	require.Len(t, rec.Trace.Steps, 7)
+	require.Equal(t, "itxn_submit", rec.Trace.Steps[5].Op)
+	require.Equal(t, []int{0}, rec.Trace.Steps[5].SpawnedInners)
+	require.Equal(t, "pushint 1", rec.Trace.Steps[6].Op)
+
+	require.Len(t, rec.Trace.InnerTraces, 1)
+	innerTrace := rec.Trace.InnerTraces[0]
+	require.Equal(t, GetProgramID(inner.Program), innerTrace.ExecID)
+	require.Zero(t, innerTrace.GroupIndex)
+	require.Len(t, innerTrace.Steps, 3)
+	require.Equal(t, []string{base64.StdEncoding.EncodeToString([]byte("inner"))}, innerTrace.Steps[1].Logs)
+
+	// a new top-level program starts from scratch
+	testAppBytes(t, outer.Program, ep)
+	require.Len(t, rec.Trace.InnerTraces, 1)
+}
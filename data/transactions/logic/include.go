@@ -0,0 +1,85 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// IncludeResolver loads the file named by an #include directive. from is the
+// name of the including source. It returns a name identifying the file, which
+// is used to detect #include cycles and is listed in OpStream.Sources, along
+// with the file's contents.
+type IncludeResolver func(from, name string) (resolved string, text []byte, err error)
+
+// FileIncludeResolver resolves #include directives as paths relative to the
+// directory of the including file. If root is not empty, included files must
+// lie within root, and both names and the top-level source's name are
+// relative to it.
+func FileIncludeResolver(root string) IncludeResolver {
+	return func(from, name string) (string, []byte, error) {
+		path := name
+		if !filepath.IsAbs(name) {
+			path = filepath.Join(filepath.Dir(from), name)
+		}
+		path = filepath.Clean(path)
+
+		full := path
+		if root != "" {
+			if filepath.IsAbs(path) || path == ".." || strings.HasPrefix(path, ".."+string(filepath.Separator)) {
+				return "", nil, fmt.Errorf("outside of the include directory")
+			}
+			full = filepath.Join(root, path)
+
+			// Symbolic links must not lead out of root either
+			realRoot, err := filepath.EvalSymlinks(root)
+			if err != nil {
+				return "", nil, err
+			}
+			realFull, err := filepath.EvalSymlinks(full)
+			if err != nil {
+				return "", nil, err
+			}
+			rel, err := filepath.Rel(realRoot, realFull)
+			if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				return "", nil, fmt.Errorf("outside of the include directory")
+			}
+		}
+
+		text, err := os.ReadFile(full)
+		if err != nil {
+			return "", nil, err
+		}
+		return path, text, nil
+	}
+}
+
+// AssembleStringWithIncludes is like AssembleString, but allows #include
+// directives, which are resolved by includes. name is the name of the
+// top-level source, passed to includes and recorded as the first entry of
+// OpStream.Sources.
+func AssembleStringWithIncludes(text string, name string, includes IncludeResolver) (*OpStream, error) {
+	ops := newOpStream(assemblerNoVersion)
+	ops.includes = includes
+	ops.Sources[0] = name
+	ops.includeStack = []string{name}
+	err := ops.assemble(text)
+	return &ops, err
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestFileIncludeResolver(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	dir := t.TempDir()
+	root := filepath.Join(dir, "root")
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "lib"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "lib", "a.teal"), []byte("#include \"b.teal\"\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "lib", "b.teal"), []byte("int 1\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "secret.teal"), []byte("int 2\n"), 0600))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "secret.teal"), filepath.Join(root, "link.teal")))
+
+	// Without a root, names are relative to the including file
+	resolve := FileIncludeResolver("")
+	name, text, err := resolve(filepath.Join(root, "lib", "a.teal"), "b.teal")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(root, "lib", "b.teal"), name)
+	require.Equal(t, "int 1\n", string(text))
+	_, _, err = resolve(filepath.Join(root, "main.teal"), "../secret.teal")
+	require.NoError(t, err)
+
+	// With a root, files outside of it can't be included
+	resolve = FileIncludeResolver(root)
+	name, _, err = resolve("", "lib/a.teal")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("lib", "a.teal"), name)
+	name, _, err = resolve(name, "b.teal")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join("lib", "b.teal"), name)
+	_, _, err = resolve(name, "../../secret.teal")
+	require.Error(t, err)
+	_, _, err = resolve("", filepath.Join(dir, "secret.teal"))
+	require.Error(t, err)
+	_, _, err = resolve("", "link.teal")
+	require.Error(t, err)
+
+	ops, err := AssembleStringWithIncludes("#pragma version 5\n#include \"lib/a.teal\"", "", resolve)
+	require.NoError(t, err)
+	require.Equal(t, []string{"", filepath.Join("lib", "a.teal"), filepath.Join("lib", "b.teal")}, ops.Sources)
+}
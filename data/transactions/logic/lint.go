@@ -0,0 +1,535 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+// ProgramKind says how a program is used, which determines the checks Lint
+// applies to it
+type ProgramKind int
+
+const (
+	// LogicSigKind is a logic signature program
+	LogicSigKind ProgramKind = iota
+	// ApprovalKind is an application's approval program
+	ApprovalKind
+	// ClearStateKind is an application's clear state program
+	ClearStateKind
+)
+
+// Names of the checks Lint performs, as reported in LintFinding.Check
+const (
+	LintUnreachable      = "unreachable"
+	LintRekeyTo          = "rekey-to"
+	LintCloseRemainderTo = "close-remainder-to"
+	LintAssetCloseTo     = "asset-close-to"
+	LintOnCompletion     = "on-completion"
+	LintUnboundedLoop    = "unbounded-loop"
+	LintCost             = "cost"
+)
+
+// LintFinding is a potential problem found by Lint
+type LintFinding struct {
+	// PC is the offset of the instruction the finding is about, or zero for
+	// findings about the program as a whole
+	PC      int
+	Check   string
+	Message string
+}
+
+// LintPath is the worst-case cost of the paths through a program that end
+// at the same place
+type LintPath struct {
+	// ExitPC is the offset of the instruction ending the paths, or the length
+	// of the program for paths that run off its end
+	ExitPC int
+	Cost   int
+
+	// Unbounded is set if some of the paths pass through a loop, so their
+	// cost can't be determined statically. Cost then only covers the
+	// instructions outside of loops.
+	Unbounded bool
+}
+
+// LintReport is the result of Lint
+type LintReport struct {
+	Version  uint64
+	Findings []LintFinding
+	Paths    []LintPath // sorted by ExitPC
+
+	// MaxCost is the highest cost of any path. Before TEAL v4 the budget
+	// applies to the whole program rather than to the path taken, so MaxCost
+	// is the cost of every instruction in the program.
+	MaxCost int
+	Budget  int
+}
+
+// lintStack is given to OpDetails.Cost so that opcodes whose cost grows with
+// the length of their arguments are costed at the longest possible argument
+var lintStack = func() []stackValue {
+	stack := make([]stackValue, len(blankStack))
+	longest := make([]byte, maxStringSize)
+	for i := range stack {
+		stack[i].Bytes = longest
+	}
+	return stack
+}()
+
+type lintInstruction struct {
+	pc     int
+	next   int
+	spec   OpSpec
+	cost   int
+	fields []string // the disassembled instruction
+	target int      // the branch target, or -1 for instructions that don't branch
+}
+
+// lintCost is the cost of a path, which is unbounded if it passes through a loop
+type lintCost struct {
+	cost      int
+	unbounded bool
+}
+
+func (c lintCost) plus(o lintCost) lintCost {
+	return lintCost{c.cost + o.cost, c.unbounded || o.unbounded}
+}
+
+func (c lintCost) max(o lintCost) lintCost {
+	if o.cost > c.cost {
+		c.cost = o.cost
+	}
+	c.unbounded = c.unbounded || o.unbounded
+	return c
+}
+
+// lintFunction summarizes the main program or a subroutine
+type lintFunction struct {
+	returns bool             // whether the subroutine can retsub
+	ret     lintCost         // the worst-case cost of returning
+	exits   map[int]lintCost // the worst-case cost of ending the program at each exit
+}
+
+type linter struct {
+	program []byte
+	version uint64
+	instrs  map[int]*lintInstruction
+	order   []int // instruction offsets in program order
+
+	reached map[int]bool
+	inLoop  map[int]bool
+
+	// summaries of the functions analyzed so far, nil while in progress
+	functions map[int]*lintFunction
+
+	report LintReport
+}
+
+// Lint analyzes an assembled program for common mistakes: unreachable code,
+// logic signatures that don't check the fields that can give away an
+// account's funds or control, approval programs that don't check
+// OnCompletion, and loops. It also estimates the worst-case cost of the paths
+// through the program, and reports those that exceed budget. A budget of
+// zero disables that check.
+func Lint(program []byte, kind ProgramKind, budget int) (LintReport, error) {
+	l := linter{
+		program:   program,
+		instrs:    make(map[int]*lintInstruction),
+		reached:   make(map[int]bool),
+		inLoop:    make(map[int]bool),
+		functions: make(map[int]*lintFunction),
+	}
+	err := l.decode()
+	if err != nil {
+		return LintReport{}, err
+	}
+	l.report.Version = l.version
+	l.report.Budget = budget
+
+	start := len(program)
+	if len(l.order) > 0 {
+		start = l.order[0]
+	}
+	l.reach(start)
+	l.checkUnreachable()
+	l.checkFields(kind)
+	l.checkLoops()
+	l.checkCost(start, budget)
+	return l.report, nil
+}
+
+func (l *linter) addFinding(pc int, check string, format string, args ...interface{}) {
+	l.report.Findings = append(l.report.Findings, LintFinding{PC: pc, Check: check, Message: fmt.Sprintf(format, args...)})
+}
+
+// decode splits the program into instructions
+func (l *linter) decode() error {
+	version, vlen := binary.Uvarint(l.program)
+	if vlen <= 0 {
+		return errors.New("invalid version")
+	}
+	if version > LogicVersion {
+		return fmt.Errorf("unsupported version %d", version)
+	}
+	l.version = version
+
+	dis := disassembleState{program: l.program, out: ioutil.Discard, numericTargets: true}
+	dis.pc = vlen
+	for dis.pc < len(l.program) {
+		spec := opsByOpcode[version][l.program[dis.pc]]
+		if spec.Name == "" {
+			return fmt.Errorf("invalid opcode %02x at pc=%d", l.program[dis.pc], dis.pc)
+		}
+		if spec.Size != 0 && dis.pc+spec.Size > len(l.program) {
+			return fmt.Errorf("%s program ends short of immediate values", spec.Name)
+		}
+		line, err := disassemble(&dis, &spec)
+		if err != nil {
+			return err
+		}
+		ins := &lintInstruction{
+			pc:     dis.pc,
+			next:   dis.nextpc,
+			spec:   spec,
+			cost:   spec.OpDetails.Cost(l.program, dis.pc, lintStack),
+			fields: strings.Fields(line),
+			target: -1,
+		}
+		if len(spec.Immediates) == 1 && spec.Immediates[0].kind == immLabel {
+			offset := int(int16(uint16(l.program[dis.pc+1])<<8 | uint16(l.program[dis.pc+2])))
+			ins.target = dis.pc + 3 + offset
+		}
+		l.instrs[ins.pc] = ins
+		l.order = append(l.order, ins.pc)
+		dis.pc = dis.nextpc
+	}
+	return nil
+}
+
+// successors returns where control may go after ins. Calls go both to the
+// subroutine and to the instruction after the callsub. A result equal to the
+// program length means the program ends.
+func (l *linter) successors(ins *lintInstruction) []int {
+	switch {
+	case ins.spec.AlwaysExits() || ins.spec.Name == "retsub":
+		return nil
+	case ins.spec.Name == "b":
+		return []int{ins.target}
+	case ins.target >= 0:
+		return []int{ins.target, ins.next}
+	default:
+		return []int{ins.next}
+	}
+}
+
+// reach marks the instructions reachable from start
+func (l *linter) reach(start int) {
+	queue := []int{start}
+	for len(queue) > 0 {
+		pc := queue[0]
+		queue = queue[1:]
+		ins, ok := l.instrs[pc]
+		if !ok || l.reached[pc] {
+			continue
+		}
+		l.reached[pc] = true
+		queue = append(queue, l.successors(ins)...)
+	}
+}
+
+// checkUnreachable reports each run of instructions that can never execute
+func (l *linter) checkUnreachable() {
+	previous := true
+	for _, pc := range l.order {
+		if !l.reached[pc] && previous {
+			l.addFinding(pc, LintUnreachable, "unreachable code")
+		}
+		previous = l.reached[pc]
+	}
+}
+
+// checkFields reports transaction fields that the program should check but
+// never reads
+func (l *linter) checkFields(kind ProgramKind) {
+	read := make(map[string]bool)
+	for pc := range l.reached {
+		ins := l.instrs[pc]
+		if strings.HasPrefix(ins.spec.Name, "txn") || strings.HasPrefix(ins.spec.Name, "gtxn") {
+			for _, field := range ins.fields[1:] {
+				read[field] = true
+			}
+		}
+	}
+
+	switch kind {
+	case LogicSigKind:
+		if l.version >= rekeyingEnabledVersion && !read["RekeyTo"] {
+			l.addFinding(0, LintRekeyTo, "logic sig never checks RekeyTo, so it can approve rekeying the account")
+		}
+		if !read["CloseRemainderTo"] {
+			l.addFinding(0, LintCloseRemainderTo, "logic sig never checks CloseRemainderTo, so it can approve closing the account")
+		}
+		if !read["AssetCloseTo"] {
+			l.addFinding(0, LintAssetCloseTo, "logic sig never checks AssetCloseTo, so it can approve closing out the account's assets")
+		}
+	case ApprovalKind:
+		if !read["OnCompletion"] {
+			l.addFinding(0, LintOnCompletion, "approval program never checks OnCompletion, so calls that update or delete the application are approved like any other")
+		}
+	}
+}
+
+// checkLoops finds the loops among the reachable instructions, as the
+// strongly connected components of the control flow graph, and reports them
+func (l *linter) checkLoops() {
+	index := make(map[int]int)
+	lowlink := make(map[int]int)
+	onStack := make(map[int]bool)
+	var stack []int
+	next := 0
+
+	var connect func(pc int)
+	connect = func(pc int) {
+		index[pc] = next
+		lowlink[pc] = next
+		next++
+		stack = append(stack, pc)
+		onStack[pc] = true
+
+		selfLoop := false
+		for _, succ := range l.successors(l.instrs[pc]) {
+			if _, ok := l.instrs[succ]; !ok {
+				continue
+			}
+			if succ == pc {
+				selfLoop = true
+			}
+			if _, visited := index[succ]; !visited {
+				connect(succ)
+				if lowlink[succ] < lowlink[pc] {
+					lowlink[pc] = lowlink[succ]
+				}
+			} else if onStack[succ] && index[succ] < lowlink[pc] {
+				lowlink[pc] = index[succ]
+			}
+		}
+
+		if lowlink[pc] != index[pc] {
+			return
+		}
+		var component []int
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == pc {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop {
+			l.loop(component)
+		}
+	}
+
+	for _, pc := range l.order {
+		if _, visited := index[pc]; !visited && l.reached[pc] {
+			connect(pc)
+		}
+	}
+}
+
+// loop reports a loop, distinguishing loops that can never be left
+func (l *linter) loop(component []int) {
+	members := make(map[int]bool, len(component))
+	head := component[0]
+	for _, pc := range component {
+		members[pc] = true
+		l.inLoop[pc] = true
+		if pc < head {
+			head = pc
+		}
+	}
+
+	for _, pc := range component {
+		ins := l.instrs[pc]
+		if ins.spec.AlwaysExits() || ins.spec.Name == "retsub" {
+			l.addFinding(head, LintUnboundedLoop, "loop has no static bound, so its cost can't be estimated")
+			return
+		}
+		for _, succ := range l.successors(ins) {
+			if !members[succ] {
+				l.addFinding(head, LintUnboundedLoop, "loop has no static bound, so its cost can't be estimated")
+				return
+			}
+		}
+	}
+	l.addFinding(head, LintUnboundedLoop, "loop never exits, so it runs until the budget is exhausted")
+}
+
+// function summarizes the subroutine, or the main program, starting at entry
+func (l *linter) function(entry int) *lintFunction {
+	if f, ok := l.functions[entry]; ok {
+		if f == nil {
+			// A recursive call, which is already reported as a loop
+			return &lintFunction{returns: true, ret: lintCost{unbounded: true}}
+		}
+		return f
+	}
+	l.functions[entry] = nil
+
+	f := &lintFunction{exits: make(map[int]lintCost)}
+	end := len(l.program)
+	if _, ok := l.instrs[entry]; !ok {
+		f.exits[end] = lintCost{}
+		l.functions[entry] = f
+		return f
+	}
+
+	// Find the function's instructions and the edges between them. Calls
+	// only lead to the instruction after the callsub, and only if the
+	// subroutine returns.
+	preds := make(map[int][]int)
+	edgeCost := make(map[int]lintCost)
+	var nodes []int
+	seen := map[int]bool{entry: true}
+	queue := []int{entry}
+	for len(queue) > 0 {
+		pc := queue[0]
+		queue = queue[1:]
+		nodes = append(nodes, pc)
+		ins := l.instrs[pc]
+
+		succs := l.successors(ins)
+		edgeCost[pc] = lintCost{cost: ins.cost}
+		if ins.spec.Name == "callsub" {
+			callee := l.function(ins.target)
+			succs = nil
+			if callee.returns {
+				succs = []int{ins.next}
+				edgeCost[pc] = edgeCost[pc].plus(callee.ret)
+			}
+		}
+		for _, succ := range succs {
+			if _, ok := l.instrs[succ]; !ok {
+				continue
+			}
+			preds[succ] = append(preds[succ], pc)
+			if !seen[succ] {
+				seen[succ] = true
+				queue = append(queue, succ)
+			}
+		}
+	}
+
+	// The worst-case cost of reaching each instruction. Instructions in
+	// loops are unbounded, and since every cycle passes through one, the
+	// remaining predecessors form a DAG.
+	dist := make(map[int]lintCost)
+	var distance func(pc int) lintCost
+	distance = func(pc int) lintCost {
+		if d, ok := dist[pc]; ok {
+			return d
+		}
+		var d lintCost
+		if l.inLoop[pc] {
+			d.unbounded = true
+		}
+		for _, pred := range preds[pc] {
+			if l.inLoop[pred] {
+				d.unbounded = true
+				continue
+			}
+			d = d.max(distance(pred).plus(edgeCost[pred]))
+		}
+		dist[pc] = d
+		return d
+	}
+
+	addExit := func(pc int, c lintCost) {
+		if prev, ok := f.exits[pc]; ok {
+			c = c.max(prev)
+		}
+		f.exits[pc] = c
+	}
+	for _, pc := range nodes {
+		ins := l.instrs[pc]
+		done := distance(pc).plus(lintCost{cost: ins.cost})
+		switch {
+		case ins.spec.AlwaysExits():
+			addExit(pc, done)
+		case ins.spec.Name == "retsub":
+			f.returns = true
+			f.ret = f.ret.max(done)
+		case ins.spec.Name == "callsub":
+			callee := l.function(ins.target)
+			for exit, c := range callee.exits {
+				addExit(exit, done.plus(c))
+			}
+			if callee.returns && ins.next == end {
+				addExit(end, done.plus(callee.ret))
+			}
+		default:
+			for _, succ := range l.successors(ins) {
+				if succ == end {
+					addExit(end, done)
+				}
+			}
+		}
+	}
+
+	l.functions[entry] = f
+	return f
+}
+
+// checkCost estimates the worst-case cost of each path through the program
+func (l *linter) checkCost(start int, budget int) {
+	main := l.function(start)
+	for pc, c := range main.exits {
+		l.report.Paths = append(l.report.Paths, LintPath{ExitPC: pc, Cost: c.cost, Unbounded: c.unbounded})
+		if c.cost > l.report.MaxCost {
+			l.report.MaxCost = c.cost
+		}
+	}
+	sort.Slice(l.report.Paths, func(i, j int) bool { return l.report.Paths[i].ExitPC < l.report.Paths[j].ExitPC })
+
+	if l.version < backBranchEnabledVersion {
+		l.report.MaxCost = 0
+		for _, pc := range l.order {
+			l.report.MaxCost += l.instrs[pc].cost
+		}
+		if budget > 0 && l.report.MaxCost > budget {
+			l.addFinding(0, LintCost, "program cost %d exceeds the budget of %d", l.report.MaxCost, budget)
+		}
+		return
+	}
+
+	if budget <= 0 {
+		return
+	}
+	for _, path := range l.report.Paths {
+		if path.Cost > budget {
+			l.addFinding(path.ExitPC, LintCost, "paths ending here cost up to %d, over the budget of %d", path.Cost, budget)
+		}
+	}
+}
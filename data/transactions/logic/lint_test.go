@@ -0,0 +1,280 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func lintProg(t *testing.T, source string, kind ProgramKind, budget int) LintReport {
+	t.Helper()
+	_, report := lintOps(t, source, kind, budget)
+	return report
+}
+
+func lintOps(t *testing.T, source string, kind ProgramKind, budget int) (*OpStream, LintReport) {
+	t.Helper()
+	ops, err := AssembleString(source)
+	require.NoError(t, err, ops.Errors)
+	report, err := Lint(ops.Program, kind, budget)
+	require.NoError(t, err)
+	return ops, report
+}
+
+// linePC returns the offset of the first instruction assembled from line
+func linePC(ops *OpStream, line int) int {
+	pc := -1
+	for offset, l := range ops.OffsetToLine {
+		if l == line && (pc == -1 || offset < pc) {
+			pc = offset
+		}
+	}
+	return pc
+}
+
+func lintChecks(report LintReport) map[string][]int {
+	checks := make(map[string][]int)
+	for _, finding := range report.Findings {
+		checks[finding.Check] = append(checks[finding.Check], finding.PC)
+	}
+	return checks
+}
+
+const safeSig = `#pragma version 5
+txn RekeyTo
+global ZeroAddress
+==
+txn CloseRemainderTo
+global ZeroAddress
+==
+&&
+txn AssetCloseTo
+global ZeroAddress
+==
+&&
+`
+
+func TestLintLogicSigFields(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	report := lintProg(t, safeSig, LogicSigKind, 0)
+	require.Empty(t, report.Findings)
+	require.Equal(t, uint64(5), report.Version)
+
+	report = lintProg(t, "#pragma version 5\nint 1", LogicSigKind, 0)
+	checks := lintChecks(report)
+	require.Len(t, checks, 3)
+	require.Contains(t, checks, LintRekeyTo)
+	require.Contains(t, checks, LintCloseRemainderTo)
+	require.Contains(t, checks, LintAssetCloseTo)
+
+	// Rekeying didn't exist before v2
+	report = lintProg(t, "#pragma version 1\nint 1", LogicSigKind, 0)
+	require.NotContains(t, lintChecks(report), LintRekeyTo)
+
+	// Checks of other transactions in the group count
+	report = lintProg(t, `#pragma version 5
+gtxn 1 RekeyTo
+gtxn 1 CloseRemainderTo
+==
+gtxn 1 AssetCloseTo
+gtxn 1 Sender
+==
+&&`, LogicSigKind, 0)
+	require.Empty(t, report.Findings)
+
+	// Checks in unreachable code don't count
+	ops, report := lintOps(t, `#pragma version 5
+int 1
+return
+txn RekeyTo
+txn CloseRemainderTo
+txn AssetCloseTo`, LogicSigKind, 0)
+	checks = lintChecks(report)
+	require.Contains(t, checks, LintRekeyTo)
+	require.Equal(t, []int{linePC(ops, 3)}, checks[LintUnreachable])
+
+	// Application programs don't need the logic sig checks
+	report = lintProg(t, "#pragma version 5\nint 1", ClearStateKind, 0)
+	require.Empty(t, report.Findings)
+}
+
+func TestLintOnCompletion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	report := lintProg(t, "#pragma version 5\nint 1", ApprovalKind, 0)
+	require.Contains(t, lintChecks(report), LintOnCompletion)
+
+	report = lintProg(t, "#pragma version 5\ntxn OnCompletion\nint NoOp\n==", ApprovalKind, 0)
+	require.Empty(t, report.Findings)
+}
+
+func TestLintUnreachable(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	report := lintProg(t, `#pragma version 5
+int 1
+bnz skip
+err
+skip:
+int 1`, ClearStateKind, 0)
+	require.Empty(t, report.Findings)
+
+	// The int 2; pop run is reported once, at its start
+	ops, report := lintOps(t, `#pragma version 5
+b done
+int 2
+pop
+done:
+int 1`, ClearStateKind, 0)
+	require.Equal(t, map[string][]int{LintUnreachable: {linePC(ops, 2)}}, lintChecks(report))
+
+	// Code after a subroutine's retsub is reachable through the callsub
+	report = lintProg(t, `#pragma version 5
+callsub sub
+int 1
+return
+sub:
+retsub`, ClearStateKind, 0)
+	require.Empty(t, report.Findings)
+}
+
+func TestLintLoops(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops, report := lintOps(t, `#pragma version 5
+int 10
+loop:
+int 1
+-
+dup
+bnz loop
+pop
+int 1`, ClearStateKind, 0)
+	require.Equal(t, map[string][]int{LintUnboundedLoop: {linePC(ops, 3)}}, lintChecks(report))
+	require.Contains(t, report.Findings[0].Message, "no static bound")
+	require.Len(t, report.Paths, 1)
+	require.True(t, report.Paths[0].Unbounded)
+
+	ops, report = lintOps(t, `#pragma version 5
+int 1
+loop:
+b loop`, ClearStateKind, 0)
+	require.Equal(t, map[string][]int{LintUnboundedLoop: {linePC(ops, 3)}}, lintChecks(report))
+	require.Contains(t, report.Findings[0].Message, "never exits")
+	require.Empty(t, report.Paths)
+
+	// Recursion is a loop too
+	report = lintProg(t, `#pragma version 5
+int 1
+callsub sub
+return
+sub:
+dup
+bz done
+int 1
+-
+callsub sub
+done:
+retsub`, ClearStateKind, 0)
+	require.Contains(t, lintChecks(report), LintUnboundedLoop)
+	require.Len(t, report.Paths, 1)
+	require.True(t, report.Paths[0].Unbounded)
+}
+
+func TestLintCost(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// The expensive branch sets the cost of the path ending at the end of
+	// the program, while the err path is cheap
+	source := `#pragma version 5
+txn Fee
+bz fail
+byte 0x00
+sha256
+keccak256
+pop
+int 1
+return
+fail:
+err`
+	ops, err := AssembleString(source)
+	require.NoError(t, err)
+	report := lintProg(t, source, ClearStateKind, 0)
+	require.Empty(t, report.Findings)
+	require.Len(t, report.Paths, 2)
+	require.Equal(t, 1+1+1+35+130+1+1+1, report.Paths[0].Cost)
+	require.Equal(t, len(ops.Program)-1, report.Paths[1].ExitPC)
+	require.Equal(t, 1+1+1, report.Paths[1].Cost)
+	require.Equal(t, report.Paths[0].Cost, report.MaxCost)
+
+	report = lintProg(t, source, ClearStateKind, 100)
+	require.Equal(t, map[string][]int{LintCost: {report.Paths[0].ExitPC}}, lintChecks(report))
+
+	// Subroutines are costed at each call
+	report = lintProg(t, `#pragma version 5
+byte 0x00
+callsub hash
+callsub hash
+pop
+int 1
+return
+hash:
+keccak256
+retsub`, ClearStateKind, 0)
+	require.Equal(t, 1+2*(1+130+1)+1+1+1, report.MaxCost)
+
+	// Lengthy arguments are assumed for opcodes whose cost depends on them
+	report = lintProg(t, "#pragma version 7\nbyte 0x00\nbase64_decode StdEncoding\nlen", ClearStateKind, 0)
+	require.Greater(t, report.MaxCost, 100)
+
+	// Before v4 every instruction counts against the budget
+	report = lintProg(t, `#pragma version 3
+int 1
+bnz skip
+byte 0x00
+sha256
+pop
+skip:
+int 1`, ClearStateKind, 30)
+	// intcblock and bytecblock included
+	require.Equal(t, 2+1+1+1+35+1+1, report.MaxCost)
+	require.Equal(t, map[string][]int{LintCost: {0}}, lintChecks(report))
+}
+
+func TestLintErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, err := Lint(nil, LogicSigKind, 0)
+	require.Error(t, err)
+
+	_, err = Lint([]byte{0x05, 0xff}, LogicSigKind, 0)
+	require.Error(t, err)
+
+	// A branch missing its offset
+	_, err = Lint([]byte{0x05, 0x40, 0x00}, LogicSigKind, 0)
+	require.Error(t, err)
+}
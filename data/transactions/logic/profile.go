@@ -0,0 +1,364 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// Profiler records the cost of every opcode executed by the evaluations it is
+// attached to through EvalParams.Profiler. Each cost is attributed to the
+// opcode, the subroutines it was called from, its program, and, for inner
+// application calls, the programs that called it, so the profile covers the
+// budget pooled across a group. WriteProfile writes the result in the pprof
+// format read by `go tool pprof`. A Profiler may be shared by concurrent
+// evaluations.
+type Profiler struct {
+	mu       deadlock.Mutex
+	programs map[crypto.Digest]*profileProgram
+	samples  map[string]*profileSample
+}
+
+// profileProgram is a program that executed, or whose source was added
+type profileProgram struct {
+	name   string
+	lines  map[int]int    // pc -> zero-based line
+	files  map[int]string // pc -> source name, if the source was added
+	source string
+}
+
+// profileFunction is a function of the profile. Opcodes are functions named
+// after the opcode, with program unset. Subroutines are identified by the pc
+// of their first instruction, and programs by an entry of -1. Transactions of
+// the group have an unset program, and are named after their index.
+type profileFunction struct {
+	name    string
+	program crypto.Digest
+	entry   int
+	file    string
+}
+
+// profileLocation is a frame of a sample's stack
+type profileLocation struct {
+	function profileFunction
+	program  crypto.Digest
+	pc       int
+}
+
+type profileSample struct {
+	stack []profileLocation // leaf first
+	cost  int64
+	count int64
+}
+
+// NewProfiler creates an empty Profiler
+func NewProfiler() *Profiler {
+	return &Profiler{
+		programs: make(map[crypto.Digest]*profileProgram),
+		samples:  make(map[string]*profileSample),
+	}
+}
+
+// AddSource lets the profile refer to the lines of the source program was
+// assembled from, as described by sm. Otherwise, the profile refers to lines
+// of the program's disassembly.
+func (p *Profiler) AddSource(program []byte, sm SourceMap) error {
+	locations, err := sm.Locations()
+	if err != nil {
+		return err
+	}
+	prog := &profileProgram{lines: make(map[int]int), files: make(map[int]string)}
+	for pc, location := range locations {
+		prog.lines[pc] = location.Line
+		prog.files[pc] = sm.Sources[location.Source]
+	}
+	if len(sm.Sources) > 0 {
+		prog.source = sm.Sources[0]
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	hash := HashProgram(program)
+	if existing, ok := p.programs[hash]; ok {
+		prog.name = existing.name
+	}
+	p.programs[hash] = prog
+	return nil
+}
+
+// program returns the profileProgram for cx's program, creating it on its
+// first execution. The caller holds p.mu.
+func (p *Profiler) program(cx *EvalContext) (crypto.Digest, *profileProgram) {
+	hash := cx.programHash()
+	prog, ok := p.programs[hash]
+	if !ok {
+		prog = &profileProgram{}
+		p.programs[hash] = prog
+	}
+	if prog.name == "" {
+		if cx.runModeFlags == modeSig {
+			prog.name = "logicsig " + basics.Address(hash).String()
+		} else {
+			prog.name = fmt.Sprintf("app %d", cx.appID)
+		}
+	}
+	if prog.lines == nil {
+		disassembly, info, err := disassembleInstrumented(cx.program, nil)
+		prog.lines = make(map[int]int, len(info.pcOffset))
+		if err == nil {
+			ds := DebugState{Disassembly: disassembly, PCOffset: info.pcOffset}
+			for _, offset := range info.pcOffset {
+				prog.lines[offset.PC] = ds.PCToLine(offset.PC)
+			}
+		}
+		prog.source = prog.name + ".teal"
+	}
+	return hash, prog
+}
+
+// record adds the cost of the opcode cx is about to execute
+func (p *Profiler) record(cx *EvalContext, cost int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var stack []profileLocation
+	top := cx
+	for c := cx; c != nil; c = c.caller {
+		hash, prog := p.program(c)
+		pc := c.pc
+		op := profileFunction{name: opsByOpcode[c.version][c.program[pc]].Name, file: prog.file(pc)}
+		stack = append(stack, profileLocation{function: op, program: hash, pc: pc})
+		for i := len(c.callstack) - 1; i >= 0; i-- {
+			// callsub's immediate is the offset of the subroutine from the
+			// instruction after it, which is the return address
+			ret := c.callstack[i]
+			entry := ret + int(int16(uint16(c.program[ret-2])<<8|uint16(c.program[ret-1])))
+			sub := profileFunction{program: hash, entry: entry, file: prog.file(entry)}
+			stack = append(stack, profileLocation{function: sub, program: hash, pc: pc})
+			pc = ret - 3
+		}
+		root := profileFunction{program: hash, entry: -1, file: prog.source}
+		stack = append(stack, profileLocation{function: root, program: hash, pc: pc})
+		top = c
+	}
+	txn := profileFunction{name: fmt.Sprintf("tx[%d]", top.groupIndex)}
+	stack = append(stack, profileLocation{function: txn, pc: -1})
+
+	key := profileKey(stack)
+	sample, ok := p.samples[key]
+	if !ok {
+		sample = &profileSample{stack: stack}
+		p.samples[key] = sample
+	}
+	sample.cost += int64(cost)
+	sample.count++
+}
+
+// profileKey identifies a stack among the samples
+func profileKey(stack []profileLocation) string {
+	var key []byte
+	for _, loc := range stack {
+		key = append(key, loc.function.name...)
+		key = append(key, 0)
+		key = append(key, loc.program[:]...)
+		key = strconv.AppendInt(key, int64(loc.function.entry), 10)
+		key = append(key, ':')
+		key = strconv.AppendInt(key, int64(loc.pc), 10)
+		key = append(key, ';')
+	}
+	return string(key)
+}
+
+// file returns the source name of the instruction at pc
+func (prog *profileProgram) file(pc int) string {
+	if file, ok := prog.files[pc]; ok {
+		return file
+	}
+	return prog.source
+}
+
+// name returns the name of f in the profile
+func (p *Profiler) name(f profileFunction) string {
+	if f.name != "" {
+		return f.name
+	}
+	prog := p.programs[f.program]
+	if f.entry < 0 {
+		return prog.name
+	}
+	return fmt.Sprintf("%s sub at %s:%d", prog.name, prog.file(f.entry), prog.lines[f.entry]+1)
+}
+
+// WriteProfile writes the costs recorded so far as a gzipped pprof profile.
+// Samples have two values: the cost of the opcodes, and how many were
+// executed.
+func (p *Profiler) WriteProfile(w io.Writer) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stringTable := []string{""}
+	stringIDs := map[string]uint64{"": 0}
+	str := func(s string) uint64 {
+		id, ok := stringIDs[s]
+		if !ok {
+			id = uint64(len(stringTable))
+			stringIDs[s] = id
+			stringTable = append(stringTable, s)
+		}
+		return id
+	}
+	valueType := func(typ, unit string) *protoWriter {
+		var vt protoWriter
+		vt.uint64(1, str(typ))
+		vt.uint64(2, str(unit))
+		return &vt
+	}
+
+	var out protoWriter
+	out.message(1, valueType("cost", "count"))
+	out.message(1, valueType("opcodes", "count"))
+
+	// Sort the samples so the output is deterministic
+	keys := make([]string, 0, len(p.samples))
+	for key := range p.samples {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var functions, locations protoWriter
+	functionIDs := make(map[profileFunction]uint64)
+	locationIDs := make(map[profileLocation]uint64)
+	for _, key := range keys {
+		sample := p.samples[key]
+		ids := make([]uint64, len(sample.stack))
+		for i, loc := range sample.stack {
+			id, ok := locationIDs[loc]
+			if !ok {
+				fid, ok := functionIDs[loc.function]
+				if !ok {
+					fid = uint64(len(functionIDs) + 1)
+					functionIDs[loc.function] = fid
+					var f protoWriter
+					f.uint64(1, fid)
+					f.uint64(2, str(p.name(loc.function)))
+					f.uint64(4, str(loc.function.file))
+					if loc.function.name == "" && loc.function.entry >= 0 {
+						f.uint64(5, uint64(p.programs[loc.function.program].lines[loc.function.entry]+1))
+					}
+					functions.message(5, &f)
+				}
+
+				id = uint64(len(locationIDs) + 1)
+				locationIDs[loc] = id
+				var line protoWriter
+				line.uint64(1, fid)
+				if loc.pc >= 0 {
+					line.uint64(2, uint64(p.programs[loc.program].lines[loc.pc]+1))
+				}
+				var l protoWriter
+				l.uint64(1, id)
+				if loc.pc >= 0 {
+					l.uint64(3, uint64(loc.pc))
+				}
+				l.message(4, &line)
+				locations.message(4, &l)
+			}
+			ids[i] = id
+		}
+
+		var s protoWriter
+		s.packed(1, ids)
+		s.packed(2, []uint64{uint64(sample.cost), uint64(sample.count)})
+		out.message(2, &s)
+	}
+	out.buf = append(out.buf, locations.buf...)
+	out.buf = append(out.buf, functions.buf...)
+
+	// Look up the remaining strings before writing the string table
+	period := valueType("cost", "count")
+	defaultType := str("cost")
+	for _, s := range stringTable {
+		out.string(6, s)
+	}
+	out.message(11, period)
+	out.uint64(12, 1)
+	out.uint64(14, defaultType)
+
+	gz := gzip.NewWriter(w)
+	_, err := gz.Write(out.buf)
+	if err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// protoWriter encodes the protocol buffer messages of a pprof profile
+type protoWriter struct {
+	buf []byte
+}
+
+func (pw *protoWriter) varint(v uint64) {
+	for v >= 0x80 {
+		pw.buf = append(pw.buf, byte(v)|0x80)
+		v >>= 7
+	}
+	pw.buf = append(pw.buf, byte(v))
+}
+
+func (pw *protoWriter) key(field int, wireType int) {
+	pw.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+// uint64 writes a varint field, omitting it if zero like proto3 does
+func (pw *protoWriter) uint64(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+	pw.key(field, 0)
+	pw.varint(v)
+}
+
+func (pw *protoWriter) bytes(field int, b []byte) {
+	pw.key(field, 2)
+	pw.varint(uint64(len(b)))
+	pw.buf = append(pw.buf, b...)
+}
+
+func (pw *protoWriter) string(field int, s string) {
+	pw.bytes(field, []byte(s))
+}
+
+func (pw *protoWriter) message(field int, m *protoWriter) {
+	pw.bytes(field, m.buf)
+}
+
+func (pw *protoWriter) packed(field int, vs []uint64) {
+	var p protoWriter
+	for _, v := range vs {
+		p.varint(v)
+	}
+	pw.bytes(field, p.buf)
+}
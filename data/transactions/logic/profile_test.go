@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+// protoFields decodes the varint and length-delimited fields of a protocol
+// buffer message, which are all a pprof profile uses
+func protoFields(t *testing.T, msg []byte) map[int][][]byte {
+	fields := make(map[int][][]byte)
+	for len(msg) > 0 {
+		key, n := binary.Uvarint(msg)
+		require.Greater(t, n, 0)
+		msg = msg[n:]
+		switch key & 7 {
+		case 0:
+			_, n = binary.Uvarint(msg)
+			require.Greater(t, n, 0)
+		case 2:
+			length, m := binary.Uvarint(msg)
+			require.Greater(t, m, 0)
+			msg = msg[m:]
+			n = int(length)
+		default:
+			require.Fail(t, "unexpected wire type", key&7)
+		}
+		fields[int(key>>3)] = append(fields[int(key>>3)], msg[:n])
+		msg = msg[n:]
+	}
+	return fields
+}
+
+// protoVarints decodes a varint field, or a packed repeated one
+func protoVarints(values [][]byte) []uint64 {
+	var ints []uint64
+	for _, value := range values {
+		for len(value) > 0 {
+			v, n := binary.Uvarint(value)
+			ints = append(ints, v)
+			value = value[n:]
+		}
+	}
+	return ints
+}
+
+// profileStacks decodes a profile into the total of each sample value by stack,
+// with each stack written as its function names and lines, root first
+func profileStacks(t *testing.T, profile []byte) map[string][2]uint64 {
+	gz, err := gzip.NewReader(bytes.NewReader(profile))
+	require.NoError(t, err)
+	raw, err := ioutil.ReadAll(gz)
+	require.NoError(t, err)
+
+	fields := protoFields(t, raw)
+	strs := make([]string, len(fields[6]))
+	for i, s := range fields[6] {
+		strs[i] = string(s)
+	}
+	require.Equal(t, "", strs[0])
+
+	names := make(map[uint64]string)
+	for _, f := range fields[5] {
+		ff := protoFields(t, f)
+		names[protoVarints(ff[1])[0]] = strs[protoVarints(ff[2])[0]]
+	}
+	frames := make(map[uint64]string)
+	for _, l := range fields[4] {
+		lf := protoFields(t, l)
+		line := protoFields(t, lf[4][0])
+		frame := names[protoVarints(line[1])[0]]
+		if lines := protoVarints(line[2]); len(lines) > 0 {
+			frame += ":" + strconv.FormatUint(lines[0], 10)
+		}
+		frames[protoVarints(lf[1])[0]] = frame
+	}
+
+	stacks := make(map[string][2]uint64)
+	for _, s := range fields[2] {
+		sf := protoFields(t, s)
+		var stack []string
+		for _, id := range protoVarints(sf[1]) {
+			stack = append([]string{frames[id]}, stack...)
+		}
+		values := protoVarints(sf[2])
+		require.Len(t, values, 2)
+		key := strings.Join(stack, " > ")
+		total := stacks[key]
+		stacks[key] = [2]uint64{total[0] + values[0], total[1] + values[1]}
+	}
+	return stacks
+}
+
+func TestProfiler(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := makeSampleEnv()
+	inner := testProg(t, "pushint 1", AssemblerMaxVersion)
+	ledger.NewApp(tx.Receiver, 222, basics.AppParams{ApprovalProgram: inner.Program})
+	ledger.NewApp(tx.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(appAddr(888), 50_000)
+	tx.ForeignApps = []basics.AppIndex{222}
+
+	profiler := NewProfiler()
+	ep.Profiler = profiler
+	testApp(t, `callsub waste
+itxn_begin
+pushint 6; itxn_field TypeEnum
+pushint 222; itxn_field ApplicationID
+itxn_submit
+pushint 1
+return
+waste:
+pushbytes "x"; keccak256; pop
+retsub`, ep)
+
+	var profile bytes.Buffer
+	require.NoError(t, profiler.WriteProfile(&profile))
+	stacks := profileStacks(t, profile.Bytes())
+
+	// Lines are those of the disassembly, which puts the #pragma on the
+	// first line, one instruction on each line after it, and labels the
+	// subroutine
+	sub := "tx[0] > app 888:2 > app 888 sub at app 888.teal:12"
+	require.Equal(t, map[string][2]uint64{
+		"tx[0] > app 888:2 > callsub:2":                             {1, 1},
+		sub + ":12 > pushbytes:12":                                  {1, 1},
+		sub + ":13 > keccak256:13":                                  {130, 1},
+		sub + ":14 > pop:14":                                        {1, 1},
+		sub + ":15 > retsub:15":                                     {1, 1},
+		"tx[0] > app 888:3 > itxn_begin:3":                          {1, 1},
+		"tx[0] > app 888:4 > pushint:4":                             {1, 1},
+		"tx[0] > app 888:5 > itxn_field:5":                          {1, 1},
+		"tx[0] > app 888:6 > pushint:6":                             {1, 1},
+		"tx[0] > app 888:7 > itxn_field:7":                          {1, 1},
+		"tx[0] > app 888:8 > itxn_submit:8":                         {1, 1},
+		"tx[0] > app 888:8 > itxn_submit:8 > app 222:2 > pushint:2": {1, 1},
+		"tx[0] > app 888:9 > pushint:9":                             {1, 1},
+		"tx[0] > app 888:10 > return:10":                            {1, 1},
+	}, stacks)
+
+	// With the source added, lines are those of the source
+	ops := testProg(t, "int 1\npushint 2\n+\n", AssemblerMaxVersion)
+	require.NoError(t, profiler.AddSource(ops.Program, GetSourceMap([]string{"add.teal"}, ops.OffsetToLine)))
+	testAppBytes(t, ops.Program, ep)
+	profile.Reset()
+	require.NoError(t, profiler.WriteProfile(&profile))
+	stacks = profileStacks(t, profile.Bytes())
+	require.Equal(t, [2]uint64{1, 1}, stacks["tx[0] > app 888:3 > +:3"])
+}
@@ -18,6 +18,7 @@ package logic
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 )
 
@@ -39,21 +40,39 @@ type SourceMap struct {
 	Mapping    string   `json:"mapping"`
 }
 
+// SourceLocation identifies a line in one of the sources an OpStream was
+// assembled from
+type SourceLocation struct {
+	Source int // index into OpStream.Sources
+	Line   int // zero-based line number
+}
+
 // GetSourceMap returns a struct containing details about
 // the assembled file and encoded mappings to the source file.
 func GetSourceMap(sourceNames []string, offsetToLine map[int]int) SourceMap {
+	offsetToSource := make(map[int]SourceLocation, len(offsetToLine))
+	for pc, line := range offsetToLine {
+		offsetToSource[pc] = SourceLocation{Line: line}
+	}
+	return GetSourceMapWithSources(sourceNames, offsetToSource)
+}
+
+// GetSourceMapWithSources is like GetSourceMap, for programs assembled from
+// several sources, such as those using #include. Each offset maps to an
+// index into sourceNames and a line of that source.
+func GetSourceMapWithSources(sourceNames []string, offsetToSource map[int]SourceLocation) SourceMap {
 	maxPC := 0
-	for pc := range offsetToLine {
+	for pc := range offsetToSource {
 		if pc > maxPC {
 			maxPC = pc
 		}
 	}
 
-	// Array where index is the PC and value is the line.
+	// Array where index is the PC and value is the source and line.
 	pcToLine := make([]string, maxPC+1)
 	for pc := range pcToLine {
-		if line, ok := offsetToLine[pc]; ok {
-			pcToLine[pc] = MakeSourceMapLine(0, 0, line, 0)
+		if location, ok := offsetToSource[pc]; ok {
+			pcToLine[pc] = MakeSourceMapLine(0, location.Source, location.Line, 0)
 		} else {
 			pcToLine[pc] = ""
 		}
@@ -70,6 +89,57 @@ func GetSourceMap(sourceNames []string, offsetToLine map[int]int) SourceMap {
 	}
 }
 
+// Locations decodes the mapping of a source map made by GetSourceMap or
+// GetSourceMapWithSources, returning the source location of each offset
+func (sm SourceMap) Locations() (map[int]SourceLocation, error) {
+	locations := make(map[int]SourceLocation)
+	for pc, segment := range strings.Split(sm.Mapping, ";") {
+		if segment == "" {
+			continue
+		}
+		values, err := vlqToInts(segment)
+		if err != nil {
+			return nil, fmt.Errorf("pc %d: %w", pc, err)
+		}
+		if len(values) != 4 {
+			return nil, fmt.Errorf("pc %d: segment has %d values, expected 4", pc, len(values))
+		}
+		if values[1] < 0 || values[1] >= len(sm.Sources) {
+			return nil, fmt.Errorf("pc %d: source %d out of range", pc, values[1])
+		}
+		locations[pc] = SourceLocation{Source: values[1], Line: values[2]}
+	}
+	return locations, nil
+}
+
+// vlqToInts decodes the values written by intToVLQ
+func vlqToInts(segment string) ([]int, error) {
+	var values []int
+	v, shift := 0, uint(0)
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(b64table, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid character %q in mapping", segment[i])
+		}
+		v |= (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+		if v&1 != 0 {
+			v = -(v >> 1)
+		} else {
+			v >>= 1
+		}
+		values = append(values, v)
+		v, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated value in mapping")
+	}
+	return values, nil
+}
+
 // intToVLQ writes out value to bytes.Buffer
 func intToVLQ(v int, buf *bytes.Buffer) {
 	v <<= 1
@@ -51,6 +51,30 @@ func TestGetSourceMap(t *testing.T) {
 	}
 }
 
+func TestGetSourceMapWithSources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	sourceNames := []string{"test.teal", "lib.teal"}
+	offsetToSource := map[int]SourceLocation{
+		1: {Source: 0, Line: 1},
+		2: {Source: 1, Line: 0},
+		5: {Source: 0, Line: 2},
+	}
+	actualSourceMap := GetSourceMapWithSources(sourceNames, offsetToSource)
+	a.Equal(sourceNames, actualSourceMap.Sources)
+
+	splitMapping := strings.Split(actualSourceMap.Mapping, ";")
+	a.Len(splitMapping, 6)
+	for pc := range splitMapping {
+		if location, ok := offsetToSource[pc]; ok {
+			a.Equal(MakeSourceMapLine(0, location.Source, location.Line, 0), splitMapping[pc])
+		} else {
+			a.Equal("", splitMapping[pc])
+		}
+	}
+}
+
 func TestVLQ(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
@@ -2288,6 +2288,19 @@ func accountsPutTotals(tx *sql.Tx, totals ledgercore.AccountTotals, catchpointSt
 	return err
 }
 
+// accountsReader provides the point lookups the account updates tracker performs
+// against the persisted account state. Each result carries the round the storage
+// was at when the lookup was performed.
+type accountsReader interface {
+	listCreatables(maxIdx basics.CreatableIndex, maxResults uint64, ctype basics.CreatableType) (results []basics.CreatableLocator, dbRound basics.Round, err error)
+	lookupCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (addr basics.Address, ok bool, dbRound basics.Round, err error)
+	lookup(addr basics.Address) (data persistedAccountData, err error)
+	lookupResources(addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (data persistedResourcesData, err error)
+	lookupAllResources(addr basics.Address) (data []persistedResourcesData, rnd basics.Round, err error)
+
+	close()
+}
+
 type accountsWriter interface {
 	insertAccount(addr basics.Address, normBalance uint64, data baseAccountData) (rowid int64, err error)
 	deleteAccount(rowid int64) (rowsAffected int64, err error)
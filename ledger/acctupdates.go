@@ -129,8 +129,8 @@ type accountUpdates struct {
 	// Connection to the database.
 	dbs db.Pair
 
-	// Prepared SQL statements for fast accounts DB lookups.
-	accountsq *accountsDbQueries
+	// Persisted account state lookups; backed by prepared SQL statements.
+	accountsq accountsReader
 
 	// cachedDBRound is always exactly tracker DB round (and therefore, accountsRound()),
 	// cached to use in lookup functions
@@ -196,6 +196,11 @@ type accountUpdates struct {
 
 	// lastMetricsLogTime is the time when the previous metrics logging occurred
 	lastMetricsLogTime time.Time
+
+	// maxAcctLookback is the minimum number of rounds before the latest one
+	// whose deltas are kept in memory. The consensus balance lookback applies
+	// when it is larger.
+	maxAcctLookback uint64
 }
 
 // RoundOffsetError is an error for when requested round is behind earliest stored db entry
@@ -208,6 +213,11 @@ func (e *RoundOffsetError) Error() string {
 	return fmt.Sprintf("round %d before dbRound %d", e.round, e.dbRound)
 }
 
+// DBRound returns the round of the account database when the error occurred
+func (e *RoundOffsetError) DBRound() basics.Round {
+	return e.dbRound
+}
+
 // StaleDatabaseRoundError is generated when we detect that the database round is behind the accountUpdates in-memory dbRound. This
 // should never happen, since we update the database first, and only upon a successful update we update the in-memory dbRound.
 type StaleDatabaseRoundError struct {
@@ -263,6 +273,8 @@ func (au *accountUpdates) initialize(cfg config.Local) {
 	// log metrics
 	au.logAccountUpdatesMetrics = cfg.EnableAccountUpdatesStats
 	au.logAccountUpdatesInterval = cfg.AccountUpdatesStatsInterval
+
+	au.maxAcctLookback = cfg.MaxAcctLookback
 }
 
 // loadFromDisk is the 2nd level initialization, and is required before the accountUpdates becomes functional
@@ -543,6 +555,11 @@ func (au *accountUpdates) committedUpTo(committedRound basics.Round) (retRound,
 
 	retRound = basics.Round(0)
 	lookback = basics.Round(config.Consensus[au.versions[len(au.versions)-1]].MaxBalLookback)
+	// keeping the deltas of maxAcctLookback rounds before the latest one
+	// requires committing one round less
+	if basics.Round(au.maxAcctLookback)+1 > lookback {
+		lookback = basics.Round(au.maxAcctLookback) + 1
+	}
 	if committedRound < lookback {
 		return
 	}
@@ -634,6 +651,158 @@ func (au *accountUpdates) LatestTotals() (basics.Round, ledgercore.AccountTotals
 	return au.latestTotalsImpl()
 }
 
+// Totals returns the totals of all accounts at the end of round rnd.
+func (au *accountUpdates) Totals(rnd basics.Round) (ledgercore.AccountTotals, error) {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+	offset, err := au.roundOffset(rnd)
+	if err != nil {
+		return ledgercore.AccountTotals{}, err
+	}
+	return au.roundTotals[offset], nil
+}
+
+// lookupWithResources returns the account data of addr as of round rnd, including all of its asset and
+// application resources, with pending rewards applied up to rnd. The round needs to be within the
+// in-memory deltas window.
+func (au *accountUpdates) lookupWithResources(rnd basics.Round, addr basics.Address) (data basics.AccountData, withoutRewards basics.MicroAlgos, err error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+	type resourceKey struct {
+		cidx  basics.CreatableIndex
+		ctype basics.CreatableType
+	}
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		var offset uint64
+		offset, err = au.roundOffset(rnd)
+		if err != nil {
+			return basics.AccountData{}, basics.MicroAlgos{}, err
+		}
+		rewardsProto := config.Consensus[au.versions[offset]]
+		rewardsLevel := au.roundTotals[offset].RewardsLevel
+
+		// the account data at rnd is the last update within the deltas [0..offset), if any.
+		var ad ledgercore.AccountData
+		foundAccount := false
+		if _, indeltas := au.accounts[addr]; indeltas {
+			for i := offset; i > 0 && !foundAccount; i-- {
+				ad, foundAccount = au.deltas[i-1].GetData(addr)
+			}
+		}
+		if !foundAccount {
+			if pad, has := au.baseAccounts.read(addr); has && pad.round == currentDbRound {
+				ad = pad.accountData.GetLedgerCoreAccountData()
+				foundAccount = true
+			}
+		}
+
+		// the resources modified within the deltas [0..offset) override the ones stored in the database.
+		// Deleted resources carry no data, so the type is taken from the delta key rather than its value.
+		updated := make(map[resourceKey]ledgercore.AccountResource)
+		for cidx := range au.resources.getForAddress(addr) {
+			for _, ctype := range []basics.CreatableType{basics.AssetCreatable, basics.AppCreatable} {
+				for i := offset; i > 0; i-- {
+					if res, ok := au.deltas[i-1].GetResource(addr, cidx, ctype); ok {
+						updated[resourceKey{cidx, ctype}] = res
+						break
+					}
+				}
+			}
+		}
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		// No updates of the account and its resources beyond what the deltas hold; read the rest
+		// from the on-disk DB, which needs to be at the same round as the deltas we've scanned.
+		dbRound := currentDbRound
+		if !foundAccount {
+			var persistedData persistedAccountData
+			persistedData, err = au.accountsq.lookup(addr)
+			if err != nil {
+				return basics.AccountData{}, basics.MicroAlgos{}, err
+			}
+			if persistedData.round == currentDbRound && persistedData.rowid != 0 {
+				au.baseAccounts.writePending(persistedData)
+				ad = persistedData.accountData.GetLedgerCoreAccountData()
+			}
+			dbRound = persistedData.round
+		}
+		var persistedResources []persistedResourcesData
+		if dbRound == currentDbRound {
+			persistedResources, dbRound, err = au.accountsq.lookupAllResources(addr)
+			if err != nil {
+				return basics.AccountData{}, basics.MicroAlgos{}, err
+			}
+		}
+
+		if dbRound == currentDbRound {
+			data = basics.AccountData{}
+			ledgercore.AssignAccountData(&data, ad)
+			for _, prd := range persistedResources {
+				res := prd.AccountResource()
+				ctype := basics.AssetCreatable
+				if prd.data.IsApp() {
+					ctype = basics.AppCreatable
+				}
+				if _, ok := updated[resourceKey{prd.aidx, ctype}]; !ok {
+					ledgercore.AssignAccountResourceToAccountData(prd.aidx, res, &data)
+				}
+			}
+			for key, res := range updated {
+				ledgercore.AssignAccountResourceToAccountData(key.cidx, res, &data)
+			}
+			withoutRewards = data.MicroAlgos
+			data = data.WithUpdatedRewards(rewardsProto, rewardsLevel)
+			return data, withoutRewards, nil
+		}
+		if dbRound < currentDbRound {
+			au.log.Errorf("accountUpdates.lookupWithResources: database round %d is behind in-memory round %d", dbRound, currentDbRound)
+			return basics.AccountData{}, basics.MicroAlgos{}, &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+		}
+
+		// the database moved on while we were reading it; wait for the in-memory state to catch up and retry.
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
+// LookupStateDelta returns the state delta of round rnd, as long as the round is still in the
+// in-memory deltas window. Only the account, creatable and totals portions of the delta are populated.
+// The returned delta is a copy, so callers may hold on to it after the round leaves the window.
+func (au *accountUpdates) LookupStateDelta(rnd basics.Round) (ledgercore.StateDelta, error) {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+	if rnd <= au.cachedDBRound {
+		return ledgercore.StateDelta{}, &RoundOffsetError{
+			round:   rnd,
+			dbRound: au.cachedDBRound,
+		}
+	}
+	offset, err := au.roundOffset(rnd)
+	if err != nil {
+		return ledgercore.StateDelta{}, err
+	}
+	creatables := make(map[basics.CreatableIndex]ledgercore.ModifiedCreatable, len(au.creatableDeltas[offset-1]))
+	for cidx, mc := range au.creatableDeltas[offset-1] {
+		creatables[cidx] = mc
+	}
+	return ledgercore.StateDelta{
+		Accts:      au.deltas[offset-1].Clone(),
+		Creatables: creatables,
+		Totals:     au.roundTotals[offset],
+	}, nil
+}
+
 // ReadCloseSizer interface implements the standard io.Reader and io.Closer as well
 // as supporting the Size() function that let the caller know what the size of the stream would be (in bytes).
 type ReadCloseSizer interface {
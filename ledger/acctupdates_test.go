@@ -205,8 +205,8 @@ func (ml *mockLedgerForTracker) trackerDB() db.Pair {
 	return ml.dbs
 }
 
-func (ml *mockLedgerForTracker) blockDB() db.Pair {
-	return db.Pair{}
+func (ml *mockLedgerForTracker) blockDB() blockStore {
+	return nil
 }
 
 func (ml *mockLedgerForTracker) trackerLog() logging.Logger {
@@ -253,20 +253,20 @@ return`
 	var rowid int64
 	var dbRound basics.Round
 	var buf []byte
-	err = l.accts.accountsq.lookupStmt.QueryRow(creator[:]).Scan(&rowid, &dbRound, &buf)
+	err = l.accts.accountsq.(*accountsDbQueries).lookupStmt.QueryRow(creator[:]).Scan(&rowid, &dbRound, &buf)
 	a.NoError(err)
 	a.Equal(basics.Round(4), dbRound)
 	a.Equal(expectedCreatorBase, buf)
-	err = l.accts.accountsq.lookupResourcesStmt.QueryRow(creator[:], basics.CreatableIndex(appIdx)).Scan(&rowid, &dbRound, &buf)
+	err = l.accts.accountsq.(*accountsDbQueries).lookupResourcesStmt.QueryRow(creator[:], basics.CreatableIndex(appIdx)).Scan(&rowid, &dbRound, &buf)
 	a.NoError(err)
 	a.Equal(basics.Round(4), dbRound)
 	a.Equal(expectedCreatorResource, buf)
 
-	err = l.accts.accountsq.lookupStmt.QueryRow(userOptin[:]).Scan(&rowid, &dbRound, &buf)
+	err = l.accts.accountsq.(*accountsDbQueries).lookupStmt.QueryRow(userOptin[:]).Scan(&rowid, &dbRound, &buf)
 	a.NoError(err)
 	a.Equal(basics.Round(4), dbRound)
 	a.Equal(expectedUserOptInBase, buf)
-	err = l.accts.accountsq.lookupResourcesStmt.QueryRow(userOptin[:], basics.CreatableIndex(appIdx)).Scan(&rowid, &dbRound, &buf)
+	err = l.accts.accountsq.(*accountsDbQueries).lookupResourcesStmt.QueryRow(userOptin[:], basics.CreatableIndex(appIdx)).Scan(&rowid, &dbRound, &buf)
 	a.NoError(err)
 	a.Equal(basics.Round(4), dbRound)
 	a.Equal(expectedUserOptInResource, buf)
@@ -282,11 +282,11 @@ return`
 	a.NoError(err)
 	a.Nil(ad.AppLocalStates[appIdx].KeyValue)
 
-	err = l.accts.accountsq.lookupStmt.QueryRow(userLocal[:]).Scan(&rowid, &dbRound, &buf)
+	err = l.accts.accountsq.(*accountsDbQueries).lookupStmt.QueryRow(userLocal[:]).Scan(&rowid, &dbRound, &buf)
 	a.NoError(err)
 	a.Equal(basics.Round(4), dbRound)
 	a.Equal(expectedUserLocalBase, buf)
-	err = l.accts.accountsq.lookupResourcesStmt.QueryRow(userLocal[:], basics.CreatableIndex(appIdx)).Scan(&rowid, &dbRound, &buf)
+	err = l.accts.accountsq.(*accountsDbQueries).lookupResourcesStmt.QueryRow(userLocal[:], basics.CreatableIndex(appIdx)).Scan(&rowid, &dbRound, &buf)
 	a.NoError(err)
 	a.Equal(basics.Round(4), dbRound)
 	a.Equal(expectedUserLocalResource, buf)
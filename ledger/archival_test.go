@@ -17,9 +17,7 @@
 package ledger
 
 import (
-	"context"
 	"crypto/rand"
-	"database/sql"
 	"fmt"
 	"io/ioutil"
 	mathrand "math/rand"
@@ -81,7 +79,7 @@ func (wl *wrappedLedger) trackerDB() db.Pair {
 	return wl.l.trackerDB()
 }
 
-func (wl *wrappedLedger) blockDB() db.Pair {
+func (wl *wrappedLedger) blockDB() blockStore {
 	return wl.l.blockDB()
 }
 
@@ -185,6 +183,14 @@ func TestArchival(t *testing.T) {
 func TestArchivalRestart(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
+	for _, backend := range []string{blockStorageBackendSQLite, blockStorageBackendFile} {
+		t.Run(backend, func(t *testing.T) {
+			testArchivalRestart(t, backend)
+		})
+	}
+}
+
+func testArchivalRestart(t *testing.T, blockBackend string) {
 	// Start in archival mode, add 2K blocks, restart, ensure all blocks are there
 
 	// disable deadlock checking code
@@ -194,9 +200,9 @@ func TestArchivalRestart(t *testing.T) {
 		deadlock.Opts.Disable = deadlockDisable
 	}()
 
-	dbTempDir, err := ioutil.TempDir("", "testdir"+t.Name())
+	dbTempDir, err := ioutil.TempDir("", "testdir"+blockBackend)
 	require.NoError(t, err)
-	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	dbName := fmt.Sprintf("%s.%d", blockBackend, crypto.RandUint64())
 	dbPrefix := filepath.Join(dbTempDir, dbName)
 	defer os.RemoveAll(dbTempDir)
 
@@ -204,6 +210,7 @@ func TestArchivalRestart(t *testing.T) {
 	const inMem = false // use persistent storage
 	cfg := config.GetDefaultLocal()
 	cfg.Archival = true
+	cfg.BlockStorageBackend = blockBackend
 
 	l, err := OpenLedger(logging.Base(), dbPrefix, inMem, genesisInitState, cfg)
 	require.NoError(t, err)
@@ -218,14 +225,9 @@ func TestArchivalRestart(t *testing.T) {
 	l.WaitForCommit(blk.Round())
 
 	var latest, earliest basics.Round
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err = blockLatest(tx)
-		require.NoError(t, err)
-
-		earliest, err = blockEarliest(tx)
-		require.NoError(t, err)
-		return err
-	})
+	latest, err = l.blocks.latest()
+	require.NoError(t, err)
+	earliest, err = l.blocks.earliest()
 	require.NoError(t, err)
 	require.Equal(t, basics.Round(maxBlocks), latest)
 	require.Equal(t, basics.Round(0), earliest)
@@ -235,14 +237,9 @@ func TestArchivalRestart(t *testing.T) {
 	require.NoError(t, err)
 	defer l.Close()
 
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err = blockLatest(tx)
-		require.NoError(t, err)
-
-		earliest, err = blockEarliest(tx)
-		require.NoError(t, err)
-		return err
-	})
+	latest, err = l.blocks.latest()
+	require.NoError(t, err)
+	earliest, err = l.blocks.earliest()
 	require.NoError(t, err)
 	require.Equal(t, basics.Round(maxBlocks), latest)
 	require.Equal(t, basics.Round(0), earliest)
@@ -691,6 +688,14 @@ func makeSignedTxnInBlock(tx transactions.Transaction) transactions.SignedTxnInB
 func TestArchivalFromNonArchival(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
+	for _, backend := range []string{blockStorageBackendSQLite, blockStorageBackendFile} {
+		t.Run(backend, func(t *testing.T) {
+			testArchivalFromNonArchival(t, backend)
+		})
+	}
+}
+
+func testArchivalFromNonArchival(t *testing.T, blockBackend string) {
 	// Start in non-archival mode, add 2K blocks, restart in archival mode ensure only genesis block is there
 	deadlockDisable := deadlock.Opts.Disable
 	deadlock.Opts.Disable = true
@@ -699,7 +704,7 @@ func TestArchivalFromNonArchival(t *testing.T) {
 	}()
 	dbTempDir, err := ioutil.TempDir(os.TempDir(), "testdir")
 	require.NoError(t, err)
-	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	dbName := fmt.Sprintf("%s.%d", blockBackend, crypto.RandUint64())
 	dbPrefix := filepath.Join(dbTempDir, dbName)
 	defer os.RemoveAll(dbTempDir)
 
@@ -724,6 +729,7 @@ func TestArchivalFromNonArchival(t *testing.T) {
 	const inMem = false // use persistent storage
 	cfg := config.GetDefaultLocal()
 	cfg.Archival = false
+	cfg.BlockStorageBackend = blockBackend
 
 	log := logging.TestingLog(t)
 	l, err := OpenLedger(log, dbPrefix, inMem, genesisInitState, cfg)
@@ -757,14 +763,9 @@ func TestArchivalFromNonArchival(t *testing.T) {
 	l.WaitForCommit(blk.Round())
 
 	var latest, earliest basics.Round
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err = blockLatest(tx)
-		require.NoError(t, err)
-
-		earliest, err = blockEarliest(tx)
-		require.NoError(t, err)
-		return err
-	})
+	latest, err = l.blocks.latest()
+	require.NoError(t, err)
+	earliest, err = l.blocks.earliest()
 	require.NoError(t, err)
 	require.Equal(t, basics.Round(maxBlocks), latest)
 	require.True(t, basics.Round(0) < earliest, fmt.Sprintf("%d < %d", basics.Round(0), earliest))
@@ -777,14 +778,9 @@ func TestArchivalFromNonArchival(t *testing.T) {
 	require.NoError(t, err)
 	defer l.Close()
 
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err = blockLatest(tx)
-		require.NoError(t, err)
-
-		earliest, err = blockEarliest(tx)
-		require.NoError(t, err)
-		return err
-	})
+	latest, err = l.blocks.latest()
+	require.NoError(t, err)
+	earliest, err = l.blocks.earliest()
 	require.NoError(t, err)
 	require.Equal(t, basics.Round(0), earliest)
 	require.Equal(t, basics.Round(0), latest)
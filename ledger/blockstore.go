@@ -0,0 +1,241 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+const (
+	// blockStorageBackendSQLite stores the blocks in a SQLite database; this is the default.
+	blockStorageBackendSQLite = "sqlite"
+	// blockStorageBackendFile stores every block in its own file within a directory.
+	blockStorageBackendFile = "file"
+)
+
+// blockStore is the persistence layer for the blocks of the ledger and their certificates.
+// The committed blocks always form a contiguous range of rounds, which grows at the top
+// through put and shrinks at the bottom through forgetBefore. During a catchpoint catchup,
+// blocks are written to a separate staging area, which replaces the committed blocks once
+// the catchup completes.
+type blockStore interface {
+	// init creates the underlying storage if needed, and adds initBlocks when no block is present.
+	init(initBlocks []bookkeeping.Block) error
+	// reset deletes all the committed blocks.
+	reset() error
+
+	latest() (basics.Round, error)
+	earliest() (basics.Round, error)
+	get(rnd basics.Round) (bookkeeping.Block, error)
+	getHdr(rnd basics.Round) (bookkeeping.BlockHeader, error)
+	getEncodedCert(rnd basics.Round) (blk []byte, cert []byte, err error)
+	getCert(rnd basics.Round) (bookkeeping.Block, agreement.Certificate, error)
+
+	// put appends the given entries, which have to start at the round that follows the latest one.
+	put(entries []blockEntry) error
+	// forgetBefore deletes the blocks preceding rnd.
+	forgetBefore(rnd basics.Round) error
+
+	startCatchupStaging(blk bookkeeping.Block) error
+	putStaging(blk bookkeeping.Block) error
+	completeCatchup() error
+	abortCatchup() error
+	ensureSingleBlock() (bookkeeping.Block, error)
+
+	setSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) error
+	close()
+}
+
+// openBlockStore opens the block store of the given backend, using storage names based on dbPathPrefix.
+func openBlockStore(backend string, dbPathPrefix string, dbMem bool, log logging.Logger) (blockStore, error) {
+	switch backend {
+	case "", blockStorageBackendSQLite:
+		dbs, err := db.OpenPair(dbPathPrefix+".block.sqlite", dbMem)
+		if err != nil {
+			return nil, err
+		}
+		dbs.Rdb.SetLogger(log)
+		dbs.Wdb.SetLogger(log)
+		return &sqliteBlockStore{dbs: dbs}, nil
+	case blockStorageBackendFile:
+		fs, err := openFileBlockStore(dbPathPrefix+".block", dbMem)
+		if err != nil {
+			return nil, err
+		}
+		return fs, nil
+	default:
+		return nil, fmt.Errorf("unknown block storage backend '%s'", backend)
+	}
+}
+
+// openBlockStoreReadOnly opens the existing block store of the given backend for reading, without
+// modifying its storage. Only the methods that read blocks may be called on the returned store.
+func openBlockStoreReadOnly(backend string, dbPathPrefix string) (blockStore, error) {
+	switch backend {
+	case "", blockStorageBackendSQLite:
+		rdb, err := db.MakeImmutableAccessor(dbPathPrefix + ".block.sqlite")
+		if err != nil {
+			return nil, err
+		}
+		return &sqliteBlockStore{dbs: db.Pair{Rdb: rdb, Wdb: rdb}}, nil
+	case blockStorageBackendFile:
+		fs := &fileBlockStore{dir: dbPathPrefix + ".block", readOnly: true}
+		if _, err := os.Stat(filepath.Join(fs.dir, fileBlockStoreReplacedDir)); err == nil {
+			return nil, fmt.Errorf("the blocks in %s are being replaced by a catchpoint catchup", fs.dir)
+		}
+		err := fs.loadRange()
+		if err != nil {
+			return nil, err
+		}
+		return fs, nil
+	default:
+		return nil, fmt.Errorf("unknown block storage backend '%s'", backend)
+	}
+}
+
+// sqliteBlockStore is a blockStore backed by the blocks table of a SQLite database.
+type sqliteBlockStore struct {
+	dbs db.Pair
+}
+
+func (s *sqliteBlockStore) init(initBlocks []bookkeeping.Block) error {
+	return s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockInit(tx, initBlocks)
+	})
+}
+
+func (s *sqliteBlockStore) reset() error {
+	return s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockResetDB(tx)
+	})
+}
+
+func (s *sqliteBlockStore) latest() (rnd basics.Round, err error) {
+	err = s.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		rnd, err0 = blockLatest(tx)
+		return
+	})
+	return
+}
+
+func (s *sqliteBlockStore) earliest() (rnd basics.Round, err error) {
+	err = s.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		rnd, err0 = blockEarliest(tx)
+		return
+	})
+	return
+}
+
+func (s *sqliteBlockStore) get(rnd basics.Round) (blk bookkeeping.Block, err error) {
+	err = s.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		blk, err0 = blockGet(tx, rnd)
+		return
+	})
+	return
+}
+
+func (s *sqliteBlockStore) getHdr(rnd basics.Round) (hdr bookkeeping.BlockHeader, err error) {
+	err = s.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		hdr, err0 = blockGetHdr(tx, rnd)
+		return
+	})
+	return
+}
+
+func (s *sqliteBlockStore) getEncodedCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
+	err = s.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		blk, cert, err0 = blockGetEncodedCert(tx, rnd)
+		return
+	})
+	return
+}
+
+func (s *sqliteBlockStore) getCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
+	err = s.dbs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		blk, cert, err0 = blockGetCert(tx, rnd)
+		return
+	})
+	return
+}
+
+func (s *sqliteBlockStore) put(entries []blockEntry) error {
+	return s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, e := range entries {
+			err := blockPut(tx, e.block, e.cert)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *sqliteBlockStore) forgetBefore(rnd basics.Round) error {
+	return s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockForgetBefore(tx, rnd)
+	})
+}
+
+func (s *sqliteBlockStore) startCatchupStaging(blk bookkeeping.Block) error {
+	return s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockStartCatchupStaging(tx, blk)
+	})
+}
+
+func (s *sqliteBlockStore) putStaging(blk bookkeeping.Block) error {
+	return s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockPutStaging(tx, blk)
+	})
+}
+
+func (s *sqliteBlockStore) completeCatchup() error {
+	return s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockCompleteCatchup(tx)
+	})
+}
+
+func (s *sqliteBlockStore) abortCatchup() error {
+	return s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return blockAbortCatchup(tx)
+	})
+}
+
+func (s *sqliteBlockStore) ensureSingleBlock() (blk bookkeeping.Block, err error) {
+	err = s.dbs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) (err0 error) {
+		blk, err0 = blockEnsureSingleBlock(tx)
+		return
+	})
+	return
+}
+
+func (s *sqliteBlockStore) setSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) error {
+	return s.dbs.Wdb.SetSynchronousMode(ctx, mode, fullfsync)
+}
+
+func (s *sqliteBlockStore) close() {
+	s.dbs.Close()
+}
@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testBlockStores runs f against a freshly opened block store of every backend.
+func testBlockStores(t *testing.T, f func(t *testing.T, bs blockStore)) {
+	for _, backend := range []string{blockStorageBackendSQLite, blockStorageBackendFile} {
+		t.Run(backend, func(t *testing.T) {
+			dbPrefix := filepath.Join(t.TempDir(), "ledger")
+			bs, err := openBlockStore(backend, dbPrefix, false, logging.TestingLog(t))
+			require.NoError(t, err)
+			defer bs.close()
+			f(t, bs)
+		})
+	}
+}
+
+func checkBlockStore(t *testing.T, bs blockStore, blocks []blockEntry) {
+	latest, err := bs.latest()
+	require.NoError(t, err)
+	require.Equal(t, blocks[len(blocks)-1].block.Round(), latest)
+
+	earliest, err := bs.earliest()
+	require.NoError(t, err)
+	require.Equal(t, blocks[0].block.Round(), earliest)
+
+	for _, e := range blocks {
+		blk, err := bs.get(e.block.Round())
+		require.NoError(t, err)
+		require.Equal(t, e.block, blk)
+
+		hdr, err := bs.getHdr(e.block.Round())
+		require.NoError(t, err)
+		require.Equal(t, e.block.BlockHeader, hdr)
+
+		blk, cert, err := bs.getCert(e.block.Round())
+		require.NoError(t, err)
+		require.Equal(t, e.block, blk)
+		require.Equal(t, e.cert, cert)
+
+		blkbuf, certbuf, err := bs.getEncodedCert(e.block.Round())
+		require.NoError(t, err)
+		require.Equal(t, protocol.Encode(&e.block), blkbuf)
+		require.Equal(t, protocol.Encode(&e.cert), certbuf)
+	}
+
+	_, err = bs.get(latest + 1)
+	require.IsType(t, ledgercore.ErrNoEntry{}, err)
+	if earliest > 0 {
+		_, err = bs.getHdr(earliest - 1)
+		require.IsType(t, ledgercore.ErrNoEntry{}, err)
+	}
+}
+
+func TestBlockStoreAppendForget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testBlockStores(t, func(t *testing.T, bs blockStore) {
+		_, err := bs.latest()
+		require.Error(t, err)
+
+		blocks := randomInitChain(protocol.ConsensusCurrentVersion, 10)
+		require.NoError(t, bs.init(blockChainBlocks(blocks)))
+		checkBlockStore(t, bs, blocks)
+
+		// initializing a non-empty store leaves it as is
+		require.NoError(t, bs.init(blockChainBlocks(randomInitChain(protocol.ConsensusCurrentVersion, 3))))
+		checkBlockStore(t, bs, blocks)
+
+		var more []blockEntry
+		for i := 10; i < 20; i++ {
+			more = append(more, randomBlock(basics.Round(i)))
+		}
+		require.NoError(t, bs.put(more[:5]))
+		blocks = append(blocks, more[:5]...)
+		checkBlockStore(t, bs, blocks)
+
+		// a batch which is not contiguous with the stored blocks is rejected as a whole
+		require.Error(t, bs.put([]blockEntry{more[5], more[6], more[8]}))
+		checkBlockStore(t, bs, blocks)
+		require.Error(t, bs.put(more[6:]))
+		checkBlockStore(t, bs, blocks)
+
+		require.NoError(t, bs.put(more[5:]))
+		blocks = append(blocks, more[5:]...)
+		checkBlockStore(t, bs, blocks)
+
+		require.NoError(t, bs.forgetBefore(5))
+		blocks = blocks[5:]
+		checkBlockStore(t, bs, blocks)
+
+		require.NoError(t, bs.forgetBefore(2))
+		checkBlockStore(t, bs, blocks)
+
+		require.Error(t, bs.forgetBefore(20))
+		checkBlockStore(t, bs, blocks)
+
+		require.NoError(t, bs.reset())
+		_, err = bs.earliest()
+		require.Error(t, err)
+		blocks = randomInitChain(protocol.ConsensusCurrentVersion, 1)
+		require.NoError(t, bs.init(blockChainBlocks(blocks)))
+		checkBlockStore(t, bs, blocks)
+	})
+}
+
+func TestBlockStoreCatchupStaging(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testBlockStores(t, func(t *testing.T, bs blockStore) {
+		blocks := randomInitChain(protocol.ConsensusCurrentVersion, 10)
+		require.NoError(t, bs.init(blockChainBlocks(blocks)))
+
+		_, err := bs.ensureSingleBlock()
+		require.Error(t, err)
+
+		staged := []blockEntry{randomBlock(100), randomBlock(98), randomBlock(99)}
+		require.NoError(t, bs.startCatchupStaging(staged[0].block))
+		require.NoError(t, bs.putStaging(staged[1].block))
+		require.NoError(t, bs.putStaging(staged[2].block))
+
+		// the staging blocks do not affect the committed ones until the catchup completes
+		checkBlockStore(t, bs, blocks)
+
+		blk, err := bs.ensureSingleBlock()
+		require.NoError(t, err)
+		require.Equal(t, staged[0].block, blk)
+
+		require.NoError(t, bs.completeCatchup())
+		latest, err := bs.latest()
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(100), latest)
+		earliest, err := bs.earliest()
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(100), earliest)
+
+		next := randomBlock(101)
+		require.NoError(t, bs.put([]blockEntry{next}))
+		blk, err = bs.get(101)
+		require.NoError(t, err)
+		require.Equal(t, next.block, blk)
+
+		// an aborted catchup leaves the committed blocks untouched
+		require.NoError(t, bs.startCatchupStaging(randomBlock(200).block))
+		require.NoError(t, bs.abortCatchup())
+		latest, err = bs.latest()
+		require.NoError(t, err)
+		require.Equal(t, basics.Round(101), latest)
+	})
+}
+
+func TestFileBlockStoreRecover(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := filepath.Join(t.TempDir(), "ledger.block")
+	fs, err := openFileBlockStore(dir, false)
+	require.NoError(t, err)
+	blocks := randomInitChain(protocol.ConsensusCurrentVersion, 10)
+	require.NoError(t, fs.init(blockChainBlocks(blocks)))
+	require.NoError(t, fs.forgetBefore(3))
+	fs.close()
+
+	// an interrupted block write leaves a temporary file behind
+	tmpPath := fs.blockPath(fileBlockStoreBlocksDir, 10) + fileBlockStoreTempSuffix
+	require.NoError(t, ioutil.WriteFile(tmpPath, []byte{1, 2, 3}, 0600))
+
+	fs, err = openFileBlockStore(dir, false)
+	require.NoError(t, err)
+	checkBlockStore(t, fs, blocks[3:])
+	_, err = os.Stat(tmpPath)
+	require.True(t, os.IsNotExist(err))
+
+	// a catchup interrupted right after moving the committed blocks away is completed on open
+	staged := randomBlock(50)
+	require.NoError(t, fs.startCatchupStaging(staged.block))
+	fs.close()
+	require.NoError(t, os.Rename(filepath.Join(dir, fileBlockStoreBlocksDir), filepath.Join(dir, fileBlockStoreReplacedDir)))
+
+	fs, err = openFileBlockStore(dir, false)
+	require.NoError(t, err)
+	defer fs.close()
+	blk, err := fs.get(50)
+	require.NoError(t, err)
+	require.Equal(t, staged.block, blk)
+	_, err = os.Stat(filepath.Join(dir, fileBlockStoreReplacedDir))
+	require.True(t, os.IsNotExist(err))
+
+	// block files with a gap are detected
+	require.NoError(t, ioutil.WriteFile(fs.blockPath(fileBlockStoreBlocksDir, 52), encodeBlockFile(&staged.block, nil), 0600))
+	_, err = openFileBlockStore(dir, false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), fmt.Sprintf("rounds %d to %d", 50, 52))
+}
+
+func TestFileBlockStorePutRollback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := filepath.Join(t.TempDir(), "ledger.block")
+	fs, err := openFileBlockStore(dir, false)
+	require.NoError(t, err)
+	defer fs.close()
+	blocks := randomInitChain(protocol.ConsensusCurrentVersion, 10)
+	require.NoError(t, fs.init(blockChainBlocks(blocks)))
+
+	// a write failing halfway through a batch removes the blocks the batch already wrote
+	blockWrite := func(rnd basics.Round) func() {
+		tmpPath := fs.blockPath(fileBlockStoreBlocksDir, rnd) + fileBlockStoreTempSuffix
+		require.NoError(t, os.MkdirAll(filepath.Join(tmpPath, "x"), 0700))
+		return func() { require.NoError(t, os.RemoveAll(tmpPath)) }
+	}
+	unblock := blockWrite(12)
+	more := []blockEntry{randomBlock(10), randomBlock(11), randomBlock(12)}
+	require.Error(t, fs.put(more))
+	unblock()
+	checkBlockStore(t, fs, blocks)
+	_, err = os.Stat(fs.blockPath(fileBlockStoreBlocksDir, 10))
+	require.True(t, os.IsNotExist(err))
+
+	// the same holds for a store that was reset, even though it used to hold later rounds
+	require.NoError(t, fs.reset())
+	unblock = blockWrite(3)
+	require.Error(t, fs.put(blocks[:5]))
+	unblock()
+	_, err = fs.latest()
+	require.Error(t, err)
+	rounds, err := fs.listRounds(fileBlockStoreBlocksDir)
+	require.NoError(t, err)
+	require.Empty(t, rounds)
+
+	require.NoError(t, fs.put(blocks))
+	checkBlockStore(t, fs, blocks)
+}
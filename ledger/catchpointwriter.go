@@ -263,7 +263,7 @@ func (cw *catchpointWriter) asyncWriter(balances chan catchpointFileBalancesChun
 
 		encodedChunk := protocol.Encode(&bc)
 		err := cw.tar.WriteHeader(&tar.Header{
-			Name: fmt.Sprintf("balances.%d.%d.msgpack", balancesChunkNum, cw.fileHeader.TotalChunks),
+			Name: catchpointBalancesChunkName(balancesChunkNum, cw.fileHeader.TotalChunks),
 			Mode: 0600,
 			Size: int64(len(encodedChunk)),
 		})
@@ -294,6 +294,11 @@ func (cw *catchpointWriter) asyncWriter(balances chan catchpointFileBalancesChun
 	}
 }
 
+// catchpointBalancesChunkName returns the name of the tar entry holding the given balances chunk.
+func catchpointBalancesChunkName(chunkNum, totalChunks uint64) string {
+	return fmt.Sprintf("balances.%d.%d.msgpack", chunkNum, totalChunks)
+}
+
 func (cw *catchpointWriter) readDatabaseStep(ctx context.Context, tx *sql.Tx) (err error) {
 	cw.balancesChunk.Balances, err = cw.accountsIterator.Next(ctx, tx, BalancesPerCatchpointFileChunk)
 	if err == nil {
@@ -0,0 +1,525 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+const (
+	fileBlockStoreBlocksDir        = "blocks"
+	fileBlockStoreStagingDir       = "catchpointblocks"
+	fileBlockStoreReplacedDir      = "blocks_old"
+	fileBlockStoreTempSuffix       = ".tmp"
+	fileBlockStoreBlockNameFormat  = "%020d"
+	fileBlockStoreBlockFileEntries = 3
+)
+
+var errMalformedBlockFile = errors.New("malformed block file")
+
+// fileBlockStore is a blockStore which keeps every block in its own file, named after
+// the block round. The committed blocks reside in the blocks subdirectory, and the blocks
+// of an ongoing catchpoint catchup in the catchpointblocks subdirectory. A block file is
+// written under a temporary name and then renamed into place, so that it is either
+// complete or absent. Since the committed blocks form a contiguous range, the store only
+// needs to track the earliest and latest rounds in memory.
+type fileBlockStore struct {
+	dir string
+
+	// removeOnClose is set for in-memory ledgers, whose blocks are kept in a temporary directory.
+	removeOnClose bool
+
+	// readOnly is set for stores opened by openBlockStoreReadOnly, which leave the directory untouched.
+	readOnly bool
+
+	mu deadlock.RWMutex
+
+	// empty is set when there are no committed blocks; min and max are meaningless then.
+	empty    bool
+	min, max basics.Round
+
+	// fsync determines whether block files are synced to disk before being renamed into place,
+	// and whether the directories are synced after entries are renamed or removed.
+	fsync bool
+}
+
+func openFileBlockStore(dir string, dbMem bool) (*fileBlockStore, error) {
+	fs := &fileBlockStore{
+		dir:   dir,
+		fsync: !dbMem,
+	}
+	if dbMem {
+		tmpDir, err := ioutil.TempDir("", "blocks")
+		if err != nil {
+			return nil, err
+		}
+		fs.dir = tmpDir
+		fs.removeOnClose = true
+	}
+
+	err := fs.recover()
+	if err != nil {
+		fs.close()
+		return nil, err
+	}
+	return fs, nil
+}
+
+// recover brings the directory layout back into a consistent state, completing a catchup
+// that was interrupted while swapping the staging blocks in, and loads the committed range.
+func (fs *fileBlockStore) recover() error {
+	blocksDir := filepath.Join(fs.dir, fileBlockStoreBlocksDir)
+	replacedDir := filepath.Join(fs.dir, fileBlockStoreReplacedDir)
+	err := os.MkdirAll(fs.dir, 0700)
+	if err != nil {
+		return err
+	}
+	if _, err = os.Stat(replacedDir); err == nil {
+		if _, err = os.Stat(blocksDir); os.IsNotExist(err) {
+			// the committed blocks were moved away, but the staging blocks were not moved in.
+			err = os.Rename(filepath.Join(fs.dir, fileBlockStoreStagingDir), blocksDir)
+			if err != nil {
+				return err
+			}
+		}
+		err = os.RemoveAll(replacedDir)
+		if err != nil {
+			return err
+		}
+	}
+	err = os.MkdirAll(blocksDir, 0700)
+	if err != nil {
+		return err
+	}
+	err = fs.syncDir(fs.dir)
+	if err != nil {
+		return err
+	}
+	return fs.loadRange()
+}
+
+// loadRange updates the committed range from the content of the blocks subdirectory.
+func (fs *fileBlockStore) loadRange() error {
+	rounds, err := fs.listRounds(fileBlockStoreBlocksDir)
+	if err != nil {
+		return err
+	}
+	fs.empty = len(rounds) == 0
+	if fs.empty {
+		return nil
+	}
+	fs.min, fs.max = rounds[0], rounds[len(rounds)-1]
+	if uint64(fs.max-fs.min)+1 != uint64(len(rounds)) {
+		return fmt.Errorf("block files in %s are not contiguous: %d files for rounds %d to %d", fs.dir, len(rounds), fs.min, fs.max)
+	}
+	return nil
+}
+
+// listRounds returns the sorted rounds of the block files in the given subdirectory, removing
+// any temporary file left behind by an interrupted write unless the store is read-only.
+func (fs *fileBlockStore) listRounds(subdir string) ([]basics.Round, error) {
+	dir := filepath.Join(fs.dir, subdir)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	rounds := make([]basics.Round, 0, len(files))
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), fileBlockStoreTempSuffix) {
+			if !fs.readOnly {
+				err = os.Remove(filepath.Join(dir, file.Name()))
+				if err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		rnd, err := strconv.ParseUint(file.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		rounds = append(rounds, basics.Round(rnd))
+	}
+	sort.Slice(rounds, func(i, j int) bool { return rounds[i] < rounds[j] })
+	return rounds, nil
+}
+
+// syncDir flushes the entries of the given directory to disk, so that the files renamed into
+// or removed from it survive a crash.
+func (fs *fileBlockStore) syncDir(dir string) error {
+	if !fs.fsync {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	err = d.Sync()
+	closeErr := d.Close()
+	if err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (fs *fileBlockStore) blockPath(subdir string, rnd basics.Round) string {
+	return filepath.Join(fs.dir, subdir, fmt.Sprintf(fileBlockStoreBlockNameFormat, rnd))
+}
+
+// encodeBlockFile encodes the header, the block and the certificate, each preceded by its length.
+// A nil certificate is stored as an empty entry.
+func encodeBlockFile(blk *bookkeeping.Block, cert *agreement.Certificate) []byte {
+	entries := [fileBlockStoreBlockFileEntries][]byte{protocol.Encode(&blk.BlockHeader), protocol.Encode(blk)}
+	if cert != nil {
+		entries[2] = protocol.Encode(cert)
+	}
+	var buf []byte
+	var lenbuf [binary.MaxVarintLen64]byte
+	for _, entry := range entries {
+		n := binary.PutUvarint(lenbuf[:], uint64(len(entry)))
+		buf = append(buf, lenbuf[:n]...)
+		buf = append(buf, entry...)
+	}
+	return buf
+}
+
+// decodeBlockFile splits the content of a block file into the encoded header, block and certificate.
+func decodeBlockFile(buf []byte) (hdr []byte, blk []byte, cert []byte, err error) {
+	var entries [fileBlockStoreBlockFileEntries][]byte
+	for i := range entries {
+		length, n := binary.Uvarint(buf)
+		if n <= 0 || uint64(len(buf)-n) < length {
+			return nil, nil, nil, errMalformedBlockFile
+		}
+		entries[i] = buf[n : n+int(length)]
+		buf = buf[n+int(length):]
+	}
+	if len(entries[2]) == 0 {
+		entries[2] = nil
+	}
+	return entries[0], entries[1], entries[2], nil
+}
+
+func (fs *fileBlockStore) writeBlock(subdir string, blk *bookkeeping.Block, cert *agreement.Certificate) error {
+	path := fs.blockPath(subdir, blk.Round())
+	tmpPath := path + fileBlockStoreTempSuffix
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(encodeBlockFile(blk, cert))
+	if err == nil && fs.fsync {
+		err = f.Sync()
+	}
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return err
+	}
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return err
+	}
+	return fs.syncDir(filepath.Dir(path))
+}
+
+func (fs *fileBlockStore) readBlock(rnd basics.Round) (hdr []byte, blk []byte, cert []byte, err error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	if fs.empty || rnd < fs.min || rnd > fs.max {
+		return nil, nil, nil, ledgercore.ErrNoEntry{Round: rnd}
+	}
+	buf, err := ioutil.ReadFile(fs.blockPath(fileBlockStoreBlocksDir, rnd))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return decodeBlockFile(buf)
+}
+
+// putLocked appends the entries to the committed blocks. Should the write of any entry fail,
+// the blocks already written by this call are removed so that the call has no effect.
+func (fs *fileBlockStore) putLocked(entries []blockEntry) (err error) {
+	empty, min, max := fs.empty, fs.min, fs.max
+	defer func() {
+		if err == nil {
+			return
+		}
+		if !fs.empty {
+			// the first round this call wrote; max is meaningless for a store that was empty
+			first := max + 1
+			if empty {
+				first = fs.min
+			}
+			// removing the files in descending order keeps the remaining blocks contiguous
+			for rnd := fs.max; rnd >= first; rnd-- {
+				os.Remove(fs.blockPath(fileBlockStoreBlocksDir, rnd))
+				if rnd == 0 {
+					break
+				}
+			}
+			fs.syncDir(filepath.Join(fs.dir, fileBlockStoreBlocksDir))
+		}
+		fs.empty, fs.min, fs.max = empty, min, max
+	}()
+
+	for i := range entries {
+		expected := fs.max + 1
+		if fs.empty {
+			expected = 0
+		}
+		if entries[i].block.Round() != expected {
+			return fmt.Errorf("inserting block %d but expected %d", entries[i].block.Round(), expected)
+		}
+		err = fs.writeBlock(fileBlockStoreBlocksDir, &entries[i].block, &entries[i].cert)
+		if err != nil {
+			return err
+		}
+		if fs.empty {
+			fs.empty = false
+			fs.min = expected
+		}
+		fs.max = expected
+	}
+	return nil
+}
+
+func (fs *fileBlockStore) init(initBlocks []bookkeeping.Block) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if !fs.empty {
+		return nil
+	}
+	entries := make([]blockEntry, len(initBlocks))
+	for i := range initBlocks {
+		entries[i].block = initBlocks[i]
+	}
+	return fs.putLocked(entries)
+}
+
+func (fs *fileBlockStore) reset() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	blocksDir := filepath.Join(fs.dir, fileBlockStoreBlocksDir)
+	err := os.RemoveAll(blocksDir)
+	if err != nil {
+		return err
+	}
+	fs.empty, fs.min, fs.max = true, 0, 0
+	err = os.MkdirAll(blocksDir, 0700)
+	if err != nil {
+		return err
+	}
+	return fs.syncDir(fs.dir)
+}
+
+func (fs *fileBlockStore) latest() (basics.Round, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	if fs.empty {
+		return 0, fmt.Errorf("no blocks present")
+	}
+	return fs.max, nil
+}
+
+func (fs *fileBlockStore) earliest() (basics.Round, error) {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	if fs.empty {
+		return 0, fmt.Errorf("no blocks present")
+	}
+	return fs.min, nil
+}
+
+func (fs *fileBlockStore) get(rnd basics.Round) (blk bookkeeping.Block, err error) {
+	_, buf, _, err := fs.readBlock(rnd)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(buf, &blk)
+	return
+}
+
+func (fs *fileBlockStore) getHdr(rnd basics.Round) (hdr bookkeeping.BlockHeader, err error) {
+	buf, _, _, err := fs.readBlock(rnd)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(buf, &hdr)
+	return
+}
+
+func (fs *fileBlockStore) getEncodedCert(rnd basics.Round) (blk []byte, cert []byte, err error) {
+	_, blk, cert, err = fs.readBlock(rnd)
+	return
+}
+
+func (fs *fileBlockStore) getCert(rnd basics.Round) (blk bookkeeping.Block, cert agreement.Certificate, err error) {
+	_, blkbuf, certbuf, err := fs.readBlock(rnd)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(blkbuf, &blk)
+	if err != nil {
+		return
+	}
+	if certbuf != nil {
+		err = protocol.Decode(certbuf, &cert)
+	}
+	return
+}
+
+func (fs *fileBlockStore) put(entries []blockEntry) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	return fs.putLocked(entries)
+}
+
+func (fs *fileBlockStore) forgetBefore(rnd basics.Round) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	next := fs.max + 1
+	if fs.empty {
+		next = 0
+	}
+	if rnd >= next {
+		return fmt.Errorf("forgetting too much: rnd %d >= next %d", rnd, next)
+	}
+	// removing the files in ascending order keeps the remaining blocks contiguous if we get interrupted.
+	for ; fs.min < rnd; fs.min++ {
+		err := os.Remove(fs.blockPath(fileBlockStoreBlocksDir, fs.min))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return fs.syncDir(filepath.Join(fs.dir, fileBlockStoreBlocksDir))
+}
+
+func (fs *fileBlockStore) startCatchupStaging(blk bookkeeping.Block) error {
+	err := fs.abortCatchup()
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Join(fs.dir, fileBlockStoreStagingDir), 0700)
+	if err != nil {
+		return err
+	}
+	err = fs.syncDir(fs.dir)
+	if err != nil {
+		return err
+	}
+	return fs.putStaging(blk)
+}
+
+func (fs *fileBlockStore) putStaging(blk bookkeeping.Block) error {
+	fs.mu.RLock()
+	defer fs.mu.RUnlock()
+	return fs.writeBlock(fileBlockStoreStagingDir, &blk, nil)
+}
+
+func (fs *fileBlockStore) completeCatchup() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	blocksDir := filepath.Join(fs.dir, fileBlockStoreBlocksDir)
+	replacedDir := filepath.Join(fs.dir, fileBlockStoreReplacedDir)
+	err := os.Rename(blocksDir, replacedDir)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(filepath.Join(fs.dir, fileBlockStoreStagingDir), blocksDir)
+	if err != nil {
+		return err
+	}
+	// the swap has to be durable before the replaced blocks are gone
+	err = fs.syncDir(fs.dir)
+	if err != nil {
+		return err
+	}
+	err = os.RemoveAll(replacedDir)
+	if err != nil {
+		return err
+	}
+	return fs.loadRange()
+}
+
+func (fs *fileBlockStore) abortCatchup() error {
+	return os.RemoveAll(filepath.Join(fs.dir, fileBlockStoreStagingDir))
+}
+
+func (fs *fileBlockStore) ensureSingleBlock() (blk bookkeeping.Block, err error) {
+	rounds, err := fs.listRounds(fileBlockStoreStagingDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = ledgercore.ErrNoEntry{}
+		}
+		return
+	}
+	if len(rounds) == 0 {
+		return bookkeeping.Block{}, ledgercore.ErrNoEntry{}
+	}
+	round := rounds[len(rounds)-1]
+	for _, rnd := range rounds[:len(rounds)-1] {
+		err = os.Remove(fs.blockPath(fileBlockStoreStagingDir, rnd))
+		if err != nil {
+			return
+		}
+	}
+
+	buf, err := ioutil.ReadFile(fs.blockPath(fileBlockStoreStagingDir, round))
+	if err != nil {
+		return
+	}
+	_, blkbuf, _, err := decodeBlockFile(buf)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(blkbuf, &blk)
+	return
+}
+
+func (fs *fileBlockStore) setSynchronousMode(ctx context.Context, mode db.SynchronousMode, fullfsync bool) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.fsync = !fs.removeOnClose && mode >= db.SynchronousModeFull
+	return nil
+}
+
+func (fs *fileBlockStore) close() {
+	if fs.removeOnClose {
+		os.RemoveAll(fs.dir)
+	}
+}
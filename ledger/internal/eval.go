@@ -839,6 +839,14 @@ func (eval *BlockEvaluator) ResetTxnBytes() {
 	eval.blockTxBytes = 0
 }
 
+// Delta returns the state changes made by the transactions added to the
+// BlockEvaluator so far, without running the end-of-block processing that
+// GenerateBlock performs. Pending application storage changes are folded into
+// the returned delta, so no further transactions should be added afterwards.
+func (eval *BlockEvaluator) Delta() ledgercore.StateDelta {
+	return eval.state.deltas()
+}
+
 // TestTransactionGroup performs basic duplicate detection and well-formedness checks
 // on a transaction group, but does not actually add the transactions to the block
 // evaluator, or modify the block evaluator state in any other visible way.
@@ -932,17 +940,96 @@ func (eval *BlockEvaluator) TransactionGroup(txads []transactions.SignedTxnWithA
 	return eval.transactionGroup(txads)
 }
 
+// SimulatedGroup describes the outcome of SimulateTransactionGroup.
+type SimulatedGroup struct {
+	// TxnGroup holds the evaluated transactions along with their ApplyData.
+	// When the group failed, it only holds the transactions that were
+	// evaluated successfully before the failing one.
+	TxnGroup []transactions.SignedTxnWithAD
+
+	// FailedAt is the index of the transaction that caused the group to
+	// fail, or -1 if the group succeeded or the failure cannot be
+	// attributed to a single transaction.
+	FailedAt int
+
+	// AppBudgetAdded and AppBudgetConsumed report the pooled opcode budget
+	// made available to, and used by, the application calls of the group,
+	// including inner application calls. Both are zero if application
+	// budget pooling is not in effect.
+	AppBudgetAdded    uint64
+	AppBudgetConsumed uint64
+}
+
+// SimulateTransactionGroup tentatively adds a transaction group to this block
+// evaluation, exactly like TransactionGroup, but reports which transaction of
+// the group failed, and the application budget used by the group. The programs
+// of the application calls, inner ones included, are evaluated with debugger
+// attached, if it is not nil. The error describing the failure, if any, is returned as well;
+// in that case the block evaluator state is unchanged.
+func (eval *BlockEvaluator) SimulateTransactionGroup(txgroup []transactions.SignedTxnWithAD, debugger logic.DebuggerHook) (SimulatedGroup, error) {
+	res := SimulatedGroup{FailedAt: -1}
+	evalParams, txibs, failedAt, evalErr := eval.evalTransactionGroup(txgroup, debugger)
+	if evalErr != nil {
+		res.FailedAt = failedAt
+		if failedAt >= 0 && failedAt < len(txibs) {
+			txibs = txibs[:failedAt]
+		}
+	}
+
+	res.TxnGroup = make([]transactions.SignedTxnWithAD, len(txibs))
+	for i, txib := range txibs {
+		var err error
+		res.TxnGroup[i].SignedTxn, res.TxnGroup[i].ApplyData, err = eval.block.DecodeSignedTxn(txib)
+		if err != nil {
+			return res, err
+		}
+	}
+	if evalErr != nil {
+		return res, evalErr
+	}
+
+	if evalParams != nil && evalParams.PooledApplicationBudget != nil {
+		apps := countAppCalls(res.TxnGroup)
+		res.AppBudgetAdded = uint64(apps * eval.proto.MaxAppProgramCost)
+		res.AppBudgetConsumed = res.AppBudgetAdded - uint64(*evalParams.PooledApplicationBudget)
+	}
+	return res, nil
+}
+
+// countAppCalls returns the number of application calls in txgroup, including
+// the ones issued as inner transactions.
+func countAppCalls(txgroup []transactions.SignedTxnWithAD) (count int) {
+	for _, txn := range txgroup {
+		if txn.Txn.Type == protocol.ApplicationCallTx {
+			count++
+		}
+		count += countAppCalls(txn.EvalDelta.InnerTxns)
+	}
+	return
+}
+
 // transactionGroup tentatively executes a group of transactions as part of this block evaluation.
 // If the transaction group cannot be added to the block without violating some constraints,
 // an error is returned and the block evaluator state is unchanged.
 func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWithAD) error {
+	_, _, _, err := eval.evalTransactionGroup(txgroup, nil)
+	return err
+}
+
+// evalTransactionGroup implements transactionGroup, evaluating application
+// programs (and inner application calls) with debugger attached if it is not
+// nil. In addition to the error, it returns the EvalParams the group was
+// evaluated with, the transactions that were evaluated, and the index of the
+// transaction that caused the failure (or -1 when no single transaction is to
+// blame).
+func (eval *BlockEvaluator) evalTransactionGroup(txgroup []transactions.SignedTxnWithAD, debugger logic.DebuggerHook) (*logic.EvalParams, []transactions.SignedTxnInBlock, int, error) {
 	// Nothing to do if there are no transactions.
 	if len(txgroup) == 0 {
-		return nil
+		return nil, nil, -1, nil
 	}
 
 	if len(txgroup) > eval.proto.MaxTxGroupSize {
-		return fmt.Errorf("group size %d exceeds maximum %d", len(txgroup), eval.proto.MaxTxGroupSize)
+		return nil, nil, -1, fmt.Errorf("group size %d exceeds maximum %d", len(txgroup), eval.proto.MaxTxGroupSize)
 	}
 
 	var txibs []transactions.SignedTxnInBlock
@@ -951,6 +1038,10 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 
 	cow := eval.state.child(len(txgroup))
 	evalParams := logic.NewEvalParams(txgroup, &eval.proto, &eval.specials)
+	if debugger != nil {
+		evalParams.Debugger = debugger
+		evalParams.DebugInnerCalls = true
+	}
 
 	// Evaluate each transaction in the group
 	txibs = make([]transactions.SignedTxnInBlock, 0, len(txgroup))
@@ -959,7 +1050,7 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 
 		err := eval.transaction(txad.SignedTxn, evalParams, gi, txad.ApplyData, cow, &txib)
 		if err != nil {
-			return err
+			return evalParams, txibs, gi, err
 		}
 
 		txibs = append(txibs, txib)
@@ -967,13 +1058,13 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 		if eval.validate {
 			groupTxBytes += txib.GetEncodedLength()
 			if eval.blockTxBytes+groupTxBytes > eval.maxTxnBytesPerBlock {
-				return ledgercore.ErrNoSpace
+				return evalParams, txibs, gi, ledgercore.ErrNoSpace
 			}
 		}
 
 		// Make sure all transactions in group have the same group value
 		if txad.SignedTxn.Txn.Group != txgroup[0].SignedTxn.Txn.Group {
-			return fmt.Errorf("transactionGroup: inconsistent group values: %v != %v",
+			return evalParams, txibs, gi, fmt.Errorf("transactionGroup: inconsistent group values: %v != %v",
 				txad.SignedTxn.Txn.Group, txgroup[0].SignedTxn.Txn.Group)
 		}
 
@@ -983,14 +1074,14 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 
 			group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(txWithoutGroup.ID()))
 		} else if len(txgroup) > 1 {
-			return fmt.Errorf("transactionGroup: [%d] had zero Group but was submitted in a group of %d", gi, len(txgroup))
+			return evalParams, txibs, gi, fmt.Errorf("transactionGroup: [%d] had zero Group but was submitted in a group of %d", gi, len(txgroup))
 		}
 	}
 
 	// If we had a non-zero Group value, check that all group members are present.
 	if group.TxGroupHashes != nil {
 		if txgroup[0].SignedTxn.Txn.Group != crypto.HashObj(group) {
-			return fmt.Errorf("transactionGroup: incomplete group: %v != %v (%v)",
+			return evalParams, txibs, -1, fmt.Errorf("transactionGroup: incomplete group: %v != %v (%v)",
 				txgroup[0].SignedTxn.Txn.Group, crypto.HashObj(group), group)
 		}
 	}
@@ -999,7 +1090,7 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 	eval.blockTxBytes += groupTxBytes
 	cow.commitToParent()
 
-	return nil
+	return evalParams, txibs, -1, nil
 }
 
 // Check the minimum balance requirement for the modified accounts in `cow`.
@@ -18,7 +18,6 @@ package ledger
 
 import (
 	"context"
-	"database/sql"
 	"fmt"
 	"os"
 	"time"
@@ -45,11 +44,11 @@ import (
 
 // Ledger is a database storing the contents of the ledger.
 type Ledger struct {
-	// Database connections to the DBs storing blocks and tracker state.
-	// We use potentially different databases to avoid SQLite contention
-	// during catchup.
+	// Database connections to the DB storing tracker state, and the
+	// storage of the blocks. We use potentially different databases
+	// to avoid SQLite contention during catchup.
 	trackerDBs db.Pair
-	blockDBs   db.Pair
+	blocks     blockStore
 
 	// blockQ is the buffer of added blocks that will be flushed to
 	// persistent storage
@@ -127,23 +126,19 @@ func OpenLedger(
 		}
 	}()
 
-	l.trackerDBs, l.blockDBs, err = openLedgerDB(dbPathPrefix, dbMem)
+	l.trackerDBs, l.blocks, err = openLedgerDB(dbPathPrefix, dbMem, cfg.BlockStorageBackend, log)
 	if err != nil {
 		err = fmt.Errorf("OpenLedger.openLedgerDB %v", err)
 		return nil, err
 	}
 	l.trackerDBs.Rdb.SetLogger(log)
 	l.trackerDBs.Wdb.SetLogger(log)
-	l.blockDBs.Rdb.SetLogger(log)
-	l.blockDBs.Wdb.SetLogger(log)
 
 	l.setSynchronousMode(context.Background(), l.synchronousMode)
 
 	start := time.Now()
 	ledgerInitblocksdbCount.Inc(nil)
-	err = l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		return initBlocksDB(tx, l, []bookkeeping.Block{genesisInitState.Block}, cfg.Archival)
-	})
+	err = initBlocksDB(l.blocks, l, []bookkeeping.Block{genesisInitState.Block}, cfg.Archival)
 	ledgerInitblocksdbMicros.AddMicrosecondsSince(start, nil)
 	if err != nil {
 		err = fmt.Errorf("OpenLedger.initBlocksDB %v", err)
@@ -156,7 +151,6 @@ func OpenLedger(
 
 	l.accts.initialize(cfg)
 	l.catchpoint.initialize(cfg, dbPathPrefix)
-
 	err = l.reloadLedger()
 	if err != nil {
 		return nil, err
@@ -237,35 +231,31 @@ func (l *Ledger) verifyMatchingGenesisHash() (err error) {
 	// Check that the genesis hash, if present, matches.
 	start := time.Now()
 	ledgerVerifygenhashCount.Inc(nil)
-	err = l.blockDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
-		latest, err := blockLatest(tx)
-		if err != nil {
-			return err
-		}
+	defer ledgerVerifygenhashMicros.AddMicrosecondsSince(start, nil)
+	latest, err := l.blocks.latest()
+	if err != nil {
+		return err
+	}
 
-		hdr, err := blockGetHdr(tx, latest)
-		if err != nil {
-			return err
-		}
+	hdr, err := l.blocks.getHdr(latest)
+	if err != nil {
+		return err
+	}
 
-		params := config.Consensus[hdr.CurrentProtocol]
-		if params.SupportGenesisHash && hdr.GenesisHash != l.genesisHash {
-			return fmt.Errorf(
-				"latest block %d genesis hash %v does not match expected genesis hash %v",
-				latest, hdr.GenesisHash, l.genesisHash,
-			)
-		}
-		return nil
-	})
-	ledgerVerifygenhashMicros.AddMicrosecondsSince(start, nil)
-	return
+	params := config.Consensus[hdr.CurrentProtocol]
+	if params.SupportGenesisHash && hdr.GenesisHash != l.genesisHash {
+		return fmt.Errorf(
+			"latest block %d genesis hash %v does not match expected genesis hash %v",
+			latest, hdr.GenesisHash, l.genesisHash,
+		)
+	}
+	return nil
 }
 
-func openLedgerDB(dbPathPrefix string, dbMem bool) (trackerDBs db.Pair, blockDBs db.Pair, err error) {
+func openLedgerDB(dbPathPrefix string, dbMem bool, blockBackend string, log logging.Logger) (trackerDBs db.Pair, blocks blockStore, err error) {
 	// Backwards compatibility: we used to store both blocks and tracker
 	// state in a single SQLite db file.
 	var trackerDBFilename string
-	var blockDBFilename string
 
 	if !dbMem {
 		commonDBFilename := dbPathPrefix + ".sqlite"
@@ -280,7 +270,6 @@ func openLedgerDB(dbPathPrefix string, dbMem bool) (trackerDBs db.Pair, blockDBs
 	}
 
 	trackerDBFilename = dbPathPrefix + ".tracker.sqlite"
-	blockDBFilename = dbPathPrefix + ".block.sqlite"
 
 	outErr := make(chan error, 2)
 	go func() {
@@ -291,7 +280,7 @@ func openLedgerDB(dbPathPrefix string, dbMem bool) (trackerDBs db.Pair, blockDBs
 
 	go func() {
 		var lerr error
-		blockDBs, lerr = db.OpenPair(blockDBFilename, dbMem)
+		blocks, lerr = openBlockStore(blockBackend, dbPathPrefix, dbMem, log)
 		outErr <- lerr
 	}()
 
@@ -310,7 +299,7 @@ func (l *Ledger) setSynchronousMode(ctx context.Context, synchronousMode db.Sync
 		return
 	}
 
-	err := l.blockDBs.Wdb.SetSynchronousMode(ctx, synchronousMode, synchronousMode >= db.SynchronousModeFull)
+	err := l.blocks.setSynchronousMode(ctx, synchronousMode, synchronousMode >= db.SynchronousModeFull)
 	if err != nil {
 		l.log.Warnf("ledger.setSynchronousMode unable to set synchronous mode on blocks db: %v", err)
 		return
@@ -326,8 +315,8 @@ func (l *Ledger) setSynchronousMode(ctx context.Context, synchronousMode db.Sync
 // initBlocksDB performs DB initialization:
 // - creates and populates it with genesis blocks
 // - ensures DB is in good shape for archival mode and resets it if not
-func initBlocksDB(tx *sql.Tx, l *Ledger, initBlocks []bookkeeping.Block, isArchival bool) (err error) {
-	err = blockInit(tx, initBlocks)
+func initBlocksDB(blocks blockStore, l *Ledger, initBlocks []bookkeeping.Block, isArchival bool) (err error) {
+	err = blocks.init(initBlocks)
 	if err != nil {
 		err = fmt.Errorf("initBlocksDB.blockInit %v", err)
 		return err
@@ -335,7 +324,7 @@ func initBlocksDB(tx *sql.Tx, l *Ledger, initBlocks []bookkeeping.Block, isArchi
 
 	// in archival mode check if DB contains all blocks up to the latest
 	if isArchival {
-		earliest, err := blockEarliest(tx)
+		earliest, err := blocks.earliest()
 		if err != nil {
 			err = fmt.Errorf("initBlocksDB.blockEarliest %v", err)
 			return err
@@ -345,12 +334,12 @@ func initBlocksDB(tx *sql.Tx, l *Ledger, initBlocks []bookkeeping.Block, isArchi
 		// So reset the DB and init it again
 		if earliest != basics.Round(0) {
 			l.log.Warnf("resetting blocks DB (earliest block is %v)", earliest)
-			err := blockResetDB(tx)
+			err := blocks.reset()
 			if err != nil {
 				err = fmt.Errorf("initBlocksDB.blockResetDB %v", err)
 				return err
 			}
-			err = blockInit(tx, initBlocks)
+			err = blocks.init(initBlocks)
 			if err != nil {
 				err = fmt.Errorf("initBlocksDB.blockInit 2 %v", err)
 				return err
@@ -379,7 +368,9 @@ func (l *Ledger) Close() {
 	l.trackers.close()
 
 	// last, we close the underlying database connections.
-	l.blockDBs.Close()
+	if l.blocks != nil {
+		l.blocks.close()
+	}
 	l.trackerDBs.Close()
 }
 
@@ -389,6 +380,13 @@ func (l *Ledger) RegisterBlockListeners(listeners []BlockListener) {
 	l.notifier.register(listeners)
 }
 
+// UnregisterBlockListeners removes listeners that were added with
+// RegisterBlockListeners. A block that is already being delivered may still
+// reach a listener after this returns.
+func (l *Ledger) UnregisterBlockListeners(listeners []BlockListener) {
+	l.notifier.unregister(listeners)
+}
+
 // notifyCommit informs the trackers that all blocks up to r have been
 // written to disk.  Returns the minimum block number that must be kept
 // in the database.
@@ -495,6 +493,17 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	return data, rnd, withoutRewards, nil
 }
 
+// LookupAccountWithResources uses the accounts tracker to return the account state, including all of its
+// asset and application resources, for a given address as of a given round. The round must be within the
+// in-memory deltas window; older rounds return a RoundOffsetError. The returned AccountData contains the
+// rewards applied up to that round number, and withoutRewards contains the value before rewards were applied.
+func (l *Ledger) LookupAccountWithResources(rnd basics.Round, addr basics.Address) (data basics.AccountData, withoutRewards basics.MicroAlgos, err error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	return l.accts.lookupWithResources(rnd, addr)
+}
+
 // LookupApplication loads an application resource that matches the request parameters from the ledger.
 func (l *Ledger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
 	r, err := l.lookupResource(rnd, addr, basics.CreatableIndex(aidx), basics.AppCreatable)
@@ -556,6 +565,14 @@ func (l *Ledger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error)
 	return l.accts.LatestTotals()
 }
 
+// LookupStateDelta returns the state delta of round rnd from the ledger's in-memory deltas.
+// Rounds which were already flushed to the accounts database return a RoundOffsetError.
+func (l *Ledger) LookupStateDelta(rnd basics.Round) (ledgercore.StateDelta, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.LookupStateDelta(rnd)
+}
+
 // OnlineTotals returns the online totals of all accounts at the end of round rnd.
 func (l *Ledger) OnlineTotals(rnd basics.Round) (basics.MicroAlgos, error) {
 	l.trackerMu.RLock()
@@ -709,8 +726,8 @@ func (l *Ledger) trackerDB() db.Pair {
 }
 
 // ledgerForTracker methods
-func (l *Ledger) blockDB() db.Pair {
-	return l.blockDBs
+func (l *Ledger) blockDB() blockStore {
+	return l.blocks
 }
 
 func (l *Ledger) trackerLog() logging.Logger {
@@ -769,6 +786,25 @@ func (l *Ledger) Validate(ctx context.Context, blk bookkeeping.Block, executionP
 	return &vb, nil
 }
 
+// BlockDelta returns the block for round rnd together with the state delta it
+// produced. The delta is read from the in-memory deltas of the ledger, so only
+// rounds after the account database round can be served; requests for older
+// rounds return a *RoundOffsetError.
+func (l *Ledger) BlockDelta(rnd basics.Round) (bookkeeping.Block, ledgercore.StateDelta, error) {
+	if rnd == 0 {
+		return bookkeeping.Block{}, ledgercore.StateDelta{}, fmt.Errorf("block delta: genesis round has no delta")
+	}
+	delta, err := l.LookupStateDelta(rnd)
+	if err != nil {
+		return bookkeeping.Block{}, ledgercore.StateDelta{}, err
+	}
+	blk, err := l.Block(rnd)
+	if err != nil {
+		return bookkeeping.Block{}, ledgercore.StateDelta{}, err
+	}
+	return blk, delta, nil
+}
+
 // CompactCertParams computes the parameters for building or verifying
 // a compact cert for block hdr, using voters from block votersHdr.
 func CompactCertParams(votersHdr bookkeeping.BlockHeader, hdr bookkeeping.BlockHeader) (res compactcert.Params, err error) {
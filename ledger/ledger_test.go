@@ -38,6 +38,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/data/txntest"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
@@ -1735,6 +1736,178 @@ func TestLookupAgreement(t *testing.T) {
 	require.Equal(t, oad, ad.OnlineAccountData())
 }
 
+func TestLedgerBlockDelta(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	_, _, err := l.BlockDelta(0)
+	require.Error(t, err)
+
+	var expected []ledgercore.StateDelta
+	for i := 0; i < 3; i++ {
+		hdr, err := l.BlockHdr(l.Latest())
+		require.NoError(t, err)
+		eval, err := l.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+		require.NoError(t, err)
+
+		pay := txntest.Txn{
+			Type:        protocol.PaymentTx,
+			Sender:      addrs[0],
+			Receiver:    addrs[i+1],
+			Amount:      uint64(1000000 * (i + 1)),
+			GenesisHash: l.GenesisHash(),
+			FirstValid:  l.Latest(),
+		}
+		pay.FillDefaults(l.GenesisProto())
+		require.NoError(t, eval.Transaction(pay.SignedTxn(), transactions.ApplyData{}))
+		vb, err := eval.GenerateBlock()
+		require.NoError(t, err)
+		require.NoError(t, l.AddValidatedBlock(*vb, agreement.Certificate{}))
+		expected = append(expected, vb.Delta())
+	}
+
+	for i, want := range expected {
+		rnd := basics.Round(i + 1)
+		blk, delta, err := l.BlockDelta(rnd)
+		require.NoError(t, err)
+		require.Equal(t, rnd, blk.Round())
+		require.Equal(t, want.Totals, delta.Totals)
+		require.Equal(t, want.Accts.Len(), delta.Accts.Len())
+		for _, addr := range want.Accts.ModifiedAccounts() {
+			wantData, ok := want.Accts.GetData(addr)
+			require.True(t, ok)
+			data, ok := delta.Accts.GetData(addr)
+			require.True(t, ok)
+			require.Equal(t, wantData, data)
+		}
+
+		cached, err := l.LookupStateDelta(rnd)
+		require.NoError(t, err)
+		require.Equal(t, want.Totals, cached.Totals)
+		require.Equal(t, want.Accts, cached.Accts)
+		require.Equal(t, want.Creatables, cached.Creatables)
+	}
+
+	_, _, err = l.BlockDelta(l.Latest() + 1)
+	require.Error(t, err)
+
+	_, err = l.LookupStateDelta(0)
+	var roundOffsetErr *RoundOffsetError
+	require.ErrorAs(t, err, &roundOffsetErr)
+	_, err = l.LookupStateDelta(l.Latest() + 1)
+	require.Error(t, err)
+
+	// once the first rounds are flushed to the account database, their deltas are gone
+	commitRound(2, 0, l)
+	_, _, err = l.BlockDelta(2)
+	require.ErrorAs(t, err, &roundOffsetErr)
+	require.Equal(t, basics.Round(2), roundOffsetErr.DBRound())
+	_, _, err = l.BlockDelta(3)
+	require.NoError(t, err)
+}
+
+func TestLedgerLookupAccountWithResources(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	addBlock := func(txn txntest.Txn) {
+		hdr, err := l.BlockHdr(l.Latest())
+		require.NoError(t, err)
+		eval, err := l.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+		require.NoError(t, err)
+		txn.Sender = addrs[0]
+		txn.GenesisHash = l.GenesisHash()
+		txn.FirstValid = l.Latest()
+		txn.FillDefaults(l.GenesisProto())
+		require.NoError(t, eval.Transaction(txn.SignedTxn(), transactions.ApplyData{}))
+		vb, err := eval.GenerateBlock()
+		require.NoError(t, err)
+		require.NoError(t, l.AddValidatedBlock(*vb, agreement.Certificate{}))
+	}
+
+	// round 1 creates an asset, round 2 destroys it
+	addBlock(txntest.Txn{
+		Type:        protocol.AssetConfigTx,
+		AssetParams: basics.AssetParams{Total: 10, UnitName: "x", Manager: addrs[0]},
+	})
+	created, _, _, err := l.LookupLatest(addrs[0])
+	require.NoError(t, err)
+	require.Len(t, created.AssetParams, 1)
+	var aidx basics.AssetIndex
+	for idx := range created.AssetParams {
+		aidx = idx
+	}
+	addBlock(txntest.Txn{
+		Type:        protocol.AssetConfigTx,
+		ConfigAsset: aidx,
+	})
+
+	latest, _, _, err := l.LookupLatest(addrs[0])
+	require.NoError(t, err)
+	require.Empty(t, latest.AssetParams)
+
+	data, withoutRewards, err := l.LookupAccountWithResources(1, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, created.AssetParams, data.AssetParams)
+	require.Equal(t, created.Assets, data.Assets)
+	base, _, baseWithoutRewards, err := l.LookupAccount(1, addrs[0])
+	require.NoError(t, err)
+	require.Equal(t, base.MicroAlgos, data.MicroAlgos)
+	require.Equal(t, baseWithoutRewards, withoutRewards)
+
+	data, _, err = l.LookupAccountWithResources(2, addrs[0])
+	require.NoError(t, err)
+	require.Empty(t, data.AssetParams)
+	require.Empty(t, data.Assets)
+	require.Equal(t, latest.MicroAlgos, data.MicroAlgos)
+
+	_, _, err = l.LookupAccountWithResources(l.Latest()+1, addrs[0])
+	require.Error(t, err)
+}
+
+type chanBlockListener chan basics.Round
+
+func (cl chanBlockListener) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	cl <- block.Round()
+}
+
+func TestLedgerUnregisterBlockListeners(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, _, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	removed := make(chanBlockListener, 10)
+	kept := make(chanBlockListener, 10)
+	l.RegisterBlockListeners([]BlockListener{removed, kept})
+
+	addBlock := func() {
+		hdr, err := l.BlockHdr(l.Latest())
+		require.NoError(t, err)
+		eval, err := l.StartEvaluator(bookkeeping.MakeBlock(hdr).BlockHeader, 0, 0)
+		require.NoError(t, err)
+		vb, err := eval.GenerateBlock()
+		require.NoError(t, err)
+		require.NoError(t, l.AddValidatedBlock(*vb, agreement.Certificate{}))
+	}
+
+	addBlock()
+	require.Equal(t, basics.Round(1), <-removed)
+	require.Equal(t, basics.Round(1), <-kept)
+
+	l.UnregisterBlockListeners([]BlockListener{removed})
+	addBlock()
+	require.Equal(t, basics.Round(2), <-kept)
+	require.Empty(t, removed)
+}
+
 func BenchmarkLedgerStartup(b *testing.B) {
 	log := logging.TestingLog(b)
 	tmpDir, err := ioutil.TempDir(os.TempDir(), "BenchmarkLedgerStartup")
@@ -300,6 +300,62 @@ func (ad *AccountDeltas) MergeAccounts(other AccountDeltas) {
 	}
 }
 
+// Clone returns a deep copy of the deltas, which shares no memory with ad
+func (ad AccountDeltas) Clone() AccountDeltas {
+	res := AccountDeltas{
+		accts: append([]NewBalanceRecord(nil), ad.accts...),
+	}
+	if ad.acctsCache != nil {
+		res.acctsCache = make(map[basics.Address]int, len(ad.acctsCache))
+		for addr, idx := range ad.acctsCache {
+			res.acctsCache[addr] = idx
+		}
+	}
+
+	if ad.appResources != nil {
+		res.appResources = make([]AppResourceRecord, len(ad.appResources))
+		for i, rec := range ad.appResources {
+			if rec.Params.Params != nil {
+				params := rec.Params.Params.Clone()
+				rec.Params.Params = &params
+			}
+			if rec.State.LocalState != nil {
+				state := rec.State.LocalState.Clone()
+				rec.State.LocalState = &state
+			}
+			res.appResources[i] = rec
+		}
+	}
+	if ad.appResourcesCache != nil {
+		res.appResourcesCache = make(map[AccountApp]int, len(ad.appResourcesCache))
+		for aa, idx := range ad.appResourcesCache {
+			res.appResourcesCache[aa] = idx
+		}
+	}
+
+	if ad.assetResources != nil {
+		res.assetResources = make([]AssetResourceRecord, len(ad.assetResources))
+		for i, rec := range ad.assetResources {
+			if rec.Params.Params != nil {
+				params := *rec.Params.Params
+				rec.Params.Params = &params
+			}
+			if rec.Holding.Holding != nil {
+				holding := *rec.Holding.Holding
+				rec.Holding.Holding = &holding
+			}
+			res.assetResources[i] = rec
+		}
+	}
+	if ad.assetResourcesCache != nil {
+		res.assetResourcesCache = make(map[AccountAsset]int, len(ad.assetResourcesCache))
+		for aa, idx := range ad.assetResourcesCache {
+			res.assetResourcesCache[aa] = idx
+		}
+	}
+	return res
+}
+
 // GetResource looks up a pair of app or asset resources, given its index and type.
 func (ad AccountDeltas) GetResource(addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (ret AccountResource, ok bool) {
 	switch ctype {
@@ -152,3 +152,41 @@ func BenchmarkTxLeases(b *testing.B) {
 		}
 	}
 }
+
+func TestAccountDeltasClone(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	addr := randomAddress()
+	ad := MakeAccountDeltas(1)
+	ad.Upsert(addr, AccountData{AccountBaseData: AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 1}}})
+	ad.UpsertAppResource(addr, 1,
+		AppParamsDelta{Params: &basics.AppParams{ApprovalProgram: []byte{1}, ClearStateProgram: []byte{1}, GlobalState: basics.TealKeyValue{"k": {Type: basics.TealUintType, Uint: 1}}}},
+		AppLocalStateDelta{LocalState: &basics.AppLocalState{KeyValue: basics.TealKeyValue{"l": {Type: basics.TealUintType, Uint: 1}}}})
+	ad.UpsertAssetResource(addr, 2,
+		AssetParamsDelta{Params: &basics.AssetParams{Total: 1}},
+		AssetHoldingDelta{Holding: &basics.AssetHolding{Amount: 1}})
+
+	clone := ad.Clone()
+	a.Equal(ad, clone)
+
+	ad.Upsert(addr, AccountData{AccountBaseData: AccountBaseData{MicroAlgos: basics.MicroAlgos{Raw: 2}}})
+	ad.Upsert(randomAddress(), AccountData{})
+	ad.appResources[0].Params.Params.GlobalState["k"] = basics.TealValue{Type: basics.TealUintType, Uint: 2}
+	ad.appResources[0].State.LocalState.KeyValue["l"] = basics.TealValue{Type: basics.TealUintType, Uint: 2}
+	ad.assetResources[0].Params.Params.Total = 2
+	ad.assetResources[0].Holding.Holding.Amount = 2
+
+	a.Equal(1, clone.Len())
+	data, ok := clone.GetData(addr)
+	a.True(ok)
+	a.Equal(uint64(1), data.MicroAlgos.Raw)
+	res, ok := clone.GetResource(addr, 1, basics.AppCreatable)
+	a.True(ok)
+	a.Equal(uint64(1), res.AppParams.GlobalState["k"].Uint)
+	a.Equal(uint64(1), res.AppLocalState.KeyValue["l"].Uint)
+	res, ok = clone.GetResource(addr, 2, basics.AssetCreatable)
+	a.True(ok)
+	a.Equal(uint64(1), res.AssetParams.Total)
+	a.Equal(uint64(1), res.AssetHolding.Amount)
+}
@@ -103,6 +103,24 @@ func (bn *blockNotifier) register(listeners []BlockListener) {
 	bn.listeners = append(bn.listeners, listeners...)
 }
 
+func (bn *blockNotifier) unregister(listeners []BlockListener) {
+	bn.mu.Lock()
+	defer bn.mu.Unlock()
+
+	// build a new slice, since the worker may still be iterating over the old one
+	remaining := make([]BlockListener, 0, len(bn.listeners))
+outer:
+	for _, listener := range bn.listeners {
+		for _, removed := range listeners {
+			if listener == removed {
+				continue outer
+			}
+		}
+		remaining = append(remaining, listener)
+	}
+	bn.listeners = remaining
+}
+
 func (bn *blockNotifier) newBlock(blk bookkeeping.Block, delta ledgercore.StateDelta) {
 	bn.mu.Lock()
 	defer bn.mu.Unlock()
@@ -0,0 +1,141 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+// Reader reads the blocks and the accounts of the ledger of a node that is not running,
+// without modifying any of its files. Unlike a Ledger, it does not replay the blocks that
+// follow the round of the accounts database, so it only serves the accounts as of that round.
+type Reader struct {
+	trackerDB db.Accessor
+	blocks    blockStore
+	accountsq *accountsDbQueries
+	dbRound   basics.Round
+}
+
+// OpenReader opens the ledger stored with the given path prefix for reading. The databases
+// are opened as immutable, so the node owning them must not run while the Reader is open.
+func OpenReader(dbPathPrefix string, cfg config.Local) (*Reader, error) {
+	trackerDBFilename := dbPathPrefix + ".tracker.sqlite"
+	if _, err := os.Stat(trackerDBFilename + "-wal"); err == nil {
+		return nil, fmt.Errorf("the ledger database %s was not closed cleanly: start the node and stop it again", trackerDBFilename)
+	}
+
+	r := &Reader{}
+	var err error
+	defer func() {
+		if err != nil {
+			r.Close()
+		}
+	}()
+	r.trackerDB, err = db.MakeImmutableAccessor(trackerDBFilename)
+	if err != nil {
+		return nil, fmt.Errorf("OpenReader: %w", err)
+	}
+	err = r.trackerDB.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		version, err0 := db.GetUserVersion(ctx, tx)
+		if err0 != nil {
+			return err0
+		}
+		if version != accountDBVersion {
+			return fmt.Errorf("ledger database version %d does not match the supported version %d: start the node once to upgrade it", version, accountDBVersion)
+		}
+		r.dbRound, err0 = accountsRound(tx)
+		return err0
+	})
+	if err != nil {
+		return nil, fmt.Errorf("OpenReader: %w", err)
+	}
+	r.accountsq, err = accountsInitDbQueries(r.trackerDB.Handle, r.trackerDB.Handle)
+	if err != nil {
+		return nil, fmt.Errorf("OpenReader: %w", err)
+	}
+	r.blocks, err = openBlockStoreReadOnly(cfg.BlockStorageBackend, dbPathPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("OpenReader: %w", err)
+	}
+	return r, nil
+}
+
+// Close closes the databases of the ledger.
+func (r *Reader) Close() {
+	if r.accountsq != nil {
+		r.accountsq.close()
+		r.accountsq = nil
+	}
+	if r.trackerDB.Handle != nil {
+		r.trackerDB.Close()
+	}
+	if r.blocks != nil {
+		r.blocks.close()
+		r.blocks = nil
+	}
+}
+
+// AccountsRound returns the round the accounts are served as of.
+func (r *Reader) AccountsRound() basics.Round {
+	return r.dbRound
+}
+
+// Latest returns the latest round of the blocks.
+func (r *Reader) Latest() (basics.Round, error) {
+	return r.blocks.latest()
+}
+
+// Block returns the block of the given round.
+func (r *Reader) Block(rnd basics.Round) (bookkeeping.Block, error) {
+	return r.blocks.get(rnd)
+}
+
+// LookupAccount returns the account data of addr as of AccountsRound, including all of its
+// asset and application resources. Pending rewards are not applied.
+func (r *Reader) LookupAccount(addr basics.Address) (data basics.AccountData, err error) {
+	pad, err := r.accountsq.lookup(addr)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	if pad.rowid == 0 {
+		return basics.AccountData{}, nil
+	}
+	ledgercore.AssignAccountData(&data, pad.accountData.GetLedgerCoreAccountData())
+	resources, _, err := r.accountsq.lookupAllResources(addr)
+	if err != nil {
+		return basics.AccountData{}, err
+	}
+	for _, prd := range resources {
+		ledgercore.AssignAccountResourceToAccountData(prd.aidx, prd.AccountResource(), &data)
+	}
+	return data, nil
+}
+
+// GetCreator returns the creator of an asset or application as of AccountsRound.
+func (r *Reader) GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	addr, ok, _, err := r.accountsq.lookupCreator(cidx, ctype)
+	return addr, ok, err
+}
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// readLedgerDir returns the content of every file below dir
+func readLedgerDir(t *testing.T, dir string) map[string][]byte {
+	files := make(map[string][]byte)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		files[path] = content
+		return err
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestReader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for _, backend := range []string{blockStorageBackendSQLite, blockStorageBackendFile} {
+		t.Run(backend, func(t *testing.T) {
+			genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 10)
+			cfg := config.GetDefaultLocal()
+			cfg.BlockStorageBackend = backend
+			dir := t.TempDir()
+			dbPrefix := filepath.Join(dir, "ledger")
+
+			_, err := OpenReader(dbPrefix, cfg)
+			require.Error(t, err)
+
+			l, err := OpenLedger(logging.TestingLog(t), dbPrefix, false, genesisInitState, cfg)
+			require.NoError(t, err)
+			blk := genesisInitState.Block
+			for i := 0; i < 3; i++ {
+				blk.BlockHeader.Round++
+				require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+			}
+			l.WaitForCommit(blk.Round())
+			l.Close()
+
+			before := readLedgerDir(t, dir)
+			r, err := OpenReader(dbPrefix, cfg)
+			require.NoError(t, err)
+
+			latest, err := r.Latest()
+			require.NoError(t, err)
+			require.Equal(t, basics.Round(3), latest)
+			b, err := r.Block(2)
+			require.NoError(t, err)
+			require.Equal(t, basics.Round(2), b.Round())
+
+			// the blocks are too few for the accounts to have been committed past the genesis
+			require.Equal(t, basics.Round(0), r.AccountsRound())
+			for addr, ad := range genesisInitState.Accounts {
+				data, err := r.LookupAccount(addr)
+				require.NoError(t, err)
+				require.Equal(t, ad, data)
+			}
+			data, err := r.LookupAccount(basics.Address{1, 2, 3})
+			require.NoError(t, err)
+			require.Equal(t, basics.AccountData{}, data)
+			_, ok, err := r.GetCreator(1, basics.AssetCreatable)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			r.Close()
+			require.Equal(t, before, readLedgerDir(t, dir))
+		})
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/internal"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// SimulationResult is the outcome of simulating a transaction group with
+// Ledger.Simulate.
+type SimulationResult struct {
+	// Round is the latest ledger round the group was evaluated against; the
+	// group is evaluated as if it was part of block Round+1.
+	Round basics.Round
+
+	// TxnGroup holds the simulated transactions along with the ApplyData
+	// that evaluating them produced. If the group failed, it holds the
+	// transactions evaluated before the failing one, followed by the failing
+	// transaction (when known) with partial ApplyData: only the logs its
+	// approval or clear state program emitted before failing.
+	TxnGroup []transactions.SignedTxnWithAD
+
+	// Delta holds the ledger state changes made by the group.
+	Delta ledgercore.StateDelta
+
+	// FailedAt is the index of the transaction that made the group fail, or
+	// -1 if the group succeeded or the failure is not specific to a single
+	// transaction. FailureMessage describes the failure.
+	FailedAt       int
+	FailureMessage string
+
+	// AppBudgetAdded and AppBudgetConsumed report the pooled opcode budget
+	// available to, and used by, the application calls in the group.
+	AppBudgetAdded    uint64
+	AppBudgetConsumed uint64
+
+	// ExecTraces holds, when requested, the execution traces of the
+	// application calls of the group, indexed like TxnGroup. Entries for
+	// transactions that ran no program are nil. Traces of inner application
+	// calls are nested in their caller's trace.
+	ExecTraces []*logic.ExecTrace
+}
+
+// WouldSucceed returns true if the simulated group evaluated successfully.
+func (sr SimulationResult) WouldSucceed() bool {
+	return sr.FailureMessage == ""
+}
+
+// Simulate evaluates txgroup on top of the latest ledger state, as if it was
+// the first group of the next block, and reports the outcome. Signatures are
+// not verified (and logic signatures are not evaluated), so txgroup may be
+// unsigned or partially signed; the transactions must still name the correct
+// authorizer (AuthAddr) for rekeyed senders. The evaluation runs on a
+// throwaway copy-on-write state, so the ledger is never modified.
+//
+// If trace is set, the result also holds the per-opcode execution traces of the
+// application calls of the group.
+//
+// An error is returned only when the simulation could not be performed;
+// evaluation failures of the group itself are reported in the result.
+func (l *Ledger) Simulate(txgroup []transactions.SignedTxn, trace bool) (SimulationResult, error) {
+	latest := l.Latest()
+	prevHdr, err := l.BlockHdr(latest)
+	if err != nil {
+		return SimulationResult{}, fmt.Errorf("simulate: unable to fetch header of round %d: %w", latest, err)
+	}
+
+	next := bookkeeping.MakeBlock(prevHdr)
+	eval, err := internal.StartEvaluator(l, next.BlockHeader,
+		internal.EvaluatorOptions{
+			PaysetHint: len(txgroup),
+			Generate:   true,
+			Validate:   true,
+		})
+	if err != nil {
+		return SimulationResult{}, fmt.Errorf("simulate: unable to start evaluator for round %d: %w", next.Round(), err)
+	}
+
+	txads := make([]transactions.SignedTxnWithAD, len(txgroup))
+	for i := range txgroup {
+		txads[i].SignedTxn = txgroup[i]
+	}
+
+	result := SimulationResult{Round: latest}
+	hook := simulationHook{logs: make(map[int][]string)}
+	if trace {
+		hook.recorder = &logic.ExecTraceRecorder{}
+		hook.traces = make(map[int]*logic.ExecTrace)
+	}
+	simulated, err := eval.SimulateTransactionGroup(txads, &hook)
+	result.FailedAt = simulated.FailedAt
+	result.TxnGroup = simulated.TxnGroup
+	if err != nil {
+		result.FailureMessage = err.Error()
+		if result.FailedAt >= 0 && result.FailedAt < len(txgroup) {
+			failed := transactions.SignedTxnWithAD{SignedTxn: txgroup[result.FailedAt]}
+			failed.EvalDelta.Logs = hook.logs[result.FailedAt]
+			result.TxnGroup = append(result.TxnGroup, failed)
+		}
+	} else {
+		result.AppBudgetAdded = simulated.AppBudgetAdded
+		result.AppBudgetConsumed = simulated.AppBudgetConsumed
+		result.Delta = eval.Delta()
+	}
+
+	if trace {
+		result.ExecTraces = make([]*logic.ExecTrace, len(result.TxnGroup))
+		for gi := range result.ExecTraces {
+			result.ExecTraces[gi] = hook.traces[gi]
+		}
+	}
+	return result, nil
+}
+
+// simulationHook is a DebuggerHook that records the logs emitted by the
+// top-level application calls of a simulated group, so that they can be
+// reported even for a call that failed and whose ApplyData is discarded. If
+// recorder is set, it also collects the execution traces of those calls.
+type simulationHook struct {
+	logs map[int][]string // group index -> logs
+
+	recorder *logic.ExecTraceRecorder
+	traces   map[int]*logic.ExecTrace // group index -> trace
+}
+
+// Register is fired on program creation (DebuggerHook interface)
+func (h *simulationHook) Register(state *logic.DebugState) error {
+	if h.recorder != nil {
+		return h.recorder.Register(state)
+	}
+	return nil
+}
+
+// Update is fired on every step (DebuggerHook interface)
+func (h *simulationHook) Update(state *logic.DebugState) error {
+	if h.recorder != nil {
+		return h.recorder.Update(state)
+	}
+	return nil
+}
+
+// Complete is called when the program exits (DebuggerHook interface)
+func (h *simulationHook) Complete(state *logic.DebugState) error {
+	if h.recorder != nil {
+		if err := h.recorder.Complete(state); err != nil {
+			return err
+		}
+	}
+	// inner application calls are reported along with their caller
+	if state.Caller != "" {
+		return nil
+	}
+	h.logs[state.GroupIndex] = append([]string(nil), state.Logs...)
+	if h.recorder != nil {
+		trace := h.recorder.Trace
+		h.traces[state.GroupIndex] = &trace
+	}
+	return nil
+}
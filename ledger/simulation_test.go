@@ -0,0 +1,182 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/txntest"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func simulationTxns(l *Ledger, txns ...*txntest.Txn) []transactions.SignedTxn {
+	for _, txn := range txns {
+		txn.GenesisHash = l.GenesisHash()
+		txn.FirstValid = l.Latest()
+		txn.FillDefaults(l.GenesisProto())
+	}
+	if len(txns) == 1 {
+		return []transactions.SignedTxn{txns[0].SignedTxn()}
+	}
+	return txntest.SignedTxns(txns...)
+}
+
+func TestSimulatePayment(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	before, _, _, err := l.LookupLatest(addrs[1])
+	require.NoError(t, err)
+
+	pay := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   1000000,
+	}
+	result, err := l.Simulate(simulationTxns(l, &pay), false)
+	require.NoError(t, err)
+	require.True(t, result.WouldSucceed(), result.FailureMessage)
+	require.Equal(t, -1, result.FailedAt)
+	require.Equal(t, l.Latest(), result.Round)
+	require.Len(t, result.TxnGroup, 1)
+
+	ad, ok := result.Delta.Accts.GetData(addrs[1])
+	require.True(t, ok)
+	require.Equal(t, before.MicroAlgos.Raw+1000000, ad.MicroAlgos.Raw)
+
+	// the ledger itself is untouched
+	after, _, _, err := l.LookupLatest(addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, before, after)
+}
+
+func TestSimulateFailureIndex(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	ok := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   1000,
+	}
+	overspend := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[2],
+		Receiver: addrs[1],
+		Amount:   genBalances.Balances[addrs[2]].MicroAlgos.Raw * 2,
+	}
+	result, err := l.Simulate(simulationTxns(l, &ok, &overspend), false)
+	require.NoError(t, err)
+	require.False(t, result.WouldSucceed())
+	require.Equal(t, 1, result.FailedAt)
+	require.Contains(t, result.FailureMessage, "overspend")
+	// the transactions evaluated before the failure are reported, then the failing one
+	require.Len(t, result.TxnGroup, 2)
+	require.Equal(t, ok.Amount, result.TxnGroup[0].Txn.Amount.Raw)
+	require.Equal(t, overspend.Amount, result.TxnGroup[1].Txn.Amount.Raw)
+	require.Equal(t, transactions.ApplyData{}, result.TxnGroup[1].ApplyData)
+}
+
+func TestSimulateFailedAppCallLogs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	pay := txntest.Txn{
+		Type:     protocol.PaymentTx,
+		Sender:   addrs[0],
+		Receiver: addrs[1],
+		Amount:   1000,
+	}
+	create := txntest.Txn{
+		Type:   protocol.ApplicationCallTx,
+		Sender: addrs[0],
+		ApprovalProgram: `#pragma version 6
+byte "checking"
+log
+int 0`,
+	}
+	result, err := l.Simulate(simulationTxns(l, &pay, &create), false)
+	require.NoError(t, err)
+	require.False(t, result.WouldSucceed())
+	require.Equal(t, 1, result.FailedAt)
+	require.Contains(t, result.FailureMessage, "rejected")
+	require.Len(t, result.TxnGroup, 2)
+	require.Equal(t, protocol.PaymentTx, result.TxnGroup[0].Txn.Type)
+	require.Equal(t, []string{"checking"}, result.TxnGroup[1].EvalDelta.Logs)
+	require.Zero(t, result.TxnGroup[1].ApplicationID)
+	require.Nil(t, result.ExecTraces)
+
+	result, err = l.Simulate(simulationTxns(l, &pay, &create), true)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.FailedAt)
+	require.Len(t, result.ExecTraces, 2)
+	require.Nil(t, result.ExecTraces[0])
+	trace := result.ExecTraces[1]
+	require.NotNil(t, trace)
+	require.Equal(t, 1, trace.GroupIndex)
+	require.Len(t, trace.Steps, 3)
+	require.Equal(t, "log", trace.Steps[1].Op)
+	// the program rejects rather than erring
+	require.Empty(t, trace.Error)
+	require.Equal(t, []basics.TealValue{{Type: basics.TealUintType}}, trace.Steps[2].StackAdditions)
+}
+
+func TestSimulateAppCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	create := txntest.Txn{
+		Type:   protocol.ApplicationCallTx,
+		Sender: addrs[0],
+		ApprovalProgram: `#pragma version 6
+byte "hello"
+log
+int 1`,
+	}
+	result, err := l.Simulate(simulationTxns(l, &create), false)
+	require.NoError(t, err)
+	require.True(t, result.WouldSucceed(), result.FailureMessage)
+	require.Len(t, result.TxnGroup, 1)
+	require.Equal(t, []string{"hello"}, result.TxnGroup[0].EvalDelta.Logs)
+	require.NotZero(t, result.TxnGroup[0].ApplicationID)
+	require.Equal(t, uint64(l.GenesisProto().MaxAppProgramCost), result.AppBudgetAdded)
+	require.Equal(t, uint64(3), result.AppBudgetConsumed)
+
+	_, ok, err := l.GetCreator(basics.CreatableIndex(result.TxnGroup[0].ApplicationID), basics.AppCreatable)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/algorand/go-codec/codec"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+const (
+	// SnapshotFormatMsgpack is a gzipped tar archive laid out like a catchpoint file: a content.msgpack
+	// header followed by the msgpack encoded balances chunks.
+	SnapshotFormatMsgpack = "msgpack"
+
+	// SnapshotFormatJSON is a line with the JSON encoded header, followed by one line per account
+	// with its JSON encoded SnapshotAccount.
+	SnapshotFormatJSON = "json"
+
+	// snapshotStepDuration is how long a snapshot writes before extending its transaction warning deadline.
+	snapshotStepDuration = time.Second
+)
+
+// errSnapshotRetried is returned when the snapshot transaction is retried after a part of the
+// snapshot was already written out.
+var errSnapshotRetried = errors.New("the accounts snapshot transaction was retried after the snapshot was partially written")
+
+// snapshotJSONHandle is protocol.JSONStrictHandle without indentation, so that every entry fits on a line.
+var snapshotJSONHandle *codec.JsonHandle
+
+func init() {
+	snapshotJSONHandle = new(codec.JsonHandle)
+	snapshotJSONHandle.ErrorIfNoField = protocol.JSONStrictHandle.ErrorIfNoField
+	snapshotJSONHandle.ErrorIfNoArrayExpand = protocol.JSONStrictHandle.ErrorIfNoArrayExpand
+	snapshotJSONHandle.Canonical = protocol.JSONStrictHandle.Canonical
+	snapshotJSONHandle.RecursiveEmptyCheck = protocol.JSONStrictHandle.RecursiveEmptyCheck
+	snapshotJSONHandle.HTMLCharsAsIs = protocol.JSONStrictHandle.HTMLCharsAsIs
+	snapshotJSONHandle.MapKeyAsString = protocol.JSONStrictHandle.MapKeyAsString
+}
+
+// SnapshotAccount is an account line of a JSON accounts snapshot.
+type SnapshotAccount struct {
+	Address basics.Address     `codec:"address"`
+	Account basics.AccountData `codec:"account"`
+}
+
+// WriteAccountsSnapshot writes a snapshot of all the accounts and their resources to w, in the given
+// format. The snapshot reflects the round the accounts database is at, and is streamed to w from a
+// single read transaction, so that it remains consistent while the ledger keeps committing rounds.
+// The accounts database is in WAL mode, so the open read transaction does not block the commits of
+// the trackers, but a slow reader does hold back the checkpointing of the WAL for as long as it reads.
+// The snapshot header is the one of catchpoint files, with an empty catchpoint label.
+func (l *Ledger) WriteAccountsSnapshot(ctx context.Context, format string, w io.Writer) (header CatchpointFileHeader, err error) {
+	if format != SnapshotFormatMsgpack && format != SnapshotFormatJSON {
+		return CatchpointFileHeader{}, fmt.Errorf("unknown accounts snapshot format '%s'", format)
+	}
+
+	out := &snapshotWriter{w: w}
+	start := time.Now()
+	ledgerWriteaccountssnapshotCount.Inc(nil)
+	err = l.trackerDBs.Rdb.Atomic(func(dbCtx context.Context, tx *sql.Tx) (err error) {
+		// a retried transaction would write the beginning of the snapshot a second time.
+		if out.written > 0 {
+			return errSnapshotRetried
+		}
+		dbRound, err := accountsRound(tx)
+		if err != nil {
+			return err
+		}
+		hdr, err := l.BlockHdr(dbRound)
+		if err != nil {
+			return err
+		}
+		cw := makeCatchpointWriter(ctx, "", tx, dbRound, crypto.Digest(hdr.Hash()), "")
+		defer cw.accountsIterator.Close()
+		err = cw.readHeaderFromDatabase(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if format == SnapshotFormatMsgpack {
+			err = writeMsgpackSnapshot(ctx, dbCtx, tx, cw, out)
+		} else {
+			err = writeJSONSnapshot(ctx, dbCtx, tx, cw, out)
+		}
+		if err != nil {
+			return err
+		}
+		header = *cw.fileHeader
+		return nil
+	})
+	ledgerWriteaccountssnapshotMicros.AddMicrosecondsSince(start, nil)
+	if err != nil {
+		return CatchpointFileHeader{}, err
+	}
+	return header, nil
+}
+
+// snapshotWriter counts the bytes written to the snapshot output.
+type snapshotWriter struct {
+	w       io.Writer
+	written int64
+}
+
+func (sw *snapshotWriter) Write(p []byte) (int, error) {
+	n, err := sw.w.Write(p)
+	sw.written += int64(n)
+	return n, err
+}
+
+func writeMsgpackSnapshot(ctx context.Context, dbCtx context.Context, tx *sql.Tx, cw *catchpointWriter, out io.Writer) error {
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	writeEntry := func(name string, content []byte) error {
+		err := tw.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0600,
+			Size: int64(len(content)),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = tw.Write(content)
+		return err
+	}
+	err := writeEntry("content.msgpack", protocol.Encode(cw.fileHeader))
+	if err != nil {
+		return err
+	}
+
+	for chunkNum := uint64(1); chunkNum <= cw.fileHeader.TotalChunks; chunkNum++ {
+		err = cw.readDatabaseStep(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if len(cw.balancesChunk.Balances) == 0 {
+			break
+		}
+		err = writeEntry(catchpointBalancesChunkName(chunkNum, cw.fileHeader.TotalChunks), protocol.Encode(&cw.balancesChunk))
+		if err != nil {
+			return err
+		}
+		err = ctx.Err()
+		if err != nil {
+			return err
+		}
+		_, err = db.ResetTransactionWarnDeadline(dbCtx, tx, time.Now().Add(snapshotStepDuration))
+		if err != nil {
+			return err
+		}
+	}
+	err = tw.Close()
+	if err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func writeJSONSnapshot(ctx context.Context, dbCtx context.Context, tx *sql.Tx, cw *catchpointWriter, w io.Writer) error {
+	out := bufio.NewWriter(w)
+	enc := codec.NewEncoder(out, snapshotJSONHandle)
+	writeLine := func(obj interface{}) error {
+		err := enc.Encode(obj)
+		if err != nil {
+			return err
+		}
+		return out.WriteByte('\n')
+	}
+	err := writeLine(cw.fileHeader)
+	if err != nil {
+		return err
+	}
+
+	var writeErr error
+	lastReset := time.Now()
+	_, err = LoadAllFullAccounts(ctx, tx, "accountbase", "resources", func(addr basics.Address, ad basics.AccountData) {
+		if writeErr != nil {
+			return
+		}
+		writeErr = writeLine(SnapshotAccount{Address: addr, Account: ad})
+		if writeErr == nil {
+			writeErr = ctx.Err()
+		}
+		if writeErr == nil && time.Since(lastReset) > snapshotStepDuration/2 {
+			_, writeErr = db.ResetTransactionWarnDeadline(dbCtx, tx, time.Now().Add(snapshotStepDuration))
+			lastReset = time.Now()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	return out.Flush()
+}
+
+var ledgerWriteaccountssnapshotCount = metrics.NewCounter("ledger_writeaccountssnapshot_count", "calls")
+var ledgerWriteaccountssnapshotMicros = metrics.NewCounter("ledger_writeaccountssnapshot_micros", "µs spent")
@@ -0,0 +1,128 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLedgerWriteAccountsSnapshot(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, _, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	_, err := l.WriteAccountsSnapshot(context.Background(), "csv", ioutil.Discard)
+	require.Error(t, err)
+
+	var buf bytes.Buffer
+	header, err := l.WriteAccountsSnapshot(context.Background(), SnapshotFormatMsgpack, &buf)
+	require.NoError(t, err)
+	require.Equal(t, CatchpointFileVersionV6, header.Version)
+	require.Equal(t, basics.Round(0), header.BalancesRound)
+	require.Equal(t, uint64(len(genBalances.Balances)), header.TotalAccounts)
+	require.Empty(t, header.Catchpoint)
+	hdr, err := l.BlockHdr(0)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Digest(hdr.Hash()), header.BlockHeaderDigest)
+
+	gz, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	accounts := 0
+	for {
+		entry, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		if entry.Name == "content.msgpack" {
+			var fileHeader CatchpointFileHeader
+			require.NoError(t, protocol.Decode(content, &fileHeader))
+			require.Equal(t, header, fileHeader)
+			continue
+		}
+		require.True(t, strings.HasPrefix(entry.Name, "balances."))
+		var chunk catchpointFileBalancesChunkV6
+		require.NoError(t, protocol.Decode(content, &chunk))
+		accounts += len(chunk.Balances)
+	}
+	require.Equal(t, len(genBalances.Balances), accounts)
+
+	buf.Reset()
+	jsonHeader, err := l.WriteAccountsSnapshot(context.Background(), SnapshotFormatJSON, &buf)
+	require.NoError(t, err)
+	require.Equal(t, header, jsonHeader)
+
+	scanner := bufio.NewScanner(&buf)
+	scanner.Buffer(nil, 1<<20)
+	require.True(t, scanner.Scan())
+	var fileHeader CatchpointFileHeader
+	require.NoError(t, protocol.DecodeJSON(scanner.Bytes(), &fileHeader))
+	require.Equal(t, header, fileHeader)
+	snapshotAccounts := make(map[basics.Address]basics.AccountData)
+	for scanner.Scan() {
+		var account SnapshotAccount
+		require.NoError(t, protocol.DecodeJSON(scanner.Bytes(), &account))
+		snapshotAccounts[account.Address] = account.Account
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, genBalances.Balances, snapshotAccounts)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestLedgerWriteAccountsSnapshotWriteError(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, _, _ := ledgertesting.NewTestGenesis()
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	for _, format := range []string{SnapshotFormatMsgpack, SnapshotFormatJSON} {
+		_, err := l.WriteAccountsSnapshot(context.Background(), format, failingWriter{})
+		require.ErrorIs(t, err, io.ErrClosedPipe)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := l.WriteAccountsSnapshot(ctx, SnapshotFormatJSON, ioutil.Discard)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -133,7 +133,7 @@ type ledgerTracker interface {
 // access.  This is particularly useful for testing trackers in isolation.
 type ledgerForTracker interface {
 	trackerDB() db.Pair
-	blockDB() db.Pair
+	blockDB() blockStore
 	trackerLog() logging.Logger
 	trackerEvalVerified(bookkeeping.Block, internal.LedgerForEvaluator) (ledgercore.StateDelta, error)
 
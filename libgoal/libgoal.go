@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -34,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/daemon/algod/api/spec/common"
 	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
 	modelV2 "github.com/algorand/go-algorand/daemon/algod/api/spec/v2"
@@ -920,6 +922,16 @@ func (c *Client) SuggestedParams() (params v1.TransactionParams, err error) {
 	return
 }
 
+// EstimateFee returns the fee a transaction group of size bytes, holding txns transactions,
+// should pay to be proposed within the given number of rounds.
+func (c *Client) EstimateFee(size, txns, rounds uint64) (resp generatedV2.FeeEstimateResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		resp, err = algod.EstimateFee(size, txns, rounds)
+	}
+	return
+}
+
 // GetPendingTransactions gets a snapshot of current pending transactions on the node.
 // If maxTxns = 0, fetches as many transactions as possible.
 func (c *Client) GetPendingTransactions(maxTxns uint64) (resp v1.PendingTransactions, err error) {
@@ -1061,6 +1073,87 @@ func (c *Client) AbortCatchup() error {
 	return nil
 }
 
+// LedgerSnapshot writes a snapshot of the node's account state in the given format to w.
+func (c *Client) LedgerSnapshot(format string, w io.Writer) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.LedgerSnapshot(format, w)
+}
+
+// GetPeers returns the peers the node is connected to, along with the traffic exchanged with each of them.
+func (c *Client) GetPeers() (resp privateV2.PeersResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetPeers()
+	}
+	return
+}
+
+// GetPersistentPeers returns the addresses the node keeps connected to.
+func (c *Client) GetPersistentPeers() (resp privateV2.PersistentPeersResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetPersistentPeers()
+	}
+	return
+}
+
+// AddPersistentPeer adds an address to the phonebook of the node until it's removed, and connects to it.
+func (c *Client) AddPersistentPeer(address string) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.AddPersistentPeer(address)
+}
+
+// RemovePersistentPeer removes an address added with AddPersistentPeer.
+func (c *Client) RemovePersistentPeer(address string) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.RemovePersistentPeer(address)
+}
+
+// DisconnectPeer disconnects the peers with the given address or remote address.
+func (c *Client) DisconnectPeer(address string) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.DisconnectPeer(address)
+}
+
+// GetPeerBans returns the IP addresses and telemetry GUIDs banned from connecting to the node.
+func (c *Client) GetPeerBans() (resp privateV2.PeerBansResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetPeerBans()
+	}
+	return
+}
+
+// BanPeer bans an IP address or a telemetry GUID for the given number of seconds.
+func (c *Client) BanPeer(target string, durationSeconds uint64) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.BanPeer(target, durationSeconds)
+}
+
+// UnbanPeer lifts the ban of an IP address or a telemetry GUID.
+func (c *Client) UnbanPeer(target string) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.UnbanPeer(target)
+}
+
 // Catchup start catching up to the give catchpoint label.
 func (c *Client) Catchup(catchpointLabel string) error {
 	algod, err := c.ensureAlgodClient()
@@ -1210,6 +1303,21 @@ func (c *Client) Dryrun(data []byte) (resp generatedV2.DryrunResponse, err error
 	return
 }
 
+// SimulateTransactionGroup evaluates a transaction group against the latest ledger state without broadcasting it,
+// tracing the execution of its application calls if execTrace is set
+func (c *Client) SimulateTransactionGroup(txgroup []transactions.SignedTxn, execTrace bool) (resp generatedV2.SimulateResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		var data []byte
+		data, err = algod.RawSimulateRawTransaction(txgroup, execTrace)
+		if err != nil {
+			return
+		}
+		err = json.Unmarshal(data, &resp)
+	}
+	return
+}
+
 // TxnProof returns a Merkle proof for a transaction in a block.
 func (c *Client) TxnProof(txid string, round uint64, hashType crypto.HashType) (resp generatedV2.ProofResponse, err error) {
 	algod, err := c.ensureAlgodClient()
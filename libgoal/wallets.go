@@ -220,3 +220,34 @@ func (c *Client) ExportMasterDerivationKey(wh []byte, pw []byte) (mdk crypto.Mas
 	// Return the mdk from the response
 	return resp.MasterDerivationKey, nil
 }
+
+// BackupWallet returns an encrypted archive of the entire wallet
+func (c *Client) BackupWallet(wh []byte, pw []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kmd.BackupWallet(wh, pw)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Backup, nil
+}
+
+// RestoreWallet creates a wallet from an archive produced by BackupWallet,
+// returning the ID of the restored wallet
+func (c *Client) RestoreWallet(name []byte, pw []byte, backup []byte) ([]byte, error) {
+	kmd, err := c.ensureKmdClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := kmd.RestoreWallet(name, pw, backup)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(resp.Wallet.ID), nil
+}
@@ -262,5 +262,11 @@ func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes in
 	if node.DeadlockDetection != 0 {
 		cfg.DeadlockDetection = node.DeadlockDetection
 	}
+	if node.ConfigJSONOverride != "" {
+		err := json.NewDecoder(strings.NewReader(node.ConfigJSONOverride)).Decode(&cfg)
+		if err != nil {
+			return fmt.Errorf("invalid config override of node %s: %v", node.Name, err)
+		}
+	}
 	return cfg.SaveToFile(configFile)
 }
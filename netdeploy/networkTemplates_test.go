@@ -97,3 +97,27 @@ func TestValidate(t *testing.T) {
 	err = template.Validate()
 	a.NoError(err)
 }
+
+func TestCreateConfigFileOverride(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	a := require.New(t)
+
+	templateDir, _ := filepath.Abs("../test/testdata/nettemplates")
+	template, err := loadTemplate(filepath.Join(templateDir, "TwoNodes50EachWithRelayP2PHybrid.json"))
+	a.NoError(err)
+	a.NoError(template.Validate())
+
+	nodeDir := t.TempDir()
+	err = createConfigFile(template.Nodes[1], filepath.Join(nodeDir, config.ConfigFilename), len(template.Nodes)-1, 1)
+	a.NoError(err)
+	cfg, err := config.LoadConfigFromDisk(nodeDir)
+	a.NoError(err)
+	a.True(cfg.EnableP2PHybridMode)
+	a.Equal([]string{"127.0.0.1:4190"}, cfg.P2PSeedArray())
+	a.Equal(0, cfg.IncomingConnectionsLimit)
+
+	node := template.Nodes[1]
+	node.ConfigJSONOverride = "{"
+	a.Error(createConfigFile(node, filepath.Join(nodeDir, config.ConfigFilename), len(template.Nodes)-1, 1))
+}
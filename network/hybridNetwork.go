@@ -0,0 +1,290 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// HybridNetwork implements GossipNode by running a websocket network and a p2p network side by side.
+// The messages are sent on both networks, the handlers receive the messages of both and the messages
+// they broadcast are forwarded on both, and the peers of one network are only ever passed back to
+// that network. The HTTP services and the operator controls of PeerAdmin are those of the websocket network.
+type HybridNetwork struct {
+	ws  *WebsocketNetwork
+	p2p *P2PNetwork
+
+	readyChan chan struct{}
+	stop      chan struct{}
+}
+
+// NewHybridNetwork creates a network running ws and p2p side by side.
+func NewHybridNetwork(ws *WebsocketNetwork, p2p *P2PNetwork) *HybridNetwork {
+	return &HybridNetwork{
+		ws:        ws,
+		p2p:       p2p,
+		readyChan: make(chan struct{}),
+		stop:      make(chan struct{}),
+	}
+}
+
+// splitPeer returns the peer as a peer of the websocket network or of the p2p network.
+func (n *HybridNetwork) splitPeer(peer Peer) (wsSide Peer, p2pSide Peer) {
+	if _, ok := peer.(*p2pPeer); ok {
+		return nil, peer
+	}
+	return peer, nil
+}
+
+// Address returns the address of the websocket network.
+func (n *HybridNetwork) Address() (string, bool) {
+	return n.ws.Address()
+}
+
+// Broadcast sends a message on both networks.
+func (n *HybridNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	wsExcept, p2pExcept := n.splitPeer(except)
+	wsErr := n.ws.Broadcast(ctx, tag, data, wait, wsExcept)
+	p2pErr := n.p2p.Broadcast(ctx, tag, data, wait, p2pExcept)
+	if wsErr != nil {
+		return wsErr
+	}
+	return p2pErr
+}
+
+// BroadcastArray sends an array of messages on both networks.
+func (n *HybridNetwork) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	wsExcept, p2pExcept := n.splitPeer(except)
+	wsErr := n.ws.BroadcastArray(ctx, tags, data, wait, wsExcept)
+	p2pErr := n.p2p.BroadcastArray(ctx, tags, data, wait, p2pExcept)
+	if wsErr != nil {
+		return wsErr
+	}
+	return p2pErr
+}
+
+// Relay relays a message on both networks.
+func (n *HybridNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	wsExcept, p2pExcept := n.splitPeer(except)
+	wsErr := n.ws.Relay(ctx, tag, data, wait, wsExcept)
+	p2pErr := n.p2p.Relay(ctx, tag, data, wait, p2pExcept)
+	if wsErr != nil {
+		return wsErr
+	}
+	return p2pErr
+}
+
+// RelayArray relays an array of messages on both networks.
+func (n *HybridNetwork) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	wsExcept, p2pExcept := n.splitPeer(except)
+	wsErr := n.ws.RelayArray(ctx, tags, data, wait, wsExcept)
+	p2pErr := n.p2p.RelayArray(ctx, tags, data, wait, p2pExcept)
+	if wsErr != nil {
+		return wsErr
+	}
+	return p2pErr
+}
+
+// Disconnect disconnects a peer from the network it belongs to.
+func (n *HybridNetwork) Disconnect(badnode Peer) {
+	wsSide, p2pSide := n.splitPeer(badnode)
+	if p2pSide != nil {
+		n.p2p.Disconnect(p2pSide)
+	} else {
+		n.ws.Disconnect(wsSide)
+	}
+}
+
+// DisconnectPeers disconnects the peers of both networks.
+func (n *HybridNetwork) DisconnectPeers() {
+	n.ws.DisconnectPeers()
+	n.p2p.DisconnectPeers()
+}
+
+// Ready returns a chan that will be closed once either network is ready.
+func (n *HybridNetwork) Ready() chan struct{} {
+	return n.readyChan
+}
+
+// RegisterHTTPHandler registers the handler on the websocket network, the one serving HTTP.
+func (n *HybridNetwork) RegisterHTTPHandler(path string, handler http.Handler) {
+	n.ws.RegisterHTTPHandler(path, handler)
+}
+
+// RequestConnectOutgoing asks both networks to connect to new peers.
+func (n *HybridNetwork) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	n.p2p.RequestConnectOutgoing(replace, quit)
+	n.ws.RequestConnectOutgoing(replace, quit)
+}
+
+// GetPeers returns the peers of both networks.
+func (n *HybridNetwork) GetPeers(options ...PeerOption) []Peer {
+	return append(n.ws.GetPeers(options...), n.p2p.GetPeers(options...)...)
+}
+
+// Start starts both networks.
+func (n *HybridNetwork) Start() {
+	n.ws.Start()
+	n.p2p.Start()
+	go func() {
+		select {
+		case <-n.ws.Ready():
+		case <-n.p2p.Ready():
+		case <-n.stop:
+			return
+		}
+		close(n.readyChan)
+	}()
+}
+
+// Stop stops both networks.
+func (n *HybridNetwork) Stop() {
+	close(n.stop)
+	n.p2p.Stop()
+	n.ws.Stop()
+}
+
+// hybridHandler passes the messages of either network to a handler, and forwards the messages
+// the handler broadcasts to the other network too.
+type hybridHandler struct {
+	net     *HybridNetwork
+	handler MessageHandler
+}
+
+func (h hybridHandler) Handle(msg IncomingMessage) OutgoingMessage {
+	msg.Net = h.net
+	outmsg := h.handler.Handle(msg)
+	if outmsg.Action == Broadcast {
+		// the network the message came from forwards it to its own peers
+		if _, fromP2P := msg.Sender.(*p2pPeer); fromP2P {
+			h.net.ws.Broadcast(context.Background(), msg.Tag, msg.Data, false, nil)
+		} else {
+			h.net.p2p.Broadcast(context.Background(), msg.Tag, msg.Data, false, nil)
+		}
+	}
+	return outmsg
+}
+
+// RegisterHandlers registers the handlers on both networks.
+func (n *HybridNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
+	hybridDispatch := make([]TaggedMessageHandler, len(dispatch))
+	for i, handler := range dispatch {
+		hybridDispatch[i] = TaggedMessageHandler{Tag: handler.Tag, MessageHandler: hybridHandler{net: n, handler: handler.MessageHandler}}
+	}
+	n.ws.RegisterHandlers(hybridDispatch)
+	n.p2p.RegisterHandlers(hybridDispatch)
+}
+
+// ClearHandlers deregisters the handlers of both networks.
+func (n *HybridNetwork) ClearHandlers() {
+	n.ws.ClearHandlers()
+	n.p2p.ClearHandlers()
+}
+
+// GetRoundTripper returns the transport of the websocket network.
+func (n *HybridNetwork) GetRoundTripper() http.RoundTripper {
+	return n.ws.GetRoundTripper()
+}
+
+// OnNetworkAdvance notifies both networks that the agreement protocol made progress.
+func (n *HybridNetwork) OnNetworkAdvance() {
+	n.ws.OnNetworkAdvance()
+	n.p2p.OnNetworkAdvance()
+}
+
+// GetHTTPRequestConnection returns the connection of a request served by the websocket network.
+func (n *HybridNetwork) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	return n.ws.GetHTTPRequestConnection(request)
+}
+
+// RegisterMessageInterest registers the interest in a tag on both networks.
+func (n *HybridNetwork) RegisterMessageInterest(t protocol.Tag) error {
+	err := n.ws.RegisterMessageInterest(t)
+	if err != nil {
+		return err
+	}
+	return n.p2p.RegisterMessageInterest(t)
+}
+
+// SubstituteGenesisID substitutes the "{genesisID}" with their network-specific genesisID.
+func (n *HybridNetwork) SubstituteGenesisID(rawURL string) string {
+	return n.ws.SubstituteGenesisID(rawURL)
+}
+
+// GetPeerData returns a value stored by SetPeerData
+func (n *HybridNetwork) GetPeerData(peer Peer, key string) interface{} {
+	wsSide, p2pSide := n.splitPeer(peer)
+	if p2pSide != nil {
+		return n.p2p.GetPeerData(p2pSide, key)
+	}
+	return n.ws.GetPeerData(wsSide, key)
+}
+
+// SetPeerData attaches a piece of data to a peer.
+func (n *HybridNetwork) SetPeerData(peer Peer, key string, value interface{}) {
+	wsSide, p2pSide := n.splitPeer(peer)
+	if p2pSide != nil {
+		n.p2p.SetPeerData(p2pSide, key, value)
+	} else {
+		n.ws.SetPeerData(wsSide, key, value)
+	}
+}
+
+// PeerStats returns the statistics of the peers of the websocket network.
+func (n *HybridNetwork) PeerStats() []PeerStats {
+	return n.ws.PeerStats()
+}
+
+// AddPersistentPeer adds a persistent peer to the websocket network.
+func (n *HybridNetwork) AddPersistentPeer(addr string) error {
+	return n.ws.AddPersistentPeer(addr)
+}
+
+// RemovePersistentPeer removes a persistent peer from the websocket network.
+func (n *HybridNetwork) RemovePersistentPeer(addr string) (bool, error) {
+	return n.ws.RemovePersistentPeer(addr)
+}
+
+// PersistentPeers returns the persistent peers of the websocket network.
+func (n *HybridNetwork) PersistentPeers() []string {
+	return n.ws.PersistentPeers()
+}
+
+// DisconnectPeer disconnects the peers of the websocket network with the given address.
+func (n *HybridNetwork) DisconnectPeer(addr string) bool {
+	return n.ws.DisconnectPeer(addr)
+}
+
+// BanPeer bans an IP address or a telemetry GUID on the websocket network.
+func (n *HybridNetwork) BanPeer(target string, duration time.Duration) error {
+	return n.ws.BanPeer(target, duration)
+}
+
+// UnbanPeer lifts a ban of the websocket network.
+func (n *HybridNetwork) UnbanPeer(target string) (bool, error) {
+	return n.ws.UnbanPeer(target)
+}
+
+// PeerBans returns the bans of the websocket network.
+func (n *HybridNetwork) PeerBans() []PeerBan {
+	return n.ws.PeerBans()
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// Set up a hybrid node H, a websocket node W connected to H, and a p2p node P connected to H,
+// and test that the messages H's handlers broadcast cross from one network to the other.
+func TestHybridNetwork(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netH := NewHybridNetwork(makeTestWebsocketNode(t), makeTestP2PNode(t, p2pTestGenesisID))
+	var _ PeerAdmin = netH
+	txnsH := &p2pRecorder{action: Broadcast}
+	netH.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: txnsH}})
+	netH.Start()
+	defer netH.Stop()
+
+	netW := makeTestWebsocketNode(t)
+	wsAddrH, listening := netH.Address()
+	require.True(t, listening)
+	netW.phonebook.ReplacePeerList([]string{wsAddrH}, "default", PhoneBookEntryRelayRole)
+	txnsW := &p2pRecorder{action: Ignore}
+	netW.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: txnsW}})
+	netW.Start()
+	defer netStop(t, netW, "W")
+
+	netP := makeTestP2PNode(t, p2pTestGenesisID, p2pListenAddress(t, netH.p2p))
+	txnsP := &p2pRecorder{action: Ignore}
+	netP.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: txnsP}})
+	netP.Start()
+	defer netP.Stop()
+
+	readyTimeout := time.NewTimer(5 * time.Second)
+	waitReady(t, netW, readyTimeout.C)
+	<-netP.Ready()
+	<-netH.Ready()
+	require.Eventually(t, func() bool {
+		return len(netH.GetPeers(PeersConnectedIn)) == 2 && onlyP2PPeer(t, netP).subscribed(p2pTopic(p2pTestGenesisID, protocol.TxnTag))
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, netW.Broadcast(context.Background(), protocol.TxnTag, []byte("from W"), true, nil))
+	txnsP.waitFor(t, 1)
+	require.Equal(t, []string{"from W"}, txnsP.data())
+
+	require.NoError(t, netP.Broadcast(context.Background(), protocol.TxnTag, []byte("from P"), true, nil))
+	txnsW.waitFor(t, 1)
+	require.Equal(t, []string{"from P"}, txnsW.data())
+
+	txnsH.waitFor(t, 2)
+	require.ElementsMatch(t, []string{"from W", "from P"}, txnsH.data())
+	for _, msg := range txnsH.msgs {
+		require.Equal(t, netH, msg.Net)
+	}
+
+	// the peers of each network are disconnected by their own network
+	for _, peer := range netH.GetPeers(PeersConnectedIn) {
+		netH.SetPeerData(peer, "key", "value")
+		require.Equal(t, "value", netH.GetPeerData(peer, "key"))
+		netH.Disconnect(peer)
+	}
+	require.Eventually(t, func() bool { return len(netH.GetPeers(PeersConnectedIn)) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
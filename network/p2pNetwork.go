@@ -0,0 +1,1026 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// p2pProtocolID opens every connection of the p2p transport, so that the two ends agree on the protocol
+// before exchanging any frame, in the manner of multistream-select.
+const p2pProtocolID = "/algorand/gossip/1.0.0\n"
+
+// p2pProtocolVersion is the version reported by the peers of the p2p transport.
+const p2pProtocolVersion = "1.0.0"
+
+// p2pAuthPrefix is prepended to the nonce a peer signs to prove it holds the key of its peer ID.
+const p2pAuthPrefix = "algorand-p2p-auth"
+
+// maxP2PFrameLength is the largest frame we accept: a message of the largest size plus its topic and framing.
+const maxP2PFrameLength = maxMessageLength + 1024
+
+// maxP2PTopicsPerPeer bounds the number of topics a peer can subscribe to.
+const maxP2PTopicsPerPeer = 256
+
+const p2pHandshakeTimeout = 10 * time.Second
+const p2pDialTimeout = 10 * time.Second
+const p2pWriteTimeout = 30 * time.Second
+const p2pMeshInterval = 30 * time.Second
+const p2pSendQueueLength = 1000
+
+var errP2PSelf = errors.New("connected to ourselves")
+var errP2PDuplicatePeer = errors.New("already connected to the peer")
+var errP2PQueueFull = errors.New("peer send queue full")
+
+type p2pFrameKind uint8
+
+const (
+	// p2pFrameHello opens a connection, announcing the network, the identity and the topics of a peer
+	p2pFrameHello p2pFrameKind = iota
+	// p2pFrameAuth carries the signature of the nonce of the other end's hello
+	p2pFrameAuth
+	// p2pFrameSubscribe and p2pFrameUnsubscribe update the topics a peer wants to receive
+	p2pFrameSubscribe
+	p2pFrameUnsubscribe
+	// p2pFramePublish carries a message published on a topic
+	p2pFramePublish
+	// p2pFrameDirect carries a message sent to this peer only, such as a request or a response
+	p2pFrameDirect
+)
+
+// p2pFrame is the unit exchanged on a connection of the p2p transport. It's prefixed with its encoded length.
+type p2pFrame struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Kind  p2pFrameKind `codec:"k"`
+	Topic string       `codec:"t"`
+	Data  []byte       `codec:"d"`
+}
+
+// p2pHello is the first frame sent by both ends of a connection.
+type p2pHello struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	GenesisID string             `codec:"gen"`
+	NetworkID protocol.NetworkID `codec:"net"`
+	PublicKey crypto.PublicKey   `codec:"pk"`
+	Nonce     [32]byte           `codec:"nonce"`
+	Topics    []string           `codec:"topics"`
+}
+
+func writeP2PFrame(w io.Writer, frame p2pFrame) error {
+	_, err := w.Write(encodeP2PFrame(frame))
+	return err
+}
+
+func encodeP2PFrame(frame p2pFrame) []byte {
+	enc := protocol.EncodeReflect(&frame)
+	data := make([]byte, 4, 4+len(enc))
+	binary.BigEndian.PutUint32(data, uint32(len(enc)))
+	return append(data, enc...)
+}
+
+func readP2PFrame(r io.Reader) (frame p2pFrame, err error) {
+	var header [4]byte
+	_, err = io.ReadFull(r, header[:])
+	if err != nil {
+		return
+	}
+	size := binary.BigEndian.Uint32(header[:])
+	if size > maxP2PFrameLength {
+		err = fmt.Errorf("frame of %d bytes is larger than %d", size, maxP2PFrameLength)
+		return
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	if err != nil {
+		return
+	}
+	err = protocol.DecodeReflect(data, &frame)
+	return
+}
+
+func p2pAuthMessage(nonce [32]byte) []byte {
+	return append([]byte(p2pAuthPrefix), nonce[:]...)
+}
+
+// p2pTopic returns the pubsub topic of the messages with the given tag.
+func p2pTopic(genesisID string, tag protocol.Tag) string {
+	return "/algorand/" + genesisID + "/" + string(tag)
+}
+
+// P2PNetwork implements GossipNode with a libp2p-style transport. Every node has an identity key and
+// its peer ID is the hash of the public key; the peers prove they hold the key when connecting.
+// Every protocol.Tag is a pubsub topic: a node subscribes to the tags it has handlers for, and messages
+// are only sent to the peers subscribed to their topic. A message whose handler returns Broadcast is
+// forwarded to the other subscribers, and the messages seen before are dropped.
+// The peers are discovered from the static seed list of config.Local.P2PSeedList.
+// The identity key is generated whenever the network is created.
+type P2PNetwork struct {
+	log       logging.Logger
+	config    config.Local
+	genesisID string
+	networkID protocol.NetworkID
+
+	identity *crypto.SignatureSecrets
+	peerID   crypto.Digest
+
+	seeds    []string
+	listener net.Listener
+
+	handlers   Multiplexer
+	readBuffer chan IncomingMessage
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	wg        sync.WaitGroup
+
+	peersLock deadlock.RWMutex
+	peers     map[crypto.Digest]*p2pPeer
+	// dialing holds the seeds we're connecting to, and seedPeers the peer IDs of the seeds we connected to before.
+	dialing   map[string]bool
+	seedPeers map[string]crypto.Digest
+
+	// subscriptions maps the topics we're subscribed to to their tags
+	subscriptionsMu    deadlock.Mutex
+	subscriptions      map[string]Tag
+	messagesOfInterest map[Tag]bool
+
+	seen *messageFilter
+
+	meshRequests chan struct{}
+
+	readyOnce sync.Once
+	readyChan chan struct{}
+}
+
+// NewP2PNetwork creates a p2p network that connects to the peers of cfg.P2PSeedList,
+// and listens on cfg.P2PNetAddress if it's set.
+func NewP2PNetwork(log logging.Logger, cfg config.Local, genesisID string, networkID protocol.NetworkID) (*P2PNetwork, error) {
+	seeds := cfg.P2PSeedArray()
+	for _, seed := range seeds {
+		_, _, err := net.SplitHostPort(seed)
+		if err != nil {
+			return nil, fmt.Errorf("invalid p2p seed %s: %v", seed, err)
+		}
+	}
+	var seed crypto.Seed
+	crypto.RandBytes(seed[:])
+	identity := crypto.GenerateSignatureSecrets(seed)
+	n := &P2PNetwork{
+		log:           log,
+		config:        cfg,
+		genesisID:     genesisID,
+		networkID:     networkID,
+		identity:      identity,
+		peerID:        crypto.Hash(identity.SignatureVerifier[:]),
+		seeds:         seeds,
+		readBuffer:    make(chan IncomingMessage, 100),
+		peers:         make(map[crypto.Digest]*p2pPeer),
+		dialing:       make(map[string]bool),
+		seedPeers:     make(map[string]crypto.Digest),
+		subscriptions: make(map[string]Tag),
+		seen:          makeMessageFilter(4, 20000),
+		meshRequests:  make(chan struct{}, 1),
+		readyChan:     make(chan struct{}),
+	}
+	n.handlers.log = log
+	n.handlers.ClearHandlers([]Tag{})
+	n.ctx, n.ctxCancel = context.WithCancel(context.Background())
+	return n, nil
+}
+
+// PeerID returns the peer ID of this node, the hash of its public key.
+func (n *P2PNetwork) PeerID() string {
+	return n.peerID.String()
+}
+
+// Address returns the address we listen on, and whether we're listening yet.
+func (n *P2PNetwork) Address() (string, bool) {
+	if n.listener == nil {
+		return n.config.P2PNetAddress, false
+	}
+	return n.listener.Addr().String(), true
+}
+
+// Broadcast publishes a message on the topic of its tag.
+// If except is not nil then we will not send it to that neighboring Peer.
+// If wait is true then the call blocks until the message is queued for all the subscribed peers.
+func (n *P2PNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	return n.BroadcastArray(ctx, []protocol.Tag{tag}, [][]byte{data}, wait, except)
+}
+
+// BroadcastArray publishes an array of messages.
+func (n *P2PNetwork) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	if n.config.DisableNetworking {
+		return nil
+	}
+	if len(tags) != len(data) {
+		return errBcastInvalidArray
+	}
+	peers := n.peersSnapshot()
+	for i, tag := range tags {
+		// remember our own messages so that we don't handle them when they come back
+		n.seen.CheckIncomingMessage(tag, data[i], true, false)
+		topic := p2pTopic(n.genesisID, tag)
+		frame := encodeP2PFrame(p2pFrame{Kind: p2pFramePublish, Topic: topic, Data: data[i]})
+		for _, peer := range peers {
+			if Peer(peer) == except || !peer.subscribed(topic) {
+				continue
+			}
+			if wait {
+				err := peer.send(ctx, frame)
+				if err != nil && err != errNetworkClosing {
+					return err
+				}
+			} else {
+				peer.trySend(frame)
+			}
+		}
+	}
+	return nil
+}
+
+// Relay publishes a message. Unlike with the websocket network, every node of the p2p network forwards
+// the messages it validated.
+func (n *P2PNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	return n.Broadcast(ctx, tag, data, wait, except)
+}
+
+// RelayArray publishes an array of messages.
+func (n *P2PNetwork) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	return n.BroadcastArray(ctx, tags, data, wait, except)
+}
+
+// Disconnect closes the connection to a peer.
+func (n *P2PNetwork) Disconnect(badnode Peer) {
+	if peer, ok := badnode.(*p2pPeer); ok {
+		peer.close()
+	}
+}
+
+// DisconnectPeers closes the connections to all the peers.
+func (n *P2PNetwork) DisconnectPeers() {
+	for _, peer := range n.peersSnapshot() {
+		peer.close()
+	}
+}
+
+// Ready returns a chan that will be closed once we're connected to a peer, or when there are no seeds to connect to.
+func (n *P2PNetwork) Ready() chan struct{} {
+	return n.readyChan
+}
+
+// RegisterHTTPHandler does nothing: the p2p transport doesn't serve HTTP. The catchup service fetches blocks
+// with requests over the p2p connections instead; the other HTTP services need the websocket network of the hybrid mode.
+func (n *P2PNetwork) RegisterHTTPHandler(path string, handler http.Handler) {
+}
+
+// RequestConnectOutgoing asks the network to connect to the seeds we're not connected to.
+func (n *P2PNetwork) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	select {
+	case n.meshRequests <- struct{}{}:
+	default:
+	}
+}
+
+// GetPeers returns the connected peers. The p2p transport has no phonebook, so PeersPhonebookRelays and
+// PeersPhonebookArchivers return no peers.
+func (n *P2PNetwork) GetPeers(options ...PeerOption) []Peer {
+	outPeers := make([]Peer, 0)
+	peers := n.peersSnapshot()
+	for _, option := range options {
+		switch option {
+		case PeersConnectedOut, PeersConnectedIn:
+			for _, peer := range peers {
+				if peer.outgoing == (option == PeersConnectedOut) {
+					outPeers = append(outPeers, Peer(peer))
+				}
+			}
+		}
+	}
+	return outPeers
+}
+
+// Start listens on P2PNetAddress and connects to the seeds.
+func (n *P2PNetwork) Start() {
+	if n.config.DisableNetworking {
+		n.setReady()
+		return
+	}
+	n.updateSubscriptions()
+	if n.config.P2PNetAddress != "" {
+		listener, err := net.Listen("tcp", n.config.P2PNetAddress)
+		if err != nil {
+			n.log.Errorf("could not listen on p2p address %s: %v", n.config.P2PNetAddress, err)
+			return
+		}
+		n.listener = listener
+		n.log.Infof("p2p network listening on %s with peer ID %s", listener.Addr().String(), n.PeerID())
+		n.wg.Add(1)
+		go n.acceptThread()
+	}
+	if len(n.seeds) == 0 {
+		n.setReady()
+	}
+	for i := 0; i < incomingThreads; i++ {
+		n.wg.Add(1)
+		go n.messageHandlerThread()
+	}
+	n.wg.Add(1)
+	go n.meshThread()
+}
+
+// Stop closes the listener and the connections, and waits for the threads to exit.
+func (n *P2PNetwork) Stop() {
+	n.handlers.ClearHandlers([]Tag{})
+	n.ctxCancel()
+	if n.listener != nil {
+		n.listener.Close()
+	}
+	n.DisconnectPeers()
+	n.wg.Wait()
+}
+
+// RegisterHandlers registers the given message handlers, and subscribes to the topics of their tags.
+func (n *P2PNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
+	n.handlers.RegisterHandlers(dispatch)
+	n.updateSubscriptions()
+}
+
+// ClearHandlers deregisters all the message handlers, and unsubscribes from their topics.
+func (n *P2PNetwork) ClearHandlers() {
+	n.handlers.ClearHandlers([]Tag{})
+	n.updateSubscriptions()
+}
+
+// GetRoundTripper returns the default transport, since the p2p transport doesn't limit HTTP connections.
+func (n *P2PNetwork) GetRoundTripper() http.RoundTripper {
+	return http.DefaultTransport
+}
+
+// OnNetworkAdvance does nothing: the p2p transport doesn't watch for cliques.
+func (n *P2PNetwork) OnNetworkAdvance() {}
+
+// GetHTTPRequestConnection returns nil, since the p2p transport doesn't serve HTTP.
+func (n *P2PNetwork) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	return nil
+}
+
+// RegisterMessageInterest restricts the topics we subscribe to to the tags registered with this call.
+func (n *P2PNetwork) RegisterMessageInterest(t protocol.Tag) error {
+	n.subscriptionsMu.Lock()
+	if n.messagesOfInterest == nil {
+		n.messagesOfInterest = make(map[Tag]bool)
+	}
+	n.messagesOfInterest[t] = true
+	n.subscriptionsMu.Unlock()
+	n.updateSubscriptions()
+	return nil
+}
+
+// SubstituteGenesisID substitutes the "{genesisID}" with their network-specific genesisID.
+func (n *P2PNetwork) SubstituteGenesisID(rawURL string) string {
+	return strings.Replace(rawURL, "{genesisID}", n.genesisID, -1)
+}
+
+// GetPeerData returns a value stored by SetPeerData
+func (n *P2PNetwork) GetPeerData(peer Peer, key string) interface{} {
+	if p, ok := peer.(*p2pPeer); ok {
+		return p.getPeerData(key)
+	}
+	return nil
+}
+
+// SetPeerData attaches a piece of data to a peer.
+func (n *P2PNetwork) SetPeerData(peer Peer, key string, value interface{}) {
+	if p, ok := peer.(*p2pPeer); ok {
+		p.setPeerData(key, value)
+	}
+}
+
+func (n *P2PNetwork) setReady() {
+	n.readyOnce.Do(func() { close(n.readyChan) })
+}
+
+func (n *P2PNetwork) peersSnapshot() []*p2pPeer {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+	peers := make([]*p2pPeer, 0, len(n.peers))
+	for _, peer := range n.peers {
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+// updateSubscriptions subscribes to the topics of the tags we have handlers for, and tells the peers about the changes.
+func (n *P2PNetwork) updateSubscriptions() {
+	n.subscriptionsMu.Lock()
+	defer n.subscriptionsMu.Unlock()
+	topics := make(map[string]Tag)
+	for tag := range n.handlers.getHandlersMap() {
+		if n.messagesOfInterest != nil && !n.messagesOfInterest[tag] {
+			continue
+		}
+		topics[p2pTopic(n.genesisID, tag)] = tag
+	}
+	peers := n.peersSnapshot()
+	for topic := range topics {
+		if _, had := n.subscriptions[topic]; !had {
+			n.sendSubscription(peers, p2pFrameSubscribe, topic)
+		}
+	}
+	for topic := range n.subscriptions {
+		if _, has := topics[topic]; !has {
+			n.sendSubscription(peers, p2pFrameUnsubscribe, topic)
+		}
+	}
+	n.subscriptions = topics
+}
+
+func (n *P2PNetwork) sendSubscription(peers []*p2pPeer, kind p2pFrameKind, topic string) {
+	frame := encodeP2PFrame(p2pFrame{Kind: kind, Topic: topic})
+	for _, peer := range peers {
+		if err := peer.send(n.ctx, frame); err != nil {
+			n.log.Debugf("could not update the subscriptions of p2p peer %s: %v", peer.GetAddress(), err)
+		}
+	}
+}
+
+// subscribedTopics returns the topics we're subscribed to.
+func (n *P2PNetwork) subscribedTopics() []string {
+	n.subscriptionsMu.Lock()
+	defer n.subscriptionsMu.Unlock()
+	topics := make([]string, 0, len(n.subscriptions))
+	for topic := range n.subscriptions {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
+func (n *P2PNetwork) topicTag(topic string) (Tag, bool) {
+	n.subscriptionsMu.Lock()
+	defer n.subscriptionsMu.Unlock()
+	tag, ok := n.subscriptions[topic]
+	return tag, ok
+}
+
+func (n *P2PNetwork) acceptThread() {
+	defer n.wg.Done()
+	for {
+		conn, err := n.listener.Accept()
+		if err != nil {
+			if n.ctx.Err() == nil {
+				n.log.Warnf("p2p network stopped accepting connections: %v", err)
+			}
+			return
+		}
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			if n.config.IncomingConnectionsLimit >= 0 && n.numPeers(false) >= n.config.IncomingConnectionsLimit {
+				conn.Close()
+				return
+			}
+			_, err := n.connect(conn, false, conn.RemoteAddr().String())
+			if err != nil {
+				n.log.Debugf("rejected p2p connection from %s: %v", conn.RemoteAddr().String(), err)
+			}
+		}()
+	}
+}
+
+func (n *P2PNetwork) meshThread() {
+	defer n.wg.Done()
+	ticker := time.NewTicker(p2pMeshInterval)
+	defer ticker.Stop()
+	for {
+		n.connectSeeds()
+		select {
+		case <-ticker.C:
+		case <-n.meshRequests:
+		case <-n.ctx.Done():
+			return
+		}
+	}
+}
+
+// connectSeeds dials the seeds we're not connected to, until we have GossipFanout outgoing connections.
+func (n *P2PNetwork) connectSeeds() {
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+	outgoing := len(n.dialing)
+	for _, peer := range n.peers {
+		if peer.outgoing {
+			outgoing++
+		}
+	}
+	for _, addr := range n.seeds {
+		if outgoing >= n.config.GossipFanout {
+			return
+		}
+		if n.dialing[addr] {
+			continue
+		}
+		if id, known := n.seedPeers[addr]; known && (id == n.peerID || n.peers[id] != nil) {
+			continue
+		}
+		n.dialing[addr] = true
+		outgoing++
+		n.wg.Add(1)
+		go n.dial(addr)
+	}
+}
+
+func (n *P2PNetwork) dial(addr string) {
+	defer n.wg.Done()
+	defer func() {
+		n.peersLock.Lock()
+		delete(n.dialing, addr)
+		n.peersLock.Unlock()
+	}()
+	dialer := net.Dialer{Timeout: p2pDialTimeout}
+	conn, err := dialer.DialContext(n.ctx, "tcp", addr)
+	if err != nil {
+		n.log.Debugf("could not connect to p2p seed %s: %v", addr, err)
+		return
+	}
+	id, err := n.connect(conn, true, addr)
+	if err != nil && err != errP2PSelf && err != errP2PDuplicatePeer {
+		n.log.Infof("could not connect to p2p seed %s: %v", addr, err)
+		return
+	}
+	n.peersLock.Lock()
+	n.seedPeers[addr] = id
+	n.peersLock.Unlock()
+}
+
+// connect runs the handshake on a new connection and adds its peer. It returns the peer ID of the other end
+// even when it's ourselves or a peer we're already connected to.
+func (n *P2PNetwork) connect(conn net.Conn, outgoing bool, addr string) (crypto.Digest, error) {
+	reader := bufio.NewReader(conn)
+	// don't keep Stop waiting for the handshake to time out
+	handshakeDone := make(chan struct{})
+	go func() {
+		select {
+		case <-n.ctx.Done():
+			conn.Close()
+		case <-handshakeDone:
+		}
+	}()
+	hello, err := n.handshake(conn, reader)
+	close(handshakeDone)
+	if err != nil {
+		conn.Close()
+		return crypto.Digest{}, err
+	}
+	id := crypto.Hash(hello.PublicKey[:])
+	if id == n.peerID {
+		conn.Close()
+		return id, errP2PSelf
+	}
+	peer := &p2pPeer{
+		net:              n,
+		conn:             conn,
+		reader:           reader,
+		id:               id,
+		address:          addr,
+		outgoing:         outgoing,
+		sendQueue:        make(chan []byte, p2pSendQueueLength),
+		closing:          make(chan struct{}),
+		topics:           make(map[string]bool),
+		responseChannels: make(map[uint64]chan *Response),
+		data:             make(map[string]interface{}),
+	}
+	for _, topic := range hello.Topics {
+		peer.setSubscribed(topic, true)
+	}
+	err = n.addPeer(peer, hello)
+	if err != nil {
+		conn.Close()
+		return id, err
+	}
+	n.log.Debugf("connected to p2p peer %s at %s", id.String(), addr)
+	return id, nil
+}
+
+// handshake exchanges the hellos on a new connection, and checks that the other end holds the key of its peer ID.
+func (n *P2PNetwork) handshake(conn net.Conn, reader *bufio.Reader) (hello p2pHello, err error) {
+	conn.SetDeadline(time.Now().Add(p2pHandshakeTimeout))
+	defer conn.SetDeadline(time.Time{})
+
+	ours := p2pHello{
+		GenesisID: n.genesisID,
+		NetworkID: n.networkID,
+		PublicKey: n.identity.SignatureVerifier,
+		Topics:    n.subscribedTopics(),
+	}
+	crypto.RandBytes(ours.Nonce[:])
+	_, err = conn.Write([]byte(p2pProtocolID))
+	if err != nil {
+		return
+	}
+	err = writeP2PFrame(conn, p2pFrame{Kind: p2pFrameHello, Data: protocol.EncodeReflect(&ours)})
+	if err != nil {
+		return
+	}
+
+	protocolID := make([]byte, len(p2pProtocolID))
+	_, err = io.ReadFull(reader, protocolID)
+	if err != nil {
+		return
+	}
+	if string(protocolID) != p2pProtocolID {
+		err = fmt.Errorf("unsupported protocol %q", protocolID)
+		return
+	}
+	frame, err := readP2PFrame(reader)
+	if err != nil {
+		return
+	}
+	if frame.Kind != p2pFrameHello {
+		err = fmt.Errorf("expected a hello, got frame kind %d", frame.Kind)
+		return
+	}
+	err = protocol.DecodeReflect(frame.Data, &hello)
+	if err != nil {
+		return
+	}
+	if hello.GenesisID != n.genesisID || hello.NetworkID != n.networkID {
+		err = fmt.Errorf("peer is on network %s with genesis %s, not %s with genesis %s", hello.NetworkID, hello.GenesisID, n.networkID, n.genesisID)
+		return
+	}
+
+	sig := n.identity.SignBytes(p2pAuthMessage(hello.Nonce))
+	err = writeP2PFrame(conn, p2pFrame{Kind: p2pFrameAuth, Data: sig[:]})
+	if err != nil {
+		return
+	}
+	frame, err = readP2PFrame(reader)
+	if err != nil {
+		return
+	}
+	var peerSig crypto.Signature
+	if frame.Kind != p2pFrameAuth || len(frame.Data) != len(peerSig) {
+		err = fmt.Errorf("expected a signature, got frame kind %d of %d bytes", frame.Kind, len(frame.Data))
+		return
+	}
+	copy(peerSig[:], frame.Data)
+	if !hello.PublicKey.VerifyBytes(p2pAuthMessage(ours.Nonce), peerSig) {
+		err = errors.New("peer signature does not match its public key")
+		return
+	}
+	return
+}
+
+// addPeer adds a peer that completed the handshake, and starts its threads.
+func (n *P2PNetwork) addPeer(peer *p2pPeer, hello p2pHello) error {
+	n.subscriptionsMu.Lock()
+	defer n.subscriptionsMu.Unlock()
+	n.peersLock.Lock()
+	if n.ctx.Err() != nil {
+		n.peersLock.Unlock()
+		return errNetworkClosing
+	}
+	if n.peers[peer.id] != nil {
+		n.peersLock.Unlock()
+		return errP2PDuplicatePeer
+	}
+	n.peers[peer.id] = peer
+	n.wg.Add(2)
+	go peer.readLoop()
+	go peer.writeLoop()
+	n.peersLock.Unlock()
+
+	// the subscriptions could have changed since we sent our hello
+	sent := make(map[string]bool, len(hello.Topics))
+	for _, topic := range hello.Topics {
+		sent[topic] = true
+	}
+	for topic := range n.subscriptions {
+		if !sent[topic] {
+			peer.trySend(encodeP2PFrame(p2pFrame{Kind: p2pFrameSubscribe, Topic: topic}))
+		}
+		delete(sent, topic)
+	}
+	for topic := range sent {
+		peer.trySend(encodeP2PFrame(p2pFrame{Kind: p2pFrameUnsubscribe, Topic: topic}))
+	}
+	n.setReady()
+	return nil
+}
+
+func (n *P2PNetwork) removePeer(peer *p2pPeer) {
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+	if n.peers[peer.id] == peer {
+		delete(n.peers, peer.id)
+	}
+}
+
+func (n *P2PNetwork) numPeers(outgoing bool) (count int) {
+	for _, peer := range n.peersSnapshot() {
+		if peer.outgoing == outgoing {
+			count++
+		}
+	}
+	return
+}
+
+// deliver queues a message for the handler threads.
+func (n *P2PNetwork) deliver(peer *p2pPeer, tag Tag, data []byte) {
+	msg := IncomingMessage{Sender: peer, Tag: tag, Data: data, Net: n, Received: time.Now().UnixNano()}
+	select {
+	case n.readBuffer <- msg:
+	case <-peer.closing:
+	case <-n.ctx.Done():
+	}
+}
+
+func (n *P2PNetwork) messageHandlerThread() {
+	defer n.wg.Done()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case msg := <-n.readBuffer:
+			outmsg := n.handlers.Handle(msg)
+			switch outmsg.Action {
+			case Disconnect:
+				n.Disconnect(msg.Sender)
+			case Broadcast:
+				err := n.Broadcast(n.ctx, msg.Tag, msg.Data, false, msg.Sender)
+				if err != nil {
+					n.log.Warnf("P2PNetwork.messageHandlerThread: P2PNetwork.Broadcast returned unexpected error %v", err)
+				}
+			case Respond:
+				err := msg.Sender.(*p2pPeer).Respond(n.ctx, msg, outmsg.Topics)
+				if err != nil && err != n.ctx.Err() {
+					n.log.Warnf("P2PNetwork.messageHandlerThread: p2pPeer.Respond returned unexpected error %v", err)
+				}
+			default:
+			}
+		}
+	}
+}
+
+// p2pPeer is a peer connected over the p2p transport. It implements UnicastPeer.
+type p2pPeer struct {
+	net      *P2PNetwork
+	conn     net.Conn
+	reader   *bufio.Reader
+	id       crypto.Digest
+	address  string
+	outgoing bool
+
+	sendQueue chan []byte
+	closing   chan struct{}
+	closeOnce sync.Once
+
+	// topics are the topics the peer subscribed to
+	topicsMu deadlock.RWMutex
+	topics   map[string]bool
+
+	requestNonce       uint64
+	responseChannelsMu deadlock.Mutex
+	responseChannels   map[uint64]chan *Response
+
+	dataMu deadlock.Mutex
+	data   map[string]interface{}
+}
+
+// GetAddress returns the seed address of an outgoing peer, or the remote address of an incoming one.
+func (p *p2pPeer) GetAddress() string {
+	return p.address
+}
+
+// Version returns the version of the p2p protocol.
+func (p *p2pPeer) Version() string {
+	return p2pProtocolVersion
+}
+
+// Unicast sends the given bytes to this specific peer. Does not wait for message to be sent.
+func (p *p2pPeer) Unicast(ctx context.Context, data []byte, tag protocol.Tag) error {
+	if !p.trySend(encodeP2PFrame(p2pFrame{Kind: p2pFrameDirect, Topic: string(tag), Data: data})) {
+		return errP2PQueueFull
+	}
+	return nil
+}
+
+// Request sends a request to the peer and waits for its response.
+func (p *p2pPeer) Request(ctx context.Context, tag Tag, topics Topics) (resp *Response, e error) {
+	nonce := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(nonce, atomic.AddUint64(&p.requestNonce, 1))
+	topics = append(topics, Topic{key: "nonce", data: nonce})
+	serializedMsg := topics.MarshallTopics()
+	hash := hashTopics(serializedMsg)
+
+	responseChannel := make(chan *Response, 1)
+	p.responseChannelsMu.Lock()
+	p.responseChannels[hash] = responseChannel
+	p.responseChannelsMu.Unlock()
+	defer p.getAndRemoveResponseChannel(hash)
+
+	e = p.send(ctx, encodeP2PFrame(p2pFrame{Kind: p2pFrameDirect, Topic: string(tag), Data: serializedMsg}))
+	if e != nil {
+		return
+	}
+	select {
+	case resp = <-responseChannel:
+		return resp, nil
+	case <-p.closing:
+		return nil, fmt.Errorf("peer closing %s", p.address)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Respond sends the response of a request message.
+func (p *p2pPeer) Respond(ctx context.Context, reqMsg IncomingMessage, topics Topics) (e error) {
+	requestHash := hashTopics(reqMsg.Data)
+	requestHashData := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(requestHashData, requestHash)
+	topics = append(topics, Topic{key: requestHashKey, data: requestHashData})
+	return p.send(ctx, encodeP2PFrame(p2pFrame{Kind: p2pFrameDirect, Topic: string(protocol.TopicMsgRespTag), Data: topics.MarshallTopics()}))
+}
+
+func (p *p2pPeer) getAndRemoveResponseChannel(key uint64) (respChan chan *Response, found bool) {
+	p.responseChannelsMu.Lock()
+	defer p.responseChannelsMu.Unlock()
+	respChan, found = p.responseChannels[key]
+	delete(p.responseChannels, key)
+	return
+}
+
+func (p *p2pPeer) subscribed(topic string) bool {
+	p.topicsMu.RLock()
+	defer p.topicsMu.RUnlock()
+	return p.topics[topic]
+}
+
+func (p *p2pPeer) setSubscribed(topic string, subscribed bool) {
+	p.topicsMu.Lock()
+	defer p.topicsMu.Unlock()
+	if !subscribed {
+		delete(p.topics, topic)
+	} else if len(p.topics) < maxP2PTopicsPerPeer {
+		p.topics[topic] = true
+	}
+}
+
+func (p *p2pPeer) getPeerData(key string) interface{} {
+	p.dataMu.Lock()
+	defer p.dataMu.Unlock()
+	return p.data[key]
+}
+
+func (p *p2pPeer) setPeerData(key string, value interface{}) {
+	p.dataMu.Lock()
+	defer p.dataMu.Unlock()
+	if value == nil {
+		delete(p.data, key)
+	} else {
+		p.data[key] = value
+	}
+}
+
+// send queues an encoded frame, waiting for room in the queue.
+func (p *p2pPeer) send(ctx context.Context, frame []byte) error {
+	select {
+	case p.sendQueue <- frame:
+		return nil
+	case <-p.closing:
+		return errNetworkClosing
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// trySend queues an encoded frame, and drops it if the queue is full.
+func (p *p2pPeer) trySend(frame []byte) bool {
+	select {
+	case p.sendQueue <- frame:
+		return true
+	default:
+		networkBroadcastsDropped.Inc(nil)
+		return false
+	}
+}
+
+func (p *p2pPeer) close() {
+	p.closeOnce.Do(func() {
+		close(p.closing)
+		p.conn.Close()
+	})
+}
+
+func (p *p2pPeer) writeLoop() {
+	defer p.net.wg.Done()
+	for {
+		select {
+		case frame := <-p.sendQueue:
+			p.conn.SetWriteDeadline(time.Now().Add(p2pWriteTimeout))
+			_, err := p.conn.Write(frame)
+			if err != nil {
+				p.net.log.Debugf("could not write to p2p peer %s: %v", p.address, err)
+				p.close()
+				return
+			}
+		case <-p.closing:
+			return
+		}
+	}
+}
+
+func (p *p2pPeer) readLoop() {
+	defer p.net.wg.Done()
+	defer p.net.removePeer(p)
+	defer p.close()
+	for {
+		frame, err := readP2PFrame(p.reader)
+		if err != nil {
+			select {
+			case <-p.closing:
+			default:
+				p.net.log.Debugf("could not read from p2p peer %s: %v", p.address, err)
+			}
+			return
+		}
+		switch frame.Kind {
+		case p2pFrameSubscribe:
+			p.setSubscribed(frame.Topic, true)
+		case p2pFrameUnsubscribe:
+			p.setSubscribed(frame.Topic, false)
+		case p2pFramePublish:
+			tag, subscribed := p.net.topicTag(frame.Topic)
+			if !subscribed || p.net.seen.CheckIncomingMessage(tag, frame.Data, true, true) {
+				continue
+			}
+			p.net.deliver(p, tag, frame.Data)
+		case p2pFrameDirect:
+			if Tag(frame.Topic) == protocol.TopicMsgRespTag {
+				p.handleResponse(frame.Data)
+				continue
+			}
+			p.net.deliver(p, Tag(frame.Topic), frame.Data)
+		default:
+			p.net.log.Infof("p2p peer %s sent an unexpected frame kind %d", p.address, frame.Kind)
+			return
+		}
+	}
+}
+
+func (p *p2pPeer) handleResponse(data []byte) {
+	topics, err := UnmarshallTopics(data)
+	if err != nil {
+		p.net.log.Warnf("p2pPeer readLoop: could not read the response from %s: %v", p.address, err)
+		return
+	}
+	requestHash, found := topics.GetValue(requestHashKey)
+	if !found {
+		p.net.log.Warnf("p2pPeer readLoop: response from %s is missing the %s", p.address, requestHashKey)
+		return
+	}
+	hashKey, _ := binary.Uvarint(requestHash)
+	channel, found := p.getAndRemoveResponseChannel(hashKey)
+	if !found {
+		p.net.log.Warnf("p2pPeer readLoop: received a response from %s for a stale request", p.address)
+		return
+	}
+	select {
+	case channel <- &Response{Topics: topics}:
+	default:
+	}
+}
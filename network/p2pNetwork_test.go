@@ -0,0 +1,221 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const p2pTestGenesisID = "go-test-network-genesis"
+
+func makeTestP2PNode(t *testing.T, genesisID string, seeds ...string) *P2PNetwork {
+	cfg := defaultConfig
+	cfg.P2PNetAddress = "127.0.0.1:0"
+	cfg.P2PSeedList = strings.Join(seeds, ";")
+	n, err := NewP2PNetwork(logging.TestingLog(t), cfg, genesisID, config.Devtestnet)
+	require.NoError(t, err)
+	return n
+}
+
+func p2pListenAddress(t *testing.T, n *P2PNetwork) string {
+	addr, listening := n.Address()
+	require.True(t, listening)
+	return addr
+}
+
+// p2pRecorder records the messages it handles, and returns action for them.
+type p2pRecorder struct {
+	mu     deadlock.Mutex
+	msgs   []IncomingMessage
+	action ForwardingPolicy
+}
+
+func (r *p2pRecorder) Handle(msg IncomingMessage) OutgoingMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.msgs = append(r.msgs, msg)
+	return OutgoingMessage{Action: r.action}
+}
+
+func (r *p2pRecorder) data() (data []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, msg := range r.msgs {
+		data = append(data, string(msg.Data))
+	}
+	return
+}
+
+func (r *p2pRecorder) waitFor(t *testing.T, count int) {
+	require.Eventually(t, func() bool { return len(r.data()) >= count }, 5*time.Second, 10*time.Millisecond)
+}
+
+// onlyP2PPeer returns the only peer of n.
+func onlyP2PPeer(t *testing.T, n *P2PNetwork) *p2pPeer {
+	peers := n.peersSnapshot()
+	require.Len(t, peers, 1)
+	return peers[0]
+}
+
+func TestP2PNetworkPubsub(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestP2PNode(t, p2pTestGenesisID)
+	votesA := &p2pRecorder{action: Broadcast}
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.AgreementVoteTag, MessageHandler: votesA}})
+	netA.Start()
+	defer netA.Stop()
+	addrA := p2pListenAddress(t, netA)
+
+	netB := makeTestP2PNode(t, p2pTestGenesisID, addrA)
+	netB.Start()
+	defer netB.Stop()
+	netC := makeTestP2PNode(t, p2pTestGenesisID, addrA)
+	votesC := &p2pRecorder{action: Ignore}
+	netC.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.AgreementVoteTag, MessageHandler: votesC}})
+	netC.Start()
+	defer netC.Stop()
+
+	voteTopic := p2pTopic(p2pTestGenesisID, protocol.AgreementVoteTag)
+	txnTopic := p2pTopic(p2pTestGenesisID, protocol.TxnTag)
+	<-netB.Ready()
+	<-netC.Ready()
+	require.Eventually(t, func() bool {
+		peers := netA.GetPeers(PeersConnectedIn)
+		return len(peers) == 2 && len(netA.GetPeers(PeersConnectedOut)) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	peerAofB := onlyP2PPeer(t, netB)
+	require.True(t, peerAofB.subscribed(voteTopic))
+	require.False(t, peerAofB.subscribed(txnTopic))
+	require.Eventually(t, func() bool {
+		for _, peer := range netA.peersSnapshot() {
+			if peer.subscribed(voteTopic) {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// B and C aren't connected: the votes of B reach C because A relays them, and A drops the duplicate
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.AgreementVoteTag, []byte("vote1"), true, nil))
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.AgreementVoteTag, []byte("vote1"), true, nil))
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.AgreementVoteTag, []byte("vote2"), true, nil))
+	votesC.waitFor(t, 2)
+	require.ElementsMatch(t, []string{"vote1", "vote2"}, votesC.data())
+	require.ElementsMatch(t, []string{"vote1", "vote2"}, votesA.data())
+	require.Equal(t, netA.peerID, votesC.msgs[0].Sender.(*p2pPeer).id)
+	require.Equal(t, netB.peerID, votesA.msgs[0].Sender.(*p2pPeer).id)
+
+	// subscribing to a topic later is announced to the connected peers
+	txnsA := &p2pRecorder{action: Ignore}
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: txnsA}})
+	require.Eventually(t, func() bool { return peerAofB.subscribed(txnTopic) }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.TxnTag, []byte("txn"), true, nil))
+	txnsA.waitFor(t, 1)
+	require.Equal(t, []string{"txn"}, txnsA.data())
+
+	netA.ClearHandlers()
+	require.Eventually(t, func() bool { return !peerAofB.subscribed(voteTopic) && !peerAofB.subscribed(txnTopic) }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestP2PNetworkRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestP2PNode(t, p2pTestGenesisID)
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.UniEnsBlockReqTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		topics, err := UnmarshallTopics(msg.Data)
+		require.NoError(t, err)
+		round, found := topics.GetValue("round")
+		require.True(t, found)
+		respTopics := Topics{MakeTopic("block", append([]byte("block"), round...))}
+		require.NoError(t, msg.Sender.(UnicastPeer).Respond(context.Background(), msg, respTopics))
+		return OutgoingMessage{Action: Ignore}
+	})}})
+	netA.Start()
+	defer netA.Stop()
+
+	netB := makeTestP2PNode(t, p2pTestGenesisID, p2pListenAddress(t, netA))
+	netB.Start()
+	defer netB.Stop()
+	<-netB.Ready()
+
+	peers := netB.GetPeers(PeersConnectedOut)
+	require.Len(t, peers, 1)
+	require.Empty(t, netB.GetPeers(PeersConnectedIn, PeersPhonebookRelays, PeersPhonebookArchivers))
+	peer := peers[0].(UnicastPeer)
+	require.Equal(t, p2pListenAddress(t, netA), peer.GetAddress())
+	for _, round := range []string{"1", "2"} {
+		resp, err := peer.Request(context.Background(), protocol.UniEnsBlockReqTag, Topics{MakeTopic("round", []byte(round))})
+		require.NoError(t, err)
+		block, found := resp.Topics.GetValue("block")
+		require.True(t, found)
+		require.Equal(t, "block"+round, string(block))
+	}
+}
+
+func TestP2PNetworkHandshake(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestP2PNode(t, p2pTestGenesisID)
+	netA.Start()
+	defer netA.Stop()
+	addrA := p2pListenAddress(t, netA)
+
+	// a node of another network is rejected
+	other := makeTestP2PNode(t, "other-genesis", addrA)
+	_, err := NewP2PNetwork(logging.TestingLog(t), config.Local{P2PSeedList: "no-port"}, p2pTestGenesisID, config.Devtestnet)
+	require.Error(t, err)
+	other.wg.Add(1)
+	other.dial(addrA)
+	require.Empty(t, other.peersSnapshot())
+	require.NotContains(t, other.seedPeers, addrA)
+
+	// a seed that is ourselves isn't dialed again
+	netA.wg.Add(1)
+	netA.dial(addrA)
+	require.Empty(t, netA.peersSnapshot())
+	require.Equal(t, netA.peerID, netA.seedPeers[addrA])
+
+	// nor is a seed we're already connected to
+	netB := makeTestP2PNode(t, p2pTestGenesisID, addrA)
+	netB.Start()
+	defer netB.Stop()
+	<-netB.Ready()
+	netB.wg.Add(1)
+	netB.dial(addrA)
+	require.Len(t, netB.peersSnapshot(), 1)
+	netB.connectSeeds()
+	require.Empty(t, netB.dialing)
+
+	// a disconnected seed is dialed again
+	netB.Disconnect(onlyP2PPeer(t, netB))
+	require.Eventually(t, func() bool { return len(netB.peersSnapshot()) == 0 }, 5*time.Second, 10*time.Millisecond)
+	netB.RequestConnectOutgoing(false, nil)
+	require.Eventually(t, func() bool { return len(netB.peersSnapshot()) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// disconnectBanned is the reason peers get disconnected for when they are banned
+const disconnectBanned disconnectReason = "Banned"
+
+// ErrInvalidBanTarget is returned when banning something that is neither an IP address nor a telemetry GUID
+var ErrInvalidBanTarget = errors.New("the ban target must be an IP address or a telemetry GUID")
+
+// ErrInvalidBanDuration is returned when banning for a non positive duration
+var ErrInvalidBanDuration = errors.New("the ban duration must be positive")
+
+// PeerBan bans the peers connecting from an IP address, or reporting a telemetry GUID.
+type PeerBan struct {
+	// Target is the banned IP address or telemetry GUID.
+	Target string `json:"target"`
+	// Expires is the time the ban is lifted at.
+	Expires time.Time `json:"expires"`
+}
+
+// peerBansFile is the content of the file the bans are persisted to
+type peerBansFile struct {
+	Bans []PeerBan `json:"bans"`
+}
+
+// peerBanList holds the bans of IP addresses and telemetry GUIDs set by the operator.
+// The zero value is an empty list that isn't persisted.
+type peerBanList struct {
+	mu deadlock.Mutex
+
+	// path is the file the bans are saved to whenever they change, if set.
+	path string
+	bans map[string]time.Time
+}
+
+// normalizeBanTarget returns the canonical form of an IP address, so that bans match
+// the hosts we compare them to, and the target unchanged if it's a telemetry GUID.
+func normalizeBanTarget(target string) (string, error) {
+	target = strings.TrimSpace(target)
+	if ip := net.ParseIP(target); ip != nil {
+		return ip.String(), nil
+	}
+	if target == "" || strings.ContainsAny(target, ":/ ") {
+		return "", ErrInvalidBanTarget
+	}
+	// telemetry GUIDs are truncated the same way when read from the handshake headers
+	return logging.SanitizeTelemetryString(target, 1), nil
+}
+
+// load reads the bans persisted to path, and persists the bans to path from now on.
+// A missing file is an empty list. If the file can't be read, the list stays empty,
+// and the file is replaced the next time the bans change.
+func (l *peerBanList) load(path string) error {
+	var persisted peerBansFile
+	err := codecs.LoadObjectFromFile(path, &persisted)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.path = path
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to load the peer bans from %s: %w", path, err)
+	}
+	now := time.Now()
+	for _, ban := range persisted.Bans {
+		if ban.Expires.After(now) {
+			if l.bans == nil {
+				l.bans = make(map[string]time.Time)
+			}
+			l.bans[ban.Target] = ban.Expires
+		}
+	}
+	return nil
+}
+
+// save persists the bans, dropping the expired ones. The caller must hold l.mu.
+func (l *peerBanList) save() error {
+	if l.path == "" {
+		return nil
+	}
+	persisted := peerBansFile{Bans: l.listLocked(time.Now())}
+	err := codecs.SaveObjectToFile(l.path, &persisted, true)
+	if err != nil {
+		return fmt.Errorf("unable to save the peer bans to %s: %w", l.path, err)
+	}
+	return nil
+}
+
+// listLocked drops the expired bans and returns the others sorted by target. The caller must hold l.mu.
+func (l *peerBanList) listLocked(now time.Time) []PeerBan {
+	out := make([]PeerBan, 0, len(l.bans))
+	for target, expires := range l.bans {
+		if !expires.After(now) {
+			delete(l.bans, target)
+			continue
+		}
+		out = append(out, PeerBan{Target: target, Expires: expires})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Target < out[j].Target
+	})
+	return out
+}
+
+func (l *peerBanList) list() []PeerBan {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.listLocked(time.Now())
+}
+
+// ban bans target until expires. The ban is in effect even if it could not be persisted.
+func (l *peerBanList) ban(target string, expires time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.bans == nil {
+		l.bans = make(map[string]time.Time)
+	}
+	l.bans[target] = expires
+	return l.save()
+}
+
+// unban lifts the ban of target, and returns false if it wasn't banned.
+func (l *peerBanList) unban(target string) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, has := l.bans[target]; !has {
+		return false, nil
+	}
+	delete(l.bans, target)
+	return true, l.save()
+}
+
+// isBanned returns true if either the host or the telemetry GUID is banned. Empty values never are.
+func (l *peerBanList) isBanned(host, telemetryGUID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.bans) == 0 {
+		return false
+	}
+	now := time.Now()
+	for _, target := range []string{host, telemetryGUID} {
+		if target == "" {
+			continue
+		}
+		if expires, has := l.bans[target]; has && expires.After(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// bannedHost normalizes the host of an IP address so that it can be looked up in the ban list
+func bannedHost(host string) string {
+	if ip := net.ParseIP(host); ip != nil {
+		return ip.String()
+	}
+	return host
+}
+
+// peerHost returns the IP address the peer is connected from, or to.
+func (wp *wsPeer) peerHost() string {
+	if !wp.outgoing && wp.OriginAddress() != "" {
+		return bannedHost(wp.OriginAddress())
+	}
+	if wp.conn != nil {
+		if addr := wp.conn.RemoteAddr(); addr != nil {
+			return bannedHost(justHost(addr.String()))
+		}
+	}
+	return ""
+}
+
+// gossipAddrBanned returns true if the host of a gossip address is a banned IP address.
+func (wn *WebsocketNetwork) gossipAddrBanned(gossipAddr string) bool {
+	parsed, err := url.Parse(gossipAddr)
+	if err != nil {
+		return false
+	}
+	return wn.peerBans.isBanned(bannedHost(parsed.Hostname()), "")
+}
+
+// LoadPeerBans loads the peer bans persisted to path, and persists them to path
+// whenever they change from now on.
+func (wn *WebsocketNetwork) LoadPeerBans(path string) error {
+	return wn.peerBans.load(path)
+}
+
+// BanPeer bans the peers connecting from an IP address, or reporting a telemetry GUID,
+// for the given duration, and disconnects the connected ones. Since telemetry GUIDs are
+// reported by the peers themselves, banning one only keeps away well behaved peers.
+func (wn *WebsocketNetwork) BanPeer(target string, duration time.Duration) error {
+	target, err := normalizeBanTarget(target)
+	if err != nil {
+		return err
+	}
+	if duration <= 0 {
+		return ErrInvalidBanDuration
+	}
+	err = wn.peerBans.ban(target, time.Now().Add(duration))
+
+	wn.peersLock.RLock()
+	var banned []*wsPeer
+	for _, peer := range wn.peers {
+		if peer.peerHost() == target || peer.TelemetryGUID == target {
+			banned = append(banned, peer)
+		}
+	}
+	wn.peersLock.RUnlock()
+	for _, peer := range banned {
+		wn.wg.Add(1)
+		go wn.disconnectThread(peer, disconnectBanned)
+	}
+	return err
+}
+
+// UnbanPeer lifts the ban of an IP address or telemetry GUID, and returns false if it wasn't banned.
+func (wn *WebsocketNetwork) UnbanPeer(target string) (bool, error) {
+	target, err := normalizeBanTarget(target)
+	if err != nil {
+		return false, err
+	}
+	return wn.peerBans.unban(target)
+}
+
+// PeerBans returns the bans in effect, sorted by target.
+func (wn *WebsocketNetwork) PeerBans() []PeerBan {
+	return wn.peerBans.list()
+}
+
+// checkIncomingConnectionBanned refuses the incoming connections from a banned IP address or telemetry GUID.
+func (wn *WebsocketNetwork) checkIncomingConnectionBanned(response http.ResponseWriter, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	if !wn.peerBans.isBanned(bannedHost(remoteHost), otherTelemetryGUID) {
+		return http.StatusOK
+	}
+	networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "banned"})
+	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+		telemetryspec.ConnectPeerFailEventDetails{
+			Address:       remoteHost,
+			TelemetryGUID: otherTelemetryGUID,
+			Incoming:      true,
+			InstanceName:  otherInstanceName,
+			Reason:        "Banned",
+		})
+	response.WriteHeader(http.StatusForbidden)
+	return http.StatusForbidden
+}
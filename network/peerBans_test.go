@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestNormalizeBanTarget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for target, expected := range map[string]string{
+		"10.0.0.1":                             "10.0.0.1",
+		" 10.0.0.1 ":                           "10.0.0.1",
+		"::ffff:10.0.0.1":                      "10.0.0.1",
+		"2001:DB8::1":                          "2001:db8::1",
+		"e06b4ee0-2ff9-4a4e-a1a1-2d4a0c0d1f5c": "e06b4ee0-2ff9-4a4e-a1a1-2d4a0c0d1f5c",
+	} {
+		normalized, err := normalizeBanTarget(target)
+		require.NoError(t, err, target)
+		require.Equal(t, expected, normalized, target)
+	}
+	for _, target := range []string{"", " ", "10.0.0.1:4160", "10.0.0.0/24", "two words"} {
+		_, err := normalizeBanTarget(target)
+		require.Equal(t, ErrInvalidBanTarget, err, target)
+	}
+}
+
+func TestPeerBanListPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), "peerbans.json")
+	var bans peerBanList
+	require.NoError(t, bans.load(path))
+	require.Empty(t, bans.list())
+	require.False(t, bans.isBanned("10.0.0.1", "guid"))
+
+	hour := time.Now().Add(time.Hour)
+	require.NoError(t, bans.ban("10.0.0.1", hour))
+	require.NoError(t, bans.ban("guid", hour))
+	require.NoError(t, bans.ban("10.0.0.2", time.Now().Add(-time.Second)))
+	require.True(t, bans.isBanned("10.0.0.1", ""))
+	require.True(t, bans.isBanned("10.0.0.3", "guid"))
+	require.False(t, bans.isBanned("10.0.0.2", ""))
+	require.False(t, bans.isBanned("", ""))
+
+	unbanned, err := bans.unban("guid")
+	require.NoError(t, err)
+	require.True(t, unbanned)
+	unbanned, err = bans.unban("guid")
+	require.NoError(t, err)
+	require.False(t, unbanned)
+
+	// the expired bans are neither listed nor reloaded
+	var reloaded peerBanList
+	require.NoError(t, reloaded.load(path))
+	list := reloaded.list()
+	require.Len(t, list, 1)
+	require.Equal(t, "10.0.0.1", list[0].Target)
+	require.True(t, list[0].Expires.Equal(hour.Round(0)))
+	require.True(t, reloaded.isBanned("10.0.0.1", ""))
+}
+
+func TestPeerBanListCorruptFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), "peerbans.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0600))
+	var bans peerBanList
+	require.Error(t, bans.load(path))
+	require.Empty(t, bans.list())
+
+	// the file is replaced the next time the bans change
+	require.NoError(t, bans.ban("10.0.0.1", time.Now().Add(time.Hour)))
+	var reloaded peerBanList
+	require.NoError(t, reloaded.load(path))
+	require.True(t, reloaded.isBanned("10.0.0.1", ""))
+}
+
+func TestPeerBanEnforced(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	// banning disconnects the connected peers
+	require.NoError(t, netA.BanPeer("127.0.0.1", time.Hour))
+	require.Eventually(t, func() bool {
+		return len(netA.PeerStats()) == 0 && len(netB.PeerStats()) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Len(t, netA.PeerBans(), 1)
+
+	// and incoming connections are refused
+	netB.RequestConnectOutgoing(false, nil)
+	require.Never(t, func() bool {
+		return len(netA.PeerStats()) > 0
+	}, 500*time.Millisecond, 10*time.Millisecond)
+
+	response := httptest.NewRecorder()
+	require.Equal(t, http.StatusForbidden, netA.checkIncomingConnectionLimits(response, nil, "127.0.0.1", "", ""))
+	require.Equal(t, http.StatusForbidden, response.Code)
+
+	// outgoing connections aren't attempted
+	require.NoError(t, netB.BanPeer("127.0.0.1", time.Hour))
+	_, ok := netB.tryConnectReserveAddr(addrA)
+	require.False(t, ok)
+	unbanned, err := netB.UnbanPeer("127.0.0.1")
+	require.NoError(t, err)
+	require.True(t, unbanned)
+
+	unbanned, err = netA.UnbanPeer("127.0.0.1")
+	require.NoError(t, err)
+	require.True(t, unbanned)
+	netB.RequestConnectOutgoing(false, nil)
+	require.Eventually(t, func() bool {
+		return len(netA.PeerStats()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// telemetry GUIDs are banned alike
+	require.NoError(t, netA.BanPeer("guid-b", time.Hour))
+	response = httptest.NewRecorder()
+	require.Equal(t, http.StatusForbidden, netA.checkIncomingConnectionLimits(response, nil, "10.0.0.1", "guid-b", ""))
+	require.Len(t, netA.PeerStats(), 1)
+}
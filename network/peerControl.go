@@ -0,0 +1,154 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/algorand/go-algorand/util/codecs"
+)
+
+// persistentPeersNetworkName is the network name the persistent peers are kept under
+// in the phonebook, so that refreshing the relays of the other networks never drops them.
+const persistentPeersNetworkName = "persistent-peers"
+
+// disconnectOperatorRequest is the reason peers get disconnected for when the operator asks for it
+const disconnectOperatorRequest disconnectReason = "OperatorRequest"
+
+// persistentPeersFile is the content of the file the persistent peers are persisted to
+type persistentPeersFile struct {
+	Peers []string `json:"peers"`
+}
+
+// LoadPersistentPeers adds the persistent peers persisted to path, and persists the persistent
+// peers to path whenever they change from now on. A missing file is an empty list. If the file
+// can't be read, no peer is added, and the file is replaced the next time the peers change.
+func (wn *WebsocketNetwork) LoadPersistentPeers(path string) error {
+	var persisted persistentPeersFile
+	err := codecs.LoadObjectFromFile(path, &persisted)
+
+	wn.persistentPeersLock.Lock()
+	defer wn.persistentPeersLock.Unlock()
+	wn.persistentPeersPath = path
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to load the persistent peers from %s: %w", path, err)
+	}
+	for _, addr := range persisted.Peers {
+		wn.persistentPeers[addr] = true
+	}
+	wn.phonebook.ExtendPeerList(persisted.Peers, persistentPeersNetworkName, PhoneBookEntryRelayRole)
+	return nil
+}
+
+// savePersistentPeers persists the persistent peers. The caller must hold wn.persistentPeersLock.
+func (wn *WebsocketNetwork) savePersistentPeers() error {
+	if wn.persistentPeersPath == "" {
+		return nil
+	}
+	persisted := persistentPeersFile{Peers: wn.persistentPeersLocked()}
+	err := codecs.SaveObjectToFile(wn.persistentPeersPath, &persisted, true)
+	if err != nil {
+		return fmt.Errorf("unable to save the persistent peers to %s: %w", wn.persistentPeersPath, err)
+	}
+	return nil
+}
+
+// AddPersistentPeer adds addr to the phonebook, where it's kept until it's removed
+// with RemovePersistentPeer, and keeps the node connected to it. The peer is added
+// even if it could not be persisted.
+func (wn *WebsocketNetwork) AddPersistentPeer(addr string) error {
+	wn.persistentPeersLock.Lock()
+	wn.persistentPeers[addr] = true
+	err := wn.savePersistentPeers()
+	wn.persistentPeersLock.Unlock()
+	wn.phonebook.ExtendPeerList([]string{addr}, persistentPeersNetworkName, PhoneBookEntryRelayRole)
+	wn.connectPersistentPeers()
+	return err
+}
+
+// RemovePersistentPeer removes addr from the persistent peers, and returns false if it wasn't one.
+// The node stays connected to it until the connection is dropped for another reason.
+func (wn *WebsocketNetwork) RemovePersistentPeer(addr string) (bool, error) {
+	wn.persistentPeersLock.Lock()
+	defer wn.persistentPeersLock.Unlock()
+	if !wn.persistentPeers[addr] {
+		return false, nil
+	}
+	delete(wn.persistentPeers, addr)
+	wn.phonebook.ReplacePeerList(wn.persistentPeersLocked(), persistentPeersNetworkName, PhoneBookEntryRelayRole)
+	return true, wn.savePersistentPeers()
+}
+
+// PersistentPeers returns the addresses of the persistent peers, sorted.
+func (wn *WebsocketNetwork) PersistentPeers() []string {
+	wn.persistentPeersLock.Lock()
+	defer wn.persistentPeersLock.Unlock()
+	return wn.persistentPeersLocked()
+}
+
+// persistentPeersLocked returns the addresses of the persistent peers, sorted. The caller must hold wn.persistentPeersLock.
+func (wn *WebsocketNetwork) persistentPeersLocked() []string {
+	out := make([]string, 0, len(wn.persistentPeers))
+	for addr := range wn.persistentPeers {
+		out = append(out, addr)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func (wn *WebsocketNetwork) isPersistentPeer(addr string) bool {
+	wn.persistentPeersLock.Lock()
+	defer wn.persistentPeersLock.Unlock()
+	return wn.persistentPeers[addr]
+}
+
+// connectPersistentPeers connects to the persistent peers the node isn't connected, nor connecting, to.
+func (wn *WebsocketNetwork) connectPersistentPeers() {
+	for _, addr := range wn.PersistentPeers() {
+		if addr == wn.config.PublicAddress {
+			continue
+		}
+		gossipAddr, ok := wn.tryConnectReserveAddr(addr)
+		if ok {
+			wn.wg.Add(1)
+			go wn.tryConnect(addr, gossipAddr)
+		}
+	}
+}
+
+// DisconnectPeer disconnects the peers whose address or remote address is addr,
+// and returns false if there were none. Persistent peers get reconnected to.
+func (wn *WebsocketNetwork) DisconnectPeer(addr string) bool {
+	wn.peersLock.RLock()
+	var matching []*wsPeer
+	for _, peer := range wn.peers {
+		if peer.GetAddress() == addr || (peer.conn != nil && peer.conn.RemoteAddr() != nil && peer.conn.RemoteAddr().String() == addr) {
+			matching = append(matching, peer)
+		}
+	}
+	wn.peersLock.RUnlock()
+	for _, peer := range matching {
+		wn.wg.Add(1)
+		go wn.disconnectThread(peer, disconnectOperatorRequest)
+	}
+	return len(matching) > 0
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPersistentPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer netStop(t, netA, "A")
+	// B doesn't look for peers on its own
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 0
+	path := filepath.Join(t.TempDir(), "persistentpeers.json")
+	require.NoError(t, netB.LoadPersistentPeers(path))
+	netB.Start()
+	defer netStop(t, netB, "B")
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+
+	require.NoError(t, netB.AddPersistentPeer(addrA))
+	require.Eventually(t, func() bool {
+		stats := netB.PeerStats()
+		return len(stats) == 1 && stats[0].Outgoing && stats[0].Address == addrA
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Equal(t, []string{addrA}, netB.PersistentPeers())
+
+	// the persistent peers are reloaded after a restart
+	restarted := makeTestWebsocketNode(t)
+	require.NoError(t, restarted.LoadPersistentPeers(path))
+	require.Equal(t, []string{addrA}, restarted.PersistentPeers())
+	require.Equal(t, []string{addrA}, restarted.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+
+	// refreshing the relays of the other networks keeps the persistent peers
+	netB.phonebook.ReplacePeerList(nil, "default", PhoneBookEntryRelayRole)
+	require.Equal(t, []string{addrA}, netB.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+
+	// disconnecting drops the connection, which the mesh thread restores
+	require.True(t, netB.DisconnectPeer(addrA))
+	require.False(t, netB.DisconnectPeer("127.0.0.1:1"))
+	require.Eventually(t, func() bool {
+		return len(netB.PeerStats()) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+	netB.RequestConnectOutgoing(false, nil)
+	require.Eventually(t, func() bool {
+		return len(netB.PeerStats()) == 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	removed, err := netB.RemovePersistentPeer(addrA)
+	require.NoError(t, err)
+	require.True(t, removed)
+	removed, err = netB.RemovePersistentPeer(addrA)
+	require.NoError(t, err)
+	require.False(t, removed)
+	require.Empty(t, netB.PersistentPeers())
+	require.Empty(t, netB.phonebook.GetAddresses(getAllAddresses, PhoneBookEntryRelayRole))
+
+	restarted = makeTestWebsocketNode(t)
+	require.NoError(t, restarted.LoadPersistentPeers(path))
+	require.Empty(t, restarted.PersistentPeers())
+}
+
+func TestPersistentPeersCorruptFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	path := filepath.Join(t.TempDir(), "persistentpeers.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0600))
+	net := makeTestWebsocketNode(t)
+	require.Error(t, net.LoadPersistentPeers(path))
+	require.Empty(t, net.PersistentPeers())
+
+	// the file is replaced the next time the persistent peers change
+	net.persistentPeersLock.Lock()
+	net.persistentPeers["r1.algorand.network:4160"] = true
+	require.NoError(t, net.savePersistentPeers())
+	net.persistentPeersLock.Unlock()
+	reloaded := makeTestWebsocketNode(t)
+	require.NoError(t, reloaded.LoadPersistentPeers(path))
+	require.Equal(t, []string{"r1.algorand.network:4160"}, reloaded.PersistentPeers())
+}
@@ -0,0 +1,270 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+const (
+	// peerReputationMax is the reputation of a newly connected peer, and the most it can recover to.
+	peerReputationMax = 100
+	// peerReputationThrottled is the reputation below which the messages of a peer are rate limited at half the configured rates.
+	peerReputationThrottled = 50
+	// peerReputationRecoveryPerSecond is the reputation a peer recovers every second.
+	peerReputationRecoveryPerSecond = 1
+)
+
+// disconnectLowReputation is the reason peers get disconnected for when their reputation drops
+// to zero because they exceeded their rate limits
+const disconnectLowReputation disconnectReason = "LowReputation"
+
+var networkThrottledMessagesByTag = metrics.NewTagCounter("algod_network_throttled_messages_{TAG}", "Number of {TAG} messages that were dropped because the sending peer exceeded its rate limit")
+var networkThrottledPeersTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_throttled_peers_total", Description: "Number of times a peer reputation dropped low enough for its messages to be rate limited at half the configured rates"})
+var networkReputationDisconnectsTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_reputation_disconnects_total", Description: "Number of peers that were disconnected because their reputation dropped to zero"})
+
+// tokenBucket allows rate messages per second on average, and bursts of up to burst messages.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func makeTokenBucket(rate float64, burst float64, now time.Time) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: now}
+}
+
+// take refills the bucket at rateFactor times its rate since the last call, and takes a token
+// out of it. It returns false if the bucket was empty.
+func (b *tokenBucket) take(now time.Time, rateFactor float64) bool {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed*b.rate*rateFactor)
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// peerRateLimiter limits the rate of the messages a peer sends us, per tag.
+// It is only used by the read loop of the peer, and isn't synchronized.
+type peerRateLimiter struct {
+	buckets map[protocol.Tag]*tokenBucket
+}
+
+// makePeerRateLimiter returns a rate limiter enforcing the PeerMessageRateLimits of cfg,
+// or nil if no limit is configured.
+func makePeerRateLimiter(cfg config.Local, now time.Time) *peerRateLimiter {
+	burstSeconds := float64(cfg.PeerMessageRateLimitBurstSeconds)
+	if burstSeconds < 1 {
+		burstSeconds = 1
+	}
+	var limiter *peerRateLimiter
+	for tag, rate := range cfg.PeerMessageRateLimits {
+		if rate <= 0 {
+			continue
+		}
+		if limiter == nil {
+			limiter = &peerRateLimiter{buckets: make(map[protocol.Tag]*tokenBucket)}
+		}
+		limiter.buckets[protocol.Tag(tag)] = makeTokenBucket(float64(rate), float64(rate)*burstSeconds, now)
+	}
+	return limiter
+}
+
+// allow returns false if a message of the given tag exceeds the rate limit of its tag.
+// The limits of throttled peers are halved.
+func (l *peerRateLimiter) allow(tag protocol.Tag, now time.Time, reputation *peerReputation) bool {
+	bucket, has := l.buckets[tag]
+	if !has {
+		return true
+	}
+	rateFactor := 1.0
+	if reputation.score(now) < peerReputationThrottled {
+		rateFactor = 0.5
+	}
+	return bucket.take(now, rateFactor)
+}
+
+// peerReputation tracks how well a peer behaves. Peers start at peerReputationMax, lose reputation
+// when they send invalid messages or exceed their rate limits, and recover it over time.
+// The zero value is a peer with the maximal reputation.
+type peerReputation struct {
+	mu deadlock.Mutex
+
+	// lost is the reputation lost at the time last, not yet recovered.
+	lost float64
+	last time.Time
+}
+
+// recoverLocked recovers the reputation regained since the last update. The caller must hold r.mu.
+func (r *peerReputation) recoverLocked(now time.Time) {
+	if r.lost > 0 {
+		r.lost = math.Max(0, r.lost-now.Sub(r.last).Seconds()*peerReputationRecoveryPerSecond)
+	}
+	r.last = now
+}
+
+func (r *peerReputation) score(now time.Time) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recoverLocked(now)
+	return peerReputationMax - r.lost
+}
+
+// penalize lowers the reputation by penalty, and returns the reputation before and after.
+func (r *peerReputation) penalize(penalty float64, now time.Time) (before, after float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.recoverLocked(now)
+	before = peerReputationMax - r.lost
+	r.lost = math.Min(peerReputationMax, r.lost+penalty)
+	return before, peerReputationMax - r.lost
+}
+
+// peerPenaltyBox holds the hosts of the incoming peers that were disconnected because their
+// reputation dropped to zero, until their penalty is over. The zero value is empty.
+type peerPenaltyBox struct {
+	mu    deadlock.Mutex
+	hosts map[string]time.Time
+}
+
+// add penalizes host until the given time, and drops the penalties that are over by now.
+func (b *peerPenaltyBox) add(host string, now, until time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.hosts == nil {
+		b.hosts = make(map[string]time.Time)
+	}
+	for h, expires := range b.hosts {
+		if !expires.After(now) {
+			delete(b.hosts, h)
+		}
+	}
+	b.hosts[host] = until
+}
+
+// remaining returns how long the host is still penalized for, or zero if it isn't.
+func (b *peerPenaltyBox) remaining(host string, now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	until, has := b.hosts[host]
+	if !has || !until.After(now) {
+		return 0
+	}
+	return until.Sub(now)
+}
+
+// allowMessage returns false if the peer exceeded the rate limit of the tag of a message it sent,
+// in which case the message should be dropped.
+func (wp *wsPeer) allowMessage(tag protocol.Tag) bool {
+	if wp.rateLimiter == nil {
+		return true
+	}
+	if wp.rateLimiter.allow(tag, time.Now(), &wp.reputation) {
+		return true
+	}
+	networkThrottledMessagesByTag.Add(string(tag), 1)
+	wp.net.penalizePeer(wp, wp.net.config.PeerReputationThrottledMessagePenalty, disconnectLowReputation)
+	return false
+}
+
+// reportInvalidMessage lowers the reputation of a peer that sent us an invalid message,
+// and disconnects it if its reputation dropped to zero. It returns false if invalid messages
+// don't affect the reputation of peers, in which case the caller should disconnect the peer.
+func (wn *WebsocketNetwork) reportInvalidMessage(node Peer) bool {
+	peer, ok := node.(*wsPeer)
+	if !ok || wn.config.PeerReputationInvalidMessagePenalty <= 0 {
+		return false
+	}
+	wn.penalizePeer(peer, wn.config.PeerReputationInvalidMessagePenalty, disconnectBadData)
+	return true
+}
+
+// peerReputationPenaltyDuration is how long we refuse to connect to, or to be connected from,
+// a peer after disconnecting it because its reputation dropped to zero.
+func (wn *WebsocketNetwork) peerReputationPenaltyDuration() time.Duration {
+	return time.Duration(wn.config.PeerReputationPenaltyDurationSeconds) * time.Second
+}
+
+// penalizePeer lowers the reputation of the peer by penalty. Peers whose reputation drops
+// to zero are disconnected for reason, and kept away for peerReputationPenaltyDuration.
+func (wn *WebsocketNetwork) penalizePeer(peer *wsPeer, penalty int, reason disconnectReason) {
+	if penalty <= 0 {
+		return
+	}
+	now := time.Now()
+	before, after := peer.reputation.penalize(float64(penalty), now)
+	if after > 0 {
+		if before >= peerReputationThrottled && after < peerReputationThrottled {
+			networkThrottledPeersTotal.Inc(nil)
+			wn.log.Infof("peer %s reputation dropped to %.0f, throttling its messages", peer.GetAddress(), after)
+		}
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&peer.reputationDisconnect, 0, 1) {
+		return
+	}
+	networkReputationDisconnectsTotal.Inc(nil)
+	penaltyDuration := wn.peerReputationPenaltyDuration()
+	wn.log.Infof("peer %s reputation dropped to zero, disconnecting it for %v: %s", peer.GetAddress(), penaltyDuration, reason)
+	if penaltyDuration > 0 {
+		until := now.Add(penaltyDuration)
+		if peer.outgoing {
+			wn.phonebook.UpdateRetryAfter(peer.GetAddress(), until)
+		} else if host := peer.peerHost(); host != "" {
+			wn.penalizedHosts.add(host, now, until)
+		}
+	}
+	wn.wg.Add(1)
+	go wn.disconnectThread(peer, reason)
+}
+
+// checkIncomingConnectionPenalized refuses the incoming connections from a host whose peer was
+// disconnected because its reputation dropped to zero, asking it to retry after its penalty.
+func (wn *WebsocketNetwork) checkIncomingConnectionPenalized(response http.ResponseWriter, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	remaining := wn.penalizedHosts.remaining(bannedHost(remoteHost), time.Now())
+	if remaining <= 0 {
+		return http.StatusOK
+	}
+	networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "low_reputation"})
+	wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+		telemetryspec.ConnectPeerFailEventDetails{
+			Address:       remoteHost,
+			TelemetryGUID: otherTelemetryGUID,
+			Incoming:      true,
+			InstanceName:  otherInstanceName,
+			Reason:        "Low Reputation",
+		})
+	response.Header().Add(TooManyRequestsRetryAfterHeader, fmt.Sprintf("%d", int64(math.Ceil(remaining.Seconds()))))
+	response.WriteHeader(http.StatusTooManyRequests)
+	return http.StatusTooManyRequests
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTokenBucket(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	now := time.Now()
+	bucket := makeTokenBucket(10, 20, now)
+	for i := 0; i < 20; i++ {
+		require.True(t, bucket.take(now, 1), i)
+	}
+	require.False(t, bucket.take(now, 1))
+
+	// refills at the rate, up to the burst
+	now = now.Add(time.Second)
+	for i := 0; i < 10; i++ {
+		require.True(t, bucket.take(now, 1), i)
+	}
+	require.False(t, bucket.take(now, 1))
+	now = now.Add(time.Minute)
+	for i := 0; i < 20; i++ {
+		require.True(t, bucket.take(now, 1), i)
+	}
+	require.False(t, bucket.take(now, 1))
+
+	// and slower when throttled
+	now = now.Add(time.Second)
+	for i := 0; i < 5; i++ {
+		require.True(t, bucket.take(now, 0.5), i)
+	}
+	require.False(t, bucket.take(now, 0.5))
+}
+
+func TestPeerRateLimiter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	require.Nil(t, makePeerRateLimiter(cfg, time.Now()))
+	cfg.PeerMessageRateLimits = map[string]int{"TX": 0}
+	require.Nil(t, makePeerRateLimiter(cfg, time.Now()))
+
+	cfg.PeerMessageRateLimits = map[string]int{"TX": 2, "AV": 0}
+	cfg.PeerMessageRateLimitBurstSeconds = 3
+	now := time.Now()
+	limiter := makePeerRateLimiter(cfg, now)
+	require.NotNil(t, limiter)
+	var reputation peerReputation
+	for i := 0; i < 6; i++ {
+		require.True(t, limiter.allow(protocol.TxnTag, now, &reputation), i)
+	}
+	require.False(t, limiter.allow(protocol.TxnTag, now, &reputation))
+	for i := 0; i < 100; i++ {
+		require.True(t, limiter.allow(protocol.AgreementVoteTag, now, &reputation), i)
+	}
+
+	// throttled peers get half the rate
+	reputation.penalize(peerReputationMax-peerReputationThrottled+2, now)
+	now = now.Add(time.Second)
+	require.True(t, limiter.allow(protocol.TxnTag, now, &reputation))
+	require.False(t, limiter.allow(protocol.TxnTag, now, &reputation))
+}
+
+func TestPeerReputation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	now := time.Now()
+	var reputation peerReputation
+	require.Equal(t, float64(peerReputationMax), reputation.score(now))
+
+	before, after := reputation.penalize(30, now)
+	require.Equal(t, float64(peerReputationMax), before)
+	require.Equal(t, float64(peerReputationMax-30), after)
+
+	// recovers over time, up to the maximum
+	now = now.Add(10 * time.Second)
+	require.Equal(t, float64(peerReputationMax-20), reputation.score(now))
+	now = now.Add(time.Hour)
+	require.Equal(t, float64(peerReputationMax), reputation.score(now))
+
+	// doesn't drop below zero
+	_, after = reputation.penalize(3*peerReputationMax, now)
+	require.Equal(t, float64(0), after)
+	now = now.Add(time.Second)
+	require.Equal(t, float64(peerReputationRecoveryPerSecond), reputation.score(now))
+}
+
+func TestPeerRateLimitEnforced(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.config.PeerMessageRateLimits = map[string]int{"TX": 5}
+	netA.config.PeerMessageRateLimitBurstSeconds = 1
+	netA.config.PeerReputationThrottledMessagePenalty = 1
+	var received uint32
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		atomic.AddUint32(&received, 1)
+		return OutgoingMessage{}
+	})}})
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	for i := 0; i < 30; i++ {
+		require.NoError(t, netB.Broadcast(context.Background(), protocol.TxnTag, []byte(fmt.Sprintf("tx %d", i)), true, nil))
+	}
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&received) >= 5
+	}, 2*time.Second, 10*time.Millisecond)
+	require.Never(t, func() bool {
+		return atomic.LoadUint32(&received) > 10
+	}, 500*time.Millisecond, 10*time.Millisecond)
+
+	// the peer is penalized for the throttled messages, but stays connected
+	netA.peersLock.RLock()
+	require.Len(t, netA.peers, 1)
+	score := netA.peers[0].reputation.score(time.Now())
+	netA.peersLock.RUnlock()
+	require.Less(t, score, float64(peerReputationMax-10))
+}
+
+func TestPeerReputationDisconnect(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.config.PeerReputationInvalidMessagePenalty = 40
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		return OutgoingMessage{Action: Disconnect}
+	})}})
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	// a single invalid message only lowers the reputation
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.TxnTag, []byte("bad 1"), true, nil))
+	require.Never(t, func() bool {
+		return len(netA.PeerStats()) == 0
+	}, 500*time.Millisecond, 10*time.Millisecond)
+
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.TxnTag, []byte("bad 2"), true, nil))
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.TxnTag, []byte("bad 3"), true, nil))
+	require.Eventually(t, func() bool {
+		return len(netA.PeerStats()) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// the peer is kept away for a while
+	response := httptest.NewRecorder()
+	require.Equal(t, http.StatusTooManyRequests, netA.checkIncomingConnectionLimits(response, nil, "127.0.0.1", "", ""))
+	require.Equal(t, http.StatusTooManyRequests, response.Code)
+	require.Equal(t, "600", response.Header().Get(TooManyRequestsRetryAfterHeader))
+	response = httptest.NewRecorder()
+	require.Equal(t, http.StatusOK, netA.checkIncomingConnectionLimits(response, nil, "10.0.0.1", "", ""))
+}
+
+func TestPeerInvalidMessageDefault(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// by default, a single invalid message disconnects the peer, without keeping it away
+	require.Equal(t, 0, config.GetDefaultLocal().PeerReputationInvalidMessagePenalty)
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		return OutgoingMessage{Action: Disconnect}
+	})}})
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.TxnTag, []byte("bad"), true, nil))
+	require.Eventually(t, func() bool {
+		return len(netA.PeerStats()) == 0
+	}, 2*time.Second, 10*time.Millisecond)
+
+	response := httptest.NewRecorder()
+	require.Equal(t, http.StatusOK, netA.checkIncomingConnectionLimits(response, nil, "127.0.0.1", "", ""))
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// maxPeerStatsTags caps the number of distinct tags counted for a single
+// peer, so that a peer sending messages with arbitrary tags can't make us
+// grow its statistics unboundedly. Messages of further tags are counted
+// under protocol.UnknownMsgTag.
+const maxPeerStatsTags = 32
+
+// PeerTagStats counts the messages of a single tag exchanged with a peer.
+type PeerTagStats struct {
+	MessagesIn  uint64
+	BytesIn     uint64
+	MessagesOut uint64
+	BytesOut    uint64
+}
+
+// PeerStats is a snapshot of a connected peer and of the traffic exchanged with it.
+type PeerStats struct {
+	// Address is the address the peer was dialed at for outgoing connections,
+	// or the address the peer announced for incoming ones.
+	Address string
+	// RemoteAddress is the remote end of the connection.
+	RemoteAddress string
+	// Outgoing is true when we initiated the connection.
+	Outgoing bool
+
+	TelemetryGUID string
+	InstanceName  string
+	// Version is the protocol version negotiated with the peer.
+	Version string
+
+	ConnectedSince time.Time
+
+	// LastPingSent is zero if the peer was never pinged, and
+	// LastPingRoundTripTime is zero until a ping was answered.
+	LastPingSent          time.Time
+	LastPingRoundTripTime time.Duration
+
+	// QueuedHighPriority and QueuedBulk are the number of sends waiting in
+	// the outgoing queues of the peer.
+	QueuedHighPriority int
+	QueuedBulk         int
+
+	// DuplicatesDropped is the number of incoming messages that were dropped
+	// because they had already been received.
+	DuplicatesDropped uint64
+	// FilteredOut is the number of outgoing messages that were not sent because
+	// the peer said it already had them, or didn't want messages of their tag.
+	FilteredOut uint64
+	// DroppedOut is the number of outgoing messages that were dropped because
+	// the outgoing queues of the peer were full, or they were too long.
+	DroppedOut uint64
+
+	Tags map[protocol.Tag]PeerTagStats
+}
+
+// peerTrafficStats accumulates the statistics of the traffic exchanged with a peer
+type peerTrafficStats struct {
+	mu deadlock.Mutex
+
+	tags              map[protocol.Tag]*PeerTagStats
+	duplicatesDropped uint64
+	filteredOut       uint64
+	droppedOut        uint64
+}
+
+// tagStats returns the counters of tag, creating them if needed. The caller must hold s.mu.
+func (s *peerTrafficStats) tagStats(tag protocol.Tag) *PeerTagStats {
+	if ts, ok := s.tags[tag]; ok {
+		return ts
+	}
+	if s.tags == nil {
+		s.tags = make(map[protocol.Tag]*PeerTagStats)
+	}
+	if len(s.tags) >= maxPeerStatsTags-1 {
+		tag = protocol.UnknownMsgTag
+		if ts, ok := s.tags[tag]; ok {
+			return ts
+		}
+	}
+	ts := &PeerTagStats{}
+	s.tags[tag] = ts
+	return ts
+}
+
+func (s *peerTrafficStats) received(tag protocol.Tag, length int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts := s.tagStats(tag)
+	ts.MessagesIn++
+	ts.BytesIn += uint64(length)
+}
+
+func (s *peerTrafficStats) sent(tag protocol.Tag, length int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ts := s.tagStats(tag)
+	ts.MessagesOut++
+	ts.BytesOut += uint64(length)
+}
+
+func (s *peerTrafficStats) duplicateDropped() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.duplicatesDropped++
+}
+
+func (s *peerTrafficStats) filtered(count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.filteredOut += uint64(count)
+}
+
+func (s *peerTrafficStats) dropped(count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.droppedOut += uint64(count)
+}
+
+// stats returns a snapshot of the peer and of its traffic
+func (wp *wsPeer) stats() PeerStats {
+	out := PeerStats{
+		Address:            wp.GetAddress(),
+		Outgoing:           wp.outgoing,
+		TelemetryGUID:      wp.TelemetryGUID,
+		InstanceName:       wp.InstanceName,
+		Version:            wp.version,
+		ConnectedSince:     wp.createTime,
+		QueuedHighPriority: len(wp.sendBufferHighPrio),
+		QueuedBulk:         len(wp.sendBufferBulk),
+	}
+	if wp.conn != nil {
+		out.RemoteAddress = wp.conn.RemoteAddr().String()
+	}
+	out.LastPingSent, out.LastPingRoundTripTime = wp.pingTimes()
+
+	wp.trafficStats.mu.Lock()
+	defer wp.trafficStats.mu.Unlock()
+	out.DuplicatesDropped = wp.trafficStats.duplicatesDropped
+	out.FilteredOut = wp.trafficStats.filteredOut
+	out.DroppedOut = wp.trafficStats.droppedOut
+	out.Tags = make(map[protocol.Tag]PeerTagStats, len(wp.trafficStats.tags))
+	for tag, ts := range wp.trafficStats.tags {
+		out.Tags[tag] = *ts
+	}
+	return out
+}
+
+// PeerStats returns a snapshot of every connected peer and of the traffic
+// exchanged with it, outgoing connections first, each in the order they
+// were established.
+func (wn *WebsocketNetwork) PeerStats() []PeerStats {
+	wn.peersLock.RLock()
+	peers := make([]*wsPeer, len(wn.peers))
+	copy(peers, wn.peers)
+	wn.peersLock.RUnlock()
+
+	out := make([]PeerStats, len(peers))
+	for i, peer := range peers {
+		out[i] = peer.stats()
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Outgoing != out[j].Outgoing {
+			return out[i].Outgoing
+		}
+		return out[i].ConnectedSince.Before(out[j].ConnectedSince)
+	})
+	return out
+}
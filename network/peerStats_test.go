@@ -0,0 +1,123 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPeerTrafficStatsTagCap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var s peerTrafficStats
+	for i := 0; i < maxPeerStatsTags+10; i++ {
+		s.received(protocol.Tag([]byte{byte('a' + i/26), byte('a' + i%26)}), 10)
+	}
+	s.sent(protocol.TxnTag, 5)
+	require.Len(t, s.tags, maxPeerStatsTags)
+	require.Equal(t, uint64(11), s.tags[protocol.UnknownMsgTag].MessagesIn)
+	require.Equal(t, uint64(110), s.tags[protocol.UnknownMsgTag].BytesIn)
+	require.Equal(t, uint64(1), s.tags[protocol.UnknownMsgTag].MessagesOut)
+}
+
+func TestPeerStats(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+	counter := newMessageCounter(t, 2)
+	counterDone := counter.done
+	netB.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: counter}})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("foo"), false, nil)
+	netA.Broadcast(context.Background(), protocol.TxnTag, []byte("barbaz"), false, nil)
+	select {
+	case <-counterDone:
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout waiting for the messages")
+	}
+
+	// the sender counts messages once they are written, which may be after the receiver got them
+	var statsA []PeerStats
+	require.Eventually(t, func() bool {
+		statsA = netA.PeerStats()
+		return len(statsA) == 1 && statsA[0].Tags[protocol.TxnTag].MessagesOut == 2
+	}, 2*time.Second, 10*time.Millisecond)
+	require.False(t, statsA[0].Outgoing)
+	require.Equal(t, uint64(13), statsA[0].Tags[protocol.TxnTag].BytesOut)
+	require.Equal(t, netB.log.GetTelemetryGUID(), statsA[0].TelemetryGUID)
+
+	statsB := netB.PeerStats()
+	require.Len(t, statsB, 1)
+	require.True(t, statsB[0].Outgoing)
+	require.Equal(t, PeerTagStats{MessagesIn: 2, BytesIn: 13}, statsB[0].Tags[protocol.TxnTag])
+	require.False(t, statsB[0].ConnectedSince.IsZero())
+	require.Zero(t, statsB[0].LastPingRoundTripTime)
+}
+
+func TestPeerStatsPing(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer netStop(t, netA, "A")
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netStop(t, netB, "B")
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	peers := netB.peersToPing()
+	require.Len(t, peers, 1)
+	require.True(t, peers[0].sendPing())
+
+	require.Eventually(t, func() bool {
+		stats := netB.PeerStats()
+		return len(stats) == 1 && stats[0].LastPingRoundTripTime > 0
+	}, 2*time.Second, 10*time.Millisecond)
+	stats := netB.PeerStats()
+	require.False(t, stats[0].LastPingSent.IsZero())
+	require.Equal(t, uint64(1), stats[0].Tags[protocol.PingTag].MessagesOut)
+	require.Equal(t, uint64(1), stats[0].Tags[protocol.PingReplyTag].MessagesIn)
+}
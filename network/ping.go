@@ -0,0 +1,108 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// pingHandler echoes the data of a ping back to the peer that sent it
+func pingHandler(message IncomingMessage) OutgoingMessage {
+	if len(message.Data) > pingLength {
+		return OutgoingMessage{}
+	}
+	peer := message.Sender.(*wsPeer)
+	tagBytes := []byte(protocol.PingReplyTag)
+	mbytes := make([]byte, len(tagBytes)+len(message.Data))
+	copy(mbytes, tagBytes)
+	copy(mbytes[len(tagBytes):], message.Data)
+	// leave the digest blank, ping replies are too short to be filtered
+	peer.writeNonBlock(context.Background(), mbytes, false, crypto.Digest{}, time.Now())
+	return OutgoingMessage{}
+}
+
+// pingReplyHandler records the round trip time of the ping a reply answers
+func pingReplyHandler(message IncomingMessage) OutgoingMessage {
+	wn := message.Net.(*WebsocketNetwork)
+	now := time.Now()
+	peer := message.Sender.(*wsPeer)
+	peer.pingLock.Lock()
+	defer peer.pingLock.Unlock()
+	if !peer.pingInFlight {
+		wn.log.Debugf("ping reply with no ping in flight from %s", peer.rootURL)
+		return OutgoingMessage{}
+	}
+	if !bytes.Equal(peer.pingData, message.Data) {
+		wn.log.Infof("ping reply with wrong data from %s", peer.rootURL)
+		return OutgoingMessage{}
+	}
+	peer.pingInFlight = false
+	peer.lastPingRoundTripTime = now.Sub(peer.pingSent)
+	return OutgoingMessage{}
+}
+
+var pingHandlers = []TaggedMessageHandler{
+	{protocol.PingTag, HandlerFunc(pingHandler)},
+	{protocol.PingReplyTag, HandlerFunc(pingReplyHandler)},
+}
+
+// pingThread periodically pings the connected peers, so that the round trip
+// time to each of them is known
+func (wn *WebsocketNetwork) pingThread() {
+	defer wn.wg.Done()
+	ticker := time.NewTicker(time.Duration(wn.config.PeerPingPeriodSeconds) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-wn.ctx.Done():
+			return
+		}
+		for _, peer := range wn.peersToPing() {
+			peer.sendPing()
+		}
+	}
+}
+
+// peersToPing returns the peers that were pinged the longest time ago. Never
+// more than a fifth of the peers are returned at once, so as not to flood the
+// outgoing connections.
+func (wn *WebsocketNetwork) peersToPing() []*wsPeer {
+	wn.peersLock.RLock()
+	peers := make([]*wsPeer, len(wn.peers))
+	copy(peers, wn.peers)
+	wn.peersLock.RUnlock()
+
+	lastSent := make(map[*wsPeer]time.Time, len(peers))
+	for _, peer := range peers {
+		lastSent[peer], _ = peer.pingTimes()
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		return lastSent[peers[i]].Before(lastSent[peers[j]])
+	})
+	maxSend := 1 + len(peers)/5
+	if len(peers) > maxSend {
+		peers = peers[:maxSend]
+	}
+	return peers
+}
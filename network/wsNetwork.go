@@ -214,6 +214,34 @@ type GossipNode interface {
 	SetPeerData(peer Peer, key string, value interface{})
 }
 
+// PeerAdmin lets operators inspect and control the peers of a network at runtime.
+// It is implemented by WebsocketNetwork, and by HybridNetwork for its websocket network; P2PNetwork doesn't implement it.
+type PeerAdmin interface {
+	// PeerStats returns a snapshot of the connected peers and of the traffic exchanged with them.
+	PeerStats() []PeerStats
+
+	// AddPersistentPeer adds an address to the phonebook until it's removed, and keeps the node connected to it.
+	AddPersistentPeer(addr string) error
+
+	// RemovePersistentPeer removes an address added with AddPersistentPeer, and returns false if there was none.
+	RemovePersistentPeer(addr string) (bool, error)
+
+	// PersistentPeers returns the addresses added with AddPersistentPeer.
+	PersistentPeers() []string
+
+	// DisconnectPeer disconnects the peers with the given address or remote address, and returns false if there were none.
+	DisconnectPeer(addr string) bool
+
+	// BanPeer bans an IP address or a telemetry GUID for the given duration, and disconnects the matching peers.
+	BanPeer(target string, duration time.Duration) error
+
+	// UnbanPeer lifts the ban of an IP address or a telemetry GUID, and returns false if there was none.
+	UnbanPeer(target string) (bool, error)
+
+	// PeerBans returns the bans in effect.
+	PeerBans() []PeerBan
+}
+
 // IncomingMessage represents a message arriving from some peer in our p2p network
 type IncomingMessage struct {
 	Sender Peer
@@ -361,6 +389,18 @@ type WebsocketNetwork struct {
 	tryConnectAddrs map[string]int64
 	tryConnectLock  deadlock.Mutex
 
+	// persistentPeers are the addresses the operator asked the node to stay connected to.
+	persistentPeers     map[string]bool
+	persistentPeersLock deadlock.Mutex
+	// persistentPeersPath is the file the persistent peers are saved to whenever they change, if set.
+	persistentPeersPath string
+
+	// peerBans are the IP addresses and telemetry GUIDs the operator banned.
+	peerBans peerBanList
+
+	// penalizedHosts holds the hosts of the incoming peers disconnected because their reputation dropped to zero
+	penalizedHosts peerPenaltyBox
+
 	incomingMsgFilter *messageFilter // message filter to remove duplicate incoming messages from different peers
 
 	eventualReadyDelay time.Duration
@@ -564,9 +604,12 @@ func (wn *WebsocketNetwork) disconnectThread(badnode Peer, reason disconnectReas
 	wn.disconnect(badnode, reason)
 }
 
-// Disconnect from a peer, probably due to protocol errors.
+// Disconnect from a peer that sent us invalid data. When PeerReputationInvalidMessagePenalty
+// is set, the reputation of the peer is lowered instead, until it drops to zero.
 func (wn *WebsocketNetwork) Disconnect(node Peer) {
-	wn.disconnect(node, disconnectBadData)
+	if !wn.reportInvalidMessage(node) {
+		wn.disconnect(node, disconnectBadData)
+	}
 }
 
 // Disconnect from a peer, probably due to protocol errors.
@@ -734,6 +777,7 @@ func (wn *WebsocketNetwork) setup() {
 	wn.meshUpdateRequests = make(chan meshRequest, 5)
 	wn.readyChan = make(chan struct{})
 	wn.tryConnectAddrs = make(map[string]int64)
+	wn.persistentPeers = make(map[string]bool)
 	wn.eventualReadyDelay = time.Minute
 	wn.prioTracker = newPrioTracker(wn)
 	if wn.slowWritingPeerMonitorInterval == 0 {
@@ -806,6 +850,9 @@ func (wn *WebsocketNetwork) Start() {
 		wn.scheme = "http"
 	}
 	wn.meshUpdateRequests <- meshRequest{false, nil}
+	if wn.config.EnablePingHandler {
+		wn.RegisterHandlers(pingHandlers)
+	}
 	if wn.prioScheme != nil {
 		wn.RegisterHandlers(prioHandlers)
 	}
@@ -832,6 +879,10 @@ func (wn *WebsocketNetwork) Start() {
 		wn.wg.Add(1)
 		go wn.prioWeightRefresh()
 	}
+	if wn.config.PeerPingPeriodSeconds > 0 {
+		wn.wg.Add(1)
+		go wn.pingThread()
+	}
 
 	go wn.postMessagesOfInterestThread()
 
@@ -964,8 +1015,16 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 	return
 }
 
-// checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
+// checkIncomingConnectionLimits perform the connection limits counting for the incoming connections,
+// after refusing the banned peers and the peers kept away because of their reputation.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	if status := wn.checkIncomingConnectionBanned(response, remoteHost, otherTelemetryGUID, otherInstanceName); status != http.StatusOK {
+		return status
+	}
+	if status := wn.checkIncomingConnectionPenalized(response, remoteHost, otherTelemetryGUID, otherInstanceName); status != http.StatusOK {
+		return status
+	}
+
 	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
@@ -1214,8 +1273,10 @@ func (wn *WebsocketNetwork) messageHandlerThread(peersConnectivityCheckCh <-chan
 			networkHandleMicros.AddUint64(uint64(handleTime.Nanoseconds()/1000), nil)
 			switch outmsg.Action {
 			case Disconnect:
-				wn.wg.Add(1)
-				go wn.disconnectThread(msg.Sender, disconnectBadData)
+				if !wn.reportInvalidMessage(msg.Sender) {
+					wn.wg.Add(1)
+					go wn.disconnectThread(msg.Sender, disconnectBadData)
+				}
 			case Broadcast:
 				err := wn.Broadcast(wn.ctx, msg.Tag, msg.Data, false, msg.Sender)
 				if err != nil && err != errBcastQFull {
@@ -1586,6 +1647,8 @@ func (wn *WebsocketNetwork) meshThread() {
 			}
 		}
 
+		wn.connectPersistentPeers()
+
 		// as long as the call to checkExistingConnectionsNeedDisconnecting is deleting existing connections, we want to
 		// kick off the creation of new connections.
 		for {
@@ -1672,7 +1735,7 @@ func (wn *WebsocketNetwork) checkExistingConnectionsNeedDisconnecting() bool {
 		wsPeer := stat.peer.(*wsPeer)
 		wsPeer.peerMessageDelay = stat.peerDelay
 		wn.log.Infof("network performance monitor - peer '%s' delay %d first message portion %d%%", wsPeer.GetAddress(), stat.peerDelay, int(stat.peerFirstMessage*100))
-		if wsPeer.throttledOutgoingConnection && leastPerformingPeer == nil {
+		if wsPeer.throttledOutgoingConnection && leastPerformingPeer == nil && !wn.isPersistentPeer(wsPeer.GetAddress()) {
 			leastPerformingPeer = wsPeer
 		}
 	}
@@ -1951,6 +2014,9 @@ func (wn *WebsocketNetwork) tryConnectReserveAddr(addr string) (gossipAddr strin
 	if exists {
 		return "", false
 	}
+	if wn.gossipAddrBanned(gossipAddr) {
+		return "", false
+	}
 	// WARNING: isConnectedTo takes wn.peersLock; to avoid deadlock, never try to take wn.peersLock outside an attempt to lock wn.tryConnectLock
 	if wn.isConnectedTo(addr) {
 		return "", false
@@ -2070,6 +2136,13 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		return
 	}
 
+	otherTelemetryGUID, otherInstanceName, _ := getCommonHeaders(response.Header)
+	if wn.peerBans.isBanned(bannedHost(justHost(conn.RemoteAddr().String())), otherTelemetryGUID) {
+		wn.log.Infof("ws connect(%s) aborted due to the peer being banned", gossipAddr)
+		conn.Close()
+		return
+	}
+
 	throttledConnection := false
 	if atomic.AddInt32(&wn.throttledOutgoingConnections, int32(-1)) >= 0 {
 		throttledConnection = true
@@ -2087,7 +2160,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		throttledOutgoingConnection: throttledConnection,
 		version:                     matchingVersion,
 	}
-	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
+	peer.TelemetryGUID, peer.InstanceName = otherTelemetryGUID, otherInstanceName
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
 	wn.addPeer(peer)
 	localAddr, _ := wn.Address()
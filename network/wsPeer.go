@@ -227,6 +227,18 @@ type wsPeer struct {
 
 	// clientDataStoreMu synchronizes access to clientDataStore
 	clientDataStoreMu deadlock.Mutex
+
+	// trafficStats counts the messages exchanged with the peer, by tag.
+	trafficStats peerTrafficStats
+
+	// rateLimiter limits the rate of the messages the peer sends us, per tag. It's nil if no limit is configured.
+	rateLimiter *peerRateLimiter
+
+	// reputation degrades when the peer sends us invalid messages or exceeds its rate limits.
+	reputation peerReputation
+
+	// reputationDisconnect is set once the peer is being disconnected because its reputation dropped to zero.
+	reputationDisconnect int32
 }
 
 // HTTPPeer is what the opaque Peer might be.
@@ -355,6 +367,7 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 	if config.EnableOutgoingNetworkMessageFiltering {
 		wp.outgoingMsgFilter = makeMessageFilter(config.OutgoingMessageFilterBucketCount, config.OutgoingMessageFilterBucketSize)
 	}
+	wp.rateLimiter = makePeerRateLimiter(config, time.Now())
 
 	wp.wg.Add(2)
 	go wp.readLoop()
@@ -433,6 +446,7 @@ func (wp *wsPeer) readLoop() {
 		networkMessageReceivedTotal.AddUint64(1, nil)
 		networkReceivedBytesByTag.Add(string(tag[:]), uint64(len(msg.Data)+2))
 		networkMessageReceivedByTag.Add(string(tag[:]), 1)
+		wp.trafficStats.received(msg.Tag, len(msg.Data)+2)
 		msg.Sender = wp
 
 		// for outgoing connections, we want to notify the connection monitor that we've received
@@ -483,10 +497,15 @@ func (wp *wsPeer) readLoop() {
 				//wp.net.log.Debugf("dropped incoming duplicate %s(%d)", msg.Tag, len(msg.Data))
 				duplicateNetworkMessageReceivedTotal.Inc(nil)
 				duplicateNetworkMessageReceivedBytesTotal.AddUint64(uint64(len(msg.Data)+len(msg.Tag)), nil)
+				wp.trafficStats.duplicateDropped()
 				// drop message, skip adding it to queue
 				continue
 			}
 		}
+		if !wp.allowMessage(msg.Tag) {
+			// drop message, the peer exceeded its rate limit
+			continue
+		}
 		//wp.net.log.Debugf("got msg %d bytes from %s", len(msg.Data), wp.conn.RemoteAddr().String())
 
 		// Wait for a previous message from this peer to be processed,
@@ -587,6 +606,7 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	if len(msg.data) > maxMessageLength {
 		wp.net.log.Errorf("trying to send a message longer than we would receive: %d > %d tag=%s", len(msg.data), maxMessageLength, string(msg.data[0:2]))
 		// just drop it, don't break the connection
+		wp.trafficStats.dropped(1)
 		return disconnectReasonNone
 	}
 	if msg.msgTags != nil {
@@ -599,6 +619,7 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	tag := protocol.Tag(msg.data[:2])
 	if !wp.sendMessageTag[tag] {
 		// the peer isn't interested in this message.
+		wp.trafficStats.filtered(1)
 		return disconnectReasonNone
 	}
 
@@ -626,6 +647,7 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
 	networkMessageSentTotal.AddUint64(1, nil)
 	networkMessageSentByTag.Add(string(tag), 1)
+	wp.trafficStats.sent(tag, len(msg.data))
 	networkMessageQueueMicrosTotal.AddUint64(uint64(time.Now().Sub(msg.peerEnqueued).Nanoseconds()/1000), nil)
 	return disconnectReasonNone
 }
@@ -686,6 +708,7 @@ func (wp *wsPeer) writeNonBlockMsgs(ctx context.Context, data [][]byte, highPrio
 			// peer has notified us it doesn't need this message
 			outgoingNetworkMessageFilteredOutTotal.Inc(nil)
 			outgoingNetworkMessageFilteredOutBytesTotal.AddUint64(uint64(len(data)), nil)
+			wp.trafficStats.filtered(1)
 		} else {
 			includeIndices = append(includeIndices, i)
 		}
@@ -713,6 +736,7 @@ func (wp *wsPeer) writeNonBlockMsgs(ctx context.Context, data [][]byte, highPrio
 		return true
 	default:
 	}
+	wp.trafficStats.dropped(len(msgs))
 	return false
 }
 
@@ -17,9 +17,24 @@
 package node
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrFollowModeRelay is returned when a node is configured both as a relay and in follow mode
+var ErrFollowModeRelay = errors.New("follow mode cannot be enabled on a relay node, NetAddress must be empty")
+
+// ErrFollowModeUnsupported is returned for operations which a node in follow mode does not perform,
+// such as broadcasting transactions or participating in agreement
+var ErrFollowModeUnsupported = errors.New("operation is not supported by a node in follow mode")
+
+// ErrNotFollowMode is returned for operations which are only available to a node in follow mode
+var ErrNotFollowMode = errors.New("operation is only supported by a node in follow mode")
+
+// ErrPeerAdminNotSupported is returned by the operator controls of the peers when the network
+// of the node doesn't implement them
+var ErrPeerAdminNotSupported = errors.New("the network of the node does not support controlling its peers")
+
 // Catchpoint already in progress error
 
 // CatchpointAlreadyInProgressError indicates that the requested catchpoint is already running
@@ -172,17 +172,84 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	if node.devMode {
 		cfg.DisableNetworking = true
 	}
+	if cfg.EnableFollowMode && cfg.NetAddress != "" {
+		log.Errorf("could not create node: %v", ErrFollowModeRelay)
+		return nil, ErrFollowModeRelay
+	}
 	node.config = cfg
 
 	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
+	var p2pNet *network.P2PNetwork
+	var err error
+	if cfg.EnableP2P || cfg.EnableP2PHybridMode {
+		p2pNet, err = network.NewP2PNetwork(node.log, node.config, genesis.ID(), genesis.Network)
+		if err != nil {
+			log.Errorf("could not create p2p node: %v", err)
+			return nil, err
+		}
+	}
+	if cfg.EnableP2P && !cfg.EnableP2PHybridMode {
+		node.net = p2pNet
+	} else {
+		wsNet, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
+		if err != nil {
+			log.Errorf("could not create websocket node: %v", err)
+			return nil, err
+		}
+		wsNet.SetPrioScheme(node)
+		// a bad admin file shouldn't keep the node from starting, it's replaced the next time it changes
+		err = wsNet.LoadPeerBans(filepath.Join(rootDir, config.PeerBansFilename))
+		if err != nil {
+			log.Errorf("could not load the peer bans, starting without any: %v", err)
+		}
+		err = wsNet.LoadPersistentPeers(filepath.Join(rootDir, config.PersistentPeersFilename))
+		if err != nil {
+			log.Errorf("could not load the persistent peers, starting without any: %v", err)
+		}
+		node.net = wsNet
+		if cfg.EnableP2PHybridMode {
+			node.net = network.NewHybridNetwork(wsNet, p2pNet)
+		}
+	}
+
+	var agreementClock timers.Clock
+	if node.devMode {
+		agreementClock = timers.MakeFrozenClock()
+	} else {
+		agreementClock = timers.MakeMonotonicClock(time.Now())
+	}
+	err = node.makeServices(log, genesis, agreementClock, false)
 	if err != nil {
-		log.Errorf("could not create websocket node: %v", err)
 		return nil, err
 	}
-	p2pNode.SetPrioScheme(node)
-	node.net = p2pNode
+	return node, nil
+}
 
+// MakeSimulated sets up an Algorand full node on a network and an agreement clock provided by
+// a network simulator, with an in-memory ledger, so that many nodes can run in a single process.
+func MakeSimulated(log logging.Logger, rootDir string, cfg config.Local, genesis bookkeeping.Genesis, net network.GossipNode, agreementClock timers.Clock) (*AlgorandFullNode, error) {
+	node := new(AlgorandFullNode)
+	node.rootDir = rootDir
+	address, _ := net.Address()
+	node.log = log.With("name", address)
+	node.genesisID = genesis.ID()
+	node.genesisHash = genesis.Hash()
+	node.config = cfg
+	node.net = net
+
+	err := node.makeServices(log, genesis, agreementClock, true)
+	if err != nil {
+		return nil, err
+	}
+	// keep the cadaver with the rest of the data of the node rather than in the working directory
+	node.agreementService.SetTracerFilename(filepath.Join(rootDir, "agreement"))
+	return node, nil
+}
+
+// makeServices loads the ledger and sets up the services of the node on top of its network.
+func (node *AlgorandFullNode) makeServices(log logging.Logger, genesis bookkeeping.Genesis, agreementClock timers.Clock, inMemLedger bool) error {
+	cfg := node.config
+	rootDir := node.rootDir
 	accountListener := makeTopAccountListener(log)
 
 	// load stored data
@@ -190,24 +257,24 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	ledgerPathnamePrefix := filepath.Join(genesisDir, config.LedgerFilenamePrefix)
 
 	// create initial ledger, if it doesn't exist
-	err = os.Mkdir(genesisDir, 0700)
+	err := os.Mkdir(genesisDir, 0700)
 	if err != nil && !os.IsExist(err) {
 		log.Errorf("Unable to create genesis directory: %v", err)
-		return nil, err
+		return err
 	}
 	genalloc, err := genesis.Balances()
 	if err != nil {
 		log.Errorf("Cannot load genesis allocation: %v", err)
-		return nil, err
+		return err
 	}
 
 	node.cryptoPool = execpool.MakePool(node)
 	node.lowPriorityCryptoVerificationPool = execpool.MakeBacklog(node.cryptoPool, 2*node.cryptoPool.GetParallelism(), execpool.LowPriority, node)
 	node.highPriorityCryptoVerificationPool = execpool.MakeBacklog(node.cryptoPool, 2*node.cryptoPool.GetParallelism(), execpool.HighPriority, node)
-	node.ledger, err = data.LoadLedger(node.log, ledgerPathnamePrefix, false, genesis.Proto, genalloc, node.genesisID, node.genesisHash, []ledger.BlockListener{}, cfg)
+	node.ledger, err = data.LoadLedger(node.log, ledgerPathnamePrefix, inMemLedger, genesis.Proto, genalloc, node.genesisID, node.genesisHash, []ledger.BlockListener{}, cfg)
 	if err != nil {
 		log.Errorf("Cannot initialize ledger (%s): %v", ledgerPathnamePrefix, err)
-		return nil, err
+		return err
 	}
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log)
@@ -228,29 +295,23 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		node.indexer, err = indexer.MakeIndexer(genesisDir, node.ledger, false)
 		if err != nil {
 			logging.Base().Errorf("failed to make indexer -  %v", err)
-			return nil, err
+			return err
 		}
 	}
 
-	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
-	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, p2pNode, node.genesisID)
-	rpcs.RegisterTxService(node.transactionPool, p2pNode, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
+	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, node.net, node.genesisID)
+	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, node.net, node.genesisID)
+	rpcs.RegisterTxService(node.transactionPool, node.net, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
 
 	crashPathname := filepath.Join(genesisDir, config.CrashFilename)
 	crashAccess, err := db.MakeAccessor(crashPathname, false, false)
 	if err != nil {
 		log.Errorf("Cannot load crash data: %v", err)
-		return nil, err
+		return err
 	}
 
 	blockValidator := blockValidatorImpl{l: node.ledger, verificationPool: node.highPriorityCryptoVerificationPool}
 	agreementLedger := makeAgreementLedger(node.ledger, node.net)
-	var agreementClock timers.Clock
-	if node.devMode {
-		agreementClock = timers.MakeFrozenClock()
-	} else {
-		agreementClock = timers.MakeMonotonicClock(time.Now())
-	}
 	agreementParameters := agreement.Parameters{
 		Logger:         log,
 		Accessor:       crashAccess,
@@ -267,20 +328,20 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.agreementService = agreement.MakeService(agreementParameters)
 
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
-	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
+	node.catchupService = catchup.MakeService(node.log, node.config, node.net, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
 	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
 
 	registry, err := ensureParticipationDB(genesisDir, node.log)
 	if err != nil {
 		log.Errorf("unable to initialize the participation registry database: %v", err)
-		return nil, err
+		return err
 	}
 	node.accountManager = data.MakeAccountManager(log, registry)
 
 	err = node.loadParticipationKeys()
 	if err != nil {
 		log.Errorf("Cannot load participation keys: %v", err)
-		return nil, err
+		return err
 	}
 
 	node.oldKeyDeletionNotify = make(chan struct{}, 1)
@@ -288,13 +349,13 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	catchpointCatchupState, err := node.ledger.GetCatchpointCatchupState(context.Background())
 	if err != nil {
 		log.Errorf("unable to determine catchpoint catchup state: %v", err)
-		return nil, err
+		return err
 	}
 	if catchpointCatchupState != ledger.CatchpointCatchupStateInactive {
 		node.catchpointCatchupService, err = catchup.MakeResumedCatchpointCatchupService(context.Background(), node, node.log, node.net, node.ledger.Ledger, node.config)
 		if err != nil {
 			log.Errorf("unable to create catchpoint catchup service: %v", err)
-			return nil, err
+			return err
 		}
 	}
 
@@ -305,11 +366,11 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	compactCertAccess, err := db.MakeAccessor(compactCertPathname, false, false)
 	if err != nil {
 		log.Errorf("Cannot load compact cert data: %v", err)
-		return nil, err
+		return err
 	}
 	node.compactCert = compactcert.NewWorker(compactCertAccess, node.log, node.accountManager, node.ledger.Ledger, node.net, node)
 
-	return node, err
+	return nil
 }
 
 // Config returns a copy of the node's Local configuration
@@ -341,12 +402,12 @@ func (node *AlgorandFullNode) Start() {
 		node.catchpointCatchupService.Start(node.ctx)
 	} else {
 		node.catchupService.Start()
-		node.agreementService.Start()
-		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		node.startParticipationServices()
 		node.blockService.Start()
 		node.ledgerService.Start()
-		node.txHandler.Start()
-		node.compactCert.Start()
+		if !node.config.EnableFollowMode {
+			node.compactCert.Start()
+		}
 		startNetwork()
 		// start indexer
 		if idx, err := node.Indexer(); err == nil {
@@ -366,6 +427,27 @@ func (node *AlgorandFullNode) Start() {
 
 }
 
+// startParticipationServices starts the services which let the node take part in agreement and relay
+// transactions. None of them are started when the node runs in follow mode.
+func (node *AlgorandFullNode) startParticipationServices() {
+	if node.config.EnableFollowMode {
+		return
+	}
+	node.agreementService.Start()
+	node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+	node.txHandler.Start()
+}
+
+// stopParticipationServices stops the services started by startParticipationServices.
+func (node *AlgorandFullNode) stopParticipationServices() {
+	if node.config.EnableFollowMode {
+		return
+	}
+	node.txHandler.Stop()
+	node.agreementService.Shutdown()
+	node.txPoolSyncerService.Stop()
+}
+
 // startMonitoringRoutines starts the internal monitoring routines used by the node.
 func (node *AlgorandFullNode) startMonitoringRoutines() {
 	node.monitoringRoutinesWaitGroup.Add(2)
@@ -410,10 +492,8 @@ func (node *AlgorandFullNode) Stop() {
 	if node.catchpointCatchupService != nil {
 		node.catchpointCatchupService.Stop()
 	} else {
-		node.txHandler.Stop()
-		node.agreementService.Shutdown()
+		node.stopParticipationServices()
 		node.catchupService.Stop()
-		node.txPoolSyncerService.Stop()
 		node.blockService.Stop()
 		node.ledgerService.Stop()
 	}
@@ -458,6 +538,9 @@ func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
 
 // BroadcastSignedTxGroup broadcasts a transaction group that has already been signed.
 func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) (err error) {
+	if node.config.EnableFollowMode {
+		return ErrFollowModeUnsupported
+	}
 	// in developer mode, we need to take a lock, so that each new transaction group would truly
 	// render into a unique block.
 	if node.devMode {
@@ -477,6 +560,9 @@ func (node *AlgorandFullNode) BroadcastSignedTxGroup(txgroup []transactions.Sign
 // BroadcastInternalSignedTxGroup broadcasts a transaction group that has already been signed.
 // It is originated internally, and in DevMode, it will not advance the round.
 func (node *AlgorandFullNode) BroadcastInternalSignedTxGroup(txgroup []transactions.SignedTxn) (err error) {
+	if node.config.EnableFollowMode {
+		return ErrFollowModeUnsupported
+	}
 	return node.broadcastSignedTxGroup(txgroup)
 }
 
@@ -731,6 +817,102 @@ func (node *AlgorandFullNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn
 	return bookkeeping.SignedTxnGroupsFlatten(node.transactionPool.PendingTxGroups()), nil
 }
 
+// EstimateFee returns the fee per byte the transaction pool recommends for a transaction
+// group to be proposed within horizon rounds.
+func (node *AlgorandFullNode) EstimateFee(horizon basics.Round) (pools.FeeEstimate, error) {
+	return node.transactionPool.EstimateFee(horizon), nil
+}
+
+// GetTransactionPoolComposition returns a breakdown of the content of the node's transaction pool,
+// along with the transaction groups the pool recently rejected or evicted.
+func (node *AlgorandFullNode) GetTransactionPoolComposition() (pools.PoolComposition, error) {
+	return node.transactionPool.Composition(), nil
+}
+
+// peerAdmin returns the operator controls of the network of the node, if it has any.
+func (node *AlgorandFullNode) peerAdmin() (network.PeerAdmin, error) {
+	admin, ok := node.net.(network.PeerAdmin)
+	if !ok {
+		return nil, ErrPeerAdminNotSupported
+	}
+	return admin, nil
+}
+
+// PeerStats returns a snapshot of the peers the node is connected to, and of
+// the traffic exchanged with each of them.
+func (node *AlgorandFullNode) PeerStats() ([]network.PeerStats, error) {
+	admin, err := node.peerAdmin()
+	if err != nil {
+		return nil, err
+	}
+	return admin.PeerStats(), nil
+}
+
+// AddPersistentPeer adds an address to the phonebook until it's removed, and keeps the node connected to it.
+// The persistent peers are persisted to the data directory.
+func (node *AlgorandFullNode) AddPersistentPeer(addr string) error {
+	admin, err := node.peerAdmin()
+	if err != nil {
+		return err
+	}
+	return admin.AddPersistentPeer(addr)
+}
+
+// RemovePersistentPeer removes an address added with AddPersistentPeer, and returns false if there was none.
+func (node *AlgorandFullNode) RemovePersistentPeer(addr string) (bool, error) {
+	admin, err := node.peerAdmin()
+	if err != nil {
+		return false, err
+	}
+	return admin.RemovePersistentPeer(addr)
+}
+
+// PersistentPeers returns the addresses added with AddPersistentPeer.
+func (node *AlgorandFullNode) PersistentPeers() ([]string, error) {
+	admin, err := node.peerAdmin()
+	if err != nil {
+		return nil, err
+	}
+	return admin.PersistentPeers(), nil
+}
+
+// DisconnectPeer disconnects the peers with the given address or remote address, and returns false if there were none.
+func (node *AlgorandFullNode) DisconnectPeer(addr string) (bool, error) {
+	admin, err := node.peerAdmin()
+	if err != nil {
+		return false, err
+	}
+	return admin.DisconnectPeer(addr), nil
+}
+
+// BanPeer bans an IP address or a telemetry GUID for the given duration, and disconnects the matching peers.
+// The bans are persisted to the data directory.
+func (node *AlgorandFullNode) BanPeer(target string, duration time.Duration) error {
+	admin, err := node.peerAdmin()
+	if err != nil {
+		return err
+	}
+	return admin.BanPeer(target, duration)
+}
+
+// UnbanPeer lifts the ban of an IP address or a telemetry GUID, and returns false if there was none.
+func (node *AlgorandFullNode) UnbanPeer(target string) (bool, error) {
+	admin, err := node.peerAdmin()
+	if err != nil {
+		return false, err
+	}
+	return admin.UnbanPeer(target)
+}
+
+// PeerBans returns the bans in effect.
+func (node *AlgorandFullNode) PeerBans() ([]network.PeerBan, error) {
+	admin, err := node.peerAdmin()
+	if err != nil {
+		return nil, err
+	}
+	return admin.PeerBans(), nil
+}
+
 // ensureParticipationDB opens or creates a participation DB.
 func ensureParticipationDB(genesisDir string, log logging.Logger) (account.ParticipationRegistry, error) {
 	accessorFile := filepath.Join(genesisDir, config.ParticipationRegistryFilename)
@@ -833,6 +1015,9 @@ func createTemporaryParticipationKey(outDir string, partKeyBinary []byte) (strin
 
 // InstallParticipationKey Given a participation key binary stream install the participation key.
 func (node *AlgorandFullNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
+	if node.config.EnableFollowMode {
+		return account.ParticipationID{}, ErrFollowModeUnsupported
+	}
 	genID := node.GenesisID()
 
 	outDir := filepath.Join(node.rootDir, genID)
@@ -1134,6 +1319,36 @@ func (node *AlgorandFullNode) AbortCatchup(catchpoint string) error {
 	return nil
 }
 
+// SetSyncRound sets the lowest round the consumers of a node in follow mode still need. The node
+// keeps following the chain only as long as the state delta of that round remains in the ledger
+// window of MaxAcctLookback rounds, and pauses catching up past it until the sync round is moved forward.
+func (node *AlgorandFullNode) SetSyncRound(rnd uint64) error {
+	if !node.config.EnableFollowMode {
+		return ErrNotFollowMode
+	}
+	// the ledger keeps the deltas of the latest MaxAcctLookback+1 rounds, so
+	// rnd stays available until the round after rnd+MaxAcctLookback is fetched
+	return node.catchupService.SetDisableSyncRound(rnd + node.config.MaxAcctLookback + 1)
+}
+
+// GetSyncRound returns the sync round of a node in follow mode, or 0 if none is set.
+func (node *AlgorandFullNode) GetSyncRound() uint64 {
+	disableSyncRound := node.catchupService.GetDisableSyncRound()
+	if disableSyncRound == 0 {
+		return 0
+	}
+	return basics.SubSaturate(disableSyncRound, node.config.MaxAcctLookback+1)
+}
+
+// UnsetSyncRound removes the sync round of a node in follow mode, letting it catch up without pausing.
+func (node *AlgorandFullNode) UnsetSyncRound() error {
+	if !node.config.EnableFollowMode {
+		return ErrNotFollowMode
+	}
+	node.catchupService.UnsetDisableSyncRound()
+	return nil
+}
+
 // SetCatchpointCatchupMode change the node's operational mode from catchpoint catchup mode and back, it returns a
 // channel which contains the updated node context. This function need to work asyncronisly so that the caller could
 // detect and handle the usecase where the node is being shut down while we're switching to/from catchup mode without
@@ -1160,10 +1375,8 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 				node.waitMonitoringRoutines()
 			}()
 			node.net.ClearHandlers()
-			node.txHandler.Stop()
-			node.agreementService.Shutdown()
+			node.stopParticipationServices()
 			node.catchupService.Stop()
-			node.txPoolSyncerService.Stop()
 			node.blockService.Stop()
 			node.ledgerService.Stop()
 
@@ -1180,11 +1393,9 @@ func (node *AlgorandFullNode) SetCatchpointCatchupMode(catchpointCatchupMode boo
 		// start
 		node.transactionPool.Reset()
 		node.catchupService.Start()
-		node.agreementService.Start()
-		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		node.startParticipationServices()
 		node.blockService.Start()
 		node.ledgerService.Start()
-		node.txHandler.Start()
 
 		// start indexer
 		if idx, err := node.Indexer(); err == nil {
@@ -35,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
@@ -553,3 +554,75 @@ func TestAsyncRecord(t *testing.T) {
 	require.Equal(t, 10000, int(records[0].LastVote))
 	require.Equal(t, 20000, int(records[0].LastBlockProposal))
 }
+
+func TestFollowMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testDirectory, err := ioutil.TempDir(os.TempDir(), t.Name())
+	require.NoError(t, err)
+	defer os.RemoveAll(testDirectory)
+
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "go-test-node-follow-mode",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     sinkAddr.String(),
+		RewardsPool: poolAddr.String(),
+	}
+
+	// a relay cannot run in follow mode
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	cfg.NetAddress = "127.0.0.1:0"
+	node, err := MakeFull(logging.TestingLog(t), testDirectory, cfg, []string{}, genesis)
+	require.Nil(t, node)
+	require.ErrorIs(t, err, ErrFollowModeRelay)
+
+	cfg.NetAddress = ""
+	cfg.DisableNetworking = true
+	cfg.MaxAcctLookback = 4
+	node, err = MakeFull(logging.TestingLog(t), testDirectory, cfg, []string{}, genesis)
+	require.NoError(t, err)
+	node.Start()
+	defer node.Stop()
+
+	require.ErrorIs(t, node.BroadcastSignedTxGroup([]transactions.SignedTxn{{}}), ErrFollowModeUnsupported)
+	_, err = node.InstallParticipationKey(nil)
+	require.ErrorIs(t, err, ErrFollowModeUnsupported)
+
+	require.Equal(t, uint64(0), node.GetSyncRound())
+	require.NoError(t, node.SetSyncRound(10))
+	require.Equal(t, uint64(10), node.GetSyncRound())
+	require.Equal(t, uint64(15), node.catchupService.GetDisableSyncRound())
+	require.NoError(t, node.UnsetSyncRound())
+	require.Equal(t, uint64(0), node.GetSyncRound())
+}
+
+func TestFollowModeSyncRoundDefaultLookback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testDirectory, err := ioutil.TempDir(os.TempDir(), t.Name())
+	require.NoError(t, err)
+	defer os.RemoveAll(testDirectory)
+
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "go-test-node-follow-mode-lookback",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     sinkAddr.String(),
+		RewardsPool: poolAddr.String(),
+	}
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableFollowMode = true
+	cfg.DisableNetworking = true
+	node, err := MakeFull(logging.TestingLog(t), testDirectory, cfg, []string{}, genesis)
+	require.NoError(t, err)
+	node.Start()
+	defer node.Stop()
+
+	// the sync round itself is still fetched, even without extra lookback
+	require.NoError(t, node.SetSyncRound(10))
+	require.Equal(t, uint64(10), node.GetSyncRound())
+	require.Equal(t, uint64(11), node.catchupService.GetDisableSyncRound())
+}
@@ -558,25 +558,35 @@ func NewPingpong(cfg PpConfig) *WorkerState {
 
 func randomizeCreatableID(cfg PpConfig, cinfo CreatablesInfo) (aidx uint64) {
 	if cfg.NumAsset > 0 {
-		rindex := rand.Intn(len(cinfo.AssetParams))
-		i := 0
-		for k := range cinfo.AssetParams {
-			if i == rindex {
-				aidx = k
-				break
-			}
-			i++
-		}
+		aidx = randomAssetID(cinfo)
 	} else if cfg.NumApp > 0 {
-		rindex := rand.Intn(len(cinfo.AppParams))
-		i := 0
-		for k := range cinfo.AppParams {
-			if i == rindex {
-				aidx = k
-				break
-			}
-			i++
+		aidx = randomAppID(cinfo)
+	}
+	return
+}
+
+func randomAssetID(cinfo CreatablesInfo) (aidx uint64) {
+	rindex := rand.Intn(len(cinfo.AssetParams))
+	i := 0
+	for k := range cinfo.AssetParams {
+		if i == rindex {
+			aidx = k
+			break
 		}
+		i++
+	}
+	return
+}
+
+func randomAppID(cinfo CreatablesInfo) (aidx uint64) {
+	rindex := rand.Intn(len(cinfo.AppParams))
+	i := 0
+	for k := range cinfo.AppParams {
+		if i == rindex {
+			aidx = k
+			break
+		}
+		i++
 	}
 	return
 }
@@ -944,6 +954,18 @@ func (pps *WorkerState) getSuggestedParams() v1.TransactionParams {
 }
 
 func (pps *WorkerState) constructTxn(from, to string, fee, amt, aidx uint64, client libgoal.Client) (txn transactions.Transaction, sender string, err error) {
+	txnType := protocol.PaymentTx
+	if pps.cfg.NumApp > 0 {
+		txnType = protocol.ApplicationCallTx
+	} else if pps.cfg.NumAsset > 0 {
+		txnType = protocol.AssetTransferTx
+	}
+	return pps.constructTxnOfType(txnType, from, to, fee, amt, aidx, client)
+}
+
+// constructTxnOfType builds a payment, an asset transfer or an application call, as
+// constructTxn does for the kind of transactions the configuration asks for
+func (pps *WorkerState) constructTxnOfType(txnType protocol.TxType, from, to string, fee, amt, aidx uint64, client libgoal.Client) (txn transactions.Transaction, sender string, err error) {
 	cfg := pps.cfg
 	cinfo := pps.cinfo
 	sender = from
@@ -967,7 +989,7 @@ func (pps *WorkerState) constructTxn(from, to string, fee, amt, aidx uint64, cli
 		crypto.RandBytes(lease[:])
 	}
 
-	if cfg.NumApp > 0 { // Construct app transaction
+	if txnType == protocol.ApplicationCallTx { // Construct app transaction
 		// select opted-in accounts for Txn.Accounts field
 		var accounts []string
 		assetOptIns := cinfo.OptIns[aidx]
@@ -1008,7 +1030,7 @@ func (pps *WorkerState) constructTxn(from, to string, fee, amt, aidx uint64, cli
 		if !cfg.Quiet {
 			_, _ = fmt.Fprintf(os.Stdout, "Calling app %d : %s\n", aidx, from)
 		}
-	} else if cfg.NumAsset > 0 { // Construct asset transaction
+	} else if txnType == protocol.AssetTransferTx { // Construct asset transaction
 		// select a pair of random opted-in accounts by aidx
 		// use them as from/to addresses
 		if from != to {
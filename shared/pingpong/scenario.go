@@ -0,0 +1,745 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pingpong
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/libgoal"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// Workload types
+const (
+	PaymentWorkload = "payment"
+	AssetWorkload   = "asset"
+	AppCallWorkload = "appcall"
+	GroupWorkload   = "group"
+)
+
+var workloadTxnTypes = map[string]protocol.TxType{
+	PaymentWorkload: protocol.PaymentTx,
+	AssetWorkload:   protocol.AssetTransferTx,
+	AppCallWorkload: protocol.ApplicationCallTx,
+}
+
+// defaultScenarioCreatables is the number of assets or apps created for the asset or
+// appcall workloads of a scenario that does not set NumAsset or NumApp
+const defaultScenarioCreatables = 5
+
+// Scenario describes a load test mixing weighted workloads, sent at a rate that
+// changes over consecutive phases
+type Scenario struct {
+	Workloads []Workload
+	Phases    []Phase
+
+	// NumAsset and NumApp are the numbers of assets and apps created for the
+	// asset and appcall workloads
+	NumAsset uint32
+	NumApp   uint32
+}
+
+// Workload is one kind of traffic of a scenario
+type Workload struct {
+	Name string
+	// Type is one of payment, asset, appcall or group
+	Type string
+	// Weight is the share of the submissions made by this workload, relative to
+	// the weights of the other workloads
+	Weight uint32
+	// GroupSize is the number of payments in each group of a group workload
+	GroupSize uint32
+}
+
+// Phase is a period of a scenario during which the rate of submissions ramps
+// linearly from StartTPS to EndTPS. A transaction group counts as one submission.
+type Phase struct {
+	Duration Duration
+	StartTPS float64
+	EndTPS   float64
+}
+
+// Duration is a time.Duration written in scenario files as a string such as "90s" or "5m"
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// MarshalJSON formats a duration as a string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// LoadScenarioFromFile reads and validates a scenario
+func LoadScenarioFromFile(file string) (sc Scenario, err error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	err = dec.Decode(&sc)
+	if err != nil {
+		return
+	}
+	err = sc.validate()
+	return
+}
+
+func (sc *Scenario) validate() error {
+	if len(sc.Workloads) == 0 {
+		return fmt.Errorf("scenario has no workloads")
+	}
+	if len(sc.Phases) == 0 {
+		return fmt.Errorf("scenario has no phases")
+	}
+	names := make(map[string]bool, len(sc.Workloads))
+	for i := range sc.Workloads {
+		w := &sc.Workloads[i]
+		if w.Name == "" {
+			w.Name = w.Type
+		}
+		if names[w.Name] {
+			return fmt.Errorf("workload name %s is not unique", w.Name)
+		}
+		names[w.Name] = true
+		if w.Weight == 0 {
+			return fmt.Errorf("workload %s has no weight", w.Name)
+		}
+		switch w.Type {
+		case PaymentWorkload, AssetWorkload, AppCallWorkload:
+		case GroupWorkload:
+			if w.GroupSize < 2 || w.GroupSize > 16 {
+				return fmt.Errorf("workload %s has invalid group size %d", w.Name, w.GroupSize)
+			}
+		default:
+			return fmt.Errorf("workload %s has unknown type %s", w.Name, w.Type)
+		}
+	}
+	if sc.NumAsset > 1000 || sc.NumApp > 1000 {
+		return fmt.Errorf("invalid number of assets %d or apps %d, (valid number: 0 - 1000)", sc.NumAsset, sc.NumApp)
+	}
+	if sc.hasWorkload(AssetWorkload) && sc.NumAsset == 0 {
+		sc.NumAsset = defaultScenarioCreatables
+	}
+	if sc.hasWorkload(AppCallWorkload) && sc.NumApp == 0 {
+		sc.NumApp = defaultScenarioCreatables
+	}
+	for i, p := range sc.Phases {
+		if p.Duration <= 0 {
+			return fmt.Errorf("phase %d has no duration", i)
+		}
+		if p.StartTPS < 0 || p.EndTPS < 0 {
+			return fmt.Errorf("phase %d has a negative rate", i)
+		}
+	}
+	if sc.maxRate() == 0 {
+		return fmt.Errorf("scenario never sends any transactions")
+	}
+	return nil
+}
+
+func (sc *Scenario) hasWorkload(workloadType string) bool {
+	for _, w := range sc.Workloads {
+		if w.Type == workloadType {
+			return true
+		}
+	}
+	return false
+}
+
+// maxRate returns the highest rate of the scenario, rounded up
+func (sc *Scenario) maxRate() uint64 {
+	var max float64
+	for _, p := range sc.Phases {
+		max = math.Max(max, math.Max(p.StartTPS, p.EndTPS))
+	}
+	return uint64(math.Ceil(max))
+}
+
+// rate returns the rate of submissions at elapsed time since the start of the
+// scenario, or false once all phases are over
+func (sc *Scenario) rate(elapsed time.Duration) (float64, bool) {
+	for _, p := range sc.Phases {
+		d := time.Duration(p.Duration)
+		if elapsed < d {
+			progress := float64(elapsed) / float64(d)
+			return p.StartTPS + (p.EndTPS-p.StartTPS)*progress, true
+		}
+		elapsed -= d
+	}
+	return 0, false
+}
+
+// pickWorkload returns the index of the workload whose weight range holds r,
+// for r in [0, total weight)
+func (sc *Scenario) pickWorkload(r uint64) int {
+	for i, w := range sc.Workloads {
+		if r < uint64(w.Weight) {
+			return i
+		}
+		r -= uint64(w.Weight)
+	}
+	return len(sc.Workloads) - 1
+}
+
+func (sc *Scenario) totalWeight() (total uint64) {
+	for _, w := range sc.Workloads {
+		total += uint64(w.Weight)
+	}
+	return
+}
+
+// WorkloadReport holds the outcome of the submissions of one workload
+type WorkloadReport struct {
+	Name      string
+	Sent      uint64
+	Confirmed uint64
+	// Latencies are the times from submission to confirmation
+	Latencies []time.Duration
+	// Rejections counts the submissions which failed or never confirmed, by reason
+	Rejections map[string]uint64
+	// Examples holds the first error seen for each reason
+	Examples map[string]string
+}
+
+// ScenarioReport holds the outcome of a scenario run, per workload
+type ScenarioReport struct {
+	Workloads []*WorkloadReport
+}
+
+func makeScenarioReport(sc Scenario) *ScenarioReport {
+	report := &ScenarioReport{}
+	for _, w := range sc.Workloads {
+		report.Workloads = append(report.Workloads, &WorkloadReport{
+			Name:       w.Name,
+			Rejections: make(map[string]uint64),
+			Examples:   make(map[string]string),
+		})
+	}
+	return report
+}
+
+func (wr *WorkloadReport) reject(reason string, example string) {
+	if _, ok := wr.Examples[reason]; !ok {
+		wr.Examples[reason] = example
+	}
+	wr.Rejections[reason]++
+}
+
+func (wr *WorkloadReport) rejected() (total uint64) {
+	for _, count := range wr.Rejections {
+		total += count
+	}
+	return
+}
+
+// percentile returns the p-th percentile of sorted latencies, by the nearest rank method
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func formatLatency(sorted []time.Duration, p float64) string {
+	if len(sorted) == 0 {
+		return "-"
+	}
+	return percentile(sorted, p).Round(time.Millisecond).String()
+}
+
+// Write prints, for each workload, the number of submissions sent, confirmed and
+// rejected, percentiles of the submit-to-confirm latency, and the reasons of the rejections
+func (r *ScenarioReport) Write(out io.Writer) error {
+	tw := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "workload\tsent\tconfirmed\trejected\tp50\tp90\tp99\tmax")
+	for _, wr := range r.Workloads {
+		latencies := append([]time.Duration(nil), wr.Latencies...)
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%s\t%s\t%s\t%s\n", wr.Name, wr.Sent, wr.Confirmed, wr.rejected(),
+			formatLatency(latencies, 50), formatLatency(latencies, 90), formatLatency(latencies, 99), formatLatency(latencies, 100))
+	}
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	for _, wr := range r.Workloads {
+		if len(wr.Rejections) == 0 {
+			continue
+		}
+		reasons := make([]string, 0, len(wr.Rejections))
+		for reason := range wr.Rejections {
+			reasons = append(reasons, reason)
+		}
+		sort.Slice(reasons, func(i, j int) bool {
+			if wr.Rejections[reasons[i]] != wr.Rejections[reasons[j]] {
+				return wr.Rejections[reasons[i]] > wr.Rejections[reasons[j]]
+			}
+			return reasons[i] < reasons[j]
+		})
+		fmt.Fprintf(out, "%s rejections:\n", wr.Name)
+		for _, reason := range reasons {
+			if reason == otherRejection {
+				fmt.Fprintf(out, "  %s: %d (e.g. %s)\n", reason, wr.Rejections[reason], wr.Examples[reason])
+			} else {
+				fmt.Fprintf(out, "  %s: %d\n", reason, wr.Rejections[reason])
+			}
+		}
+	}
+	return nil
+}
+
+// rejectionReasons maps fragments of the errors returned by algod, or met
+// building transactions, to the reasons reported for rejections
+var rejectionReasons = []struct {
+	fragment string
+	reason   string
+}{
+	{"transaction pool have reached capacity", "pool full"},
+	{"broadcast queue full", "broadcast queue full"},
+	{"already in ledger", "already in ledger"},
+	{"txn dead", "txn dead"},
+	{"overspend", "overspend"},
+	{"below min", "below min balance"},
+	{"below threshold", "fee too low"},
+	{"rejected by logic", "rejected by logic"},
+	{"logic eval error", "logic eval error"},
+	{"must optin", "not opted in"},
+	{"missing from", "not opted in"},
+	{"not opted in", "not opted in"},
+}
+
+const (
+	expiredRejection     = "expired"
+	unconfirmedRejection = "unconfirmed"
+	noAccountsRejection  = "no funded accounts"
+	otherRejection       = "other"
+)
+
+func rejectionReason(err error) string {
+	msg := err.Error()
+	for _, r := range rejectionReasons {
+		if strings.Contains(msg, r.fragment) {
+			return r.reason
+		}
+	}
+	return otherRejection
+}
+
+type pendingSubmission struct {
+	workload  int
+	sent      time.Time
+	lastValid uint64
+}
+
+// confirmationTracker follows the blocks of the network to time how long
+// submissions take to be confirmed
+type confirmationTracker struct {
+	mu        deadlock.Mutex
+	report    *ScenarioReport
+	pending   map[transactions.Txid]pendingSubmission
+	lastRound uint64
+}
+
+func makeConfirmationTracker(report *ScenarioReport) *confirmationTracker {
+	return &confirmationTracker{
+		report:  report,
+		pending: make(map[transactions.Txid]pendingSubmission),
+	}
+}
+
+// submitted records a submission, identified by the ID of its first transaction
+func (t *confirmationTracker) submitted(workload int, txid transactions.Txid, lastValid uint64, sent time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.report.Workloads[workload].Sent++
+	t.pending[txid] = pendingSubmission{workload: workload, sent: sent, lastValid: lastValid}
+}
+
+func (t *confirmationTracker) rejected(workload int, reason string, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	wr := t.report.Workloads[workload]
+	wr.Sent++
+	wr.reject(reason, err.Error())
+}
+
+// observe records the confirmation of the transactions of round, seen at time now,
+// and the expiry of the submissions which can no longer be confirmed
+func (t *confirmationTracker) observe(round uint64, txids []transactions.Txid, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, txid := range txids {
+		p, ok := t.pending[txid]
+		if !ok {
+			continue
+		}
+		wr := t.report.Workloads[p.workload]
+		wr.Confirmed++
+		wr.Latencies = append(wr.Latencies, now.Sub(p.sent))
+		delete(t.pending, txid)
+	}
+	for txid, p := range t.pending {
+		if p.lastValid <= round {
+			t.report.Workloads[p.workload].reject(expiredRejection, fmt.Sprintf("not confirmed by round %d", p.lastValid))
+			delete(t.pending, txid)
+		}
+	}
+	t.lastRound = round
+}
+
+func (t *confirmationTracker) pendingCount() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.pending)
+}
+
+// giveUp counts the submissions still pending as unconfirmed
+func (t *confirmationTracker) giveUp() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for txid, p := range t.pending {
+		t.report.Workloads[p.workload].reject(unconfirmedRejection, fmt.Sprintf("still pending after round %d", t.lastRound))
+		delete(t.pending, txid)
+	}
+}
+
+// follow observes every block from round on, until ctx is done
+func (t *confirmationTracker) follow(ctx context.Context, client libgoal.Client, round uint64) {
+	for ctx.Err() == nil {
+		status, err := client.WaitForRound(round - 1)
+		if err != nil || status.LastRound < round {
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		now := time.Now()
+		block, err := client.BookkeepingBlock(round)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "error fetching block %d: %v\n", round, err)
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		payset, err := block.DecodePaysetFlat()
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "error decoding block %d: %v\n", round, err)
+			payset = nil
+		}
+		txids := make([]transactions.Txid, len(payset))
+		for i := range payset {
+			txids[i] = payset[i].ID()
+		}
+		t.observe(round, txids, now)
+		round++
+	}
+}
+
+// scenarioDrainTimeout bounds how long a scenario run waits for its last submissions to be confirmed
+const scenarioDrainTimeout = time.Minute
+
+// PrepareScenario sets up the accounts, and the assets and apps, the workloads of sc need
+func (pps *WorkerState) PrepareScenario(ac libgoal.Client, sc Scenario) (err error) {
+	cfg := pps.cfg
+	// fund the accounts for the peak rate
+	cfg.TxnPerSec = sc.maxRate()
+	cfg.NumAsset = 0
+	cfg.NumApp = 0
+	if sc.hasWorkload(AssetWorkload) {
+		cfg.NumAsset = sc.NumAsset
+	}
+	if sc.hasWorkload(AppCallWorkload) {
+		cfg.NumApp = sc.NumApp
+	}
+	for _, w := range sc.Workloads {
+		if w.GroupSize > cfg.GroupSize {
+			cfg.GroupSize = w.GroupSize
+		}
+	}
+	if cfg.NumAsset > 0 && cfg.NumApp > 0 {
+		cfg.MinAccountFunds, err = mixedAccountFunds(ac, cfg)
+		if err != nil {
+			return
+		}
+	}
+
+	pps.accounts, pps.cfg, err = pps.ensureAccounts(ac, cfg)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "ensure accounts failed %v\n", err)
+		return
+	}
+
+	_, err = pps.prepareNewAccounts(ac)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "prepare new accounts failed: %v\n", err)
+		return
+	}
+
+	pps.cinfo.OptIns = make(map[uint64][]string)
+	if pps.cfg.NumAsset > 0 {
+		var optIns map[uint64][]string
+		pps.cinfo.AssetParams, optIns, err = pps.prepareAssets(pps.accounts, ac)
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "prepare assets failed %v\n", err)
+			return
+		}
+		if len(pps.cinfo.AssetParams) == 0 {
+			return fmt.Errorf("no assets were created for the asset workloads")
+		}
+		for aidx, addrs := range optIns {
+			pps.cinfo.OptIns[aidx] = addrs
+		}
+	}
+	if pps.cfg.NumApp > 0 {
+		var optIns map[uint64][]string
+		pps.cinfo.AppParams, optIns, err = pps.prepareApps(pps.accounts, ac, pps.cfg)
+		if err != nil {
+			return
+		}
+		if len(pps.cinfo.AppParams) == 0 {
+			return fmt.Errorf("no apps were created for the appcall workloads")
+		}
+		// asset and app indices never collide
+		for aidx, addrs := range optIns {
+			pps.cinfo.OptIns[aidx] = addrs
+		}
+	}
+	return
+}
+
+// mixedAccountFunds returns the balance accounts are funded with when the workloads both
+// call apps and transfer assets. computeAccountMinBalance covers either apps or assets,
+// so the accounts are funded for each of them.
+func mixedAccountFunds(ac libgoal.Client, cfg PpConfig) (uint64, error) {
+	assetCfg := cfg
+	assetCfg.NumApp = 0
+	assetFunds, _, err := computeAccountMinBalance(ac, assetCfg)
+	if err != nil {
+		return 0, err
+	}
+	appCfg := cfg
+	appCfg.NumAsset = 0
+	appFunds, _, err := computeAccountMinBalance(ac, appCfg)
+	if err != nil {
+		return 0, err
+	}
+	return assetFunds + appFunds, nil
+}
+
+// RunScenario sends the traffic of sc until its last phase is over, and reports
+// what became of it once its submissions are confirmed or expired
+func (pps *WorkerState) RunScenario(ctx context.Context, ac libgoal.Client, sc Scenario) *ScenarioReport {
+	cfg := pps.cfg
+	report := makeScenarioReport(sc)
+	tracker := makeConfirmationTracker(report)
+
+	followCtx, stopFollowing := context.WithCancel(ctx)
+	defer stopFollowing()
+	status, err := ac.Status()
+	for err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "error getting status: %v\n", err)
+		time.Sleep(500 * time.Millisecond)
+		status, err = ac.Status()
+	}
+	go tracker.follow(followCtx, ac, status.LastRound+1)
+
+	totalWeight := sc.totalWeight()
+	refreshTime := time.Now().Add(cfg.RefreshTime)
+	startTime := time.Now()
+	nextSendTime := startTime
+	lastLog := startTime
+	nextLog := lastLog.Add(logPeriod)
+	var totalSent, lastTotalSent uint64
+	for ctx.Err() == nil {
+		now := time.Now()
+		rate, ok := sc.rate(now.Sub(startTime))
+		if !ok {
+			break
+		}
+		if now.After(nextLog) {
+			dt := now.Sub(lastLog)
+			fmt.Printf("%d sent, %0.2f/s, target %0.2f/s (%d total)\n", totalSent-lastTotalSent, float64(totalSent-lastTotalSent)/dt.Seconds(), rate, totalSent)
+			lastTotalSent = totalSent
+			for now.After(nextLog) {
+				nextLog = nextLog.Add(logPeriod)
+			}
+			lastLog = now
+		}
+		if rate <= 0 {
+			time.Sleep(100 * time.Millisecond)
+			nextSendTime = time.Now()
+			continue
+		}
+
+		dur := time.Until(nextSendTime)
+		if dur > 0 {
+			time.Sleep(dur)
+		}
+		// do not burst to catch up after falling behind
+		nextSendTime = nextSendTime.Add(time.Duration(float64(time.Second) / rate))
+		if time.Since(nextSendTime) > time.Second {
+			nextSendTime = time.Now()
+		}
+
+		workload := sc.pickWorkload(rand.Uint64() % totalWeight)
+		pps.sendWorkload(ac, sc.Workloads[workload], workload, tracker)
+		totalSent++
+
+		if cfg.RefreshTime > 0 && time.Now().After(refreshTime) {
+			err = pps.refreshAccounts(pps.accounts, ac, cfg)
+			if err != nil {
+				_, _ = fmt.Fprintf(os.Stderr, "error refreshing: %v\n", err)
+			}
+			refreshTime = refreshTime.Add(cfg.RefreshTime)
+		}
+	}
+
+	fmt.Printf("Waiting for %d submissions to be confirmed\n", tracker.pendingCount())
+	deadline := time.Now().Add(scenarioDrainTimeout)
+	for tracker.pendingCount() > 0 && time.Now().Before(deadline) && ctx.Err() == nil {
+		time.Sleep(100 * time.Millisecond)
+	}
+	stopFollowing()
+	tracker.giveUp()
+	return report
+}
+
+// sendWorkload builds, signs and submits one transaction or group of w
+func (pps *WorkerState) sendWorkload(client libgoal.Client, w Workload, workload int, tracker *confirmationTracker) {
+	cfg := pps.cfg
+	minimumAmount := cfg.MinAccountFunds + (cfg.MaxAmt+cfg.MaxFee)*2
+	fromList := listSufficientAccounts(pps.accounts, minimumAmount, cfg.SrcAccount)
+	if len(fromList) < 2 {
+		tracker.rejected(workload, noAccountsRejection, fmt.Errorf("%d accounts with at least %d", len(fromList), minimumAmount))
+		return
+	}
+	from, to := fromList[0], fromList[1]
+
+	amt := cfg.MaxAmt
+	if cfg.RandomizeAmt && cfg.MaxAmt > 0 {
+		amt = rand.Uint64()%cfg.MaxAmt + 1
+	}
+	fee := pps.fee()
+
+	var txGroup []transactions.Transaction
+	var txSigners []string
+	var err error
+	switch w.Type {
+	case GroupWorkload:
+		for j := 0; j < int(w.GroupSize); j++ {
+			var txn transactions.Transaction
+			var signer string
+			if j%2 == 0 {
+				txn, signer, err = pps.constructTxnOfType(protocol.PaymentTx, from, to, fee, amt, 0, client)
+			} else {
+				txn, signer, err = pps.constructTxnOfType(protocol.PaymentTx, to, from, fee, amt, 0, client)
+			}
+			if err != nil {
+				break
+			}
+			txGroup = append(txGroup, txn)
+			txSigners = append(txSigners, signer)
+		}
+		if err == nil {
+			var gid crypto.Digest
+			gid, err = client.GroupID(txGroup)
+			for j := range txGroup {
+				txGroup[j].Group = gid
+			}
+		}
+	default:
+		var aidx uint64
+		switch w.Type {
+		case AssetWorkload:
+			aidx = randomAssetID(pps.cinfo)
+			amt = 1
+		case AppCallWorkload:
+			aidx = randomAppID(pps.cinfo)
+			amt = 0
+		}
+		var txn transactions.Transaction
+		var signer string
+		txn, signer, err = pps.constructTxnOfType(workloadTxnTypes[w.Type], from, to, fee, amt, aidx, client)
+		txGroup = []transactions.Transaction{txn}
+		txSigners = []string{signer}
+	}
+	if err != nil {
+		tracker.rejected(workload, rejectionReason(err), err)
+		return
+	}
+
+	stxGroup := make([]transactions.SignedTxn, len(txGroup))
+	for j, txn := range txGroup {
+		stxGroup[j], err = signTxn(txSigners[j], txn, pps.accounts, cfg)
+		if err != nil {
+			tracker.rejected(workload, rejectionReason(err), err)
+			return
+		}
+	}
+
+	sent := time.Now()
+	if len(stxGroup) == 1 {
+		_, err = client.BroadcastTransaction(stxGroup[0])
+	} else {
+		err = client.BroadcastTransactionGroup(stxGroup)
+	}
+	if err != nil {
+		tracker.rejected(workload, rejectionReason(err), err)
+		return
+	}
+	tracker.submitted(workload, stxGroup[0].ID(), uint64(txGroup[0].LastValid), sent)
+
+	for j, txn := range txGroup {
+		if txn.Type == protocol.PaymentTx {
+			pps.accounts[txSigners[j]].addBalance(-int64(txn.Fee.Raw + txn.Amount.Raw))
+		} else {
+			pps.accounts[txSigners[j]].addBalance(-int64(txn.Fee.Raw))
+		}
+	}
+}
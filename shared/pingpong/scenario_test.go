@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pingpong
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestLoadScenario(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	sc, err := LoadScenarioFromFile("testdata/scenario.json")
+	a.NoError(err)
+	a.Len(sc.Workloads, 4)
+	a.Equal(uint32(4), sc.Workloads[1].GroupSize)
+	a.Equal(Duration(2*time.Minute), sc.Phases[1].Duration)
+	a.Equal(uint32(defaultScenarioCreatables), sc.NumAsset)
+	a.Equal(uint32(3), sc.NumApp)
+	a.Equal(uint64(100), sc.maxRate())
+	a.Equal(uint64(100), sc.totalWeight())
+
+	// ramping up, steady, ramping down, then over
+	rate, ok := sc.rate(15 * time.Second)
+	a.True(ok)
+	a.InDelta(55, rate, 0.001)
+	rate, ok = sc.rate(time.Minute)
+	a.True(ok)
+	a.InDelta(100, rate, 0.001)
+	rate, ok = sc.rate(2*time.Minute + 45*time.Second)
+	a.True(ok)
+	a.InDelta(50, rate, 0.001)
+	_, ok = sc.rate(3 * time.Minute)
+	a.False(ok)
+
+	a.Equal(0, sc.pickWorkload(0))
+	a.Equal(0, sc.pickWorkload(59))
+	a.Equal(1, sc.pickWorkload(60))
+	a.Equal(2, sc.pickWorkload(70))
+	a.Equal(3, sc.pickWorkload(99))
+}
+
+func TestValidateScenario(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	phases := []Phase{{Duration: Duration(time.Minute), StartTPS: 10, EndTPS: 10}}
+	tests := []struct {
+		sc  Scenario
+		err string
+	}{
+		{Scenario{Phases: phases}, "no workloads"},
+		{Scenario{Workloads: []Workload{{Type: PaymentWorkload, Weight: 1}}}, "no phases"},
+		{Scenario{Workloads: []Workload{{Type: "swap", Weight: 1}}, Phases: phases}, "unknown type"},
+		{Scenario{Workloads: []Workload{{Type: PaymentWorkload}}, Phases: phases}, "no weight"},
+		{Scenario{Workloads: []Workload{{Type: GroupWorkload, Weight: 1, GroupSize: 1}}, Phases: phases}, "invalid group size"},
+		{Scenario{Workloads: []Workload{{Type: PaymentWorkload, Weight: 1}, {Type: PaymentWorkload, Weight: 1}}, Phases: phases}, "not unique"},
+		{Scenario{Workloads: []Workload{{Type: PaymentWorkload, Weight: 1}}, Phases: []Phase{{Duration: Duration(time.Minute)}}}, "never sends"},
+		{Scenario{Workloads: []Workload{{Type: PaymentWorkload, Weight: 1}}, Phases: []Phase{{StartTPS: 1}}}, "no duration"},
+	}
+	for _, test := range tests {
+		err := test.sc.validate()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), test.err)
+	}
+}
+
+func TestScenarioReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	sc := Scenario{Workloads: []Workload{{Name: "pay"}, {Name: "app"}}}
+	report := makeScenarioReport(sc)
+	tracker := makeConfirmationTracker(report)
+
+	start := time.Now()
+	for i := 0; i < 10; i++ {
+		tracker.submitted(0, transactions.Txid{byte(i)}, 20, start)
+	}
+	tracker.submitted(1, transactions.Txid{0xff}, 12, start)
+	tracker.rejected(1, rejectionReason(errors.New("TransactionPool.Remember: transaction ABC: overspend (account X)")), errors.New("overspend"))
+	tracker.rejected(1, rejectionReason(errors.New("something unexpected")), errors.New("something unexpected"))
+
+	// one pay submission confirms in each of rounds 11 to 20, a second apart
+	for i := 0; i < 10; i++ {
+		tracker.observe(uint64(11+i), []transactions.Txid{{byte(i)}}, start.Add(time.Duration(i+1)*time.Second))
+	}
+	a.Equal(0, tracker.pendingCount())
+	tracker.giveUp()
+
+	pay := report.Workloads[0]
+	a.Equal(uint64(10), pay.Sent)
+	a.Equal(uint64(10), pay.Confirmed)
+	a.Empty(pay.Rejections)
+	app := report.Workloads[1]
+	a.Equal(uint64(3), app.Sent)
+	a.Equal(uint64(0), app.Confirmed)
+	a.Equal(map[string]uint64{expiredRejection: 1, "overspend": 1, otherRejection: 1}, app.Rejections)
+
+	var out bytes.Buffer
+	a.NoError(report.Write(&out))
+	a.Equal(`workload  sent  confirmed  rejected  p50  p90  p99  max
+pay       10    10         0         5s   9s   10s  10s
+app       3     0          3         -    -    -    -
+app rejections:
+  expired: 1
+  other: 1 (e.g. something unexpected)
+  overspend: 1
+`, out.String())
+}
@@ -727,3 +727,211 @@ func TestMasterKeyGeneratePastImportedKeys(t *testing.T) {
 	// Address should be equal to addrs[2]
 	a.Equal(addr1, addrs[2])
 }
+
+func TestSignTransactionWithPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate a key
+	req0 := kmdapi.APIV1POSTKeyRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp0 := kmdapi.APIV1POSTKeyResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+	addr, err := basics.UnmarshalChecksumAddress(resp0.Address)
+	a.NoError(err)
+
+	// The wallet starts out without a policy
+	req1 := kmdapi.APIV1POSTWalletPolicyRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp1 := kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+	a.Empty(resp1.Policy.Rules)
+
+	// Limit payments from the key to 1000 microAlgos, twice an hour
+	policy := kmdapi.APIV1Policy{
+		Rules: []kmdapi.APIV1PolicyRule{{
+			Address:              resp0.Address,
+			AllowedTxTypes:       []protocol.TxType{protocol.PaymentTx},
+			MaxPaymentAmount:     1000,
+			MaxSignaturesPerHour: 2,
+		}},
+	}
+
+	// Setting a policy requires the wallet password
+	req2 := kmdapi.APIV1POSTWalletPolicySetRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    "wr0ng_p4ssw0rd",
+		PolicyPassword:    "p0l1cy_p4ssw0rd",
+		Policy:            policy,
+	}
+	resp2 := kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.Error(err)
+
+	req2.WalletPassword = f.WalletPassword
+	resp2 = kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.NoError(err)
+	a.Equal(policy, resp2.Policy)
+
+	resp1 = kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+	a.Equal(policy, resp1.Policy)
+
+	sign := func(tx transactions.Transaction) error {
+		req := kmdapi.APIV1POSTTransactionSignRequest{
+			WalletHandleToken: walletHandleToken,
+			Transaction:       protocol.Encode(&tx),
+			WalletPassword:    f.WalletPassword,
+		}
+		resp := kmdapi.APIV1POSTTransactionSignResponse{}
+		return f.Client.DoV1Request(req, &resp)
+	}
+
+	tx := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:     addr,
+			Fee:        basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusCurrentVersion].MinTxnFee},
+			FirstValid: basics.Round(1),
+			LastValid:  basics.Round(1),
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Amount: basics.MicroAlgos{Raw: 1001},
+		},
+	}
+
+	// Payments over the limit are refused
+	a.Error(sign(tx))
+
+	// Other transaction types are refused
+	keyreg := transactions.Transaction{Type: protocol.KeyRegistrationTx, Header: tx.Header}
+	a.Error(sign(keyreg))
+
+	// Payments within the limit are signed, until the rate limit is hit
+	tx.Amount.Raw = 1000
+	a.NoError(sign(tx))
+	a.NoError(sign(tx))
+	a.Error(sign(tx))
+
+	// Programs can't be signed with a restricted key
+	req3 := kmdapi.APIV1POSTProgramSignRequest{
+		WalletHandleToken: walletHandleToken,
+		Address:           resp0.Address,
+		Program:           []byte("program"),
+		WalletPassword:    f.WalletPassword,
+	}
+	resp3 := kmdapi.APIV1POSTProgramSignResponse{}
+	err = f.Client.DoV1Request(req3, &resp3)
+	a.Error(err)
+}
+
+func TestRestrictedHandleCannotLiftPolicy(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate a key
+	req0 := kmdapi.APIV1POSTKeyRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp0 := kmdapi.APIV1POSTKeyResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	// The administrator restricts the key, protecting the policy with a
+	// policy password the automation holding the handle does not know
+	policy := kmdapi.APIV1Policy{
+		Rules: []kmdapi.APIV1PolicyRule{{
+			Address:          resp0.Address,
+			AllowedTxTypes:   []protocol.TxType{protocol.PaymentTx},
+			MaxPaymentAmount: 1000,
+		}},
+	}
+	req1 := kmdapi.APIV1POSTWalletPolicySetRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+		PolicyPassword:    "p0l1cy_p4ssw0rd",
+		Policy:            policy,
+	}
+	resp1 := kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.NoError(err)
+
+	// The handle and the wallet password are not enough to clear or loosen
+	// the policy
+	req2 := kmdapi.APIV1POSTWalletPolicySetRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp2 := kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.Error(err)
+
+	req2.PolicyPassword = f.WalletPassword
+	resp2 = kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.Error(err)
+
+	req3 := kmdapi.APIV1POSTWalletPolicyRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp3 := kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req3, &resp3)
+	a.NoError(err)
+	a.Equal(policy, resp3.Policy)
+
+	// Nor to take the keys out of kmd
+	req4 := kmdapi.APIV1POSTKeyExportRequest{
+		WalletHandleToken: walletHandleToken,
+		Address:           resp0.Address,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp4 := kmdapi.APIV1POSTKeyExportResponse{}
+	err = f.Client.DoV1Request(req4, &resp4)
+	a.Error(err)
+
+	req5 := kmdapi.APIV1POSTMasterKeyExportRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp5 := kmdapi.APIV1POSTMasterKeyExportResponse{}
+	err = f.Client.DoV1Request(req5, &resp5)
+	a.Error(err)
+
+	req6 := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp6 := kmdapi.APIV1POSTWalletBackupResponse{}
+	err = f.Client.DoV1Request(req6, &resp6)
+	a.Error(err)
+
+	// With the policy password, the policy can be removed, after which the
+	// key can be exported again
+	req2.PolicyPassword = "p0l1cy_p4ssw0rd"
+	resp2 = kmdapi.APIV1POSTWalletPolicyResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.NoError(err)
+	a.Empty(resp2.Policy.Rules)
+
+	resp4 = kmdapi.APIV1POSTKeyExportResponse{}
+	err = f.Client.DoV1Request(req4, &resp4)
+	a.NoError(err)
+}
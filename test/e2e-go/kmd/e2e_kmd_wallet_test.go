@@ -324,3 +324,73 @@ func TestWalletSessionExpiry(t *testing.T) {
 	// Token should have expired
 	a.Error(err)
 }
+
+func TestWalletBackupRestore(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	defer fixtures.ShutdownSynchronizedTest(t)
+
+	a := require.New(fixtures.SynchronizedTest(t))
+	t.Parallel()
+	var f fixtures.KMDFixture
+	walletHandleToken := f.SetupWithWallet(t)
+	defer f.Shutdown()
+
+	// Generate a key
+	req0 := kmdapi.APIV1POSTKeyRequest{
+		WalletHandleToken: walletHandleToken,
+	}
+	resp0 := kmdapi.APIV1POSTKeyResponse{}
+	err := f.Client.DoV1Request(req0, &resp0)
+	a.NoError(err)
+
+	// Backing up with the wrong password should fail
+	req1 := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    "wr0ng_p4ssw0rd",
+	}
+	resp1 := kmdapi.APIV1POSTWalletBackupResponse{}
+	err = f.Client.DoV1Request(req1, &resp1)
+	a.Error(err)
+
+	// Back up the wallet
+	req2 := kmdapi.APIV1POSTWalletBackupRequest{
+		WalletHandleToken: walletHandleToken,
+		WalletPassword:    f.WalletPassword,
+	}
+	resp2 := kmdapi.APIV1POSTWalletBackupResponse{}
+	err = f.Client.DoV1Request(req2, &resp2)
+	a.NoError(err)
+	a.NotEmpty(resp2.Backup)
+
+	// Restore it into a different kmd
+	var f2 fixtures.KMDFixture
+	f2.Setup(t)
+	defer f2.Shutdown()
+
+	req3 := kmdapi.APIV1POSTWalletRestoreRequest{
+		WalletName:     "restored",
+		WalletPassword: f.WalletPassword,
+		Backup:         resp2.Backup,
+	}
+	resp3 := kmdapi.APIV1POSTWalletRestoreResponse{}
+	err = f2.Client.DoV1Request(req3, &resp3)
+	a.NoError(err)
+	a.Equal("restored", resp3.Wallet.Name)
+
+	// The restored wallet should hold the generated key
+	req4 := kmdapi.APIV1POSTWalletInitRequest{
+		WalletID:       resp3.Wallet.ID,
+		WalletPassword: f.WalletPassword,
+	}
+	resp4 := kmdapi.APIV1POSTWalletInitResponse{}
+	err = f2.Client.DoV1Request(req4, &resp4)
+	a.NoError(err)
+
+	req5 := kmdapi.APIV1POSTKeyListRequest{
+		WalletHandleToken: resp4.WalletHandleToken,
+	}
+	resp5 := kmdapi.APIV1POSTKeyListResponse{}
+	err = f2.Client.DoV1Request(req5, &resp5)
+	a.NoError(err)
+	a.Equal([]string{resp0.Address}, resp5.Addresses)
+}
@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netsim
+
+import (
+	"time"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/timers"
+)
+
+// virtualClock is a timers.Clock whose timeouts fire in the virtual time of a simulator
+type virtualClock struct {
+	sim      *Simulator
+	zero     time.Duration
+	timeouts map[time.Duration]<-chan time.Time
+}
+
+// MakeClock creates a clock emitting timeouts in the virtual time of the simulator,
+// zeroed at the current virtual time. It can be given to the agreement service of a node.
+func (s *Simulator) MakeClock() timers.Clock {
+	return &virtualClock{sim: s, zero: s.Elapsed()}
+}
+
+// Zero returns a new Clock reset to the current virtual time.
+func (c *virtualClock) Zero() timers.Clock {
+	return c.sim.MakeClock()
+}
+
+// Since returns the virtual time elapsed since the clock was zeroed.
+func (c *virtualClock) Since() time.Duration {
+	return c.sim.Elapsed() - c.zero
+}
+
+// TimeoutAt returns a channel that fires once the virtual time is delta past the zero of the clock.
+func (c *virtualClock) TimeoutAt(delta time.Duration) <-chan time.Time {
+	if c.timeouts == nil {
+		c.timeouts = make(map[time.Duration]<-chan time.Time)
+	}
+	timeoutCh, ok := c.timeouts[delta]
+	if ok {
+		return timeoutCh
+	}
+
+	c.sim.mu.Lock()
+	defer c.sim.mu.Unlock()
+	target := c.zero + delta
+	timeout := make(chan time.Time, 1)
+	if target <= c.sim.now {
+		close(timeout)
+	} else {
+		fireAt := c.sim.epoch.Add(target)
+		c.sim.scheduleLocked(target, func() {
+			timeout <- fireAt
+		})
+	}
+	c.timeouts[delta] = timeout
+	return timeout
+}
+
+// Encode implements timers.Clock.Encode.
+func (c *virtualClock) Encode() []byte {
+	return protocol.EncodeReflect(c.zero)
+}
+
+// Decode implements timers.Clock.Decode.
+func (c *virtualClock) Decode(data []byte) (timers.Clock, error) {
+	var zero time.Duration
+	err := protocol.DecodeReflect(data, &zero)
+	if err != nil {
+		return nil, err
+	}
+	return &virtualClock{sim: c.sim, zero: zero}, nil
+}
+
+func (c *virtualClock) String() string {
+	return c.zero.String()
+}
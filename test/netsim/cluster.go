@@ -0,0 +1,191 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netsim
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+const (
+	// clusterStake is the stake of the account of every node of a cluster
+	clusterStake = 1000000000000
+	// clusterKeyRounds is the number of rounds the participation keys of a cluster are valid for
+	clusterKeyRounds = 10000
+
+	defaultStep   = 50 * time.Millisecond
+	defaultSettle = 10 * time.Millisecond
+)
+
+// Cluster is a set of full nodes with in-memory ledgers, running on a simulated network.
+// Every node participates in consensus with an equal share of the stake.
+type Cluster struct {
+	Sim   *Simulator
+	Nodes []*node.AlgorandFullNode
+	// Net holds the simulated network node of each full node.
+	Net []*Node
+
+	// Step is the virtual time Run advances the simulation by at once, and Settle the real
+	// time it then leaves the nodes to react to the messages and timeouts delivered.
+	Step   time.Duration
+	Settle time.Duration
+}
+
+// accountSecrets derives the secrets of the account of a node of a cluster from the seed of the simulation
+func accountSecrets(seed int64, i int) *crypto.SignatureSecrets {
+	var s crypto.Seed
+	binary.LittleEndian.PutUint64(s[:8], uint64(seed))
+	binary.LittleEndian.PutUint64(s[8:16], uint64(i))
+	copy(s[16:], "netsim-account")
+	return crypto.GenerateSignatureSecrets(s)
+}
+
+// MakeCluster creates numNodes full nodes on the simulated network, keeping their data under
+// rootDir. The accounts of the nodes are derived from the seed of the simulation, but their
+// participation keys are random.
+func MakeCluster(log logging.Logger, rootDir string, numNodes int, sim *Simulator) (*Cluster, error) {
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "netsim",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     basics.Address(crypto.Hash([]byte("netsim-fee-sink"))).String(),
+		RewardsPool: basics.Address(crypto.Hash([]byte("netsim-rewards-pool"))).String(),
+	}
+	for _, addr := range []string{genesis.FeeSink, genesis.RewardsPool} {
+		genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
+			Address: addr,
+			State:   basics.AccountData{Status: basics.NotParticipating, MicroAlgos: basics.MicroAlgos{Raw: clusterStake}},
+		})
+	}
+
+	names := make([]string, numNodes)
+	dirs := make([]string, numNodes)
+	for i := range names {
+		names[i] = fmt.Sprintf("node%d", i)
+		dirs[i] = filepath.Join(rootDir, names[i])
+		genesisDir := filepath.Join(dirs[i], genesis.ID())
+		err := os.MkdirAll(genesisDir, 0700)
+		if err != nil {
+			return nil, err
+		}
+
+		addr := basics.Address(accountSecrets(sim.seed, i).SignatureVerifier)
+		access, err := db.MakeAccessor(filepath.Join(genesisDir, config.PartKeyFilename(names[i], 0, clusterKeyRounds)), false, false)
+		if err != nil {
+			return nil, err
+		}
+		part, err := account.FillDBWithParticipationKeys(access, addr, 0, clusterKeyRounds, config.Consensus[genesis.Proto].DefaultKeyDilution)
+		if err != nil {
+			access.Close()
+			return nil, err
+		}
+		genesis.Allocation = append(genesis.Allocation, bookkeeping.GenesisAllocation{
+			Address: addr.String(),
+			Comment: names[i],
+			State: basics.AccountData{
+				Status:          basics.Online,
+				MicroAlgos:      basics.MicroAlgos{Raw: clusterStake},
+				SelectionID:     part.VRFSecrets().PK,
+				VoteID:          part.VotingSecrets().OneTimeSignatureVerifier,
+				VoteFirstValid:  part.FirstValid,
+				VoteLastValid:   part.LastValid,
+				VoteKeyDilution: part.KeyDilution,
+			},
+		})
+		part.Close()
+	}
+
+	c := &Cluster{Sim: sim, Step: defaultStep, Settle: defaultSettle}
+	for i, name := range names {
+		cfg := config.GetDefaultLocal()
+		netNode := sim.AddNode(name)
+		netNode.GenesisID = genesis.ID()
+		fullNode, err := node.MakeSimulated(log, dirs[i], cfg, genesis, netNode, sim.MakeClock())
+		if err != nil {
+			c.Stop()
+			return nil, err
+		}
+		c.Nodes = append(c.Nodes, fullNode)
+		c.Net = append(c.Net, netNode)
+	}
+	return c, nil
+}
+
+// Start starts all the nodes.
+func (c *Cluster) Start() {
+	for _, n := range c.Nodes {
+		n.Start()
+	}
+}
+
+// Stop stops all the nodes.
+func (c *Cluster) Stop() {
+	for _, n := range c.Nodes {
+		n.Stop()
+	}
+}
+
+// Run advances the virtual time by d, in steps.
+func (c *Cluster) Run(d time.Duration) {
+	for end := c.Sim.Elapsed() + d; c.Sim.Elapsed() < end; {
+		c.Sim.Advance(c.Step)
+		time.Sleep(c.Settle)
+	}
+}
+
+// Rounds returns the latest round of the ledger of every node.
+func (c *Cluster) Rounds() []basics.Round {
+	out := make([]basics.Round, len(c.Nodes))
+	for i, n := range c.Nodes {
+		out[i] = n.Ledger().Latest()
+	}
+	return out
+}
+
+// WaitForRound runs the simulation until all the nodes reached the round, or for at most timeout
+// of virtual time. It returns false if the timeout elapsed first.
+func (c *Cluster) WaitForRound(round basics.Round, timeout time.Duration) bool {
+	for end := c.Sim.Elapsed() + timeout; ; {
+		reached := true
+		for _, r := range c.Rounds() {
+			if r < round {
+				reached = false
+				break
+			}
+		}
+		if reached {
+			return true
+		}
+		if c.Sim.Elapsed() >= end {
+			return false
+		}
+		c.Run(c.Step)
+	}
+}
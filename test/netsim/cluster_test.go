@@ -0,0 +1,60 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netsim
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestClusterPartitionAndHeal(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	if testing.Short() {
+		t.Skip()
+	}
+
+	sim := MakeSimulator(1, LinkConfig{Latency: 50 * time.Millisecond, Jitter: 50 * time.Millisecond})
+	log := logging.TestingLog(t)
+	log.SetLevel(logging.Warn)
+	cluster, err := MakeCluster(log, t.TempDir(), 5, sim)
+	require.NoError(t, err)
+	cluster.Start()
+	defer cluster.Stop()
+
+	require.True(t, cluster.WaitForRound(2, time.Minute), "rounds %v", cluster.Rounds())
+
+	// the majority keeps making progress while the isolated node stalls
+	sim.Partition(cluster.Net[:4], cluster.Net[4:])
+	cluster.Run(time.Second)
+	isolated := cluster.Nodes[4].Ledger().Latest()
+	target := isolated + 3
+	deadline := sim.Elapsed() + 2*time.Minute
+	for cluster.Nodes[0].Ledger().Latest() < target {
+		require.True(t, sim.Elapsed() < deadline, "rounds %v", cluster.Rounds())
+		cluster.Run(time.Second)
+	}
+	require.Equal(t, isolated, cluster.Nodes[4].Ledger().Latest())
+
+	// once healed, the isolated node catches up with the others
+	sim.Heal()
+	require.True(t, cluster.WaitForRound(target+1, 2*time.Minute), "rounds %v", cluster.Rounds())
+}
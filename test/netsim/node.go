@@ -0,0 +1,394 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netsim
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// errPeerUnreachable is returned when requesting something from a peer that can't be reached
+var errPeerUnreachable = errors.New("the simulated peer is unreachable")
+
+var _ network.GossipNode = (*Node)(nil)
+var _ network.UnicastPeer = (*Peer)(nil)
+
+// simulatedProtocolVersion is the protocol version the simulated peers report
+const simulatedProtocolVersion = "2.1"
+
+// Node is a node of a simulated network. It implements network.GossipNode, so that it
+// can replace the websocket network of a node. Since the simulated network carries no
+// HTTP, services relying on HTTP peers aren't available, but requests, such as the
+// catchup ones, are sent over gossip.
+type Node struct {
+	sim  *Simulator
+	name string
+
+	// GenesisID is substituted for {genesisID} in the paths of the node.
+	GenesisID string
+
+	mu       deadlock.Mutex
+	running  bool
+	ready    chan struct{}
+	handlers map[protocol.Tag]network.MessageHandler
+	// peers are the handles of the other nodes, as seen from this node
+	peers map[*Node]*Peer
+	// peerData holds the data attached to the other nodes with SetPeerData
+	peerData map[*Node]map[string]interface{}
+	// requests are the channels waiting for the responses to the requests sent by this node
+	requests      map[uint64]chan *network.Response
+	lastRequestID uint64
+}
+
+// Peer is how a node sees another node of the simulated network. It implements network.UnicastPeer.
+type Peer struct {
+	local  *Node
+	remote *Node
+	// requestID identifies the request the peer sent, if it was given to a handler along with one
+	requestID uint64
+}
+
+func makeNode(sim *Simulator, name string) *Node {
+	ready := make(chan struct{})
+	close(ready)
+	return &Node{
+		sim:      sim,
+		name:     name,
+		ready:    ready,
+		handlers: make(map[protocol.Tag]network.MessageHandler),
+		peers:    make(map[*Node]*Peer),
+		peerData: make(map[*Node]map[string]interface{}),
+		requests: make(map[uint64]chan *network.Response),
+	}
+}
+
+// Name returns the name the node was added to the simulator with.
+func (n *Node) Name() string {
+	return n.name
+}
+
+// peer returns the handle of another node, as seen from this node
+func (n *Node) peer(remote *Node) *Peer {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	p, has := n.peers[remote]
+	if !has {
+		p = &Peer{local: n, remote: remote}
+		n.peers[remote] = p
+	}
+	return p
+}
+
+// reachablePeers returns the handles of the nodes this node can reach, in the order they were added
+func (n *Node) reachablePeers() []*Peer {
+	var out []*Peer
+	for _, remote := range n.sim.Nodes() {
+		if n.sim.reachable(n, remote) {
+			out = append(out, n.peer(remote))
+		}
+	}
+	return out
+}
+
+// handler returns the handler of a tag if the node is running
+func (n *Node) handler(tag protocol.Tag) network.MessageHandler {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.running {
+		return nil
+	}
+	return n.handlers[tag]
+}
+
+// deliver hands a message sent by a peer to the handler of its tag, and acts as the handler asks
+func (n *Node) deliver(sender *Peer, tag protocol.Tag, data []byte) {
+	handler := n.handler(tag)
+	if handler == nil {
+		return
+	}
+	msg := network.IncomingMessage{
+		Sender:   sender,
+		Tag:      tag,
+		Data:     data,
+		Net:      n,
+		Received: time.Now().UnixNano(),
+	}
+	outmsg := handler.Handle(msg)
+	switch outmsg.Action {
+	case network.Disconnect:
+		n.Disconnect(sender)
+	case network.Broadcast:
+		n.Broadcast(context.Background(), tag, data, false, sender)
+	case network.Respond:
+		sender.Respond(context.Background(), msg, outmsg.Topics)
+	}
+}
+
+// sendTo sends a message to another node
+func (n *Node) sendTo(remote *Node, tag protocol.Tag, data []byte) {
+	sender := remote.peer(n)
+	n.sim.send(n, remote, tag, data, func() {
+		remote.deliver(sender, tag, data)
+	})
+}
+
+// exceptNode returns the node a peer handle stands for, if it's one of ours
+func exceptNode(except network.Peer) *Node {
+	if p, ok := except.(*Peer); ok {
+		return p.remote
+	}
+	return nil
+}
+
+// Address returns the name of the node.
+func (n *Node) Address() (string, bool) {
+	return n.name, true
+}
+
+// Broadcast sends a message to all the nodes this node can reach, except the given one.
+func (n *Node) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	exceptRemote := exceptNode(except)
+	for _, p := range n.reachablePeers() {
+		if p.remote != exceptRemote {
+			n.sendTo(p.remote, tag, data)
+		}
+	}
+	return nil
+}
+
+// BroadcastArray broadcasts the messages one after the other.
+func (n *Node) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except network.Peer) error {
+	for i := range tags {
+		n.Broadcast(ctx, tags[i], data[i], wait, except)
+	}
+	return nil
+}
+
+// Relay is the same as Broadcast, since every node of the simulated network relays.
+func (n *Node) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except network.Peer) error {
+	return n.Broadcast(ctx, tag, data, wait, except)
+}
+
+// RelayArray is the same as BroadcastArray.
+func (n *Node) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except network.Peer) error {
+	return n.BroadcastArray(ctx, tags, data, wait, except)
+}
+
+// Disconnect cuts the links between this node and the node of the peer, until the network is healed.
+func (n *Node) Disconnect(badnode network.Peer) {
+	if remote := exceptNode(badnode); remote != nil {
+		n.sim.disconnect(n, remote)
+	}
+}
+
+// DisconnectPeers does nothing, since the nodes of the simulated network don't connect to each other.
+func (n *Node) DisconnectPeers() {
+}
+
+// Ready returns a closed channel, since the node is always connected to the nodes it can reach.
+func (n *Node) Ready() chan struct{} {
+	return n.ready
+}
+
+// RegisterHTTPHandler does nothing, since the simulated network carries no HTTP.
+func (n *Node) RegisterHTTPHandler(path string, handler http.Handler) {
+}
+
+// RequestConnectOutgoing does nothing, since the nodes of the simulated network don't connect to each other.
+func (n *Node) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+}
+
+// GetPeers returns the nodes this node can reach as its outgoing peers. There are no
+// incoming peers, and no phonebook peers, since those are HTTP peers.
+func (n *Node) GetPeers(options ...network.PeerOption) []network.Peer {
+	wantOutgoing := false
+	for _, option := range options {
+		if option == network.PeersConnectedOut {
+			wantOutgoing = true
+		}
+	}
+	if !wantOutgoing {
+		return nil
+	}
+	peers := n.reachablePeers()
+	out := make([]network.Peer, len(peers))
+	for i, p := range peers {
+		out[i] = p
+	}
+	return out
+}
+
+// Start makes the node deliver the messages it receives to its handlers.
+func (n *Node) Start() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.running = true
+}
+
+// Stop makes the node drop the messages it receives.
+func (n *Node) Stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.running = false
+}
+
+// RegisterHandlers adds to the set of given message handlers.
+func (n *Node) RegisterHandlers(dispatch []network.TaggedMessageHandler) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, h := range dispatch {
+		n.handlers[h.Tag] = h.MessageHandler
+	}
+}
+
+// ClearHandlers deregisters all the existing message handlers.
+func (n *Node) ClearHandlers() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.handlers = make(map[protocol.Tag]network.MessageHandler)
+}
+
+// GetRoundTripper returns the default transport, since the simulated network carries no HTTP.
+func (n *Node) GetRoundTripper() http.RoundTripper {
+	return http.DefaultTransport
+}
+
+// OnNetworkAdvance does nothing.
+func (n *Node) OnNetworkAdvance() {
+}
+
+// GetHTTPRequestConnection returns nil, since the simulated network carries no HTTP.
+func (n *Node) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	return nil
+}
+
+// RegisterMessageInterest does nothing, since the nodes receive the messages of every tag.
+func (n *Node) RegisterMessageInterest(protocol.Tag) error {
+	return nil
+}
+
+// SubstituteGenesisID substitutes the "{genesisID}" with the GenesisID of the node.
+func (n *Node) SubstituteGenesisID(rawURL string) string {
+	return strings.Replace(rawURL, "{genesisID}", n.GenesisID, -1)
+}
+
+// GetPeerData returns a value stored by SetPeerData
+func (n *Node) GetPeerData(peer network.Peer, key string) interface{} {
+	remote := exceptNode(peer)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.peerData[remote][key]
+}
+
+// SetPeerData attaches a piece of data to a peer.
+func (n *Node) SetPeerData(peer network.Peer, key string, value interface{}) {
+	remote := exceptNode(peer)
+	if remote == nil {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	data := n.peerData[remote]
+	if data == nil {
+		data = make(map[string]interface{})
+		n.peerData[remote] = data
+	}
+	if value == nil {
+		delete(data, key)
+	} else {
+		data[key] = value
+	}
+}
+
+// GetAddress returns the name of the node of the peer.
+func (p *Peer) GetAddress() string {
+	return p.remote.name
+}
+
+// Unicast sends a message to the node of the peer.
+func (p *Peer) Unicast(ctx context.Context, data []byte, tag protocol.Tag) error {
+	p.local.sendTo(p.remote, tag, data)
+	return nil
+}
+
+// Version returns the protocol version of the simulated peers.
+func (p *Peer) Version() string {
+	return simulatedProtocolVersion
+}
+
+// Request sends a request to the node of the peer, and waits for its response.
+func (p *Peer) Request(ctx context.Context, tag network.Tag, topics network.Topics) (resp *network.Response, e error) {
+	local, remote := p.local, p.remote
+	if !local.sim.reachable(local, remote) {
+		return nil, errPeerUnreachable
+	}
+
+	local.mu.Lock()
+	local.lastRequestID++
+	requestID := local.lastRequestID
+	responseChannel := make(chan *network.Response, 1)
+	local.requests[requestID] = responseChannel
+	local.mu.Unlock()
+	defer func() {
+		local.mu.Lock()
+		delete(local.requests, requestID)
+		local.mu.Unlock()
+	}()
+
+	// the request identifier is part of the message, so that identical requests don't share their fate
+	topics = append(topics, network.MakeTopic("nonce", []byte(fmt.Sprintf("%s/%d", local.name, requestID))))
+	data := topics.MarshallTopics()
+	sender := &Peer{local: remote, remote: local, requestID: requestID}
+	local.sim.send(local, remote, tag, data, func() {
+		remote.deliver(sender, tag, data)
+	})
+
+	select {
+	case resp = <-responseChannel:
+		return resp, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Respond sends the response to a request to the node of the peer that sent it.
+func (p *Peer) Respond(ctx context.Context, reqMsg network.IncomingMessage, topics network.Topics) (e error) {
+	local, remote, requestID := p.local, p.remote, p.requestID
+	resp := &network.Response{Topics: topics}
+	local.sim.send(local, remote, protocol.TopicMsgRespTag, topics.MarshallTopics(), func() {
+		remote.mu.Lock()
+		responseChannel := remote.requests[requestID]
+		remote.mu.Unlock()
+		if responseChannel != nil {
+			select {
+			case responseChannel <- resp:
+			default:
+			}
+		}
+	})
+	return nil
+}
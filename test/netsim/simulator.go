@@ -0,0 +1,273 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package netsim simulates a network of nodes in a single process. Messages cross
+// the links between nodes after a configurable latency, can be lost, and don't cross
+// partitions, all in virtual time that only advances when the simulation is stepped.
+// The fate of every message is drawn from the seed of the simulation and from the
+// message itself, so that a run doesn't depend on the order the goroutines of the
+// nodes happen to send their messages in.
+package netsim
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// LinkConfig describes how messages cross the link from a node to another.
+type LinkConfig struct {
+	// Latency is the time it takes a message to cross the link.
+	Latency time.Duration
+	// Jitter is the most a message may be delayed further than Latency.
+	Jitter time.Duration
+	// Loss is the probability for a message to be lost, between 0 and 1.
+	Loss float64
+}
+
+// link is the directed link from a node to another
+type link struct {
+	from, to *Node
+}
+
+// event is something happening at a point of the virtual time
+type event struct {
+	at  time.Duration
+	seq uint64
+	run func()
+}
+
+// eventQueue is a heap of events, ordered by time, and by scheduling order for events happening at the same time
+type eventQueue []*event
+
+func (q eventQueue) Len() int { return len(q) }
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+func (q eventQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *eventQueue) Push(x interface{}) { *q = append(*q, x.(*event)) }
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	e := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return e
+}
+
+// Simulator is a simulated network. Nodes are connected to each other with links
+// configured by the default LinkConfig unless SetLink configured them otherwise.
+type Simulator struct {
+	mu deadlock.Mutex
+
+	seed  int64
+	epoch time.Time
+	now   time.Duration
+	seq   uint64
+	queue eventQueue
+
+	nodes       []*Node
+	defaultLink LinkConfig
+	links       map[link]LinkConfig
+
+	// groups assigns the nodes to partitions. It's nil when the network isn't partitioned.
+	groups map[*Node]int
+	// cut holds the links cut by the nodes disconnecting from one another.
+	cut map[link]bool
+	// sent counts the messages sent on every link, by content, so that a message
+	// sent again on the same link doesn't necessarily share the fate of the first one.
+	sent map[link]map[crypto.Digest]uint64
+}
+
+// MakeSimulator creates a simulated network, connecting nodes with links configured
+// by defaultLink. Runs with the same seed make the same decisions about the messages.
+func MakeSimulator(seed int64, defaultLink LinkConfig) *Simulator {
+	return &Simulator{
+		seed:        seed,
+		epoch:       time.Unix(0, 0).UTC(),
+		defaultLink: defaultLink,
+		links:       make(map[link]LinkConfig),
+		cut:         make(map[link]bool),
+		sent:        make(map[link]map[crypto.Digest]uint64),
+	}
+}
+
+// AddNode adds a node to the network, connected to all the other nodes.
+func (s *Simulator) AddNode(name string) *Node {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := makeNode(s, name)
+	s.nodes = append(s.nodes, n)
+	return n
+}
+
+// Nodes returns the nodes of the network, in the order they were added.
+func (s *Simulator) Nodes() []*Node {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Node(nil), s.nodes...)
+}
+
+// SetLink configures the links between two nodes, in both directions.
+func (s *Simulator) SetLink(a, b *Node, config LinkConfig) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.links[link{from: a, to: b}] = config
+	s.links[link{from: b, to: a}] = config
+}
+
+// Partition splits the network into groups of nodes that can only reach the nodes of
+// their own group. Nodes that aren't part of any group can't reach any other node.
+func (s *Simulator) Partition(groups ...[]*Node) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.groups = make(map[*Node]int)
+	for i, group := range groups {
+		for _, n := range group {
+			s.groups[n] = i
+		}
+	}
+}
+
+// Heal undoes the partitions, and reconnects the nodes that disconnected from one another.
+func (s *Simulator) Heal() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.groups = nil
+	s.cut = make(map[link]bool)
+}
+
+// disconnect cuts the links between two nodes, until the network is healed.
+func (s *Simulator) disconnect(a, b *Node) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cut[link{from: a, to: b}] = true
+	s.cut[link{from: b, to: a}] = true
+}
+
+// reachableLocked returns true if messages from a node can reach another one. The caller must hold s.mu.
+func (s *Simulator) reachableLocked(from, to *Node) bool {
+	if from == to || s.cut[link{from: from, to: to}] {
+		return false
+	}
+	if s.groups == nil {
+		return true
+	}
+	fromGroup, hasFrom := s.groups[from]
+	toGroup, hasTo := s.groups[to]
+	return hasFrom && hasTo && fromGroup == toGroup
+}
+
+func (s *Simulator) reachable(from, to *Node) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reachableLocked(from, to)
+}
+
+// Now returns the current virtual time.
+func (s *Simulator) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.epoch.Add(s.now)
+}
+
+// Elapsed returns the virtual time elapsed since the simulation started.
+func (s *Simulator) Elapsed() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.now
+}
+
+// scheduleLocked runs f once the virtual time reaches at. The caller must hold s.mu.
+func (s *Simulator) scheduleLocked(at time.Duration, f func()) {
+	s.seq++
+	heap.Push(&s.queue, &event{at: at, seq: s.seq, run: f})
+}
+
+// Advance advances the virtual time by d, delivering the messages and firing the
+// timeouts that are due in the meantime, in order.
+func (s *Simulator) Advance(d time.Duration) {
+	s.mu.Lock()
+	target := s.now + d
+	for len(s.queue) > 0 && s.queue[0].at <= target {
+		e := heap.Pop(&s.queue).(*event)
+		s.now = e.at
+		// events may send messages, and need to take the lock again
+		s.mu.Unlock()
+		e.run()
+		s.mu.Lock()
+	}
+	s.now = target
+	s.mu.Unlock()
+}
+
+// send schedules the delivery of a message from a node to another, unless it's lost or
+// the nodes can't reach each other. The fate of the message is drawn from the seed of
+// the simulation, the link, the tag and data of the message, and the number of times
+// the same message was sent on the link before.
+func (s *Simulator) send(from, to *Node, tag protocol.Tag, data []byte, deliver func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.reachableLocked(from, to) {
+		return
+	}
+	l := link{from: from, to: to}
+	config, has := s.links[l]
+	if !has {
+		config = s.defaultLink
+	}
+
+	digest := s.messageDigest(from, to, tag, data)
+	sent := s.sent[l]
+	if sent == nil {
+		sent = make(map[crypto.Digest]uint64)
+		s.sent[l] = sent
+	}
+	occurrence := sent[digest]
+	sent[digest]++
+	var fateSeed [8]byte
+	binary.LittleEndian.PutUint64(fateSeed[:], occurrence)
+	fate := crypto.Hash(append(digest[:], fateSeed[:]...))
+
+	// the first 8 bytes of the fate decide if the message is lost, the next 8 its jitter
+	if config.Loss > 0 && float64(binary.LittleEndian.Uint64(fate[:8])>>11)/(1<<53) < config.Loss {
+		return
+	}
+	delay := config.Latency
+	if config.Jitter > 0 {
+		delay += time.Duration(binary.LittleEndian.Uint64(fate[8:16]) % uint64(config.Jitter+1))
+	}
+	s.scheduleLocked(s.now+delay, deliver)
+}
+
+// messageDigest identifies a message sent on a link, for the given seed
+func (s *Simulator) messageDigest(from, to *Node, tag protocol.Tag, data []byte) crypto.Digest {
+	buf := make([]byte, 8, 8+len(from.name)+len(to.name)+len(tag)+len(data)+2)
+	binary.LittleEndian.PutUint64(buf, uint64(s.seed))
+	buf = append(buf, from.name...)
+	buf = append(buf, 0)
+	buf = append(buf, to.name...)
+	buf = append(buf, 0)
+	buf = append(buf, tag...)
+	buf = append(buf, data...)
+	return crypto.Hash(buf)
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package netsim
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// recorder records the virtual times at which a node received messages
+type recorder struct {
+	sim      *Simulator
+	received []time.Duration
+	action   network.ForwardingPolicy
+}
+
+func (r *recorder) Handle(msg network.IncomingMessage) network.OutgoingMessage {
+	r.received = append(r.received, r.sim.Elapsed())
+	return network.OutgoingMessage{Action: r.action}
+}
+
+func makeTestNodes(sim *Simulator, count int) ([]*Node, []*recorder) {
+	nodes := make([]*Node, count)
+	recorders := make([]*recorder, count)
+	for i := range nodes {
+		nodes[i] = sim.AddNode(fmt.Sprintf("node%d", i))
+		recorders[i] = &recorder{sim: sim}
+		nodes[i].RegisterHandlers([]network.TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: recorders[i]}})
+		nodes[i].Start()
+	}
+	return nodes, recorders
+}
+
+func TestSimulatorLatency(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim := MakeSimulator(1, LinkConfig{Latency: 100 * time.Millisecond})
+	nodes, recorders := makeTestNodes(sim, 3)
+	sim.SetLink(nodes[0], nodes[2], LinkConfig{Latency: 300 * time.Millisecond})
+
+	require.NoError(t, nodes[0].Broadcast(context.Background(), protocol.TxnTag, []byte{1}, false, nil))
+	sim.Advance(99 * time.Millisecond)
+	require.Empty(t, recorders[1].received)
+	sim.Advance(time.Millisecond)
+	require.Equal(t, []time.Duration{100 * time.Millisecond}, recorders[1].received)
+	require.Empty(t, recorders[2].received)
+	sim.Advance(time.Second)
+	require.Equal(t, []time.Duration{300 * time.Millisecond}, recorders[2].received)
+	require.Empty(t, recorders[0].received)
+	require.Equal(t, 1100*time.Millisecond, sim.Elapsed())
+}
+
+func TestSimulatorReproducible(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	run := func(seed int64) [][]time.Duration {
+		sim := MakeSimulator(seed, LinkConfig{Latency: 10 * time.Millisecond, Jitter: 100 * time.Millisecond, Loss: 0.3})
+		nodes, recorders := makeTestNodes(sim, 4)
+		for i := 0; i < 50; i++ {
+			for _, n := range nodes {
+				n.Broadcast(context.Background(), protocol.TxnTag, []byte{byte(i)}, false, nil)
+			}
+			sim.Advance(10 * time.Millisecond)
+		}
+		sim.Advance(time.Second)
+		out := make([][]time.Duration, len(recorders))
+		for i, r := range recorders {
+			out[i] = r.received
+		}
+		return out
+	}
+
+	first := run(1)
+	require.Equal(t, first, run(1))
+	require.NotEqual(t, first, run(2))
+	for _, received := range first {
+		// 150 messages sent to every node, 30% of them lost
+		require.Greater(t, len(received), 75)
+		require.Less(t, len(received), 135)
+	}
+}
+
+func TestSimulatorPartition(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim := MakeSimulator(1, LinkConfig{Latency: 10 * time.Millisecond})
+	nodes, recorders := makeTestNodes(sim, 3)
+
+	sim.Partition(nodes[:2])
+	require.Len(t, nodes[0].GetPeers(network.PeersConnectedOut), 1)
+	require.Empty(t, nodes[2].GetPeers(network.PeersConnectedOut))
+	nodes[0].Broadcast(context.Background(), protocol.TxnTag, []byte{1}, false, nil)
+	nodes[2].Broadcast(context.Background(), protocol.TxnTag, []byte{2}, false, nil)
+	sim.Advance(time.Second)
+	require.Len(t, recorders[1].received, 1)
+	require.Empty(t, recorders[0].received)
+	require.Empty(t, recorders[2].received)
+
+	sim.Heal()
+	require.Len(t, nodes[2].GetPeers(network.PeersConnectedOut), 2)
+	nodes[2].Broadcast(context.Background(), protocol.TxnTag, []byte{2}, false, nil)
+	sim.Advance(time.Second)
+	require.Len(t, recorders[0].received, 1)
+	require.Len(t, recorders[1].received, 2)
+}
+
+func TestSimulatorHandlerActions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim := MakeSimulator(1, LinkConfig{Latency: 10 * time.Millisecond})
+	nodes, recorders := makeTestNodes(sim, 3)
+
+	// node1 relays what it receives, except to the sender
+	recorders[1].action = network.Broadcast
+	nodes[0].Relay(context.Background(), protocol.TxnTag, []byte{1}, false, nodes[0].peer(nodes[2]))
+	sim.Advance(time.Second)
+	require.Equal(t, []time.Duration{10 * time.Millisecond}, recorders[1].received)
+	require.Equal(t, []time.Duration{20 * time.Millisecond}, recorders[2].received)
+	require.Empty(t, recorders[0].received)
+
+	// node2 disconnects from whoever sends it a message
+	recorders[2].action = network.Disconnect
+	nodes[0].Broadcast(context.Background(), protocol.TxnTag, []byte{2}, false, nil)
+	sim.Advance(time.Second)
+	require.Len(t, recorders[2].received, 3)
+	require.Empty(t, nodes[2].GetPeers(network.PeersConnectedOut))
+	require.Len(t, nodes[0].GetPeers(network.PeersConnectedOut), 1)
+}
+
+func TestSimulatorRequestRespond(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim := MakeSimulator(1, LinkConfig{Latency: 10 * time.Millisecond})
+	server := sim.AddNode("server")
+	client := sim.AddNode("client")
+	server.RegisterHandlers([]network.TaggedMessageHandler{{Tag: protocol.UniEnsBlockReqTag, MessageHandler: network.HandlerFunc(func(msg network.IncomingMessage) network.OutgoingMessage {
+		topics, err := network.UnmarshallTopics(msg.Data)
+		require.NoError(t, err)
+		value, found := topics.GetValue("q")
+		require.True(t, found)
+		return network.OutgoingMessage{Action: network.Respond, Topics: network.Topics{network.MakeTopic("a", append(value, value...))}}
+	})}})
+	server.Start()
+	client.Start()
+
+	peer := client.GetPeers(network.PeersConnectedOut)[0].(network.UnicastPeer)
+	done := make(chan struct{})
+	var resp *network.Response
+	var err error
+	go func() {
+		defer close(done)
+		resp, err = peer.Request(context.Background(), protocol.UniEnsBlockReqTag, network.Topics{network.MakeTopic("q", []byte("x"))})
+	}()
+	for {
+		select {
+		case <-done:
+			require.NoError(t, err)
+			value, found := resp.Topics.GetValue("a")
+			require.True(t, found)
+			require.Equal(t, []byte("xx"), value)
+			return
+		case <-time.After(time.Millisecond):
+			require.Less(t, sim.Elapsed(), time.Second)
+			sim.Advance(time.Millisecond)
+		}
+	}
+}
+
+func TestVirtualClock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sim := MakeSimulator(1, LinkConfig{})
+	sim.Advance(time.Second)
+	clock := sim.MakeClock()
+	timeout := clock.TimeoutAt(100 * time.Millisecond)
+	require.Equal(t, timeout, clock.TimeoutAt(100*time.Millisecond))
+
+	sim.Advance(99 * time.Millisecond)
+	require.Equal(t, 99*time.Millisecond, clock.Since())
+	select {
+	case <-timeout:
+		require.Fail(t, "timeout fired early")
+	default:
+	}
+	sim.Advance(time.Millisecond)
+	select {
+	case fired := <-timeout:
+		require.Equal(t, sim.Now(), fired)
+	default:
+		require.Fail(t, "timeout didn't fire")
+	}
+
+	// timeouts already past fire right away
+	<-clock.TimeoutAt(50 * time.Millisecond)
+
+	decoded, err := clock.Decode(clock.Encode())
+	require.NoError(t, err)
+	require.Equal(t, clock.Since(), decoded.Since())
+	require.Equal(t, time.Duration(0), clock.Zero().Since())
+}
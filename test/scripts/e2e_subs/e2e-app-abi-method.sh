@@ -53,6 +53,45 @@ if [[ $RES != *"${EXPECTED}"* ]]; then
     false
 fi
 
+# 1 + 2 = 3, through an ARC-4 contract description holding the app ID of this network
+GENESIS_HASH=$(goal node status | grep '^Genesis hash: ' | awk '{ print $3 }')
+cat > "${TEMPDIR}/contract.json" <<EOF
+{
+  "name": "AbiMethodExample",
+  "networks": {"${GENESIS_HASH}": {"appID": ${APPID}}},
+  "methods": [
+    {"name": "add", "args": [{"name": "a", "type": "uint64"}, {"name": "b", "type": "uint64"}], "returns": {"type": "uint64"}}
+  ]
+}
+EOF
+RES=$(${gcmd} app method --contract "${TEMPDIR}/contract.json" --method add --arg 1 --arg 2 --from $ACCOUNT 2>&1 || true)
+EXPECTED="method add(uint64,uint64)uint64 succeeded with output: 3"
+if [[ $RES != *"${EXPECTED}"* ]]; then
+    date '+app-abi-method-test FAIL the method call to add through the contract description should not fail %Y%m%d_%H%M%S'
+    false
+fi
+
+# read-only methods of a contract are simulated instead of submitted, unless --submit is given
+cat > "${TEMPDIR}/contract-readonly.json" <<EOF
+{
+  "name": "AbiMethodExample",
+  "networks": {"${GENESIS_HASH}": {"appID": ${APPID}}},
+  "methods": [
+    {"name": "add", "args": [{"name": "a", "type": "uint64"}, {"name": "b", "type": "uint64"}], "returns": {"type": "uint64"}, "readonly": true}
+  ]
+}
+EOF
+RES=$(${gcmd} app method --contract "${TEMPDIR}/contract-readonly.json" --method add --arg 1 --arg 2 --from $ACCOUNT 2>&1 || true)
+if [[ $RES == *"Issued"* ]]; then
+    date '+app-abi-method-test FAIL the read-only method call to add should not be submitted %Y%m%d_%H%M%S'
+    false
+fi
+RES=$(${gcmd} app method --contract "${TEMPDIR}/contract-readonly.json" --method add --arg 1 --arg 2 --submit --from $ACCOUNT 2>&1 || true)
+if [[ $RES != *"Issued"* || $RES != *"${EXPECTED}"* ]]; then
+    date '+app-abi-method-test FAIL the read-only method call to add with --submit should be submitted %Y%m%d_%H%M%S'
+    false
+fi
+
 # 18446744073709551614 + 1 = 18446744073709551615
 RES=$(${gcmd} app method --method "add(uint64,uint64)uint64" --arg 18446744073709551614 --arg 1 --app-id $APPID --from $ACCOUNT 2>&1 || true)
 EXPECTED="method add(uint64,uint64)uint64 succeeded with output: 18446744073709551615"
@@ -83,6 +83,13 @@ func MakeAccessor(dbfilename string, readOnly bool, inMemory bool) (Accessor, er
 	return makeAccessorImpl(dbfilename, readOnly, inMemory, []string{"_journal_mode=wal"})
 }
 
+// MakeImmutableAccessor creates a new read-only Accessor for an existing database which no other
+// process modifies while it is open. SQLite neither locks the database nor creates the files of its
+// write-ahead log, so the database is left untouched.
+func MakeImmutableAccessor(dbfilename string) (Accessor, error) {
+	return makeAccessorImpl(dbfilename, true, false, []string{"mode=ro", "immutable=1"})
+}
+
 // MakeErasableAccessor creates a new Accessor with the secure_delete pragma set;
 // see https://www.sqlite.org/pragma.html#pragma_secure_delete
 // It is not read-only and not in-memory (otherwise, erasability doesn't matter)